@@ -39,7 +39,10 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	sr.HandleFunc("/feeds/{feedID}", handler.getFeed).Methods("GET")
 	sr.HandleFunc("/feeds/{feedID}", handler.updateFeed).Methods("PUT")
 	sr.HandleFunc("/feeds/{feedID}", handler.removeFeed).Methods("DELETE")
+	sr.HandleFunc("/feeds/{feedID}/preview", handler.previewFeed).Methods("POST")
+	sr.HandleFunc("/feeds/{feedID}/deduplicate", handler.deduplicateFeed).Methods("PUT")
 	sr.HandleFunc("/feeds/{feedID}/icon", handler.feedIcon).Methods("GET")
+	sr.HandleFunc("/feeds/{feedID}/icon/refresh", handler.refreshFeedIcon).Methods("PUT")
 	sr.HandleFunc("/export", handler.exportFeeds).Methods("GET")
 	sr.HandleFunc("/import", handler.importFeeds).Methods("POST")
 	sr.HandleFunc("/feeds/{feedID}/entries", handler.getFeedEntries).Methods("GET")
@@ -47,5 +50,28 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	sr.HandleFunc("/entries", handler.getEntries).Methods("GET")
 	sr.HandleFunc("/entries", handler.setEntryStatus).Methods("PUT")
 	sr.HandleFunc("/entries/{entryID}", handler.getEntry).Methods("GET")
+	sr.HandleFunc("/entries/{entryID}/revisions", handler.getEntryRevisions).Methods("GET")
 	sr.HandleFunc("/entries/{entryID}/bookmark", handler.toggleBookmark).Methods("PUT")
+	sr.HandleFunc("/entries/{entryID}/labels", handler.getEntryLabels).Methods("GET")
+	sr.HandleFunc("/entries/{entryID}/labels", handler.setEntryLabels).Methods("PUT")
+	sr.HandleFunc("/entries/{entryID}/highlights", handler.getEntryHighlights).Methods("GET")
+	sr.HandleFunc("/entries/{entryID}/highlights", handler.createHighlight).Methods("POST")
+	sr.HandleFunc("/highlights", handler.getHighlights).Methods("GET")
+	sr.HandleFunc("/highlights/{highlightID}", handler.getHighlight).Methods("GET")
+	sr.HandleFunc("/highlights/{highlightID}", handler.updateHighlight).Methods("PUT")
+	sr.HandleFunc("/highlights/{highlightID}", handler.removeHighlight).Methods("DELETE")
+	sr.HandleFunc("/labels", handler.getLabels).Methods("GET")
+	sr.HandleFunc("/labels", handler.createLabel).Methods("POST")
+	sr.HandleFunc("/labels/{labelID}", handler.updateLabel).Methods("PUT")
+	sr.HandleFunc("/labels/{labelID}", handler.removeLabel).Methods("DELETE")
+	sr.HandleFunc("/folders", handler.getSmartFolders).Methods("GET")
+	sr.HandleFunc("/folders", handler.createSmartFolder).Methods("POST")
+	sr.HandleFunc("/folders/{folderID}", handler.getSmartFolder).Methods("GET")
+	sr.HandleFunc("/folders/{folderID}", handler.updateSmartFolder).Methods("PUT")
+	sr.HandleFunc("/folders/{folderID}", handler.removeSmartFolder).Methods("DELETE")
+	sr.HandleFunc("/folders/{folderID}/entries", handler.getSmartFolderEntries).Methods("GET")
+	sr.HandleFunc("/filters", handler.getFilterRules).Methods("GET")
+	sr.HandleFunc("/filters", handler.createFilterRule).Methods("POST")
+	sr.HandleFunc("/filters/{ruleID}", handler.updateFilterRule).Methods("PUT")
+	sr.HandleFunc("/filters/{ruleID}", handler.removeFilterRule).Methods("DELETE")
 }
@@ -10,6 +10,10 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/reader/extractor"
+	"miniflux.app/reader/rewrite"
+	"miniflux.app/reader/scraper"
 )
 
 func (h *handler) createCategory(w http.ResponseWriter, r *http.Request) {
@@ -26,6 +30,26 @@ func (h *handler) createCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, err := extractor.ParseChain(category.ContentExtractors); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateFilterMode(category.FilterMode); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if _, err := scraper.ParseRules(category.ScraperRules); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := rewrite.ValidateRules(category.RewriteRules); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
 	if c, err := h.store.CategoryByTitle(userID, category.Title); err != nil || c != nil {
 		json.BadRequest(w, r, errors.New("This category already exists"))
 		return
@@ -42,19 +66,49 @@ func (h *handler) createCategory(w http.ResponseWriter, r *http.Request) {
 func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
 	categoryID := request.RouteInt64Param(r, "categoryID")
 
-	category, err := decodeCategoryPayload(r.Body)
+	categoryChanges, err := decodeCategoryModificationPayload(r.Body)
 	if err != nil {
 		json.BadRequest(w, r, err)
 		return
 	}
 
-	category.UserID = request.UserID(r)
-	category.ID = categoryID
+	category, err := h.store.Category(request.UserID(r), categoryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	categoryChanges.Update(category)
 	if err := category.ValidateCategoryModification(); err != nil {
 		json.BadRequest(w, r, err)
 		return
 	}
 
+	if _, err := extractor.ParseChain(category.ContentExtractors); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateFilterMode(category.FilterMode); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if _, err := scraper.ParseRules(category.ScraperRules); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := rewrite.ValidateRules(category.RewriteRules); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
 	err = h.store.UpdateCategory(category)
 	if err != nil {
 		json.ServerError(w, r, err)
@@ -56,6 +56,32 @@ func (h *handler) getEntry(w http.ResponseWriter, r *http.Request) {
 	json.OK(w, r, entry)
 }
 
+func (h *handler) getEntryRevisions(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithEntryID(entryID)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	revisions, err := h.store.EntryRevisions(userID, entry.ID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, revisions)
+}
+
 func (h *handler) getFeedEntries(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 
@@ -217,6 +243,11 @@ func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) {
 		builder.WithCategoryID(categoryID)
 	}
 
+	labelID := request.QueryInt64Param(r, "label_id", 0)
+	if labelID > 0 {
+		builder.WithLabelID(labelID)
+	}
+
 	if request.HasQueryParam(r, "starred") {
 		builder.WithStarred()
 	}
@@ -8,8 +8,13 @@ import (
 	"errors"
 	"net/http"
 
+	"miniflux.app/config"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/reader/extractor"
+	"miniflux.app/reader/rewrite"
+	"miniflux.app/reader/scraper"
 )
 
 func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
@@ -41,17 +46,56 @@ func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	feed, err := h.feedHandler.CreateFeed(
-		userID,
-		feedInfo.CategoryID,
-		feedInfo.FeedURL,
-		feedInfo.Crawler,
-		feedInfo.UserAgent,
-		feedInfo.Username,
-		feedInfo.Password,
-		feedInfo.ScraperRules,
-		feedInfo.RewriteRules,
-	)
+	if err := model.ValidateFeedSourceType(feedInfo.SourceType); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if model.IsLocalSourceType(feedInfo.SourceType) {
+		if !config.Opts.HasLocalSources() {
+			json.BadRequest(w, r, errors.New("Local sources are disabled"))
+			return
+		}
+
+		if !request.IsAdminUser(r) {
+			json.Forbidden(w, r)
+			return
+		}
+	}
+
+	if feedInfo.SourceType == model.FeedSourceTypeWebPage {
+		if err := feedInfo.SourceSettings.WebPage.Validate(); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
+	if err := feedInfo.SourceSettings.PageMonitor.Validate(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if _, err := scraper.ParseRules(feedInfo.ScraperRules); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := rewrite.ValidateRules(feedInfo.RewriteRules); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateFilterMode(feedInfo.FilterMode); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateEntryIdentity(feedInfo.EntryIdentity); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	feed, err := h.feedHandler.CreateFeed(userID, feedInfo.Request())
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -82,6 +126,45 @@ func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
 	json.NoContent(w, r)
 }
 
+func (h *handler) deduplicateFeed(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	userID := request.UserID(r)
+
+	if !h.store.FeedExists(userID, feedID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	count, err := h.store.DeduplicateEntries(userID, feedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	type result struct {
+		MergedEntries int `json:"merged_entries"`
+	}
+
+	json.OK(w, r, &result{MergedEntries: count})
+}
+
+func (h *handler) refreshFeedIcon(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	userID := request.UserID(r)
+
+	if !h.store.FeedExists(userID, feedID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.feedHandler.RefreshFeedIcon(userID, feedID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
 func (h *handler) refreshAllFeeds(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	jobs, err := h.store.NewUserBatch(userID, h.store.CountFeeds(userID))
@@ -118,8 +201,14 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	previousIdentity := originalFeed.EntryIdentity
 	feedChanges.Update(originalFeed)
 
+	if err := validateFeedSettings(originalFeed); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
 	if !h.store.CategoryExists(userID, originalFeed.Category.ID) {
 		json.BadRequest(w, r, errors.New("This category_id doesn't exists or doesn't belongs to this user"))
 		return
@@ -130,6 +219,14 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The stored entries must be recognized with the new strategy when the feed is refreshed.
+	if originalFeed.EntryIdentity != previousIdentity {
+		if _, err := h.store.DeduplicateEntries(userID, feedID); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+	}
+
 	originalFeed, err = h.store.FeedByID(userID, feedID)
 	if err != nil {
 		json.ServerError(w, r, err)
@@ -181,3 +278,34 @@ func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
 
 	json.NoContent(w, r)
 }
+
+// validateFeedSettings makes sure the settings of the feed can be applied to the entries.
+func validateFeedSettings(feed *model.Feed) error {
+	if feed.IsWebPage() {
+		if err := feed.SourceSettings.WebPage.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := feed.SourceSettings.PageMonitor.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := extractor.ParseChain(feed.ContentExtractors); err != nil {
+		return err
+	}
+
+	if _, err := scraper.ParseRules(feed.ScraperRules); err != nil {
+		return err
+	}
+
+	if err := rewrite.ValidateRules(feed.RewriteRules); err != nil {
+		return err
+	}
+
+	if err := model.ValidateFilterMode(feed.FilterMode); err != nil {
+		return err
+	}
+
+	return model.ValidateEntryIdentity(feed.EntryIdentity)
+}
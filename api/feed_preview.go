@@ -0,0 +1,63 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/reader/filter"
+)
+
+// previewFeed applies the proposed settings to the latest entries of the feed without saving anything.
+//
+// The stored filter rules are used unless the payload contains a list of rules,
+// the rules without ID are numbered in order to identify them in the result.
+func (h *handler) previewFeed(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	preview, err := decodeFeedPreviewPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	feed, err := h.store.FeedByID(request.UserID(r), feedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	preview.Update(feed)
+
+	if err := validateFeedSettings(feed); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	for i, rule := range preview.FilterRules {
+		if rule.ID == 0 {
+			rule.ID = int64(i + 1)
+		}
+
+		if err := filter.ValidateRule(rule); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
+	previews, err := h.feedHandler.PreviewFeed(feed, preview.FilterRules, preview.Request())
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, previews)
+}
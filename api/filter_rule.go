@@ -0,0 +1,118 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/reader/filter"
+)
+
+func (h *handler) getFilterRules(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.store.FilterRules(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, rules)
+}
+
+func (h *handler) createFilterRule(w http.ResponseWriter, r *http.Request) {
+	rule, err := decodeFilterRulePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	rule.UserID = request.UserID(r)
+	if err := h.validateFilterRule(rule); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.CreateFilterRule(rule); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, rule)
+}
+
+func (h *handler) updateFilterRule(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	ruleID := request.RouteInt64Param(r, "ruleID")
+
+	originalRule, err := h.store.FilterRule(userID, ruleID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if originalRule == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	rule, err := decodeFilterRulePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	rule.ID = originalRule.ID
+	rule.UserID = userID
+	rule.HitCount = originalRule.HitCount
+	rule.LastHitAt = originalRule.LastHitAt
+	rule.CreatedAt = originalRule.CreatedAt
+	if err := h.validateFilterRule(rule); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.UpdateFilterRule(rule); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, rule)
+}
+
+func (h *handler) removeFilterRule(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	ruleID := request.RouteInt64Param(r, "ruleID")
+
+	if !h.store.FilterRuleExists(userID, ruleID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveFilterRule(userID, ruleID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) validateFilterRule(rule *model.FilterRule) error {
+	if err := filter.ValidateRule(rule); err != nil {
+		return err
+	}
+
+	if rule.CategoryID > 0 && !h.store.CategoryExists(rule.UserID, rule.CategoryID) {
+		return errors.New("This category does not exist or does not belong to this user")
+	}
+
+	if rule.FeedID > 0 && !h.store.FeedExists(rule.UserID, rule.FeedID) {
+		return errors.New("This feed does not exist or does not belong to this user")
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+func (h *handler) createHighlight(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	highlight, err := decodeHighlightPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if !h.store.EntryExists(userID, entryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	highlight.UserID = userID
+	highlight.EntryID = entryID
+	if err := highlight.ValidateHighlight(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.CreateHighlight(highlight); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, highlight)
+}
+
+func (h *handler) updateHighlight(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	highlightID := request.RouteInt64Param(r, "highlightID")
+
+	highlight, err := h.store.Highlight(userID, highlightID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if highlight == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	// Only the note can be changed, the passage stays the same.
+	changes, err := decodeHighlightPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	highlight.Note = changes.Note
+	if err := h.store.UpdateHighlight(highlight); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, highlight)
+}
+
+func (h *handler) getHighlights(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	limit := request.QueryIntParam(r, "limit", 100)
+	offset := request.QueryIntParam(r, "offset", 0)
+	if err := model.ValidateRange(offset, limit); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	highlights, err := h.store.Highlights(userID, offset, limit)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	count, err := h.store.CountHighlights(userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &highlightsResponse{Total: count, Highlights: highlights})
+}
+
+func (h *handler) getHighlight(w http.ResponseWriter, r *http.Request) {
+	highlight, err := h.store.Highlight(request.UserID(r), request.RouteInt64Param(r, "highlightID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if highlight == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, highlight)
+}
+
+func (h *handler) removeHighlight(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	highlightID := request.RouteInt64Param(r, "highlightID")
+
+	highlight, err := h.store.Highlight(userID, highlightID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if highlight == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveHighlight(userID, highlightID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) getEntryHighlights(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	if !h.store.EntryExists(userID, entryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	highlights, err := h.store.EntryHighlights(userID, entryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, highlights)
+}
@@ -0,0 +1,173 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+)
+
+func (h *handler) createLabel(w http.ResponseWriter, r *http.Request) {
+	label, err := decodeLabelPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	label.UserID = request.UserID(r)
+	if err := label.ValidateLabel(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if h.store.AnotherLabelExists(label.UserID, 0, label.Title) {
+		json.BadRequest(w, r, errors.New("This label already exists"))
+		return
+	}
+
+	if err := h.store.CreateLabel(label); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, label)
+}
+
+func (h *handler) updateLabel(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	labelID := request.RouteInt64Param(r, "labelID")
+
+	originalLabel, err := h.store.Label(userID, labelID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if originalLabel == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	label, err := decodeLabelPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	label.ID = labelID
+	label.UserID = userID
+	if err := label.ValidateLabel(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if h.store.AnotherLabelExists(userID, labelID, label.Title) {
+		json.BadRequest(w, r, errors.New("This label already exists"))
+		return
+	}
+
+	if err := h.store.UpdateLabel(label); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, label)
+}
+
+func (h *handler) getLabels(w http.ResponseWriter, r *http.Request) {
+	labels, err := h.store.Labels(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, labels)
+}
+
+func (h *handler) removeLabel(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	labelID := request.RouteInt64Param(r, "labelID")
+
+	label, err := h.store.Label(userID, labelID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if label == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveLabel(userID, labelID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) getEntryLabels(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	if !h.store.EntryExists(userID, entryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	labels, err := h.store.EntryLabels(userID, entryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, labels)
+}
+
+func (h *handler) setEntryLabels(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	labelIDs, err := decodeEntryLabelsPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if !h.store.EntryExists(userID, entryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	for _, labelID := range labelIDs {
+		label, err := h.store.Label(userID, labelID)
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		if label == nil {
+			json.BadRequest(w, r, errors.New("This label does not exist"))
+			return
+		}
+	}
+
+	if err := h.store.SetEntryLabels(userID, entryID, labelIDs); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	labels, err := h.store.EntryLabels(userID, entryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, labels)
+}
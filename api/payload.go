@@ -23,15 +23,41 @@ type entriesResponse struct {
 	Entries model.Entries `json:"entries"`
 }
 
+type highlightsResponse struct {
+	Total      int              `json:"total"`
+	Highlights model.Highlights `json:"highlights"`
+}
+
 type feedCreation struct {
-	FeedURL      string `json:"feed_url"`
-	CategoryID   int64  `json:"category_id"`
-	UserAgent    string `json:"user_agent"`
-	Username     string `json:"username"`
-	Password     string `json:"password"`
-	Crawler      bool   `json:"crawler"`
-	ScraperRules string `json:"scraper_rules"`
-	RewriteRules string `json:"rewrite_rules"`
+	FeedURL        string               `json:"feed_url"`
+	CategoryID     int64                `json:"category_id"`
+	UserAgent      string               `json:"user_agent"`
+	Username       string               `json:"username"`
+	Password       string               `json:"password"`
+	Crawler        bool                 `json:"crawler"`
+	ScraperRules   string               `json:"scraper_rules"`
+	RewriteRules   string               `json:"rewrite_rules"`
+	SourceType     string               `json:"source_type"`
+	SourceSettings model.SourceSettings `json:"source_settings"`
+	FilterMode     string               `json:"filter_mode"`
+	EntryIdentity  string               `json:"entry_identity"`
+}
+
+func (f *feedCreation) Request() *model.FeedCreationRequest {
+	return &model.FeedCreationRequest{
+		FeedURL:        f.FeedURL,
+		CategoryID:     f.CategoryID,
+		UserAgent:      f.UserAgent,
+		Username:       f.Username,
+		Password:       f.Password,
+		Crawler:        f.Crawler,
+		ScraperRules:   f.ScraperRules,
+		RewriteRules:   f.RewriteRules,
+		SourceType:     f.SourceType,
+		SourceSettings: f.SourceSettings,
+		FilterMode:     f.FilterMode,
+		EntryIdentity:  f.EntryIdentity,
+	}
 }
 
 type subscriptionDiscovery struct {
@@ -42,17 +68,23 @@ type subscriptionDiscovery struct {
 }
 
 type feedModification struct {
-	FeedURL      *string `json:"feed_url"`
-	SiteURL      *string `json:"site_url"`
-	Title        *string `json:"title"`
-	ScraperRules *string `json:"scraper_rules"`
-	RewriteRules *string `json:"rewrite_rules"`
-	Crawler      *bool   `json:"crawler"`
-	UserAgent    *string `json:"user_agent"`
-	Username     *string `json:"username"`
-	Password     *string `json:"password"`
-	CategoryID   *int64  `json:"category_id"`
-	Disabled     *bool   `json:"disabled"`
+	FeedURL         *string               `json:"feed_url"`
+	SiteURL         *string               `json:"site_url"`
+	Title           *string               `json:"title"`
+	ScraperRules    *string               `json:"scraper_rules"`
+	RewriteRules    *string               `json:"rewrite_rules"`
+	Crawler         *bool                 `json:"crawler"`
+	CrawlerOverride *bool                 `json:"crawler_override"`
+	UserAgent       *string               `json:"user_agent"`
+	Username        *string               `json:"username"`
+	Password        *string               `json:"password"`
+	CategoryID      *int64                `json:"category_id"`
+	Disabled        *bool                 `json:"disabled"`
+	SourceSettings  *model.SourceSettings `json:"source_settings"`
+
+	ContentExtractors *string `json:"content_extractors"`
+	FilterMode        *string `json:"filter_mode"`
+	EntryIdentity     *string `json:"entry_identity"`
 }
 
 func (f *feedModification) Update(feed *model.Feed) {
@@ -80,6 +112,10 @@ func (f *feedModification) Update(feed *model.Feed) {
 		feed.Crawler = *f.Crawler
 	}
 
+	if f.CrawlerOverride != nil {
+		feed.CrawlerOverride = *f.CrawlerOverride
+	}
+
 	if f.UserAgent != nil {
 		feed.UserAgent = *f.UserAgent
 	}
@@ -99,6 +135,78 @@ func (f *feedModification) Update(feed *model.Feed) {
 	if f.Disabled != nil {
 		feed.Disabled = *f.Disabled
 	}
+
+	if f.SourceSettings != nil {
+		feed.SourceSettings = *f.SourceSettings
+	}
+
+	if f.ContentExtractors != nil {
+		feed.ContentExtractors = *f.ContentExtractors
+	}
+
+	if f.FilterMode != nil {
+		feed.FilterMode = *f.FilterMode
+	}
+
+	if f.EntryIdentity != nil {
+		feed.EntryIdentity = *f.EntryIdentity
+	}
+}
+
+type categoryModification struct {
+	Title             *string `json:"title"`
+	ContentExtractors *string `json:"content_extractors"`
+	FilterMode        *string `json:"filter_mode"`
+	Crawler           *bool   `json:"crawler"`
+	UserAgent         *string `json:"user_agent"`
+	ScraperRules      *string `json:"scraper_rules"`
+	RewriteRules      *string `json:"rewrite_rules"`
+}
+
+func (c *categoryModification) Update(category *model.Category) {
+	if c.Title != nil {
+		category.Title = *c.Title
+	}
+
+	if c.ContentExtractors != nil {
+		category.ContentExtractors = *c.ContentExtractors
+	}
+
+	if c.FilterMode != nil {
+		category.FilterMode = *c.FilterMode
+	}
+
+	if c.Crawler != nil {
+		category.Crawler = *c.Crawler
+	}
+
+	if c.UserAgent != nil {
+		category.UserAgent = *c.UserAgent
+	}
+
+	if c.ScraperRules != nil {
+		category.ScraperRules = *c.ScraperRules
+	}
+
+	if c.RewriteRules != nil {
+		category.RewriteRules = *c.RewriteRules
+	}
+}
+
+type feedPreview struct {
+	feedModification
+	FilterRules model.FilterRules `json:"filter_rules"`
+	Limit       int               `json:"limit"`
+	Refetch     bool              `json:"refetch"`
+	Crawl       bool              `json:"crawl"`
+}
+
+func (f *feedPreview) Request() *model.FeedPreviewRequest {
+	return &model.FeedPreviewRequest{
+		Limit:   f.Limit,
+		Refetch: f.Refetch,
+		Crawl:   f.Crawl,
+	}
 }
 
 type userModification struct {
@@ -217,6 +325,18 @@ func decodeFeedModificationPayload(r io.ReadCloser) (*feedModification, error) {
 	return &feed, nil
 }
 
+func decodeFeedPreviewPayload(r io.ReadCloser) (*feedPreview, error) {
+	defer r.Close()
+
+	var preview feedPreview
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&preview); err != nil {
+		return nil, fmt.Errorf("Unable to decode feed preview JSON object: %v", err)
+	}
+
+	return &preview, nil
+}
+
 func decodeCategoryPayload(r io.ReadCloser) (*model.Category, error) {
 	var category model.Category
 
@@ -228,3 +348,78 @@ func decodeCategoryPayload(r io.ReadCloser) (*model.Category, error) {
 
 	return &category, nil
 }
+
+func decodeCategoryModificationPayload(r io.ReadCloser) (*categoryModification, error) {
+	defer r.Close()
+
+	var category categoryModification
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&category); err != nil {
+		return nil, fmt.Errorf("Unable to decode category modification JSON object: %v", err)
+	}
+
+	return &category, nil
+}
+
+func decodeLabelPayload(r io.ReadCloser) (*model.Label, error) {
+	var label model.Label
+
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&label); err != nil {
+		return nil, fmt.Errorf("Unable to decode label JSON object: %v", err)
+	}
+
+	return &label, nil
+}
+
+func decodeSmartFolderPayload(r io.ReadCloser) (*model.SmartFolder, error) {
+	var folder model.SmartFolder
+
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&folder); err != nil {
+		return nil, fmt.Errorf("Unable to decode smart folder JSON object: %v", err)
+	}
+
+	return &folder, nil
+}
+
+func decodeHighlightPayload(r io.ReadCloser) (*model.Highlight, error) {
+	var highlight model.Highlight
+
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&highlight); err != nil {
+		return nil, fmt.Errorf("Unable to decode highlight JSON object: %v", err)
+	}
+
+	return &highlight, nil
+}
+
+func decodeEntryLabelsPayload(r io.ReadCloser) ([]int64, error) {
+	type payload struct {
+		LabelIDs []int64 `json:"label_ids"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	return p.LabelIDs, nil
+}
+
+func decodeFilterRulePayload(r io.ReadCloser) (*model.FilterRule, error) {
+	var rule model.FilterRule
+
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&rule); err != nil {
+		return nil, fmt.Errorf("Unable to decode filter rule JSON object: %v", err)
+	}
+
+	return &rule, nil
+}
@@ -197,6 +197,57 @@ func TestUpdateFeedCategoryWhenNotSet(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedSourceSettings(t *testing.T) {
+	settings := &model.SourceSettings{WebPage: &model.WebPageRules{ItemSelector: "article", TitleSelector: "h2"}}
+	changes := &feedModification{SourceSettings: settings}
+	feed := &model.Feed{SourceType: model.FeedSourceTypeWebPage}
+	changes.Update(feed)
+
+	if feed.SourceSettings.WebPage == nil || feed.SourceSettings.WebPage.ItemSelector != "article" {
+		t.Fatalf(`Unexpected value, got %v`, feed.SourceSettings.WebPage)
+	}
+}
+
+func TestUpdateFeedSourceSettingsWhenNotSet(t *testing.T) {
+	changes := &feedModification{}
+	feed := &model.Feed{SourceSettings: model.SourceSettings{WebPage: &model.WebPageRules{ItemSelector: "li"}}}
+	changes.Update(feed)
+
+	if feed.SourceSettings.WebPage.ItemSelector != "li" {
+		t.Fatal(`The source settings should not be modified`)
+	}
+}
+
+func TestUpdateCategoryTitle(t *testing.T) {
+	title := "New title"
+	changes := &categoryModification{Title: &title}
+	category := &model.Category{Title: "Old title", Crawler: true, UserAgent: "Category UA"}
+	changes.Update(category)
+
+	if category.Title != title {
+		t.Fatalf(`Unexpected value, got %q instead of %q`, category.Title, title)
+	}
+
+	if !category.Crawler || category.UserAgent != "Category UA" {
+		t.Fatalf(`The category settings should not be modified: %+v`, category)
+	}
+}
+
+func TestUpdateCategoryCrawler(t *testing.T) {
+	crawler := false
+	changes := &categoryModification{Crawler: &crawler}
+	category := &model.Category{Title: "Title", Crawler: true}
+	changes.Update(category)
+
+	if category.Crawler {
+		t.Fatal(`The category crawler should be disabled`)
+	}
+
+	if category.Title != "Title" {
+		t.Fatal(`The category title should not be modified`)
+	}
+}
+
 func TestUpdateUserTheme(t *testing.T) {
 	theme := "Example 2"
 	changes := &userModification{Theme: &theme}
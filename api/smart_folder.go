@@ -0,0 +1,207 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+func (h *handler) createSmartFolder(w http.ResponseWriter, r *http.Request) {
+	folder, err := decodeSmartFolderPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	folder.UserID = request.UserID(r)
+	if err := folder.ValidateSmartFolder(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if h.store.AnotherSmartFolderExists(folder.UserID, 0, folder.Title) {
+		json.BadRequest(w, r, errors.New("This smart folder already exists"))
+		return
+	}
+
+	if err := h.store.CreateSmartFolder(folder); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	// The stored folder has its arrays normalized and its unread counter.
+	folder, err = h.store.SmartFolder(folder.UserID, folder.ID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, folder)
+}
+
+func (h *handler) updateSmartFolder(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	folderID := request.RouteInt64Param(r, "folderID")
+
+	originalFolder, err := h.store.SmartFolder(userID, folderID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if originalFolder == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	folder, err := decodeSmartFolderPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	folder.ID = folderID
+	folder.UserID = userID
+	if err := folder.ValidateSmartFolder(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if h.store.AnotherSmartFolderExists(userID, folderID, folder.Title) {
+		json.BadRequest(w, r, errors.New("This smart folder already exists"))
+		return
+	}
+
+	if err := h.store.UpdateSmartFolder(folder); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	// The stored folder has its arrays normalized and its unread counter.
+	folder, err = h.store.SmartFolder(userID, folderID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, folder)
+}
+
+func (h *handler) getSmartFolders(w http.ResponseWriter, r *http.Request) {
+	folders, err := h.store.SmartFolders(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, folders)
+}
+
+func (h *handler) getSmartFolder(w http.ResponseWriter, r *http.Request) {
+	folder, err := h.store.SmartFolder(request.UserID(r), request.RouteInt64Param(r, "folderID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if folder == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, folder)
+}
+
+func (h *handler) removeSmartFolder(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	folderID := request.RouteInt64Param(r, "folderID")
+
+	folder, err := h.store.SmartFolder(userID, folderID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if folder == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveSmartFolder(userID, folderID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) getSmartFolderEntries(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	folder, err := h.store.SmartFolder(userID, request.RouteInt64Param(r, "folderID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if folder == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	status := request.QueryStringParam(r, "status", "")
+	if status != "" {
+		if err := model.ValidateEntryStatus(status); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
+	order := request.QueryStringParam(r, "order", model.DefaultSortingOrder)
+	if err := model.ValidateEntryOrder(order); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	direction := request.QueryStringParam(r, "direction", model.DefaultSortingDirection)
+	if err := model.ValidateDirection(direction); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	limit := request.QueryIntParam(r, "limit", 100)
+	offset := request.QueryIntParam(r, "offset", 0)
+	if err := model.ValidateRange(offset, limit); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithSmartFolder(folder)
+	builder.WithStatus(status)
+	builder.WithOrder(order)
+	builder.WithDirection(direction)
+	builder.WithOffset(offset)
+	builder.WithLimit(limit)
+	configureFilters(builder, r)
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	count, err := builder.CountEntries()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &entriesResponse{Total: count, Entries: entries})
+}
@@ -18,6 +18,7 @@ import (
 	"miniflux.app/reader/feed"
 	"miniflux.app/service/httpd"
 	"miniflux.app/service/scheduler"
+	"miniflux.app/service/smtpd"
 	"miniflux.app/storage"
 	"miniflux.app/worker"
 )
@@ -43,6 +44,11 @@ func startDaemon(store *storage.Storage) {
 		httpServer = httpd.Serve(store, pool, feedHandler)
 	}
 
+	var mailServer *smtpd.Server
+	if config.Opts.HasMailService() {
+		mailServer = smtpd.Serve(store)
+	}
+
 	<-stop
 	logger.Info("Shutting down the process...")
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -52,6 +58,10 @@ func startDaemon(store *storage.Storage) {
 		httpServer.Shutdown(ctx)
 	}
 
+	if mailServer != nil {
+		mailServer.Shutdown()
+	}
+
 	logger.Info("Process gracefully stopped")
 }
 
@@ -212,6 +212,23 @@ func (c *Client) UpdateCategory(categoryID int64, title string) (*Category, erro
 	return category, nil
 }
 
+// UpdateCategorySettings updates the title and the default settings of a category.
+func (c *Client) UpdateCategorySettings(categoryID int64, categoryChanges *Category) (*Category, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/categories/%d", categoryID), categoryChanges)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var category *Category
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&category); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return category, nil
+}
+
 // DeleteCategory removes a category.
 func (c *Client) DeleteCategory(categoryID int64) error {
 	body, err := c.request.Delete(fmt.Sprintf("/v1/categories/%d", categoryID))
@@ -223,6 +240,68 @@ func (c *Client) DeleteCategory(categoryID int64) error {
 	return nil
 }
 
+// FilterRules gets the list of filter rules.
+func (c *Client) FilterRules() (FilterRules, error) {
+	body, err := c.request.Get("/v1/filters")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var rules FilterRules
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return rules, nil
+}
+
+// CreateFilterRule creates a new filter rule.
+func (c *Client) CreateFilterRule(rule *FilterRule) (*FilterRule, error) {
+	body, err := c.request.Post("/v1/filters", rule)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var createdRule *FilterRule
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&createdRule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return createdRule, nil
+}
+
+// UpdateFilterRule updates a filter rule.
+func (c *Client) UpdateFilterRule(ruleID int64, rule *FilterRule) (*FilterRule, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/filters/%d", ruleID), rule)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var updatedRule *FilterRule
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&updatedRule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return updatedRule, nil
+}
+
+// DeleteFilterRule removes a filter rule.
+func (c *Client) DeleteFilterRule(ruleID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/filters/%d", ruleID))
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return nil
+}
+
 // Feeds gets all feeds.
 func (c *Client) Feeds() (Feeds, error) {
 	body, err := c.request.Get("/v1/feeds")
@@ -320,6 +399,23 @@ func (c *Client) UpdateFeed(feedID int64, feedChanges *FeedModification) (*Feed,
 	return f, nil
 }
 
+// PreviewFeed applies the settings to the latest entries of a feed without saving anything.
+func (c *Client) PreviewFeed(feedID int64, preview *FeedPreview) (EntryPreviews, error) {
+	body, err := c.request.Post(fmt.Sprintf("/v1/feeds/%d/preview", feedID), preview)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var previews EntryPreviews
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&previews); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return previews, nil
+}
+
 // RefreshAllFeeds refreshes all feeds.
 func (c *Client) RefreshAllFeeds() error {
 	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/refresh"), nil)
@@ -340,6 +436,37 @@ func (c *Client) RefreshFeed(feedID int64) error {
 	return nil
 }
 
+// DeduplicateFeed merges the duplicate entries of a feed and returns the number of removed entries.
+func (c *Client) DeduplicateFeed(feedID int64) (int, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d/deduplicate", feedID), nil)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	type result struct {
+		MergedEntries int `json:"merged_entries"`
+	}
+
+	var r result
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&r); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return r.MergedEntries, nil
+}
+
+// RefreshFeedIcon downloads the feed icon again.
+func (c *Client) RefreshFeedIcon(feedID int64) error {
+	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d/icon/refresh", feedID), nil)
+	if err != nil {
+		return err
+	}
+	body.Close()
+	return nil
+}
+
 // DeleteFeed removes a feed.
 func (c *Client) DeleteFeed(feedID int64) error {
 	body, err := c.request.Delete(fmt.Sprintf("/v1/feeds/%d", feedID))
@@ -401,6 +528,23 @@ func (c *Client) Entry(entryID int64) (*Entry, error) {
 	return entry, nil
 }
 
+// EntryRevisions fetches the previous versions of an entry.
+func (c *Client) EntryRevisions(entryID int64) (EntryRevisions, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d/revisions", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var revisions EntryRevisions
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&revisions); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return revisions, nil
+}
+
 // Entries fetch entries.
 func (c *Client) Entries(filter *Filter) (*EntryResultSet, error) {
 	path := buildFilterQueryString("/v1/entries", filter)
@@ -466,6 +610,294 @@ func (c *Client) ToggleBookmark(entryID int64) error {
 	return nil
 }
 
+// Labels gets the list of labels.
+func (c *Client) Labels() (Labels, error) {
+	body, err := c.request.Get("/v1/labels")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var labels Labels
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&labels); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return labels, nil
+}
+
+// CreateLabel creates a new label.
+func (c *Client) CreateLabel(title string) (*Label, error) {
+	body, err := c.request.Post("/v1/labels", map[string]interface{}{
+		"title": title,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var label *Label
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&label); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return label, nil
+}
+
+// UpdateLabel renames a label.
+func (c *Client) UpdateLabel(labelID int64, title string) (*Label, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/labels/%d", labelID), map[string]interface{}{
+		"title": title,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var label *Label
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&label); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return label, nil
+}
+
+// DeleteLabel removes a label, the labeled entries are kept.
+func (c *Client) DeleteLabel(labelID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/labels/%d", labelID))
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return nil
+}
+
+// EntryLabels gets the labels attached to an entry.
+func (c *Client) EntryLabels(entryID int64) (Labels, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d/labels", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var labels Labels
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&labels); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return labels, nil
+}
+
+// SetEntryLabels replaces the labels attached to an entry.
+func (c *Client) SetEntryLabels(entryID int64, labelIDs []int64) (Labels, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/labels", entryID), map[string]interface{}{
+		"label_ids": labelIDs,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var labels Labels
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&labels); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return labels, nil
+}
+
+// Highlights gets the highlights of the user, the most recent first.
+func (c *Client) Highlights(offset, limit int) (*HighlightResultSet, error) {
+	values := url.Values{}
+	values.Set("offset", strconv.Itoa(offset))
+	values.Set("limit", strconv.Itoa(limit))
+
+	body, err := c.request.Get("/v1/highlights?" + values.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result HighlightResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// EntryHighlights gets the highlights of an entry.
+func (c *Client) EntryHighlights(entryID int64) (Highlights, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d/highlights", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlights Highlights
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&highlights); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlights, nil
+}
+
+// CreateHighlight highlights a passage of an entry.
+func (c *Client) CreateHighlight(entryID int64, highlight *Highlight) (*Highlight, error) {
+	body, err := c.request.Post(fmt.Sprintf("/v1/entries/%d/highlights", entryID), highlight)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var createdHighlight *Highlight
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&createdHighlight); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return createdHighlight, nil
+}
+
+// UpdateHighlight changes the note of a highlight.
+func (c *Client) UpdateHighlight(highlightID int64, note string) (*Highlight, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/highlights/%d", highlightID), map[string]interface{}{
+		"note": note,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlight *Highlight
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&highlight); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlight, nil
+}
+
+// DeleteHighlight removes a highlight.
+func (c *Client) DeleteHighlight(highlightID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/highlights/%d", highlightID))
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return nil
+}
+
+// SmartFolders gets the list of smart folders.
+func (c *Client) SmartFolders() (SmartFolders, error) {
+	body, err := c.request.Get("/v1/folders")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var folders SmartFolders
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&folders); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return folders, nil
+}
+
+// SmartFolder gets a smart folder.
+func (c *Client) SmartFolder(folderID int64) (*SmartFolder, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/folders/%d", folderID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var folder *SmartFolder
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&folder); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return folder, nil
+}
+
+// CreateSmartFolder creates a new smart folder.
+func (c *Client) CreateSmartFolder(folder *SmartFolder) (*SmartFolder, error) {
+	body, err := c.request.Post("/v1/folders", folder)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var createdFolder *SmartFolder
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&createdFolder); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return createdFolder, nil
+}
+
+// UpdateSmartFolder updates a smart folder.
+func (c *Client) UpdateSmartFolder(folderID int64, folder *SmartFolder) (*SmartFolder, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/folders/%d", folderID), folder)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var updatedFolder *SmartFolder
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&updatedFolder); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return updatedFolder, nil
+}
+
+// DeleteSmartFolder removes a smart folder, the entries are kept.
+func (c *Client) DeleteSmartFolder(folderID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/folders/%d", folderID))
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return nil
+}
+
+// SmartFolderEntries fetches the entries matching a smart folder.
+func (c *Client) SmartFolderEntries(folderID int64, filter *Filter) (*EntryResultSet, error) {
+	path := buildFilterQueryString(fmt.Sprintf("/v1/folders/%d/entries", folderID), filter)
+
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
 func buildFilterQueryString(path string, filter *Filter) string {
 	if filter != nil {
 		values := url.Values{}
@@ -514,6 +946,10 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("search", filter.Search)
 		}
 
+		if filter.LabelID > 0 {
+			values.Set("label_id", strconv.FormatInt(filter.LabelID, 10))
+		}
+
 		path = fmt.Sprintf("%s?%s", path, values.Encode())
 	}
 
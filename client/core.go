@@ -50,9 +50,15 @@ type Users []User
 
 // Category represents a feed category.
 type Category struct {
-	ID     int64  `json:"id,omitempty"`
-	Title  string `json:"title,omitempty"`
-	UserID int64  `json:"user_id,omitempty"`
+	ID                int64  `json:"id,omitempty"`
+	Title             string `json:"title,omitempty"`
+	UserID            int64  `json:"user_id,omitempty"`
+	ContentExtractors string `json:"content_extractors,omitempty"`
+	FilterMode        string `json:"filter_mode,omitempty"`
+	Crawler           bool   `json:"crawler,omitempty"`
+	UserAgent         string `json:"user_agent,omitempty"`
+	ScraperRules      string `json:"scraper_rules,omitempty"`
+	RewriteRules      string `json:"rewrite_rules,omitempty"`
 }
 
 func (c Category) String() string {
@@ -62,6 +68,72 @@ func (c Category) String() string {
 // Categories represents a list of categories.
 type Categories []*Category
 
+// Label represents a label attached to entries.
+type Label struct {
+	ID         int64  `json:"id"`
+	UserID     int64  `json:"user_id"`
+	Title      string `json:"title"`
+	EntryCount int    `json:"entry_count,omitempty"`
+}
+
+func (l Label) String() string {
+	return fmt.Sprintf("#%d %s", l.ID, l.Title)
+}
+
+// Labels represents a list of labels.
+type Labels []*Label
+
+// Highlight represents a passage of an entry with an optional note.
+type Highlight struct {
+	ID         int64     `json:"id"`
+	UserID     int64     `json:"user_id"`
+	EntryID    int64     `json:"entry_id"`
+	Quote      string    `json:"quote"`
+	Prefix     string    `json:"prefix"`
+	Suffix     string    `json:"suffix"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"created_at"`
+	EntryTitle string    `json:"entry_title,omitempty"`
+	EntryURL   string    `json:"entry_url,omitempty"`
+	FeedID     int64     `json:"feed_id,omitempty"`
+	FeedTitle  string    `json:"feed_title,omitempty"`
+}
+
+func (h Highlight) String() string {
+	return fmt.Sprintf("#%d %q", h.ID, h.Quote)
+}
+
+// Highlights represents a list of highlights.
+type Highlights []*Highlight
+
+// HighlightResultSet represents the response when fetching highlights.
+type HighlightResultSet struct {
+	Total      int        `json:"total"`
+	Highlights Highlights `json:"highlights"`
+}
+
+// SmartFolder represents a saved search.
+type SmartFolder struct {
+	ID              int64      `json:"id"`
+	UserID          int64      `json:"user_id"`
+	Title           string     `json:"title"`
+	SearchQuery     string     `json:"search_query"`
+	FeedIDs         []int64    `json:"feed_ids"`
+	CategoryIDs     []int64    `json:"category_ids"`
+	LabelIDs        []int64    `json:"label_ids"`
+	Status          string     `json:"status"`
+	PublishedAfter  *time.Time `json:"published_after"`
+	PublishedBefore *time.Time `json:"published_before"`
+	UnreadCount     int        `json:"unread_count"`
+}
+
+func (s SmartFolder) String() string {
+	return fmt.Sprintf("#%d %s", s.ID, s.Title)
+}
+
+// SmartFolders represents a list of smart folders.
+type SmartFolders []*SmartFolder
+
 // Subscription represents a feed subscription.
 type Subscription struct {
 	Title string `json:"title"`
@@ -91,9 +163,14 @@ type Feed struct {
 	ScraperRules       string    `json:"scraper_rules"`
 	RewriteRules       string    `json:"rewrite_rules"`
 	Crawler            bool      `json:"crawler"`
+	CrawlerOverride    bool      `json:"crawler_override"`
 	UserAgent          string    `json:"user_agent"`
 	Username           string    `json:"username"`
 	Password           string    `json:"password"`
+	ContentExtractors  string    `json:"content_extractors"`
+	FilterMode         string    `json:"filter_mode"`
+	EntryIdentity      string    `json:"entry_identity"`
+	SuppressedCount    int64     `json:"suppressed_count"`
 	Category           *Category `json:"category,omitempty"`
 }
 
@@ -109,8 +186,45 @@ type FeedModification struct {
 	Username     *string `json:"username"`
 	Password     *string `json:"password"`
 	CategoryID   *int64  `json:"category_id"`
+
+	CrawlerOverride *bool `json:"crawler_override"`
+
+	ContentExtractors *string `json:"content_extractors"`
+	FilterMode        *string `json:"filter_mode"`
+	EntryIdentity     *string `json:"entry_identity"`
+}
+
+// FeedPreview represents the settings to preview on the latest entries of a feed.
+//
+// The stored filter rules are used when FilterRules is nil.
+type FeedPreview struct {
+	FeedModification
+	FilterRules FilterRules `json:"filter_rules"`
+	Limit       int         `json:"limit"`
+	Refetch     bool        `json:"refetch"`
+	Crawl       bool        `json:"crawl"`
+}
+
+// EntryPreview shows how an entry would look like with the previewed settings.
+type EntryPreview struct {
+	EntryID         int64    `json:"entry_id"`
+	URL             string   `json:"url"`
+	OriginalTitle   string   `json:"original_title"`
+	Title           string   `json:"title"`
+	OriginalContent string   `json:"original_content"`
+	Content         string   `json:"content"`
+	ContentDiff     string   `json:"content_diff"`
+	Status          string   `json:"status"`
+	Starred         bool     `json:"starred"`
+	Tags            []string `json:"tags"`
+	MatchedRules    []int64  `json:"matched_rules"`
+	Suppressed      bool     `json:"suppressed"`
+	Error           string   `json:"error"`
 }
 
+// EntryPreviews represents a list of entry previews.
+type EntryPreviews []*EntryPreview
+
 // FeedIcon represents the feed icon.
 type FeedIcon struct {
 	ID       int64  `json:"id"`
@@ -123,25 +237,66 @@ type Feeds []*Feed
 
 // Entry represents a subscription item in the system.
 type Entry struct {
-	ID         int64      `json:"id"`
-	UserID     int64      `json:"user_id"`
-	FeedID     int64      `json:"feed_id"`
-	Status     string     `json:"status"`
-	Hash       string     `json:"hash"`
-	Title      string     `json:"title"`
-	URL        string     `json:"url"`
-	Date       time.Time  `json:"published_at"`
-	Content    string     `json:"content"`
-	Author     string     `json:"author"`
-	ShareCode  string     `json:"share_code"`
-	Starred    bool       `json:"starred"`
-	Enclosures Enclosures `json:"enclosures,omitempty"`
-	Feed       *Feed      `json:"feed,omitempty"`
+	ID            int64      `json:"id"`
+	UserID        int64      `json:"user_id"`
+	FeedID        int64      `json:"feed_id"`
+	Status        string     `json:"status"`
+	Hash          string     `json:"hash"`
+	Title         string     `json:"title"`
+	URL           string     `json:"url"`
+	Date          time.Time  `json:"published_at"`
+	Content       string     `json:"content"`
+	Author        string     `json:"author"`
+	ShareCode     string     `json:"share_code"`
+	Starred       bool       `json:"starred"`
+	Tags          []string   `json:"tags"`
+	Labels        Labels     `json:"labels"`
+	Highlights    Highlights `json:"highlights"`
+	ClusterID     int64      `json:"cluster_id,omitempty"`
+	RevisionCount int        `json:"revision_count"`
+	Enclosures    Enclosures `json:"enclosures,omitempty"`
+	Feed          *Feed      `json:"feed,omitempty"`
 }
 
 // Entries represents a list of entries.
 type Entries []*Entry
 
+// EntryRevision represents the title and the content of an entry before it was updated.
+type EntryRevision struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	EntryID   int64     `json:"entry_id"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EntryRevisions represents the previous versions of an entry, the most recent first.
+type EntryRevisions []*EntryRevision
+
+// FilterRule represents a rule applied to the new entries.
+type FilterRule struct {
+	ID          int64      `json:"id"`
+	UserID      int64      `json:"user_id"`
+	CategoryID  int64      `json:"category_id"`
+	FeedID      int64      `json:"feed_id"`
+	Field       string     `json:"field"`
+	Operator    string     `json:"operator"`
+	Value       string     `json:"value"`
+	Action      string     `json:"action"`
+	ActionValue string     `json:"action_value"`
+	ErrorMsg    string     `json:"error_message,omitempty"`
+	HitCount    int64      `json:"hit_count"`
+	LastHitAt   *time.Time `json:"last_hit_at"`
+}
+
+func (f FilterRule) String() string {
+	return fmt.Sprintf("#%d %s %s %q => %s", f.ID, f.Field, f.Operator, f.Value, f.Action)
+}
+
+// FilterRules represents a list of filter rules.
+type FilterRules []*FilterRule
+
 // Enclosure represents an attachment.
 type Enclosure struct {
 	ID       int64  `json:"id"`
@@ -169,6 +324,7 @@ type Filter struct {
 	AfterEntryID  int64
 	Search        string
 	CategoryID    int64
+	LabelID       int64
 }
 
 // EntryResultSet represents the response when fetching entries.
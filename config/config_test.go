@@ -587,6 +587,41 @@ func TestCleanupRemoveSessionsDays(t *testing.T) {
 	}
 }
 
+func TestDefaultIconRefreshDaysValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultIconRefreshDays
+	result := opts.IconRefreshDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ICON_REFRESH_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestIconRefreshDays(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ICON_REFRESH_DAYS", "0")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 0
+	result := opts.IconRefreshDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ICON_REFRESH_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestDefaultWorkerPoolSizeValue(t *testing.T) {
 	os.Clearenv()
 
@@ -1246,6 +1281,97 @@ func TestDefaultHTTPClientMaxBodySizeValue(t *testing.T) {
 	}
 }
 
+func TestLocalSourcesDisabledByDefault(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasLocalSources() {
+		t.Fatalf(`Local sources should be disabled by default`)
+	}
+
+	if opts.LocalSourcesTimeout() != defaultLocalSourcesTimeout {
+		t.Fatalf(`Unexpected LOCAL_SOURCES_TIMEOUT value, got %d instead of %d`, opts.LocalSourcesTimeout(), defaultLocalSourcesTimeout)
+	}
+}
+
+func TestLocalSources(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("LOCAL_SOURCES_DIRECTORY", "/srv/feeds")
+	os.Setenv("LOCAL_SOURCES_TIMEOUT", "5")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasLocalSources() || opts.LocalSourcesDirectory() != "/srv/feeds" {
+		t.Fatalf(`Unexpected LOCAL_SOURCES_DIRECTORY value, got %q`, opts.LocalSourcesDirectory())
+	}
+
+	if opts.LocalSourcesTimeout() != 5 {
+		t.Fatalf(`Unexpected LOCAL_SOURCES_TIMEOUT value, got %d instead of 5`, opts.LocalSourcesTimeout())
+	}
+}
+
+func TestMailServiceDisabledByDefault(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("BASE_URL", "https://reader.example.org:8443/miniflux")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasMailService() {
+		t.Fatalf(`The mail service should be disabled by default`)
+	}
+
+	if opts.MailProtocol() != "smtp" {
+		t.Fatalf(`Unexpected MAIL_PROTOCOL value, got %q instead of "smtp"`, opts.MailProtocol())
+	}
+
+	if opts.MailDomain() != "reader.example.org" {
+		t.Fatalf(`Unexpected MAIL_DOMAIN value, got %q instead of "reader.example.org"`, opts.MailDomain())
+	}
+}
+
+func TestMailService(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("MAIL_LISTEN_ADDR", "/run/miniflux/lmtp.sock")
+	os.Setenv("MAIL_PROTOCOL", "LMTP")
+	os.Setenv("MAIL_DOMAIN", "news.example.org")
+	os.Setenv("MAIL_MAX_MESSAGE_SIZE", "2")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasMailService() || opts.MailListenAddr() != "/run/miniflux/lmtp.sock" {
+		t.Fatalf(`Unexpected MAIL_LISTEN_ADDR value, got %q`, opts.MailListenAddr())
+	}
+
+	if opts.MailProtocol() != "lmtp" {
+		t.Fatalf(`Unexpected MAIL_PROTOCOL value, got %q instead of "lmtp"`, opts.MailProtocol())
+	}
+
+	if opts.MailDomain() != "news.example.org" {
+		t.Fatalf(`Unexpected MAIL_DOMAIN value, got %q`, opts.MailDomain())
+	}
+
+	if opts.MailMaxMessageSize() != 2*1024*1024 {
+		t.Fatalf(`Unexpected MAIL_MAX_MESSAGE_SIZE value, got %d`, opts.MailMaxMessageSize())
+	}
+}
+
 func TestParseConfigFile(t *testing.T) {
 	content := []byte(`
  # This is a comment
@@ -1362,3 +1488,119 @@ func TestAuthProxyUserCreationAdmin(t *testing.T) {
 		t.Fatalf(`Unexpected AUTH_PROXY_USER_CREATION value, got %v instead of %v`, result, expected)
 	}
 }
+
+func TestNormalizeImages(t *testing.T) {
+	os.Clearenv()
+
+	opts, err := NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.NormalizeImages() {
+		t.Fatal(`The images should be normalized by default`)
+	}
+
+	os.Setenv("NORMALIZE_IMAGES", "0")
+
+	opts, err = NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.NormalizeImages() {
+		t.Fatal(`Unexpected NORMALIZE_IMAGES value, got true instead of false`)
+	}
+}
+
+func TestSanitizerPolicy(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SANITIZER_ALLOWED_TAGS", "Details, summary, mark")
+	os.Setenv("SANITIZER_ALLOWED_ATTRIBUTES", "details:open, svg:viewbox, svg:xlink:href")
+	os.Setenv("SANITIZER_IFRAME_HOSTS", "video.example.org, *.example.net")
+
+	opts, err := NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	policy := opts.SanitizerPolicy()
+	expected := "tags=[details[open] mark[] summary[] svg[viewbox xlink:href]] iframe_hosts=[video.example.org *.example.net]"
+	if policy.String() != expected {
+		t.Fatalf(`Unexpected sanitizer policy, got %q instead of %q`, policy.String(), expected)
+	}
+}
+
+func TestDefaultSanitizerPolicy(t *testing.T) {
+	os.Clearenv()
+
+	opts, err := NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.SanitizerPolicy().IsEmpty() {
+		t.Fatalf(`The default sanitizer policy should be empty, got %v`, opts.SanitizerPolicy())
+	}
+}
+
+func TestInvalidSanitizerPolicy(t *testing.T) {
+	scenarios := map[string]string{
+		"SANITIZER_ALLOWED_TAGS":       "script",
+		"SANITIZER_IFRAME_HOSTS":       "javascript:alert(1)",
+		"SANITIZER_ALLOWED_ATTRIBUTES": "open",
+	}
+
+	for key, value := range scenarios {
+		os.Clearenv()
+		os.Setenv(key, value)
+
+		if _, err := NewParser().ParseEnvironmentVariables(); err == nil {
+			t.Errorf(`The value %q should be rejected for %s`, value, key)
+		}
+	}
+
+	for _, value := range []string{"img:onerror", "a:style", "svg:xmlns:xlink", "details:id", "iframe:src", "object:data", "foreignobject:width", "mark:srcset"} {
+		policy := NewSanitizerPolicy()
+		if err := policy.AllowAttributes(value); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := policy.Validate(); err == nil {
+			t.Errorf(`The attribute %q should be rejected`, value)
+		}
+	}
+}
+
+func TestTrackingParameters(t *testing.T) {
+	os.Clearenv()
+
+	opts, err := NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if parameters := opts.TrackingParameters(); len(parameters) == 0 || parameters[0] != "utm_*" {
+		t.Fatalf(`Unexpected default TRACKING_PARAMETERS value, got %v`, parameters)
+	}
+
+	if opts.ResolveRedirectURLs() {
+		t.Fatal(`The redirect URLs should not be resolved by default`)
+	}
+
+	os.Setenv("TRACKING_PARAMETERS", "ref, , mc_*")
+	os.Setenv("RESOLVE_REDIRECT_URLS", "1")
+
+	opts, err = NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if parameters := opts.TrackingParameters(); len(parameters) != 2 || parameters[0] != "ref" || parameters[1] != "mc_*" {
+		t.Fatalf(`Unexpected TRACKING_PARAMETERS value, got %v`, parameters)
+	}
+
+	if !opts.ResolveRedirectURLs() {
+		t.Fatal(`Unexpected RESOLVE_REDIRECT_URLS value, got false instead of true`)
+	}
+}
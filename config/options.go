@@ -6,6 +6,7 @@ package config // import "miniflux.app/config"
 
 import (
 	"fmt"
+	url_parser "net/url"
 	"strings"
 )
 
@@ -34,6 +35,7 @@ const (
 	defaultCleanupFrequencyHours       = 24
 	defaultCleanupArchiveReadDays      = 60
 	defaultCleanupRemoveSessionsDays   = 30
+	defaultIconRefreshDays             = 30
 	defaultProxyImages                 = "http-only"
 	defaultCreateAdmin                 = false
 	defaultOAuth2UserCreation          = false
@@ -47,6 +49,15 @@ const (
 	defaultHTTPClientMaxBodySize       = 15
 	defaultAuthProxyHeader             = ""
 	defaultAuthProxyUserCreation       = false
+	defaultLocalSourcesDirectory       = ""
+	defaultLocalSourcesTimeout         = 30
+	defaultMailListenAddr              = ""
+	defaultMailProtocol                = "smtp"
+	defaultMailDomain                  = ""
+	defaultMailMaxMessageSize          = 10
+	defaultNormalizeImages             = true
+	defaultTrackingParameters          = "utm_*, fbclid, gclid, gclsrc, dclid, msclkid, yclid, igshid, mc_cid, mc_eid, mkt_tok, _hsenc, _hsmi, __hssc, __hstc, __hsfp, oly_anon_id, oly_enc_id, vero_id, vero_conv, wt_mc, wt_zmc, xtor, _openstat, ncid, sr_share"
+	defaultResolveRedirectURLs         = false
 )
 
 // Options contains configuration options.
@@ -72,6 +83,7 @@ type Options struct {
 	cleanupFrequencyHours       int
 	cleanupArchiveReadDays      int
 	cleanupRemoveSessionsDays   int
+	iconRefreshDays             int
 	pollingFrequency            int
 	batchSize                   int
 	workerPoolSize              int
@@ -88,6 +100,16 @@ type Options struct {
 	httpClientMaxBodySize       int64
 	authProxyHeader             string
 	authProxyUserCreation       bool
+	localSourcesDirectory       string
+	localSourcesTimeout         int
+	mailListenAddr              string
+	mailProtocol                string
+	mailDomain                  string
+	mailMaxMessageSize          int64
+	normalizeImages             bool
+	sanitizerPolicy             *SanitizerPolicy
+	trackingParameters          []string
+	resolveRedirectURLs         bool
 }
 
 // NewOptions returns Options with default values.
@@ -114,6 +136,7 @@ func NewOptions() *Options {
 		cleanupFrequencyHours:       defaultCleanupFrequencyHours,
 		cleanupArchiveReadDays:      defaultCleanupArchiveReadDays,
 		cleanupRemoveSessionsDays:   defaultCleanupRemoveSessionsDays,
+		iconRefreshDays:             defaultIconRefreshDays,
 		pollingFrequency:            defaultPollingFrequency,
 		batchSize:                   defaultBatchSize,
 		workerPoolSize:              defaultWorkerPoolSize,
@@ -130,6 +153,16 @@ func NewOptions() *Options {
 		httpClientMaxBodySize:       defaultHTTPClientMaxBodySize * 1024 * 1024,
 		authProxyHeader:             defaultAuthProxyHeader,
 		authProxyUserCreation:       defaultAuthProxyUserCreation,
+		localSourcesDirectory:       defaultLocalSourcesDirectory,
+		localSourcesTimeout:         defaultLocalSourcesTimeout,
+		mailListenAddr:              defaultMailListenAddr,
+		mailProtocol:                defaultMailProtocol,
+		mailDomain:                  defaultMailDomain,
+		mailMaxMessageSize:          defaultMailMaxMessageSize * 1024 * 1024,
+		normalizeImages:             defaultNormalizeImages,
+		sanitizerPolicy:             NewSanitizerPolicy(),
+		trackingParameters:          parseList(defaultTrackingParameters),
+		resolveRedirectURLs:         defaultResolveRedirectURLs,
 	}
 }
 
@@ -218,6 +251,11 @@ func (o *Options) CleanupRemoveSessionsDays() int {
 	return o.cleanupRemoveSessionsDays
 }
 
+// IconRefreshDays returns the number of days after which feed icons are downloaded again, 0 disables the refresh.
+func (o *Options) IconRefreshDays() int {
+	return o.iconRefreshDays
+}
+
 // WorkerPoolSize returns the number of background worker.
 func (o *Options) WorkerPoolSize() int {
 	return o.workerPoolSize
@@ -323,6 +361,75 @@ func (o *Options) IsAuthProxyUserCreationAllowed() bool {
 	return o.authProxyUserCreation
 }
 
+// HasLocalSources returns true if feeds can be read from local files and commands.
+func (o *Options) HasLocalSources() bool {
+	return o.localSourcesDirectory != ""
+}
+
+// LocalSourcesDirectory returns the directory containing the files and commands allowed as feed sources.
+func (o *Options) LocalSourcesDirectory() string {
+	return o.localSourcesDirectory
+}
+
+// LocalSourcesTimeout returns the time limit in seconds before a command is stopped.
+func (o *Options) LocalSourcesTimeout() int {
+	return o.localSourcesTimeout
+}
+
+// HasMailService returns true if the newsletter receiver is enabled.
+func (o *Options) HasMailService() bool {
+	return o.mailListenAddr != ""
+}
+
+// MailListenAddr returns the listen address, or the Unix socket, of the newsletter receiver.
+func (o *Options) MailListenAddr() string {
+	return o.mailListenAddr
+}
+
+// MailProtocol returns "smtp" or "lmtp".
+func (o *Options) MailProtocol() string {
+	return o.mailProtocol
+}
+
+// MailDomain returns the domain of the newsletter addresses, the host of the base URL by default.
+func (o *Options) MailDomain() string {
+	if o.mailDomain != "" {
+		return o.mailDomain
+	}
+
+	u, err := url_parser.Parse(o.rootURL)
+	if err != nil {
+		return ""
+	}
+
+	return u.Hostname()
+}
+
+// MailMaxMessageSize returns the maximum size in bytes of the messages accepted by the newsletter receiver.
+func (o *Options) MailMaxMessageSize() int64 {
+	return o.mailMaxMessageSize
+}
+
+// NormalizeImages returns true if lazy-loaded and responsive images are resolved in the entry contents.
+func (o *Options) NormalizeImages() bool {
+	return o.normalizeImages
+}
+
+// SanitizerPolicy returns the HTML elements allowed in addition to the built-in rules of the sanitizer.
+func (o *Options) SanitizerPolicy() *SanitizerPolicy {
+	return o.sanitizerPolicy
+}
+
+// TrackingParameters returns the query parameters removed from the entry URLs and links.
+func (o *Options) TrackingParameters() []string {
+	return o.trackingParameters
+}
+
+// ResolveRedirectURLs returns true if the entry URLs of redirection services are replaced by their destination.
+func (o *Options) ResolveRedirectURLs() bool {
+	return o.resolveRedirectURLs
+}
+
 func (o *Options) String() string {
 	var builder strings.Builder
 	builder.WriteString(fmt.Sprintf("LOG_DATE_TIME: %v\n", o.logDateTime))
@@ -346,6 +453,7 @@ func (o *Options) String() string {
 	builder.WriteString(fmt.Sprintf("CLEANUP_FREQUENCY_HOURS: %v\n", o.cleanupFrequencyHours))
 	builder.WriteString(fmt.Sprintf("CLEANUP_ARCHIVE_READ_DAYS: %v\n", o.cleanupArchiveReadDays))
 	builder.WriteString(fmt.Sprintf("CLEANUP_REMOVE_SESSIONS_DAYS: %v\n", o.cleanupRemoveSessionsDays))
+	builder.WriteString(fmt.Sprintf("ICON_REFRESH_DAYS: %v\n", o.iconRefreshDays))
 	builder.WriteString(fmt.Sprintf("WORKER_POOL_SIZE: %v\n", o.workerPoolSize))
 	builder.WriteString(fmt.Sprintf("POLLING_FREQUENCY: %v\n", o.pollingFrequency))
 	builder.WriteString(fmt.Sprintf("BATCH_SIZE: %v\n", o.batchSize))
@@ -362,5 +470,15 @@ func (o *Options) String() string {
 	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_MAX_BODY_SIZE: %v\n", o.httpClientMaxBodySize))
 	builder.WriteString(fmt.Sprintf("AUTH_PROXY_HEADER: %v\n", o.authProxyHeader))
 	builder.WriteString(fmt.Sprintf("AUTH_PROXY_USER_CREATION: %v\n", o.authProxyUserCreation))
+	builder.WriteString(fmt.Sprintf("LOCAL_SOURCES_DIRECTORY: %v\n", o.localSourcesDirectory))
+	builder.WriteString(fmt.Sprintf("LOCAL_SOURCES_TIMEOUT: %v\n", o.localSourcesTimeout))
+	builder.WriteString(fmt.Sprintf("MAIL_LISTEN_ADDR: %v\n", o.mailListenAddr))
+	builder.WriteString(fmt.Sprintf("MAIL_PROTOCOL: %v\n", o.mailProtocol))
+	builder.WriteString(fmt.Sprintf("MAIL_DOMAIN: %v\n", o.MailDomain()))
+	builder.WriteString(fmt.Sprintf("MAIL_MAX_MESSAGE_SIZE: %v\n", o.mailMaxMessageSize))
+	builder.WriteString(fmt.Sprintf("NORMALIZE_IMAGES: %v\n", o.normalizeImages))
+	builder.WriteString(fmt.Sprintf("SANITIZER_POLICY: %v\n", o.sanitizerPolicy))
+	builder.WriteString(fmt.Sprintf("TRACKING_PARAMETERS: %v\n", o.trackingParameters))
+	builder.WriteString(fmt.Sprintf("RESOLVE_REDIRECT_URLS: %v\n", o.resolveRedirectURLs))
 	return builder.String()
 }
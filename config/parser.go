@@ -134,6 +134,8 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			}
 		case "WORKER_POOL_SIZE":
 			p.opts.workerPoolSize = parseInt(value, defaultWorkerPoolSize)
+		case "ICON_REFRESH_DAYS":
+			p.opts.iconRefreshDays = parseInt(value, defaultIconRefreshDays)
 		case "POLLING_FREQUENCY":
 			p.opts.pollingFrequency = parseInt(value, defaultPollingFrequency)
 		case "BATCH_SIZE":
@@ -164,13 +166,40 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.authProxyHeader = parseString(value, defaultAuthProxyHeader)
 		case "AUTH_PROXY_USER_CREATION":
 			p.opts.authProxyUserCreation = parseBool(value, defaultAuthProxyUserCreation)
+		case "LOCAL_SOURCES_DIRECTORY":
+			p.opts.localSourcesDirectory = parseString(value, defaultLocalSourcesDirectory)
+		case "LOCAL_SOURCES_TIMEOUT":
+			p.opts.localSourcesTimeout = parseInt(value, defaultLocalSourcesTimeout)
+		case "MAIL_LISTEN_ADDR":
+			p.opts.mailListenAddr = parseString(value, defaultMailListenAddr)
+		case "MAIL_PROTOCOL":
+			p.opts.mailProtocol = strings.ToLower(parseString(value, defaultMailProtocol))
+		case "MAIL_DOMAIN":
+			p.opts.mailDomain = parseString(value, defaultMailDomain)
+		case "MAIL_MAX_MESSAGE_SIZE":
+			p.opts.mailMaxMessageSize = int64(parseInt(value, defaultMailMaxMessageSize) * 1024 * 1024)
+		case "NORMALIZE_IMAGES":
+			p.opts.normalizeImages = parseBool(value, defaultNormalizeImages)
+		case "SANITIZER_ALLOWED_TAGS":
+			p.opts.sanitizerPolicy.AllowTags(value)
+		case "SANITIZER_ALLOWED_ATTRIBUTES":
+			if err := p.opts.sanitizerPolicy.AllowAttributes(value); err != nil {
+				return err
+			}
+		case "SANITIZER_IFRAME_HOSTS":
+			p.opts.sanitizerPolicy.AllowIframeHosts(value)
+		case "TRACKING_PARAMETERS":
+			p.opts.trackingParameters = parseList(parseString(value, defaultTrackingParameters))
+		case "RESOLVE_REDIRECT_URLS":
+			p.opts.resolveRedirectURLs = parseBool(value, defaultResolveRedirectURLs)
 		}
 	}
 
 	if port != "" {
 		p.opts.listenAddr = ":" + port
 	}
-	return nil
+
+	return p.opts.sanitizerPolicy.Validate()
 }
 
 func parseBaseURL(value string) (string, string, string, error) {
@@ -229,3 +258,14 @@ func parseString(value string, fallback string) string {
 	}
 	return value
 }
+
+func parseList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
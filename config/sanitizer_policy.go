@@ -0,0 +1,149 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package config // import "miniflux.app/config"
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	sanitizerNameRegex = regexp.MustCompile(`^[a-z][a-z0-9-]*(:[a-z][a-z0-9-]*)?$`)
+	sanitizerHostRegex = regexp.MustCompile(`^(\*\.)?[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*(:[0-9]+)?$`)
+
+	// Tags that can execute scripts, load documents or submit data.
+	forbiddenSanitizerTags = []string{
+		"animate", "animatemotion", "animatetransform", "applet", "base", "body", "button", "embed",
+		"foreignobject", "form", "frame", "frameset", "head", "html", "iframe", "input", "link", "meta",
+		"noscript", "object", "option", "script", "select", "set", "style", "template", "textarea",
+		"title", "use",
+	}
+
+	// Attributes that can execute scripts, change the styles or the identity of the page elements.
+	forbiddenSanitizerAttributes = []string{
+		"action", "background", "class", "data", "dynsrc", "formaction", "id", "is", "lowsrc", "name",
+		"srcdoc", "srcset", "style",
+	}
+)
+
+// SanitizerPolicy contains the HTML elements allowed in addition to the built-in rules of the sanitizer.
+type SanitizerPolicy struct {
+	// Tags contains the additional tags and the attributes allowed for each of them.
+	Tags map[string][]string
+
+	// IframeHosts contains the additional hostnames allowed as HTTPS iframe sources,
+	// a leading wildcard allows the subdomains.
+	IframeHosts []string
+}
+
+// NewSanitizerPolicy returns an empty policy.
+func NewSanitizerPolicy() *SanitizerPolicy {
+	return &SanitizerPolicy{Tags: make(map[string][]string)}
+}
+
+// AllowTags parses a comma separated list of tags.
+func (p *SanitizerPolicy) AllowTags(value string) {
+	for _, tag := range splitList(value) {
+		if _, found := p.Tags[tag]; !found {
+			p.Tags[tag] = []string{}
+		}
+	}
+}
+
+// AllowAttributes parses a comma separated list of "tag:attribute" pairs, the tags are allowed as well.
+func (p *SanitizerPolicy) AllowAttributes(value string) error {
+	for _, pair := range splitList(value) {
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf(`sanitizer policy: the attribute %q must be written as "tag:attribute"`, pair)
+		}
+
+		tag, attribute := parts[0], parts[1]
+		if !inList(attribute, p.Tags[tag]) {
+			p.Tags[tag] = append(p.Tags[tag], attribute)
+		}
+	}
+
+	return nil
+}
+
+// AllowIframeHosts parses a comma separated list of hostnames.
+func (p *SanitizerPolicy) AllowIframeHosts(value string) {
+	for _, host := range splitList(value) {
+		if !inList(host, p.IframeHosts) {
+			p.IframeHosts = append(p.IframeHosts, host)
+		}
+	}
+}
+
+// IsEmpty returns true if the policy does not extend the built-in rules.
+func (p *SanitizerPolicy) IsEmpty() bool {
+	return len(p.Tags) == 0 && len(p.IframeHosts) == 0
+}
+
+// Validate makes sure the policy cannot be used to inject scripts.
+func (p *SanitizerPolicy) Validate() error {
+	for tag, attributes := range p.Tags {
+		if !sanitizerNameRegex.MatchString(tag) || strings.Contains(tag, ":") {
+			return fmt.Errorf("sanitizer policy: invalid tag name %q", tag)
+		}
+
+		if inList(tag, forbiddenSanitizerTags) {
+			return fmt.Errorf("sanitizer policy: the tag %q cannot be allowed", tag)
+		}
+
+		for _, attribute := range attributes {
+			if !sanitizerNameRegex.MatchString(attribute) {
+				return fmt.Errorf("sanitizer policy: invalid attribute name %q", attribute)
+			}
+
+			if strings.HasPrefix(attribute, "on") || strings.HasPrefix(attribute, "xmlns") || inList(attribute, forbiddenSanitizerAttributes) {
+				return fmt.Errorf("sanitizer policy: the attribute %q cannot be allowed", attribute)
+			}
+		}
+	}
+
+	for _, host := range p.IframeHosts {
+		if !sanitizerHostRegex.MatchString(host) {
+			return fmt.Errorf("sanitizer policy: invalid iframe hostname %q", host)
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable representation of the policy.
+func (p *SanitizerPolicy) String() string {
+	var tags []string
+	for tag, attributes := range p.Tags {
+		tags = append(tags, fmt.Sprintf("%s[%s]", tag, strings.Join(attributes, " ")))
+	}
+	sort.Strings(tags)
+
+	return fmt.Sprintf("tags=%v iframe_hosts=%v", tags, p.IframeHosts)
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.ToLower(strings.TrimSpace(item)); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func inList(needle string, haystack []string) bool {
+	for _, element := range haystack {
+		if element == needle {
+			return true
+		}
+	}
+
+	return false
+}
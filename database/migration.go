@@ -12,7 +12,7 @@ import (
 	"miniflux.app/logger"
 )
 
-const schemaVersion = 28
+const schemaVersion = 46
 
 // Migrate executes database migrations.
 func Migrate(db *sql.DB) {
@@ -38,6 +38,13 @@ func Migrate(db *sql.DB) {
 			logger.Fatal("[Migrate] %v", err)
 		}
 
+		if step, found := migrationSteps[version]; found {
+			if err := step(tx); err != nil {
+				tx.Rollback()
+				logger.Fatal("[Migrate] %v", err)
+			}
+		}
+
 		if _, err := tx.Exec(`delete from schema_version`); err != nil {
 			tx.Rollback()
 			logger.Fatal("[Migrate] %v", err)
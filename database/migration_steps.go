@@ -0,0 +1,72 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package database // import "miniflux.app/database"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+	"miniflux.app/reader/filter"
+)
+
+// migrationSteps contains the migrations that cannot be written in SQL,
+// they run in the same transaction after the SQL file of their version.
+var migrationSteps = map[int]func(tx *sql.Tx) error{
+	46: flagInvalidFilterRules,
+}
+
+// flagInvalidFilterRules saves the error of the rules that cannot be evaluated.
+//
+// The title and content filters of the feeds were PostgreSQL regular expressions,
+// some of them are not supported by the regexp package used by the migrated rules.
+func flagInvalidFilterRules(tx *sql.Tx) error {
+	rows, err := tx.Query(`
+		SELECT
+			id, coalesce(category_id, 0), coalesce(feed_id, 0), field, operator, value, action, action_value
+		FROM
+			filter_rules
+	`)
+	if err != nil {
+		return fmt.Errorf(`unable to fetch filter rules: %v`, err)
+	}
+
+	errorMessages := make(map[int64]string)
+	for rows.Next() {
+		var rule model.FilterRule
+		err := rows.Scan(
+			&rule.ID,
+			&rule.CategoryID,
+			&rule.FeedID,
+			&rule.Field,
+			&rule.Operator,
+			&rule.Value,
+			&rule.Action,
+			&rule.ActionValue,
+		)
+		if err != nil {
+			rows.Close()
+			return fmt.Errorf(`unable to fetch filter rule row: %v`, err)
+		}
+
+		if err := filter.ValidateRule(&rule); err != nil {
+			errorMessages[rule.ID] = err.Error()
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return fmt.Errorf(`unable to fetch filter rules: %v`, err)
+	}
+	rows.Close()
+
+	for ruleID, errorMessage := range errorMessages {
+		if _, err := tx.Exec(`UPDATE filter_rules SET error_msg=$1 WHERE id=$2`, errorMessage, ruleID); err != nil {
+			return fmt.Errorf(`unable to flag filter rule #%d: %v`, ruleID, err)
+		}
+	}
+
+	return nil
+}
@@ -174,6 +174,9 @@ create table api_keys (
 
 alter table entries add column share_code text not null default '';
 create unique index entries_share_code_idx on entries using btree(share_code) where share_code <> '';
+`,
+	"schema_version_29": `alter table feeds add column source_type text not null default 'feed';
+alter table feeds add column source_settings jsonb not null default '{}';
 `,
 	"schema_version_3": `create table tokens (
     id text not null,
@@ -181,8 +184,178 @@ create unique index entries_share_code_idx on entries using btree(share_code) wh
     created_at timestamp with time zone not null default now(),
     primary key(id, value)
 );`,
+	"schema_version_30": `create table page_snapshots (
+    feed_id bigint not null,
+    content text not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (feed_id),
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+`,
+	"schema_version_31": `create table gemini_certificates (
+    host text not null,
+    fingerprint text not null,
+    expires_at timestamp with time zone not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (host)
+);
+`,
+	"schema_version_32": `create table newsletter_tokens (
+    user_id int not null,
+    token text not null unique,
+    created_at timestamp with time zone not null default now(),
+    primary key (user_id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table newsletter_images (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    token text not null unique,
+    mime_type text not null,
+    content bytea not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+`,
+	"schema_version_33": `alter table feeds add column icon_checked_at timestamp with time zone;
+update feeds set icon_checked_at=now() where id in (select feed_id from feed_icons);
+`,
+	"schema_version_34": `alter table feeds add column content_extractors text not null default '';
+alter table categories add column content_extractors text not null default '';
+`,
+	"schema_version_35": `create table filter_rules (
+    id bigserial not null,
+    user_id int not null,
+    category_id int,
+    feed_id bigint,
+    field text not null,
+    operator text not null,
+    value text not null default '',
+    action text not null,
+    action_value text not null default '',
+    hit_count bigint not null default 0,
+    last_hit_at timestamp with time zone,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (category_id) references categories(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+
+create index filter_rules_user_idx on filter_rules using btree(user_id);
+
+insert into filter_rules (user_id, feed_id, field, operator, value, action)
+    select user_id, id, 'title', 'matches', title_filter, 'remove' from feeds where coalesce(title_filter, '') <> '';
+
+insert into filter_rules (user_id, feed_id, field, operator, value, action)
+    select user_id, id, 'content', 'matches', content_filter, 'remove' from feeds where coalesce(content_filter, '') <> '';
+
+alter table feeds drop column title_filter;
+alter table feeds drop column content_filter;
+
+alter table entries add column tags text[] not null default '{}';
+`,
+	"schema_version_36": `alter table feeds add column filter_mode text not null default '';
+alter table feeds add column suppressed_count bigint not null default 0;
+alter table categories add column filter_mode text not null default '';
+`,
+	"schema_version_37": `alter table categories add column crawler bool not null default 'f';
+alter table categories add column user_agent text not null default '';
+alter table categories add column scraper_rules text not null default '';
+alter table categories add column rewrite_rules text not null default '';
+`,
+	"schema_version_38": `alter table entries add column fingerprint bigint not null default 0;
+alter table entries add column cluster_id bigint;
+create index entries_user_cluster_idx on entries(user_id, cluster_id) where cluster_id is not null;
+`,
+	"schema_version_39": `alter table feeds add column entry_identity text not null default '';
+alter table entries add column guid_hash text not null default '';
+`,
 	"schema_version_4": `create type entry_sorting_direction as enum('asc', 'desc');
 alter table users add column entry_direction entry_sorting_direction default 'asc';
+`,
+	"schema_version_40": `create table entry_revisions (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    title text not null,
+    content text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+
+create index entry_revisions_entry_idx on entry_revisions(entry_id);
+
+alter table entries add column revision_count int not null default 0;
+alter table users add column entry_revisions_limit int not null default 5;
+alter table users add column unread_on_update bool not null default 'f';
+`,
+	"schema_version_41": `create table labels (
+    id bigserial not null,
+    user_id int not null,
+    title text not null,
+    primary key (id),
+    unique (user_id, title),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table entry_labels (
+    user_id int not null,
+    entry_id bigint not null,
+    label_id bigint not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (entry_id, label_id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade,
+    foreign key (label_id) references labels(id) on delete cascade
+);
+
+create index entry_labels_label_idx on entry_labels(label_id);
+`,
+	"schema_version_42": `create table smart_folders (
+    id bigserial not null,
+    user_id int not null,
+    title text not null,
+    search_query text not null default '',
+    feed_ids bigint[] not null default '{}',
+    category_ids bigint[] not null default '{}',
+    label_ids bigint[] not null default '{}',
+    status text not null default '',
+    published_after timestamp with time zone,
+    published_before timestamp with time zone,
+    primary key (id),
+    unique (user_id, title),
+    foreign key (user_id) references users(id) on delete cascade
+);
+`,
+	"schema_version_43": `create table highlights (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    quote text not null,
+    prefix text not null default '',
+    suffix text not null default '',
+    note text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+
+create index highlights_entry_idx on highlights(entry_id);
+create index highlights_user_created_idx on highlights(user_id, created_at);
+`,
+	"schema_version_44": `alter table feeds add column crawler_override boolean not null default false;
+`,
+	"schema_version_45": `alter table entries add column identity_hashes jsonb not null default '{}';
+`,
+	"schema_version_46": `alter table filter_rules add column error_msg text not null default '';
 `,
 	"schema_version_5": `create table integrations (
     user_id int not null,
@@ -231,8 +404,26 @@ var SqlMapChecksums = map[string]string{
 	"schema_version_26": "1224754c5b9c6b4038599852bbe72656d21b09cb018d3970bd7c00f0019845bf",
 	"schema_version_27": "f8e492fba2fc6324dec234cb715180cd6d2d632aa60b0d63cad02b2a104acef6",
 	"schema_version_28": "10bc999a87dbf9d7290e10a29b14144b4fd6fd9ecbc8b6f8251fe7711f9b65d7",
+	"schema_version_29": "8bbcc90c5b6902cb1caeb2eb590490b8c40cd30c993b7b3498c89ee541ddd369",
 	"schema_version_3":  "a54745dbc1c51c000f74d4e5068f1e2f43e83309f023415b1749a47d5c1e0f12",
+	"schema_version_30": "7e3eb4f1a369a7d5299beda123b5d75ab7dd72a0e0d488458ff91ef00543e57f",
+	"schema_version_31": "328ab75c6e5ddbb15ce4b17ad78d57bed8f16184b4da429c79b7e960deb4c541",
+	"schema_version_32": "d44ba8215290bec04bf26d3f57b6d389e36c218b2281182afde9e25468d18c3b",
+	"schema_version_33": "a30f44a2e720a824003812616e732b90ce77e7c55ff71b1f6d3074b6fd2893bb",
+	"schema_version_34": "33ad1ee0f564d37a10cd84ddce5cba3bcb4de490cc25c13f4545c09efca8f55b",
+	"schema_version_35": "ea978c6e87e410adcacea50bf2bc41bfdd3f96f14286dee0e21c817af06b5a8f",
+	"schema_version_36": "2d98828ade944115fead729be5a3e8f4bd6d385ad3598494b540b0b7d1d304d2",
+	"schema_version_37": "dc3b7a3a3d34f48af70d0e4408f71a4bba6ca1c8a15fe4c826d5ed1569fcda71",
+	"schema_version_38": "3081f0c15fed62fc863dfac236b9f030ea1605c89b6a33f6c4817ac06dc666f8",
+	"schema_version_39": "ab6073bd2001b2a3aeda72a6e7729f82326ab1aa6c4bc675ae8dd57c8465d6c6",
 	"schema_version_4":  "216ea3a7d3e1704e40c797b5dc47456517c27dbb6ca98bf88812f4f63d74b5d9",
+	"schema_version_40": "66ebb845ea385c7ff7264670d2f16f8cfa349561e1a01815614ab21f66ecb51f",
+	"schema_version_41": "cf120d54594eed495208b263e72c4805e956da35b03467ec575a6f215c0d3b40",
+	"schema_version_42": "7182969c78700624ee5c7cd23bbbb4fad771f6f44da1f57b40b50d27491df645",
+	"schema_version_43": "1e981f47c1d6ed6d721b9533ad0b6e9a4afde284cdd67a1d1084d890a78fa313",
+	"schema_version_44": "6f228e2f2ef861a0d178a89d26bf1649c9d53c0dcca7d41d0b5c07097d51a1a4",
+	"schema_version_45": "a3f2df197bae6b45a470806c6d96ce0c81d5fa3785ec9872bd8dac2e0b441d2d",
+	"schema_version_46": "9e06420a1650f9313d9b2fc7153a95620de7a9f3367a1a2e3b8a1df48206daac",
 	"schema_version_5":  "46397e2f5f2c82116786127e9f6a403e975b14d2ca7b652a48cd1ba843e6a27c",
 	"schema_version_6":  "9d05b4fb223f0e60efc716add5048b0ca9c37511cf2041721e20505d6d798ce4",
 	"schema_version_7":  "33f298c9aa30d6de3ca28e1270df51c2884d7596f1283a75716e2aeb634cd05c",
@@ -0,0 +1,2 @@
+alter table feeds add column source_type text not null default 'feed';
+alter table feeds add column source_settings jsonb not null default '{}';
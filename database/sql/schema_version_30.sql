@@ -0,0 +1,7 @@
+create table page_snapshots (
+    feed_id bigint not null,
+    content text not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (feed_id),
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
@@ -0,0 +1,7 @@
+create table gemini_certificates (
+    host text not null,
+    fingerprint text not null,
+    expires_at timestamp with time zone not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (host)
+);
@@ -0,0 +1,20 @@
+create table newsletter_tokens (
+    user_id int not null,
+    token text not null unique,
+    created_at timestamp with time zone not null default now(),
+    primary key (user_id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table newsletter_images (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    token text not null unique,
+    mime_type text not null,
+    content bytea not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
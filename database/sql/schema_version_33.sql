@@ -0,0 +1,2 @@
+alter table feeds add column icon_checked_at timestamp with time zone;
+update feeds set icon_checked_at=now() where id in (select feed_id from feed_icons);
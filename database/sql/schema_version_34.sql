@@ -0,0 +1,2 @@
+alter table feeds add column content_extractors text not null default '';
+alter table categories add column content_extractors text not null default '';
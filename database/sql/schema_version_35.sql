@@ -0,0 +1,31 @@
+create table filter_rules (
+    id bigserial not null,
+    user_id int not null,
+    category_id int,
+    feed_id bigint,
+    field text not null,
+    operator text not null,
+    value text not null default '',
+    action text not null,
+    action_value text not null default '',
+    hit_count bigint not null default 0,
+    last_hit_at timestamp with time zone,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (category_id) references categories(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+
+create index filter_rules_user_idx on filter_rules using btree(user_id);
+
+insert into filter_rules (user_id, feed_id, field, operator, value, action)
+    select user_id, id, 'title', 'matches', title_filter, 'remove' from feeds where coalesce(title_filter, '') <> '';
+
+insert into filter_rules (user_id, feed_id, field, operator, value, action)
+    select user_id, id, 'content', 'matches', content_filter, 'remove' from feeds where coalesce(content_filter, '') <> '';
+
+alter table feeds drop column title_filter;
+alter table feeds drop column content_filter;
+
+alter table entries add column tags text[] not null default '{}';
@@ -0,0 +1,3 @@
+alter table feeds add column filter_mode text not null default '';
+alter table feeds add column suppressed_count bigint not null default 0;
+alter table categories add column filter_mode text not null default '';
@@ -0,0 +1,4 @@
+alter table categories add column crawler bool not null default 'f';
+alter table categories add column user_agent text not null default '';
+alter table categories add column scraper_rules text not null default '';
+alter table categories add column rewrite_rules text not null default '';
@@ -0,0 +1,3 @@
+alter table entries add column fingerprint bigint not null default 0;
+alter table entries add column cluster_id bigint;
+create index entries_user_cluster_idx on entries(user_id, cluster_id) where cluster_id is not null;
@@ -0,0 +1,2 @@
+alter table feeds add column entry_identity text not null default '';
+alter table entries add column guid_hash text not null default '';
@@ -0,0 +1,17 @@
+create table entry_revisions (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    title text not null,
+    content text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+
+create index entry_revisions_entry_idx on entry_revisions(entry_id);
+
+alter table entries add column revision_count int not null default 0;
+alter table users add column entry_revisions_limit int not null default 5;
+alter table users add column unread_on_update bool not null default 'f';
@@ -0,0 +1,21 @@
+create table labels (
+    id bigserial not null,
+    user_id int not null,
+    title text not null,
+    primary key (id),
+    unique (user_id, title),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table entry_labels (
+    user_id int not null,
+    entry_id bigint not null,
+    label_id bigint not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (entry_id, label_id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade,
+    foreign key (label_id) references labels(id) on delete cascade
+);
+
+create index entry_labels_label_idx on entry_labels(label_id);
@@ -0,0 +1,15 @@
+create table smart_folders (
+    id bigserial not null,
+    user_id int not null,
+    title text not null,
+    search_query text not null default '',
+    feed_ids bigint[] not null default '{}',
+    category_ids bigint[] not null default '{}',
+    label_ids bigint[] not null default '{}',
+    status text not null default '',
+    published_after timestamp with time zone,
+    published_before timestamp with time zone,
+    primary key (id),
+    unique (user_id, title),
+    foreign key (user_id) references users(id) on delete cascade
+);
@@ -0,0 +1,16 @@
+create table highlights (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    quote text not null,
+    prefix text not null default '',
+    suffix text not null default '',
+    note text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+
+create index highlights_entry_idx on highlights(entry_id);
+create index highlights_user_created_idx on highlights(user_id, created_at);
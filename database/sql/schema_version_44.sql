@@ -0,0 +1 @@
+alter table feeds add column crawler_override boolean not null default false;
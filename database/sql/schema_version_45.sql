@@ -0,0 +1 @@
+alter table entries add column identity_hashes jsonb not null default '{}';
@@ -0,0 +1 @@
+alter table filter_rules add column error_msg text not null default '';
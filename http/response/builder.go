@@ -93,11 +93,12 @@ func (b *Builder) Write() {
 }
 
 func (b *Builder) writeHeaders() {
-	b.headers["X-XSS-Protection"] = "1; mode=block"
-	b.headers["X-Content-Type-Options"] = "nosniff"
-	b.headers["X-Frame-Options"] = "DENY"
-	b.headers["Content-Security-Policy"] = "default-src 'self'; img-src *; media-src *; frame-src *; font-src *"
+	b.w.Header().Set("X-XSS-Protection", "1; mode=block")
+	b.w.Header().Set("X-Content-Type-Options", "nosniff")
+	b.w.Header().Set("X-Frame-Options", "DENY")
+	b.w.Header().Set("Content-Security-Policy", "default-src 'self'; img-src *; media-src *; frame-src *; font-src *")
 
+	// The headers of the response can be more restrictive than the default ones.
 	for key, value := range b.headers {
 		b.w.Header().Set(key, value)
 	}
@@ -43,6 +43,32 @@ func TestResponseHasCommonHeaders(t *testing.T) {
 	}
 }
 
+func TestBuildResponseWithCustomSecurityHeader(t *testing.T) {
+	r, err := http.NewRequest("GET", "/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		New(w, r).WithHeader("Content-Security-Policy", "default-src 'none'").Write()
+	})
+
+	handler.ServeHTTP(w, r)
+	resp := w.Result()
+
+	expected := "default-src 'none'"
+	actual := resp.Header.Get("Content-Security-Policy")
+	if actual != expected {
+		t.Fatalf(`Unexpected header value, got %q instead of %q`, actual, expected)
+	}
+
+	if actual := resp.Header.Get("X-Content-Type-Options"); actual != "nosniff" {
+		t.Fatalf(`Unexpected header value, got %q instead of %q`, actual, "nosniff")
+	}
+}
+
 func TestBuildResponseWithCustomStatusCode(t *testing.T) {
 	r, err := http.NewRequest("GET", "/", nil)
 	if err != nil {
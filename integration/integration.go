@@ -5,6 +5,9 @@
 package integration // import "miniflux.app/integration"
 
 import (
+	"html"
+	"strings"
+
 	"miniflux.app/config"
 	"miniflux.app/integration/instapaper"
 	"miniflux.app/integration/nunuxkeeper"
@@ -15,6 +18,15 @@ import (
 	"miniflux.app/model"
 )
 
+// SendEntries sends the entries to the activated providers in the background.
+func SendEntries(entries model.Entries, integration *model.Integration) {
+	go func() {
+		for _, entry := range entries {
+			SendEntry(entry, integration)
+		}
+	}()
+}
+
 // SendEntry send the entry to the activated providers.
 func SendEntry(entry *model.Entry, integration *model.Integration) {
 	if integration.PinboardEnabled {
@@ -22,7 +34,7 @@ func SendEntry(entry *model.Entry, integration *model.Integration) {
 		err := client.AddBookmark(
 			entry.URL,
 			entry.Title,
-			integration.PinboardTags,
+			pinboardTags(integration.PinboardTags, entry.Labels),
 			integration.PinboardMarkAsUnread,
 		)
 
@@ -47,7 +59,7 @@ func SendEntry(entry *model.Entry, integration *model.Integration) {
 			integration.WallabagPassword,
 		)
 
-		if err := client.AddEntry(entry.URL, entry.Title); err != nil {
+		if err := client.AddEntry(entry.URL, entry.Title, entry.Labels.Titles(), wallabagAnnotations(entry.Highlights)); err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
 		}
 	}
@@ -58,15 +70,56 @@ func SendEntry(entry *model.Entry, integration *model.Integration) {
 			integration.NunuxKeeperAPIKey,
 		)
 
-		if err := client.AddEntry(entry.URL, entry.Title, entry.Content); err != nil {
+		if err := client.AddEntry(entry.URL, entry.Title, contentWithHighlights(entry.Content, entry.Highlights)); err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
 		}
 	}
 
 	if integration.PocketEnabled {
 		client := pocket.NewClient(config.Opts.PocketConsumerKey(integration.PocketConsumerKey), integration.PocketAccessToken)
-		if err := client.AddURL(entry.URL, entry.Title); err != nil {
+		if err := client.AddURL(entry.URL, entry.Title, entry.Labels.Titles()); err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
 		}
 	}
 }
+
+// wallabagAnnotations converts the highlights of an entry to Wallabag annotations.
+func wallabagAnnotations(highlights model.Highlights) []wallabag.Annotation {
+	annotations := make([]wallabag.Annotation, 0, len(highlights))
+	for _, highlight := range highlights {
+		annotations = append(annotations, wallabag.Annotation{Quote: highlight.Quote, Text: highlight.Note})
+	}
+
+	return annotations
+}
+
+// contentWithHighlights appends the highlights and their notes to the content of an entry,
+// for the providers that keep a copy of the content without annotations.
+func contentWithHighlights(content string, highlights model.Highlights) string {
+	if len(highlights) == 0 {
+		return content
+	}
+
+	var buffer strings.Builder
+	buffer.WriteString(content)
+	buffer.WriteString("<hr><h2>Highlights</h2>")
+	for _, highlight := range highlights {
+		buffer.WriteString("<blockquote>" + html.EscapeString(highlight.Quote) + "</blockquote>")
+		if highlight.Note != "" {
+			buffer.WriteString("<p>" + html.EscapeString(highlight.Note) + "</p>")
+		}
+	}
+
+	return buffer.String()
+}
+
+// pinboardTags appends the labels of the entry to the tags configured by the user.
+// Pinboard tags are separated by spaces, the spaces of the labels are replaced by underscores.
+func pinboardTags(tags string, labels model.Labels) string {
+	parts := strings.Fields(tags)
+	for _, label := range labels {
+		parts = append(parts, strings.Join(strings.Fields(label.Title), "_"))
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,55 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package integration // import "miniflux.app/integration"
+
+import (
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestPinboardTags(t *testing.T) {
+	scenarios := []struct {
+		tags     string
+		labels   model.Labels
+		expected string
+	}{
+		{"", nil, ""},
+		{"miniflux", nil, "miniflux"},
+		{"", model.Labels{{Title: "Later"}}, "Later"},
+		{"miniflux  rss", model.Labels{{Title: "Read later"}, {Title: "Work"}}, "miniflux rss Read_later Work"},
+	}
+
+	for _, scenario := range scenarios {
+		if result := pinboardTags(scenario.tags, scenario.labels); result != scenario.expected {
+			t.Errorf(`Unexpected tags for %q and %v: got %q instead of %q`, scenario.tags, scenario.labels.Titles(), result, scenario.expected)
+		}
+	}
+}
+
+func TestWallabagAnnotations(t *testing.T) {
+	highlights := model.Highlights{{Quote: "first passage", Note: "a note"}, {Quote: "second passage"}}
+	annotations := wallabagAnnotations(highlights)
+
+	if len(annotations) != 2 {
+		t.Fatalf(`Unexpected number of annotations: %d`, len(annotations))
+	}
+
+	if annotations[0].Quote != "first passage" || annotations[0].Text != "a note" || annotations[1].Text != "" {
+		t.Errorf(`Unexpected annotations: %v`, annotations)
+	}
+}
+
+func TestContentWithHighlights(t *testing.T) {
+	if result := contentWithHighlights("<p>Content</p>", nil); result != "<p>Content</p>" {
+		t.Errorf(`The content without highlights should not change, got %q`, result)
+	}
+
+	highlights := model.Highlights{{Quote: "a <b> passage", Note: "R&D"}, {Quote: "another passage"}}
+	expected := `<p>Content</p><hr><h2>Highlights</h2><blockquote>a &lt;b&gt; passage</blockquote><p>R&amp;D</p><blockquote>another passage</blockquote>`
+	if result := contentWithHighlights("<p>Content</p>", highlights); result != expected {
+		t.Errorf(`Unexpected content: got %q instead of %q`, result, expected)
+	}
+}
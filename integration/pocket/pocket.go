@@ -6,6 +6,7 @@ package pocket // import "miniflux.app/integration/pocket"
 
 import (
 	"fmt"
+	"strings"
 
 	"miniflux.app/http/client"
 )
@@ -16,8 +17,8 @@ type Client struct {
 	accessToken string
 }
 
-// AddURL sends a single link to Pocket.
-func (c *Client) AddURL(link, title string) error {
+// AddURL sends a single link to Pocket, the tags are added to the Pocket item.
+func (c *Client) AddURL(link, title string, tags []string) error {
 	if c.consumerKey == "" || c.accessToken == "" {
 		return fmt.Errorf("pocket: missing credentials")
 	}
@@ -27,6 +28,7 @@ func (c *Client) AddURL(link, title string) error {
 		ConsumerKey string `json:"consumer_key"`
 		Title       string `json:"title,omitempty"`
 		URL         string `json:"url"`
+		Tags        string `json:"tags,omitempty"`
 	}
 
 	data := &body{
@@ -34,6 +36,7 @@ func (c *Client) AddURL(link, title string) error {
 		ConsumerKey: c.consumerKey,
 		Title:       title,
 		URL:         link,
+		Tags:        strings.Join(tags, ","),
 	}
 
 	clt := client.New("https://getpocket.com/v3/add")
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"strings"
 
 	"miniflux.app/http/client"
 )
@@ -22,8 +23,14 @@ type Client struct {
 	password     string
 }
 
-// AddEntry sends a link to Wallabag.
-func (c *Client) AddEntry(link, title string) error {
+// Annotation represents a passage of the entry with an optional comment.
+type Annotation struct {
+	Quote string
+	Text  string
+}
+
+// AddEntry sends a link to Wallabag, the tags and the annotations are added to the Wallabag entry.
+func (c *Client) AddEntry(link, title string, tags []string, annotations []Annotation) error {
 	if c.baseURL == "" || c.clientID == "" || c.clientSecret == "" || c.username == "" || c.password == "" {
 		return fmt.Errorf("wallabag: missing credentials")
 	}
@@ -33,20 +40,57 @@ func (c *Client) AddEntry(link, title string) error {
 		return err
 	}
 
-	return c.createEntry(accessToken, link, title)
+	entryID, err := c.createEntry(accessToken, link, title, tags)
+	if err != nil {
+		return err
+	}
+
+	for _, annotation := range annotations {
+		if err := c.createAnnotation(accessToken, entryID, annotation); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (c *Client) createEntry(accessToken, link, title string) error {
+func (c *Client) createEntry(accessToken, link, title string, tags []string) (int64, error) {
 	endpoint, err := getAPIEndpoint(c.baseURL, "/api/entries.json")
 	if err != nil {
-		return fmt.Errorf("wallbag: unable to get entries endpoint: %v", err)
+		return 0, fmt.Errorf("wallbag: unable to get entries endpoint: %v", err)
 	}
 
 	clt := client.New(endpoint)
 	clt.WithAuthorization("Bearer " + accessToken)
-	response, err := clt.PostJSON(map[string]string{"url": link, "title": title})
+	response, err := clt.PostJSON(map[string]string{"url": link, "title": title, "tags": strings.Join(tags, ",")})
 	if err != nil {
-		return fmt.Errorf("wallabag: unable to post entry: %v", err)
+		return 0, fmt.Errorf("wallabag: unable to post entry: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return 0, fmt.Errorf("wallabag: request failed, status=%d", response.StatusCode)
+	}
+
+	entry, err := decodeEntryResponse(response.Body)
+	if err != nil {
+		return 0, err
+	}
+
+	return entry.ID, nil
+}
+
+// createAnnotation adds an annotation without ranges, Wallabag lists it with the entry without highlighting the passage.
+func (c *Client) createAnnotation(accessToken string, entryID int64, annotation Annotation) error {
+	endpoint, err := getAPIEndpoint(c.baseURL, fmt.Sprintf("/api/annotations/%d.json", entryID))
+	if err != nil {
+		return fmt.Errorf("wallbag: unable to get annotations endpoint: %v", err)
+	}
+
+	clt := client.New(endpoint)
+	clt.WithAuthorization("Bearer " + accessToken)
+	response, err := clt.PostJSON(map[string]interface{}{"quote": annotation.Quote, "text": annotation.Text, "ranges": []interface{}{}})
+	if err != nil {
+		return fmt.Errorf("wallabag: unable to post annotation: %v", err)
 	}
 
 	if response.HasServerFailure() {
@@ -109,6 +153,21 @@ type tokenResponse struct {
 	TokenType    string `json:"token_type"`
 }
 
+type entryResponse struct {
+	ID int64 `json:"id"`
+}
+
+func decodeEntryResponse(body io.Reader) (*entryResponse, error) {
+	var entry entryResponse
+
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&entry); err != nil {
+		return nil, fmt.Errorf("wallabag: unable to decode entry response: %v", err)
+	}
+
+	return &entry, nil
+}
+
 func decodeTokenResponse(body io.Reader) (*tokenResponse, error) {
 	var token tokenResponse
 
@@ -15,11 +15,14 @@ var translations = map[string]string{
     "action.remove": "Entfernen",
     "action.remove_feed": "Dieses Abonnement entfernen",
     "action.update": "Aktualisieren",
+    "action.preview": "Vorschau",
     "action.edit": "Bearbeiten",
     "action.download": "Herunterladen",
     "action.import": "Importieren",
     "action.login": "Anmelden",
     "action.home_screen": "Zum Startbildschirm hinzufügen",
+    "action.refresh_icon": "Symbol erneut herunterladen",
+    "action.deduplicate": "Doppelte Einträge entfernen",
     "tooltip.keyboard_shortcuts": "Tastenkürzel: %s",
     "tooltip.logged_user": "Angemeldet als %s",
     "menu.unread": "Ungelesen",
@@ -46,13 +49,27 @@ var translations = map[string]string{
     "menu.refresh_all_feeds": "Alle Abonnements im Hintergrund aktualisieren",
     "menu.edit_feed": "Bearbeiten",
     "menu.edit_category": "Bearbeiten",
+    "menu.labels": "Labels",
+    "menu.create_label": "Label erstellen",
+    "menu.edit_label": "Bearbeiten",
+    "menu.label_entries": "Artikel",
+    "menu.smart_folders": "Intelligente Ordner",
+    "menu.highlights": "Markierungen",
+    "menu.edit_highlight": "Notiz bearbeiten",
+    "menu.create_smart_folder": "Intelligenten Ordner anlegen",
+    "menu.edit_smart_folder": "Bearbeiten",
+    "menu.smart_folder_entries": "Artikel",
+    "menu.save_search": "Diese Suche speichern",
     "menu.add_feed": "Abonnement hinzufügen",
     "menu.add_user": "Benutzer anlegen",
     "menu.flush_history": "Verlauf leeren",
     "menu.feed_entries": "Artikel",
     "menu.api_keys": "API-Schlüssel",
     "menu.create_api_key": "Erstellen Sie einen neuen API-Schlüssel",
+    "menu.filter_rules": "Filter",
+    "menu.create_filter_rule": "Filterregel hinzufügen",
     "menu.shared_entries": "Geteilte Artikel",
+    "menu.back_to_entry": "Zurück zum Artikel",
     "search.label": "Suche",
     "search.placeholder": "Suche...",
     "pagination.next": "Nächste",
@@ -83,10 +100,36 @@ var translations = map[string]string{
     "entry.unshare.label": "Nicht teilen",
     "entry.shared_entry.title": "Öffnen Sie den öffentlichen Link",
     "entry.shared_entry.label": "Teilen",
+    "entry.cluster.also_covered_by": "Ebenfalls berichtet von:",
+    "entry.labels.edit": "Labels",
+    "entry.highlight.label": "Markieren",
+    "entry.highlight.title": "Ausgewählte Textstelle markieren",
+    "entry.highlight.note": "Notiz (optional):",
+    "entry.highlight.toast.empty": "Wählen Sie zuerst eine Textstelle des Artikels aus",
+    "entry.revisions.updated": [
+        "Aktualisiert (%d Version)",
+        "Aktualisiert (%d Versionen)"
+    ],
     "page.shared_entries.title": "Geteilte Artikel",
     "page.unread.title": "Ungelesen",
     "page.starred.title": "Lesezeichen",
     "page.categories.title": "Kategorien",
+    "page.labels.title": "Labels",
+    "page.highlights.title": "Markierungen",
+    "page.edit_highlight.title": "Markierung bearbeiten",
+    "page.labels.entry_count": [
+        "%d Artikel",
+        "%d Artikel"
+    ],
+    "page.edit_label.title": "Label bearbeiten: %s",
+    "page.smart_folders.title": "Intelligente Ordner",
+    "page.smart_folders.unread_count": [
+        "%d ungelesener Artikel",
+        "%d ungelesene Artikel"
+    ],
+    "page.new_smart_folder.title": "Neuer intelligenter Ordner",
+    "page.edit_smart_folder.title": "Intelligenten Ordner bearbeiten: %s",
+    "page.entry_labels.title": "Labels dieses Artikels",
     "page.categories.no_feed": "Kein Abonnement.",
     "page.categories.feeds": "Siehe Abonnements",
     "page.categories.feed_count": [
@@ -99,6 +142,7 @@ var translations = map[string]string{
     "page.edit_user.title": "Benutzer bearbeiten: %s",
     "page.feeds.title": "Abonnements",
     "page.feeds.last_check": "Letzte Aktualisierung:",
+    "page.feeds.suppressed_count": "Von der Positivliste unterdrückt: %d",
     "page.feeds.unread_counter": "Anzahl der ungelesenen Artikel",
     "page.feeds.read_counter": "Anzahl der gelesenen Artikel",
     "page.feeds.error_count": [
@@ -120,13 +164,44 @@ var translations = map[string]string{
     "page.add_feed.submit": "Abonnement suchen",
     "page.add_feed.legend.advanced_options": "Erweiterte Optionen",
     "page.add_feed.choose_feed": "Abonnement auswählen",
+    "page.add_feed.legend.web_page": "Web Page Rules",
+    "page.add_feed.legend.page_monitor": "Page Monitoring",
+    "page.add_feed.preview": "Preview",
+    "page.add_feed.preview_title": "Extracted Items",
+    "page.add_feed.preview_empty": "No item matches these selectors.",
     "page.edit_feed.title": "Abonnement bearbeiten: %s",
     "page.edit_feed.last_check": "Letzte Aktualisierung:",
     "page.edit_feed.last_modified_header": "Zuletzt geändert:",
     "page.edit_feed.etag_header": "ETag-Kopfzeile:",
     "page.edit_feed.no_header": "Nicht verfügbar",
+    "page.edit_feed.icon": "Symbol:",
+    "page.edit_feed.suppressed_count": "Von der Positivliste unterdrückte Artikel:",
+    "page.edit_feed.deduplicate.help": "Einträge, die nach der Identitätsstrategie identisch sind, werden zusammengeführt. Der älteste wird behalten und übernimmt den Gelesen- und Favoritenstatus sowie die Tags der anderen.",
+    "page.edit_feed.effective_settings": "Wirksame Einstellungen",
+    "page.edit_feed.effective_settings.help": "Beim Aktualisieren des Abonnements verwendete Einstellungen, leere Werte werden aus der Kategorie „%s“ übernommen.",
+    "page.edit_feed.inherited": "aus der Kategorie",
+    "page.edit_feed.enabled": "Aktiviert",
+    "page.edit_feed.disabled": "Deaktiviert",
+    "page.edit_feed.legend.preview": "Vorschau-Optionen",
+    "page.edit_feed.preview.title": "Vorschau",
+    "page.edit_feed.preview.summary": "%d von %d Artikeln würden entfernt, nichts wurde gespeichert.",
+    "page.edit_feed.preview.suppressed": "Von der Positivliste unterdrückt",
+    "page.edit_feed.preview.removed": "Von einer Filterregel entfernt",
+    "page.edit_feed.preview.read": "Als gelesen markiert",
+    "page.edit_feed.preview.kept": "Behalten",
+    "page.edit_feed.preview.starred": "Lesezeichen",
+    "page.edit_feed.preview.matched_rules": "Passende Filterregeln: %d",
+    "page.edit_feed.preview.content_changed": "Änderungen am Inhalt anzeigen",
+    "page.edit_feed.preview.content_unchanged": "Der Inhalt bleibt unverändert.",
+    "page.entry_revisions.title": "Frühere Versionen",
+    "page.entry_revisions.help": "Der Herausgeber hat diesen Artikel aktualisiert. Jede Version wird mit der Version verglichen, die sie ersetzt hat, die neueste zuerst.",
+    "page.entry_revisions.replaced": "Ersetzt",
+    "page.entry_revisions.content_changed": "Änderungen des Inhalts anzeigen",
+    "page.entry_revisions.content_unchanged": "Nur der Titel wurde geändert.",
     "page.edit_feed.last_parsing_error": "Letzter Analysefehler",
+    "page.edit_feed.newsletter_address": "An diese Adresse gesendete Nachrichten werden diesem Abonnement zugestellt:",
     "page.entry.attachments": "Anlagen",
+    "page.entry.highlights": "Markierungen",
     "page.keyboard_shortcuts.title": "Tastenkürzel",
     "page.keyboard_shortcuts.subtitle.sections": "Navigation zwischen den Menüpunkten",
     "page.keyboard_shortcuts.subtitle.items": "Navigation zwischen den Artikeln",
@@ -182,6 +257,8 @@ var translations = map[string]string{
     "page.integration.bookmarklet": "Bookmarklet",
     "page.integration.bookmarklet.name": "Mit Miniflux abonnieren",
     "page.integration.bookmarklet.instructions": "Ziehen Sie diesen Link in Ihre Lesezeichen.",
+    "page.integration.newsletter": "Newsletter",
+    "page.integration.newsletter.help": "Abonnieren Sie Newsletter mit dieser Adresse, die Nachrichten jedes Absenders werden in einem eigenen Abonnement zugestellt:",
     "page.integration.bookmarklet.help": "Dieser spezielle Link ermöglicht es, eine Webseite direkt über ein Lesezeichen im Browser zu abonnieren.",
     "page.sessions.title": "Sitzungen",
     "page.sessions.table.date": "Datum",
@@ -197,14 +274,31 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Aktionen",
     "page.api_keys.never_used": "Nie benutzt",
     "page.new_api_key.title": "Neuer API-Schlüssel",
+    "page.filter_rules.title": "Filterregeln",
+    "page.filter_rules.table.scope": "Gilt für",
+    "page.filter_rules.table.condition": "Bedingung",
+    "page.filter_rules.table.action": "Aktion",
+    "page.filter_rules.table.hits": "Betroffene Artikel",
+    "page.filter_rules.table.error": "Fehler",
+    "page.filter_rules.ignored": "Diese Regel wird ignoriert, bis sie korrigiert wird:",
+    "page.filter_rules.table.actions": "Aktionen",
+    "page.new_filter_rule.title": "Neue Filterregel",
+    "page.edit_filter_rule.title": "Filterregel bearbeiten",
     "alert.no_shared_entry": "Es existieren derzeit keine geteilten Artikel.",
     "alert.no_bookmark": "Es existiert derzeit kein Lesezeichen.",
     "alert.no_category": "Es ist keine Kategorie vorhanden.",
+    "alert.no_label": "Sie haben keine Labels.",
+    "alert.no_highlight": "Sie haben keine Markierungen, wählen Sie eine Textstelle eines Artikels aus, um sie zu markieren.",
+    "alert.no_label_entry": "Es gibt keine Artikel mit diesem Label.",
+    "alert.no_smart_folder": "Es gibt keine intelligenten Ordner. Speichern Sie eine Suche, um einen anzulegen.",
+    "alert.no_smart_folder_entry": "Es gibt keine Artikel, die zu diesem intelligenten Ordner passen.",
     "alert.no_category_entry": "Es befindet sich kein Artikel in dieser Kategorie.",
     "alert.no_feed_entry": "Es existiert kein Artikel für dieses Abonnement.",
     "alert.no_feed": "Es sind keine Abonnements vorhanden.",
     "alert.no_feed_in_category": "Für diese Kategorie gibt es kein Abonnement.",
+    "alert.no_filter_rule": "Es gibt keine Filterregel.",
     "alert.no_history": "Es existiert zur Zeit kein Verlauf.",
+    "alert.no_entry_revision": "Dieser Artikel wurde nicht aktualisiert.",
     "alert.feed_error": "Es gibt ein Problem mit diesem Abonnement",
     "alert.no_search_result": "Es gibt kein Ergebnis für diese Suche.",
     "alert.no_unread_entry": "Es existiert kein ungelesener Artikel.",
@@ -213,12 +307,26 @@ var translations = map[string]string{
     "alert.account_linked": "Ihr externes Konto wurde verknüpft!",
     "alert.pocket_linked": "Ihr Pocket Konto ist jetzt verknüpft!",
     "alert.prefs_saved": "Einstellungen gespeichert!",
+    "alert.feed_icon_refreshed": "Das Symbol wurde aktualisiert.",
+    "alert.feed_deduplicated": "Entfernte doppelte Einträge: %d.",
     "error.unlink_account_without_password": "Sie müssen ein Passwort festlegen, sonst können Sie sich nicht erneut anmelden.",
     "error.duplicate_linked_account": "Es ist bereits jemand mit diesem Anbieter assoziiert!",
     "error.duplicate_fever_username": "Es existiert bereits jemand mit diesem Fever Benutzernamen!",
     "error.pocket_request_token": "Anfrage-Token konnte nicht von Pocket abgerufen werden!",
     "error.pocket_access_token": "Zugriffstoken konnte nicht von Pocket abgerufen werden!",
+    "error.feed_icon_not_found": "Das Symbol dieser Webseite konnte nicht gefunden werden.",
+    "error.unable_to_deduplicate_feed": "Die doppelten Einträge dieses Abonnements konnten nicht entfernt werden.",
     "error.category_already_exists": "Diese Kategorie existiert bereits.",
+    "error.label_already_exists": "Dieses Label existiert bereits.",
+    "error.unable_to_create_label": "Dieses Label konnte nicht erstellt werden.",
+    "error.unable_to_update_label": "Dieses Label konnte nicht aktualisiert werden.",
+    "error.unable_to_update_highlight": "Diese Markierung kann nicht aktualisiert werden.",
+    "error.smart_folder_already_exists": "Dieser intelligente Ordner existiert bereits.",
+    "error.unable_to_create_smart_folder": "Dieser intelligente Ordner konnte nicht angelegt werden.",
+    "error.unable_to_update_smart_folder": "Dieser intelligente Ordner konnte nicht aktualisiert werden.",
+    "error.smart_folder_invalid_status": "Der Status muss ungelesen oder gelesen sein.",
+    "error.smart_folder_invalid_date": "Die Daten müssen das Format JJJJ-MM-TT haben.",
+    "error.smart_folder_invalid_date_range": "Das Anfangsdatum muss vor dem Enddatum liegen.",
     "error.unable_to_create_category": "Diese Kategorie konnte nicht angelegt werden.",
     "error.unable_to_update_category": "Diese Kategorie konnte nicht aktualisiert werden.",
     "error.user_already_exists": "Dieser Benutzer existiert bereits.",
@@ -234,27 +342,91 @@ var translations = map[string]string{
     "error.password_min_length": "Wenigstens 6 Zeichen müssen genutzt werden.",
     "error.settings_mandatory_fields": "Die Felder für Benutzername, Thema, Sprache und Zeitzone sind obligatorisch.",
     "error.feed_mandatory_fields": "Die URL und die Kategorie sind obligatorisch.",
+    "error.web_page_rules_invalid": "The item selector and a title or link selector are mandatory.",
+    "error.content_extractors_invalid": "Unbekannter Inhaltsextraktor oder ungültiges Zeitlimit.",
+    "error.filter_mode_invalid": "Ungültiger Filtermodus.",
+    "error.entry_identity_invalid": "Ungültige Eintragsidentität.",
+    "error.no_entry_to_preview": "Es gibt keine Artikel für die Vorschau.",
+    "error.scraper_rules_invalid": "Ungültige Scraper-Regeln, die Anzahl der Seiten muss zwischen 1 und 20 liegen.",
+    "error.rewrite_rules_invalid": "Ungültige Umschreiberegeln, überprüfen Sie die Regelnamen, die Argumente in Anführungszeichen und die regulären Ausdrücke.",
+    "error.page_monitor_threshold_invalid": "The change threshold must be between 0 and 100.",
+    "error.local_sources_forbidden": "Local sources are disabled or reserved to administrators.",
     "error.user_mandatory_fields": "Der Benutzername ist obligatorisch.",
     "error.api_key_already_exists": "Dieser API-Schlüssel ist bereits vorhanden.",
     "error.unable_to_create_api_key": "Dieser API-Schlüssel kann nicht erstellt werden.",
+    "error.filter_rule_invalid": "Ungültige Filterregel, überprüfen Sie den Wert der Bedingung, den regulären Ausdruck oder die Dauer.",
+    "error.entry_revisions_limit_invalid": "Die Anzahl der aufzubewahrenden Versionen muss zwischen 0 und %d liegen.",
+    "error.unable_to_create_filter_rule": "Diese Filterregel konnte nicht erstellt werden.",
+    "error.unable_to_update_filter_rule": "Diese Filterregel konnte nicht aktualisiert werden.",
     "form.feed.label.title": "Titel",
     "form.feed.label.site_url": "Webseite-URL",
     "form.feed.label.feed_url": "Abonnement-URL",
     "form.feed.label.category": "Kategorie",
     "form.feed.label.crawler": "Inhalt herunterladen",
+    "form.feed.label.crawler_override": "Die Einstellung der Kategorie für den Inhalt ignorieren",
     "form.feed.label.feed_username": "Benutzername des Abonnements",
     "form.feed.label.feed_password": "Passwort des Abonnements",
     "form.feed.label.user_agent": "Standardbenutzeragenten überschreiben",
     "form.feed.label.scraper_rules": "Extraktionsregeln",
     "form.feed.label.rewrite_rules": "Umschreiberegeln",
+    "form.feed.label.use_mercury": "Mercury Parser verwenden",
+    "form.feed.label.content_extractors": "Inhaltsextraktoren",
+    "form.feed.help.content_extractors": "Kommagetrennte Liste der nacheinander versuchten Extraktoren, jeweils optional mit einem Zeitlimit in Sekunden, zum Beispiel \"scraper, readability:10\". Verfügbare Extraktoren: %s.",
+    "form.feed.label.filter_mode": "Filtermodus",
+    "form.feed.filter_mode.inherit": "Wie die Kategorie",
+    "form.feed.help.preview": "Wendet den Filtermodus, die Filterregeln, die Inhaltsextraktoren sowie die Extraktions- und Umschreiberegeln dieses Formulars auf die neuesten Artikel des Abonnements an, ohne etwas zu speichern.",
+    "form.feed.label.preview_refetch": "Abonnement erneut herunterladen, statt die gespeicherten Artikel zu verwenden",
+    "form.feed.label.preview_crawl": "Originalinhalt mit den Inhaltsextraktoren abrufen (langsamer)",
+    "form.feed.help.scraper_rules": "CSS-Selektoren durch Semikolons getrennt. Verwenden Sie „exclude: Selektor“ um Elemente zu entfernen, „next“ oder „next: Selektor“ um der Seitennummerierung zu folgen und „pages: Anzahl“ um die Anzahl der Seiten zu begrenzen.",
+    "form.feed.help.rewrite_rules": "Kommagetrennte Liste von Regeln, zum Beispiel: nl2br, replace(\"regex\", \"Ersetzung\"), replace_title(\"regex\", \"Ersetzung\"), remove(\"CSS-Selektor\"), add_class(\"CSS-Selektor\", \"Name\"). Den hinzugefügten Klassennamen wird „rewrite-“ vorangestellt.",
+    "form.category.help.content_extractors": "Wird von den Abonnements dieser Kategorie ohne eigene Inhaltsextraktoren verwendet. Verfügbare Extraktoren: %s.",
+    "form.category.help.default_settings": "Die folgenden Einstellungen werden von den Abonnements dieser Kategorie verwendet, die keine eigenen festlegen. Ist der Crawler hier aktiviert, gilt er für alle Abonnements der Kategorie.",
+    "form.category.label.filter_mode": "Filtermodus",
+    "form.filter_mode.block": "Alle Artikel behalten, außer den von den Filterregeln entfernten",
+    "form.filter_mode.allowlist": "Nur Artikel behalten, die einer „Behalten“-Regel entsprechen (Positivliste)",
+    "form.filter_mode.help": "Im Positivlisten-Modus werden neue Artikel entfernt, die keiner Filterregel mit der Aktion „Behalten“ entsprechen, alle, wenn es keine solche Regel gibt.",
+    "form.feed.label.entry_identity": "Eintragsidentität",
+    "form.feed.entry_identity.default": "Standard (Kennung des Feed-Eintrags)",
+    "form.feed.entry_identity.guid": "GUID des Eintrags",
+    "form.feed.entry_identity.url": "URL",
+    "form.feed.entry_identity.canonical_url": "Kanonische URL (ohne Schema, „www.“ und abschließenden Schrägstrich)",
+    "form.feed.entry_identity.title_date": "Titel und Veröffentlichungsdatum",
+    "form.feed.entry_identity.content": "Inhalt",
+    "form.feed.help.entry_identity": "Legt fest, wie Einträge beim Aktualisieren des Abonnements erkannt werden. Eine Änderung entfernt die doppelten Einträge des Abonnements. Der Inhalt sollte nicht mit dem Crawler verwendet werden, Titel und Datum nur bei Feeds mit Veröffentlichungsdaten.",
+    "form.feed.label.source_type": "Source Type",
+    "form.feed.source_type.feed": "Feed (RSS, Atom, JSON)",
+    "form.feed.source_type.web_page": "Web page (CSS selectors)",
+    "form.feed.source_type.page_monitor": "Web page changes",
+    "form.feed.source_type.file": "Local file (file:// URL)",
+    "form.feed.source_type.command": "Command output (file:// URL of an executable)",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.summary_selector": "Summary Selector",
+    "form.feed.label.monitor_selector": "Monitored Elements (CSS selector, optional)",
+    "form.feed.label.monitor_threshold": "Minimum Percentage of Changed Words",
     "form.feed.label.disabled": "Dieses Abonnement nicht aktualisieren",
-    "form.feed.label.title_filter": "Titelfilter",
-    "form.feed.label.content_filter": "Inhaltsfilter",
     "form.category.label.title": "Titel",
+    "form.label.label.title": "Titel",
+    "form.highlight.label.note": "Notiz",
+    "form.smart_folder.label.title": "Titel",
+    "form.smart_folder.label.search_query": "Suchbegriffe",
+    "form.smart_folder.label.feeds": "Abonnements",
+    "form.smart_folder.label.categories": "Kategorien",
+    "form.smart_folder.label.labels": "Labels",
+    "form.smart_folder.help.selection": "Lassen Sie eine Liste leer, um nicht danach zu filtern. Ein Artikel passt zu einer Liste, wenn er zu einem der ausgewählten Einträge passt.",
+    "form.smart_folder.label.status": "Status",
+    "form.smart_folder.status.all": "Alle Artikel",
+    "form.smart_folder.status.unread": "Ungelesene Artikel",
+    "form.smart_folder.status.read": "Gelesene Artikel",
+    "form.smart_folder.label.published_after": "Veröffentlicht ab",
+    "form.smart_folder.label.published_before": "Veröffentlicht vor",
     "form.user.label.username": "Benutzername",
     "form.user.label.password": "Passwort",
     "form.user.label.confirmation": "Passwort Bestätigung",
     "form.user.label.admin": "Administrator",
+    "form.user.label.mercury_api_url": "Mercury API URL",
     "form.prefs.label.language": "Sprache",
     "form.prefs.label.timezone": "Zeitzone",
     "form.prefs.label.theme": "Thema",
@@ -262,6 +434,9 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Älteste Artikel zuerst",
     "form.prefs.select.recent_first": "Neueste Artikel zuerst",
     "form.prefs.label.keyboard_shortcuts": "Tastaturkürzel aktivieren",
+    "form.prefs.label.entry_revisions_limit": "Aufbewahrte frühere Versionen pro aktualisiertem Artikel",
+    "form.prefs.help.entry_revisions_limit": "Mit 0 werden der frühere Titel und Inhalt aktualisierter Artikel nicht mehr aufbewahrt.",
+    "form.prefs.label.unread_on_update": "Artikel wieder als ungelesen markieren, wenn sich ihr Inhalt wesentlich ändert",
     "form.prefs.label.custom_css": "Benutzerdefiniertes CSS",
     "form.import.label.file": "OPML Datei",
     "form.import.label.url": "URL",
@@ -290,6 +465,36 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API-Endpunkt",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API-Schlüssel",
     "form.api_key.label.description": "API-Schlüsselbezeichnung",
+    "form.filter_rule.label.scope": "Gilt für",
+    "form.filter_rule.scope.all": "Alle Abonnements",
+    "form.filter_rule.scope.categories": "Kategorien",
+    "form.filter_rule.scope.feeds": "Abonnements",
+    "form.filter_rule.label.field": "Feld",
+    "form.filter_rule.label.operator": "Operator",
+    "form.filter_rule.label.value": "Wert",
+    "form.filter_rule.help.value": "Groß- und Kleinschreibung wird nicht beachtet. Verwenden Sie einen regulären Ausdruck mit „entspricht“ und eine Dauer wie 12h, 7d oder 2w mit dem Datum.",
+    "form.filter_rule.label.action": "Aktion",
+    "form.filter_rule.label.action_value": "Schlagwort",
+    "form.filter_rule.help.action_value": "Wird nur von der Aktion „Schlagwort hinzufügen“ verwendet.",
+    "filter_rule.field.title": "Titel",
+    "filter_rule.field.content": "Inhalt",
+    "filter_rule.field.author": "Autor",
+    "filter_rule.field.url": "URL",
+    "filter_rule.field.tags": "Schlagwörter",
+    "filter_rule.field.date": "Datum",
+    "filter_rule.operator.contains": "enthält",
+    "filter_rule.operator.not_contains": "enthält nicht",
+    "filter_rule.operator.equals": "ist",
+    "filter_rule.operator.matches": "entspricht",
+    "filter_rule.operator.not_matches": "entspricht nicht",
+    "filter_rule.operator.older_than": "ist älter als",
+    "filter_rule.operator.newer_than": "ist neuer als",
+    "filter_rule.action.remove": "Artikel entfernen",
+    "filter_rule.action.read": "Als gelesen markieren",
+    "filter_rule.action.star": "Lesezeichen hinzufügen",
+    "filter_rule.action.tag": "Schlagwort hinzufügen",
+    "filter_rule.action.integration": "An die Dienste senden",
+    "filter_rule.action.keep": "Behalten (Positivlisten-Modus)",
     "form.submit.loading": "Lade...",
     "form.submit.saving": "Speichern...",
     "time_elapsed.not_yet": "noch nicht",
@@ -353,11 +558,14 @@ var translations = map[string]string{
     "action.remove": "Remove",
     "action.remove_feed": "Remove this feed",
     "action.update": "Update",
+    "action.preview": "Preview",
     "action.edit": "Edit",
     "action.download": "Download",
     "action.import": "Import",
     "action.login": "Login",
     "action.home_screen": "Add to home screen",
+    "action.refresh_icon": "Download icon again",
+    "action.deduplicate": "Remove duplicate entries",
     "tooltip.keyboard_shortcuts": "Keyboard Shortcut: %s",
     "tooltip.logged_user": "Logged as %s",
     "menu.unread": "Unread",
@@ -383,13 +591,27 @@ var translations = map[string]string{
     "menu.refresh_all_feeds": "Refresh all feeds in the background",
     "menu.edit_feed": "Edit",
     "menu.edit_category": "Edit",
+    "menu.labels": "Labels",
+    "menu.create_label": "Create a label",
+    "menu.edit_label": "Edit",
+    "menu.label_entries": "Entries",
+    "menu.smart_folders": "Smart Folders",
+    "menu.highlights": "Highlights",
+    "menu.edit_highlight": "Edit note",
+    "menu.create_smart_folder": "Create a smart folder",
+    "menu.edit_smart_folder": "Edit",
+    "menu.smart_folder_entries": "Entries",
+    "menu.save_search": "Save this search",
     "menu.add_feed": "Add subscription",
     "menu.add_user": "Add user",
     "menu.flush_history": "Flush history",
     "menu.feed_entries": "Entries",
     "menu.api_keys": "API Keys",
     "menu.create_api_key": "Create a new API key",
+    "menu.filter_rules": "Filters",
+    "menu.create_filter_rule": "Add a filter rule",
     "menu.shared_entries": "Shared entries",
+    "menu.back_to_entry": "Back to the entry",
     "search.label": "Search",
     "search.placeholder": "Search...",
     "pagination.next": "Next",
@@ -420,10 +642,36 @@ var translations = map[string]string{
     "entry.unshare.label": "Unshare",
     "entry.shared_entry.title": "Open the public link",
     "entry.shared_entry.label": "Share",
+    "entry.cluster.also_covered_by": "Also covered by:",
+    "entry.labels.edit": "Labels",
+    "entry.highlight.label": "Highlight",
+    "entry.highlight.title": "Highlight the selected passage",
+    "entry.highlight.note": "Note (optional):",
+    "entry.highlight.toast.empty": "Select a passage of the article first",
+    "entry.revisions.updated": [
+        "Updated (%d version)",
+        "Updated (%d versions)"
+    ],
     "page.shared_entries.title": "Shared Entries",
     "page.unread.title": "Unread",
     "page.starred.title": "Starred",
     "page.categories.title": "Categories",
+    "page.labels.title": "Labels",
+    "page.highlights.title": "Highlights",
+    "page.edit_highlight.title": "Edit Highlight",
+    "page.labels.entry_count": [
+        "%d article",
+        "%d articles"
+    ],
+    "page.edit_label.title": "Edit Label: %s",
+    "page.smart_folders.title": "Smart Folders",
+    "page.smart_folders.unread_count": [
+        "%d unread article",
+        "%d unread articles"
+    ],
+    "page.new_smart_folder.title": "New Smart Folder",
+    "page.edit_smart_folder.title": "Edit Smart Folder: %s",
+    "page.entry_labels.title": "Labels of this entry",
     "page.categories.no_feed": "No feed.",
     "page.categories.feeds": "See subscriptions",
     "page.categories.feed_count": [
@@ -436,6 +684,7 @@ var translations = map[string]string{
     "page.edit_user.title": "Edit User: %s",
     "page.feeds.title": "Feeds",
     "page.feeds.last_check": "Last check:",
+    "page.feeds.suppressed_count": "Suppressed by the allowlist: %d",
     "page.feeds.unread_counter": "Number of unread entries",
     "page.feeds.read_counter": "Number of read entries",
     "page.feeds.error_count": [
@@ -457,13 +706,44 @@ var translations = map[string]string{
     "page.add_feed.submit": "Find a subscription",
     "page.add_feed.legend.advanced_options": "Advanced Options",
     "page.add_feed.choose_feed": "Choose a Subscription",
+    "page.add_feed.legend.web_page": "Web Page Rules",
+    "page.add_feed.legend.page_monitor": "Page Monitoring",
+    "page.add_feed.preview": "Preview",
+    "page.add_feed.preview_title": "Extracted Items",
+    "page.add_feed.preview_empty": "No item matches these selectors.",
     "page.edit_feed.title": "Edit Feed: %s",
     "page.edit_feed.last_check": "Last check:",
     "page.edit_feed.last_modified_header": "LastModified header:",
     "page.edit_feed.etag_header": "ETag header:",
     "page.edit_feed.no_header": "None",
+    "page.edit_feed.icon": "Icon:",
+    "page.edit_feed.suppressed_count": "Entries suppressed by the allowlist:",
+    "page.edit_feed.deduplicate.help": "Entries recognized as the same entry by the identity strategy are merged, the oldest one is kept with the read and starred states and the tags of the others.",
+    "page.edit_feed.effective_settings": "Effective Settings",
+    "page.edit_feed.effective_settings.help": "Settings used when the feed is refreshed, the empty ones are taken from the category \"%s\".",
+    "page.edit_feed.inherited": "from the category",
+    "page.edit_feed.enabled": "Enabled",
+    "page.edit_feed.disabled": "Disabled",
+    "page.edit_feed.legend.preview": "Preview Options",
+    "page.edit_feed.preview.title": "Preview",
+    "page.edit_feed.preview.summary": "%d of %d entries would be removed, nothing has been saved.",
+    "page.edit_feed.preview.suppressed": "Suppressed by the allowlist",
+    "page.edit_feed.preview.removed": "Removed by a filter rule",
+    "page.edit_feed.preview.read": "Marked as read",
+    "page.edit_feed.preview.kept": "Kept",
+    "page.edit_feed.preview.starred": "Starred",
+    "page.edit_feed.preview.matched_rules": "Matched filter rules: %d",
+    "page.edit_feed.preview.content_changed": "Show the content changes",
+    "page.edit_feed.preview.content_unchanged": "The content is unchanged.",
+    "page.entry_revisions.title": "Previous versions",
+    "page.entry_revisions.help": "The publisher has updated this entry. Each version is compared with the one that replaced it, the most recent first.",
+    "page.entry_revisions.replaced": "Replaced",
+    "page.entry_revisions.content_changed": "Show the changes of the content",
+    "page.entry_revisions.content_unchanged": "Only the title has changed.",
     "page.edit_feed.last_parsing_error": "Last Parsing Error",
+    "page.edit_feed.newsletter_address": "Messages sent to this address are delivered to this feed:",
     "page.entry.attachments": "Attachments",
+    "page.entry.highlights": "Highlights",
     "page.keyboard_shortcuts.title": "Keyboard Shortcuts",
     "page.keyboard_shortcuts.subtitle.sections": "Sections Navigation",
     "page.keyboard_shortcuts.subtitle.items": "Items Navigation",
@@ -519,6 +799,8 @@ var translations = map[string]string{
     "page.integration.bookmarklet": "Bookmarklet",
     "page.integration.bookmarklet.name": "Add to Miniflux",
     "page.integration.bookmarklet.instructions": "Drag and drop this link to your bookmarks.",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address, the messages of each sender are delivered to their own feed:",
     "page.integration.bookmarklet.help": "This special link allows you to subscribe to a website directly by using a bookmark in your web browser.",
     "page.sessions.title": "Sessions",
     "page.sessions.table.date": "Date",
@@ -534,14 +816,31 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Actions",
     "page.api_keys.never_used": "Never Used",
     "page.new_api_key.title": "New API Key",
+    "page.filter_rules.title": "Filter Rules",
+    "page.filter_rules.table.scope": "Applies To",
+    "page.filter_rules.table.condition": "Condition",
+    "page.filter_rules.table.action": "Action",
+    "page.filter_rules.table.hits": "Matched Articles",
+    "page.filter_rules.table.error": "Error",
+    "page.filter_rules.ignored": "This rule is ignored until it is fixed:",
+    "page.filter_rules.table.actions": "Actions",
+    "page.new_filter_rule.title": "New Filter Rule",
+    "page.edit_filter_rule.title": "Edit Filter Rule",
     "alert.no_shared_entry": "There is no shared entry.",
     "alert.no_bookmark": "There is no bookmark at the moment.",
     "alert.no_category": "There is no category.",
+    "alert.no_label": "You don't have any label.",
+    "alert.no_highlight": "There are no highlights. Select a passage of an article to highlight it.",
+    "alert.no_label_entry": "There are no articles with this label.",
+    "alert.no_smart_folder": "There are no smart folders. Save a search to create one.",
+    "alert.no_smart_folder_entry": "There are no articles matching this smart folder.",
     "alert.no_category_entry": "There are no articles in this category.",
     "alert.no_feed_entry": "There are no articles for this feed.",
     "alert.no_feed": "You don't have any subscriptions.",
     "alert.no_feed_in_category": "There is no subscription for this category.",
+    "alert.no_filter_rule": "There is no filter rule.",
     "alert.no_history": "There is no history at the moment.",
+    "alert.no_entry_revision": "This entry has not been updated.",
     "alert.feed_error": "There is a problem with this feed",
     "alert.no_search_result": "There are no results for this search.",
     "alert.no_unread_entry": "There are no unread articles.",
@@ -550,12 +849,26 @@ var translations = map[string]string{
     "alert.account_linked": "Your external account is now linked!",
     "alert.pocket_linked": "Your Pocket account is now linked!",
     "alert.prefs_saved": "Preferences saved!",
+    "alert.feed_icon_refreshed": "The icon has been updated.",
+    "alert.feed_deduplicated": "Duplicate entries removed: %d.",
     "error.unlink_account_without_password": "You must define a password otherwise you won't be able to login again.",
     "error.duplicate_linked_account": "There is already someone associated with this provider!",
     "error.duplicate_fever_username": "There is already someone else with the same Fever username!",
     "error.pocket_request_token": "Unable to fetch request token from Pocket!",
     "error.pocket_access_token": "Unable to fetch access token from Pocket!",
+    "error.feed_icon_not_found": "Unable to find the icon of this website.",
+    "error.unable_to_deduplicate_feed": "Unable to remove the duplicate entries of this feed.",
     "error.category_already_exists": "This category already exists.",
+    "error.label_already_exists": "This label already exists.",
+    "error.unable_to_create_label": "Unable to create this label.",
+    "error.unable_to_update_label": "Unable to update this label.",
+    "error.unable_to_update_highlight": "Unable to update this highlight.",
+    "error.smart_folder_already_exists": "This smart folder already exists.",
+    "error.unable_to_create_smart_folder": "Unable to create this smart folder.",
+    "error.unable_to_update_smart_folder": "Unable to update this smart folder.",
+    "error.smart_folder_invalid_status": "The status must be unread or read.",
+    "error.smart_folder_invalid_date": "The dates must use the format YYYY-MM-DD.",
+    "error.smart_folder_invalid_date_range": "The start date must be before the end date.",
     "error.unable_to_create_category": "Unable to create this category.",
     "error.unable_to_update_category": "Unable to update this category.",
     "error.user_already_exists": "This user already exists.",
@@ -571,24 +884,86 @@ var translations = map[string]string{
     "error.password_min_length": "The password must have at least 6 characters.",
     "error.settings_mandatory_fields": "The username, theme, language and timezone fields are mandatory.",
     "error.feed_mandatory_fields": "The URL and the category are mandatory.",
+    "error.web_page_rules_invalid": "The item selector and a title or link selector are mandatory.",
+    "error.content_extractors_invalid": "Unknown content extractor or invalid time limit.",
+    "error.filter_mode_invalid": "Invalid filter mode.",
+    "error.entry_identity_invalid": "Invalid entry identity.",
+    "error.no_entry_to_preview": "There is no entry to preview.",
+    "error.scraper_rules_invalid": "Invalid scraper rules, the number of pages must be between 1 and 20.",
+    "error.rewrite_rules_invalid": "Invalid rewrite rules, check the rule names, the quoted arguments and the regular expressions.",
+    "error.page_monitor_threshold_invalid": "The change threshold must be between 0 and 100.",
+    "error.local_sources_forbidden": "Local sources are disabled or reserved to administrators.",
     "error.user_mandatory_fields": "The username is mandatory.",
     "error.api_key_already_exists": "This API Key already exists.",
     "error.unable_to_create_api_key": "Unable to create this API Key.",
+    "error.filter_rule_invalid": "Invalid filter rule, check the value of the condition, the regular expression or the duration.",
+    "error.entry_revisions_limit_invalid": "The number of versions to keep must be between 0 and %d.",
+    "error.unable_to_create_filter_rule": "Unable to create this filter rule.",
+    "error.unable_to_update_filter_rule": "Unable to update this filter rule.",
     "form.feed.label.title": "Title",
     "form.feed.label.site_url": "Site URL",
     "form.feed.label.feed_url": "Feed URL",
     "form.feed.label.category": "Category",
     "form.feed.label.crawler": "Fetch original content",
+    "form.feed.label.crawler_override": "Ignore the crawler setting of the category",
     "form.feed.label.feed_username": "Feed Username",
     "form.feed.label.feed_password": "Feed Password",
     "form.feed.label.user_agent": "Override Default User Agent",
     "form.feed.label.scraper_rules": "Scraper Rules",
     "form.feed.label.rewrite_rules": "Rewrite Rules",
-    "form.feed.label.title_filter": "Title Filter",
-    "form.feed.label.content_filter": "Content Filter",
+    "form.feed.label.source_type": "Source Type",
+    "form.feed.source_type.feed": "Feed (RSS, Atom, JSON)",
+    "form.feed.source_type.web_page": "Web page (CSS selectors)",
+    "form.feed.source_type.page_monitor": "Web page changes",
+    "form.feed.source_type.file": "Local file (file:// URL)",
+    "form.feed.source_type.command": "Command output (file:// URL of an executable)",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.summary_selector": "Summary Selector",
+    "form.feed.label.monitor_selector": "Monitored Elements (CSS selector, optional)",
+    "form.feed.label.monitor_threshold": "Minimum Percentage of Changed Words",
     "form.feed.label.use_mercury": "Use Mercury Parser",
+    "form.feed.label.content_extractors": "Content extractors",
+    "form.feed.help.content_extractors": "Comma separated list of extractors tried in order, each one can have a time limit in seconds, for example \"scraper, readability:10\". Available extractors: %s.",
+    "form.feed.label.filter_mode": "Filter Mode",
+    "form.feed.filter_mode.inherit": "Same as the category",
+    "form.feed.help.preview": "Applies the filter mode, the filter rules, the content extractors, the scraper and rewrite rules of this form to the latest entries of the feed without saving anything.",
+    "form.feed.label.preview_refetch": "Download the feed again instead of using the stored entries",
+    "form.feed.label.preview_crawl": "Fetch the original content with the content extractors (slower)",
+    "form.feed.help.scraper_rules": "CSS selectors separated by semicolons. Use \"exclude: selector\" to remove elements, \"next\" or \"next: selector\" to follow the pagination and \"pages: number\" to limit the number of pages.",
+    "form.feed.help.rewrite_rules": "Comma separated list of rules, for example: nl2br, replace(\"regex\", \"replacement\"), replace_title(\"regex\", \"replacement\"), remove(\"CSS selector\"), add_class(\"CSS selector\", \"name\"). The added class names are prefixed with \"rewrite-\".",
+    "form.category.help.content_extractors": "Used by the feeds of this category without their own content extractors. Available extractors: %s.",
+    "form.category.help.default_settings": "The following settings are used by the feeds of this category that do not define their own. When the crawler is enabled here, it is enabled for all the feeds of the category.",
+    "form.category.label.filter_mode": "Filter Mode",
+    "form.filter_mode.block": "Keep all entries except the ones removed by the filter rules",
+    "form.filter_mode.allowlist": "Keep only the entries matching a \"keep\" rule (allowlist)",
+    "form.filter_mode.help": "In allowlist mode, new entries not matching any filter rule with the action \"keep\" are removed, all of them if there is no such rule.",
+    "form.feed.label.entry_identity": "Entry Identity",
+    "form.feed.entry_identity.default": "Default (identifier of the feed item)",
+    "form.feed.entry_identity.guid": "GUID of the item",
+    "form.feed.entry_identity.url": "URL",
+    "form.feed.entry_identity.canonical_url": "Canonical URL (without scheme, \"www.\" and trailing slash)",
+    "form.feed.entry_identity.title_date": "Title and publication date",
+    "form.feed.entry_identity.content": "Content",
+    "form.feed.help.entry_identity": "Defines how the entries are recognized when the feed is refreshed. Changing it removes the duplicate entries of the feed. The content should not be used with the crawler, and the title and date only with feeds providing publication dates.",
     "form.feed.label.disabled": "Do not refresh this feed",
     "form.category.label.title": "Title",
+    "form.label.label.title": "Title",
+    "form.highlight.label.note": "Note",
+    "form.smart_folder.label.title": "Title",
+    "form.smart_folder.label.search_query": "Search terms",
+    "form.smart_folder.label.feeds": "Feeds",
+    "form.smart_folder.label.categories": "Categories",
+    "form.smart_folder.label.labels": "Labels",
+    "form.smart_folder.help.selection": "Leave a list empty to not filter on it. An article matches a list when it matches one of the selected items.",
+    "form.smart_folder.label.status": "Status",
+    "form.smart_folder.status.all": "All articles",
+    "form.smart_folder.status.unread": "Unread articles",
+    "form.smart_folder.status.read": "Read articles",
+    "form.smart_folder.label.published_after": "Published from",
+    "form.smart_folder.label.published_before": "Published before",
     "form.user.label.username": "Username",
     "form.user.label.password": "Password",
     "form.user.label.confirmation": "Password Confirmation",
@@ -601,6 +976,9 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Older entries first",
     "form.prefs.select.recent_first": "Recent entries first",
     "form.prefs.label.keyboard_shortcuts": "Enable keyboard shortcuts",
+    "form.prefs.label.entry_revisions_limit": "Previous versions kept for each updated entry",
+    "form.prefs.help.entry_revisions_limit": "Set to 0 to stop keeping the previous title and content of updated entries.",
+    "form.prefs.label.unread_on_update": "Mark entries as unread again when their content changes substantially",
     "form.prefs.label.custom_css": "Custom CSS",
     "form.import.label.file": "OPML file",
     "form.import.label.url": "URL",
@@ -629,6 +1007,36 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API key",
     "form.api_key.label.description": "API Key Label",
+    "form.filter_rule.label.scope": "Applies To",
+    "form.filter_rule.scope.all": "All feeds",
+    "form.filter_rule.scope.categories": "Categories",
+    "form.filter_rule.scope.feeds": "Feeds",
+    "form.filter_rule.label.field": "Field",
+    "form.filter_rule.label.operator": "Operator",
+    "form.filter_rule.label.value": "Value",
+    "form.filter_rule.help.value": "The comparisons are case-insensitive. Use a regular expression with \"matches\", and a duration such as 12h, 7d or 2w with the date.",
+    "form.filter_rule.label.action": "Action",
+    "form.filter_rule.label.action_value": "Tag",
+    "form.filter_rule.help.action_value": "Only used by the action \"Add a tag\".",
+    "filter_rule.field.title": "Title",
+    "filter_rule.field.content": "Content",
+    "filter_rule.field.author": "Author",
+    "filter_rule.field.url": "URL",
+    "filter_rule.field.tags": "Tags",
+    "filter_rule.field.date": "Date",
+    "filter_rule.operator.contains": "contains",
+    "filter_rule.operator.not_contains": "does not contain",
+    "filter_rule.operator.equals": "is",
+    "filter_rule.operator.matches": "matches",
+    "filter_rule.operator.not_matches": "does not match",
+    "filter_rule.operator.older_than": "is older than",
+    "filter_rule.operator.newer_than": "is newer than",
+    "filter_rule.action.remove": "Remove the article",
+    "filter_rule.action.read": "Mark as read",
+    "filter_rule.action.star": "Star",
+    "filter_rule.action.tag": "Add a tag",
+    "filter_rule.action.integration": "Send to the integrations",
+    "filter_rule.action.keep": "Keep (allowlist mode)",
     "form.submit.loading": "Loading...",
     "form.submit.saving": "Saving...",
     "time_elapsed.not_yet": "not yet",
@@ -672,11 +1080,14 @@ var translations = map[string]string{
     "action.remove": "Quitar",
     "action.remove_feed": "Quitar esta fuente",
     "action.update": "Actualizar",
+    "action.preview": "Preview",
     "action.edit": "Editar",
     "action.download": "Descargar",
     "action.import": "Importar",
     "action.login": "Iniciar sesión",
     "action.home_screen": "Añadir a la pantalla principal",
+    "action.refresh_icon": "Download icon again",
+    "action.deduplicate": "Remove duplicate entries",
     "tooltip.keyboard_shortcuts": "Atajo de teclado: %s",
     "tooltip.logged_user": "Registrado como %s",
     "menu.unread": "No leídos",
@@ -702,13 +1113,27 @@ var translations = map[string]string{
     "menu.refresh_all_feeds": "Refrescar todas las fuentes en el fondo",
     "menu.edit_feed": "Editar",
     "menu.edit_category": "Editar",
+    "menu.labels": "Labels",
+    "menu.create_label": "Create a label",
+    "menu.edit_label": "Edit",
+    "menu.label_entries": "Entries",
+    "menu.smart_folders": "Smart Folders",
+    "menu.highlights": "Highlights",
+    "menu.edit_highlight": "Edit note",
+    "menu.create_smart_folder": "Create a smart folder",
+    "menu.edit_smart_folder": "Edit",
+    "menu.smart_folder_entries": "Entries",
+    "menu.save_search": "Save this search",
     "menu.add_feed": "Agregar suscripción",
     "menu.add_user": "Agregar usuario",
     "menu.flush_history": "Borrar historial",
     "menu.feed_entries": "Artículos",
     "menu.api_keys": "Claves API",
     "menu.create_api_key": "Crear una nueva clave API",
+    "menu.filter_rules": "Filters",
+    "menu.create_filter_rule": "Add a filter rule",
     "menu.shared_entries": "Entradas compartidas",
+    "menu.back_to_entry": "Back to the entry",
     "search.label": "Buscar",
     "search.placeholder": "Búsqueda...",
     "pagination.next": "Siguiente",
@@ -739,10 +1164,36 @@ var translations = map[string]string{
     "entry.unshare.label": "No compartir",
     "entry.shared_entry.title": "Abrir el enlace público",
     "entry.shared_entry.label": "Compartir",
+    "entry.cluster.also_covered_by": "Also covered by:",
+    "entry.labels.edit": "Labels",
+    "entry.highlight.label": "Highlight",
+    "entry.highlight.title": "Highlight the selected passage",
+    "entry.highlight.note": "Note (optional):",
+    "entry.highlight.toast.empty": "Select a passage of the article first",
+    "entry.revisions.updated": [
+        "Updated (%d version)",
+        "Updated (%d versions)"
+    ],
     "page.shared_entries.title": "Entradas compartidas",
     "page.unread.title": "No leídos",
     "page.starred.title": "Marcadores",
     "page.categories.title": "Categorias",
+    "page.labels.title": "Labels",
+    "page.highlights.title": "Highlights",
+    "page.edit_highlight.title": "Edit Highlight",
+    "page.labels.entry_count": [
+        "%d article",
+        "%d articles"
+    ],
+    "page.edit_label.title": "Edit Label: %s",
+    "page.smart_folders.title": "Smart Folders",
+    "page.smart_folders.unread_count": [
+        "%d unread article",
+        "%d unread articles"
+    ],
+    "page.new_smart_folder.title": "New Smart Folder",
+    "page.edit_smart_folder.title": "Edit Smart Folder: %s",
+    "page.entry_labels.title": "Labels of this entry",
     "page.categories.no_feed": "No fuente.",
     "page.categories.feeds": "Ver suscripciones",
     "page.categories.feed_count": [
@@ -755,6 +1206,7 @@ var translations = map[string]string{
     "page.edit_user.title": "Editar usuario: %s",
     "page.feeds.title": "Fuentes",
     "page.feeds.last_check": "Última verificación:",
+    "page.feeds.suppressed_count": "Suppressed by the allowlist: %d",
     "page.feeds.unread_counter": "Número de entradas no leídas",
     "page.feeds.read_counter": "Número de entradas leídas",
     "page.feeds.error_count": [
@@ -776,13 +1228,44 @@ var translations = map[string]string{
     "page.add_feed.submit": "Encontrar una suscripción",
     "page.add_feed.legend.advanced_options": "Opciones avanzadas",
     "page.add_feed.choose_feed": "Elegir una suscripción",
+    "page.add_feed.legend.web_page": "Web Page Rules",
+    "page.add_feed.legend.page_monitor": "Page Monitoring",
+    "page.add_feed.preview": "Preview",
+    "page.add_feed.preview_title": "Extracted Items",
+    "page.add_feed.preview_empty": "No item matches these selectors.",
     "page.edit_feed.title": "Editar fuente: %s",
     "page.edit_feed.last_check": "Última verificación:",
     "page.edit_feed.last_modified_header": "Cabecera de LastModified:",
     "page.edit_feed.etag_header": "Cabecera de ETag:",
     "page.edit_feed.no_header": "Sin cabecera",
+    "page.edit_feed.icon": "Icon:",
+    "page.edit_feed.suppressed_count": "Entries suppressed by the allowlist:",
+    "page.edit_feed.deduplicate.help": "Entries recognized as the same entry by the identity strategy are merged, the oldest one is kept with the read and starred states and the tags of the others.",
+    "page.edit_feed.effective_settings": "Effective Settings",
+    "page.edit_feed.effective_settings.help": "Settings used when the feed is refreshed, the empty ones are taken from the category \"%s\".",
+    "page.edit_feed.inherited": "from the category",
+    "page.edit_feed.enabled": "Enabled",
+    "page.edit_feed.disabled": "Disabled",
+    "page.edit_feed.legend.preview": "Preview Options",
+    "page.edit_feed.preview.title": "Preview",
+    "page.edit_feed.preview.summary": "%d of %d entries would be removed, nothing has been saved.",
+    "page.edit_feed.preview.suppressed": "Suppressed by the allowlist",
+    "page.edit_feed.preview.removed": "Removed by a filter rule",
+    "page.edit_feed.preview.read": "Marked as read",
+    "page.edit_feed.preview.kept": "Kept",
+    "page.edit_feed.preview.starred": "Starred",
+    "page.edit_feed.preview.matched_rules": "Matched filter rules: %d",
+    "page.edit_feed.preview.content_changed": "Show the content changes",
+    "page.edit_feed.preview.content_unchanged": "The content is unchanged.",
+    "page.entry_revisions.title": "Previous versions",
+    "page.entry_revisions.help": "The publisher has updated this entry. Each version is compared with the one that replaced it, the most recent first.",
+    "page.entry_revisions.replaced": "Replaced",
+    "page.entry_revisions.content_changed": "Show the changes of the content",
+    "page.entry_revisions.content_unchanged": "Only the title has changed.",
     "page.edit_feed.last_parsing_error": "Último error de análisis",
+    "page.edit_feed.newsletter_address": "Messages sent to this address are delivered to this feed:",
     "page.entry.attachments": "Archivos adjuntos",
+    "page.entry.highlights": "Highlights",
     "page.keyboard_shortcuts.title": "Atajos de teclado",
     "page.keyboard_shortcuts.subtitle.sections": "Navegación de secciones",
     "page.keyboard_shortcuts.subtitle.items": "Navegación de artículos",
@@ -838,6 +1321,8 @@ var translations = map[string]string{
     "page.integration.bookmarklet": "Bookmarklet",
     "page.integration.bookmarklet.name": "Agregar a Miniflux",
     "page.integration.bookmarklet.instructions": "Arrastrar y soltar este enlace a tus marcadores del navegador.",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address, the messages of each sender are delivered to their own feed:",
     "page.integration.bookmarklet.help": "Este enlace especial te permite suscribirte a un sitio de web directamente usando un marcador del navegador.",
     "page.sessions.title": "Sesiones",
     "page.sessions.table.date": "Fecha",
@@ -853,14 +1338,31 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Acciones",
     "page.api_keys.never_used": "Nunca usado",
     "page.new_api_key.title": "Nueva clave API",
+    "page.filter_rules.title": "Filter Rules",
+    "page.filter_rules.table.scope": "Applies To",
+    "page.filter_rules.table.condition": "Condition",
+    "page.filter_rules.table.action": "Action",
+    "page.filter_rules.table.hits": "Matched Articles",
+    "page.filter_rules.table.error": "Error",
+    "page.filter_rules.ignored": "This rule is ignored until it is fixed:",
+    "page.filter_rules.table.actions": "Actions",
+    "page.new_filter_rule.title": "New Filter Rule",
+    "page.edit_filter_rule.title": "Edit Filter Rule",
     "alert.no_shared_entry": "No hay entrada compartida.",
     "alert.no_bookmark": "No hay marcador en este momento.",
     "alert.no_category": "No hay categoría.",
+    "alert.no_label": "You don't have any label.",
+    "alert.no_highlight": "There are no highlights. Select a passage of an article to highlight it.",
+    "alert.no_label_entry": "There are no articles with this label.",
+    "alert.no_smart_folder": "There are no smart folders. Save a search to create one.",
+    "alert.no_smart_folder_entry": "There are no articles matching this smart folder.",
     "alert.no_category_entry": "No hay artículos en esta categoria.",
     "alert.no_feed_entry": "No hay artículos para esta fuente.",
     "alert.no_feed": "No tienes suscripciones.",
     "alert.no_feed_in_category": "No hay suscripción para esta categoría.",
+    "alert.no_filter_rule": "There is no filter rule.",
     "alert.no_history": "No hay historial en este momento.",
+    "alert.no_entry_revision": "This entry has not been updated.",
     "alert.feed_error": "Hay un problema con esta fuente.",
     "alert.no_search_result": "No hay resultados para esta búsqueda.",
     "alert.no_unread_entry": "No hay artículos sin leer.",
@@ -869,12 +1371,26 @@ var translations = map[string]string{
     "alert.account_linked": "¡Tu cuenta externa ya está vinculada!",
     "alert.pocket_linked": "¡Tu cuenta de Pocket ya está vinculada!",
     "alert.prefs_saved": "¡Las preferencias se han guardado!",
+    "alert.feed_icon_refreshed": "The icon has been updated.",
+    "alert.feed_deduplicated": "Duplicate entries removed: %d.",
     "error.unlink_account_without_password": "Debe definir una contraseña, de lo contrario no podrá volver a iniciar sesión.",
     "error.duplicate_linked_account": "¡Ya hay alguien asociado a este servicio!",
     "error.duplicate_fever_username": "¡Ya hay alguien con el mismo nombre de usuario de Fever!",
     "error.pocket_request_token": "Incapaz de obtener un token de solicitud de Pocket!",
     "error.pocket_access_token": "Incapaz de obtener un token de acceso de Pocket!",
+    "error.feed_icon_not_found": "Unable to find the icon of this website.",
+    "error.unable_to_deduplicate_feed": "Unable to remove the duplicate entries of this feed.",
     "error.category_already_exists": "Esta categoría ya existe.",
+    "error.label_already_exists": "This label already exists.",
+    "error.unable_to_create_label": "Unable to create this label.",
+    "error.unable_to_update_label": "Unable to update this label.",
+    "error.unable_to_update_highlight": "Unable to update this highlight.",
+    "error.smart_folder_already_exists": "This smart folder already exists.",
+    "error.unable_to_create_smart_folder": "Unable to create this smart folder.",
+    "error.unable_to_update_smart_folder": "Unable to update this smart folder.",
+    "error.smart_folder_invalid_status": "The status must be unread or read.",
+    "error.smart_folder_invalid_date": "The dates must use the format YYYY-MM-DD.",
+    "error.smart_folder_invalid_date_range": "The start date must be before the end date.",
     "error.unable_to_create_category": "Incapaz de crear esta categoría.",
     "error.unable_to_update_category": "Incapaz de actualizar esta categoría.",
     "error.user_already_exists": "Este usuario ya existe.",
@@ -890,27 +1406,91 @@ var translations = map[string]string{
     "error.password_min_length": "La contraseña debería tener al menos 6 caracteres.",
     "error.settings_mandatory_fields": "Los campos de nombre de usuario, tema, idioma y zona horaria son obligatorios.",
     "error.feed_mandatory_fields": "Los campos de URL y categoría son obligatorios.",
+    "error.web_page_rules_invalid": "The item selector and a title or link selector are mandatory.",
+    "error.content_extractors_invalid": "Unknown content extractor or invalid time limit.",
+    "error.filter_mode_invalid": "Invalid filter mode.",
+    "error.entry_identity_invalid": "Invalid entry identity.",
+    "error.no_entry_to_preview": "There is no entry to preview.",
+    "error.scraper_rules_invalid": "Invalid scraper rules, the number of pages must be between 1 and 20.",
+    "error.rewrite_rules_invalid": "Invalid rewrite rules, check the rule names, the quoted arguments and the regular expressions.",
+    "error.page_monitor_threshold_invalid": "The change threshold must be between 0 and 100.",
+    "error.local_sources_forbidden": "Local sources are disabled or reserved to administrators.",
     "error.user_mandatory_fields": "El nombre de usuario es obligatorio.",
     "error.api_key_already_exists": "Esta clave API ya existe.",
     "error.unable_to_create_api_key": "No se puede crear esta clave API.",
+    "error.filter_rule_invalid": "Invalid filter rule, check the value of the condition, the regular expression or the duration.",
+    "error.entry_revisions_limit_invalid": "The number of versions to keep must be between 0 and %d.",
+    "error.unable_to_create_filter_rule": "Unable to create this filter rule.",
+    "error.unable_to_update_filter_rule": "Unable to update this filter rule.",
     "form.feed.label.title": "Título",
     "form.feed.label.site_url": "URL del sitio",
     "form.feed.label.feed_url": "URL de la fuente",
     "form.feed.label.category": "Categoría",
     "form.feed.label.crawler": "Obtener contento original",
+    "form.feed.label.crawler_override": "Ignore the crawler setting of the category",
     "form.feed.label.feed_username": "Nombre de usuario de fuente",
     "form.feed.label.feed_password": "Contraseña de fuente",
     "form.feed.label.user_agent": "Invalidar el agente de usuario predeterminado",
     "form.feed.label.scraper_rules": "Reglas de raspador",
     "form.feed.label.rewrite_rules": "Reglas de reescribir",
-    "form.feed.label.title_filter": "Filtro de título",
-    "form.feed.label.content_filter": "Filtro de contenido",
+    "form.feed.label.use_mercury": "Usar Mercury Parser",
+    "form.feed.label.content_extractors": "Content extractors",
+    "form.feed.help.content_extractors": "Comma separated list of extractors tried in order, each one can have a time limit in seconds, for example \"scraper, readability:10\". Available extractors: %s.",
+    "form.feed.label.filter_mode": "Filter Mode",
+    "form.feed.filter_mode.inherit": "Same as the category",
+    "form.feed.help.preview": "Applies the filter mode, the filter rules, the content extractors, the scraper and rewrite rules of this form to the latest entries of the feed without saving anything.",
+    "form.feed.label.preview_refetch": "Download the feed again instead of using the stored entries",
+    "form.feed.label.preview_crawl": "Fetch the original content with the content extractors (slower)",
+    "form.feed.help.scraper_rules": "CSS selectors separated by semicolons. Use \"exclude: selector\" to remove elements, \"next\" or \"next: selector\" to follow the pagination and \"pages: number\" to limit the number of pages.",
+    "form.feed.help.rewrite_rules": "Comma separated list of rules, for example: nl2br, replace(\"regex\", \"replacement\"), replace_title(\"regex\", \"replacement\"), remove(\"CSS selector\"), add_class(\"CSS selector\", \"name\"). The added class names are prefixed with \"rewrite-\".",
+    "form.category.help.content_extractors": "Used by the feeds of this category without their own content extractors. Available extractors: %s.",
+    "form.category.help.default_settings": "The following settings are used by the feeds of this category that do not define their own. When the crawler is enabled here, it is enabled for all the feeds of the category.",
+    "form.category.label.filter_mode": "Filter Mode",
+    "form.filter_mode.block": "Keep all entries except the ones removed by the filter rules",
+    "form.filter_mode.allowlist": "Keep only the entries matching a \"keep\" rule (allowlist)",
+    "form.filter_mode.help": "In allowlist mode, new entries not matching any filter rule with the action \"keep\" are removed, all of them if there is no such rule.",
+    "form.feed.label.entry_identity": "Entry Identity",
+    "form.feed.entry_identity.default": "Default (identifier of the feed item)",
+    "form.feed.entry_identity.guid": "GUID of the item",
+    "form.feed.entry_identity.url": "URL",
+    "form.feed.entry_identity.canonical_url": "Canonical URL (without scheme, \"www.\" and trailing slash)",
+    "form.feed.entry_identity.title_date": "Title and publication date",
+    "form.feed.entry_identity.content": "Content",
+    "form.feed.help.entry_identity": "Defines how the entries are recognized when the feed is refreshed. Changing it removes the duplicate entries of the feed. The content should not be used with the crawler, and the title and date only with feeds providing publication dates.",
+    "form.feed.label.source_type": "Source Type",
+    "form.feed.source_type.feed": "Feed (RSS, Atom, JSON)",
+    "form.feed.source_type.web_page": "Web page (CSS selectors)",
+    "form.feed.source_type.page_monitor": "Web page changes",
+    "form.feed.source_type.file": "Local file (file:// URL)",
+    "form.feed.source_type.command": "Command output (file:// URL of an executable)",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.summary_selector": "Summary Selector",
+    "form.feed.label.monitor_selector": "Monitored Elements (CSS selector, optional)",
+    "form.feed.label.monitor_threshold": "Minimum Percentage of Changed Words",
     "form.feed.label.disabled": "No actualice este feed",
     "form.category.label.title": "Título",
+    "form.label.label.title": "Title",
+    "form.highlight.label.note": "Note",
+    "form.smart_folder.label.title": "Title",
+    "form.smart_folder.label.search_query": "Search terms",
+    "form.smart_folder.label.feeds": "Feeds",
+    "form.smart_folder.label.categories": "Categories",
+    "form.smart_folder.label.labels": "Labels",
+    "form.smart_folder.help.selection": "Leave a list empty to not filter on it. An article matches a list when it matches one of the selected items.",
+    "form.smart_folder.label.status": "Status",
+    "form.smart_folder.status.all": "All articles",
+    "form.smart_folder.status.unread": "Unread articles",
+    "form.smart_folder.status.read": "Read articles",
+    "form.smart_folder.label.published_after": "Published from",
+    "form.smart_folder.label.published_before": "Published before",
     "form.user.label.username": "Nombre de usuario",
     "form.user.label.password": "Contraseña",
     "form.user.label.confirmation": "Confirmación de contraseña",
     "form.user.label.admin": "Administrador",
+    "form.user.label.mercury_api_url": "Mercury API URL",
     "form.prefs.label.language": "Idioma",
     "form.prefs.label.timezone": "Zona horaria",
     "form.prefs.label.theme": "Tema",
@@ -918,6 +1498,9 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Entradas más viejas primero",
     "form.prefs.select.recent_first": "Entradas recientes primero",
     "form.prefs.label.keyboard_shortcuts": "Habilitar atajos de teclado",
+    "form.prefs.label.entry_revisions_limit": "Previous versions kept for each updated entry",
+    "form.prefs.help.entry_revisions_limit": "Set to 0 to stop keeping the previous title and content of updated entries.",
+    "form.prefs.label.unread_on_update": "Mark entries as unread again when their content changes substantially",
     "form.prefs.label.custom_css": "CSS personalizado",
     "form.import.label.file": "Archivo OPML",
     "form.import.label.url": "URL",
@@ -946,6 +1529,36 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_endpoint": "Extremo de API de Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "Clave de API de Nunux Keeper",
     "form.api_key.label.description": "Etiqueta de clave API",
+    "form.filter_rule.label.scope": "Applies To",
+    "form.filter_rule.scope.all": "All feeds",
+    "form.filter_rule.scope.categories": "Categories",
+    "form.filter_rule.scope.feeds": "Feeds",
+    "form.filter_rule.label.field": "Field",
+    "form.filter_rule.label.operator": "Operator",
+    "form.filter_rule.label.value": "Value",
+    "form.filter_rule.help.value": "The comparisons are case-insensitive. Use a regular expression with \"matches\", and a duration such as 12h, 7d or 2w with the date.",
+    "form.filter_rule.label.action": "Action",
+    "form.filter_rule.label.action_value": "Tag",
+    "form.filter_rule.help.action_value": "Only used by the action \"Add a tag\".",
+    "filter_rule.field.title": "Title",
+    "filter_rule.field.content": "Content",
+    "filter_rule.field.author": "Author",
+    "filter_rule.field.url": "URL",
+    "filter_rule.field.tags": "Tags",
+    "filter_rule.field.date": "Date",
+    "filter_rule.operator.contains": "contains",
+    "filter_rule.operator.not_contains": "does not contain",
+    "filter_rule.operator.equals": "is",
+    "filter_rule.operator.matches": "matches",
+    "filter_rule.operator.not_matches": "does not match",
+    "filter_rule.operator.older_than": "is older than",
+    "filter_rule.operator.newer_than": "is newer than",
+    "filter_rule.action.remove": "Remove the article",
+    "filter_rule.action.read": "Mark as read",
+    "filter_rule.action.star": "Star",
+    "filter_rule.action.tag": "Add a tag",
+    "filter_rule.action.integration": "Send to the integrations",
+    "filter_rule.action.keep": "Keep (allowlist mode)",
     "form.submit.loading": "Cargando...",
     "form.submit.saving": "Guardando...",
     "time_elapsed.not_yet": "todavía no",
@@ -989,11 +1602,14 @@ var translations = map[string]string{
     "action.remove": "Supprimer",
     "action.remove_feed": "Supprimer ce flux",
     "action.update": "Mettre à jour",
+    "action.preview": "Aperçu",
     "action.edit": "Modifier",
     "action.download": "Télécharger",
     "action.import": "Importer",
     "action.login": "Se connecter",
     "action.home_screen": "Ajouter à l'écran d'accueil",
+    "action.refresh_icon": "Télécharger l'icône à nouveau",
+    "action.deduplicate": "Supprimer les entrées en double",
     "tooltip.keyboard_shortcuts": "Raccourci clavier : %s",
     "tooltip.logged_user": "Connecté en tant que %s",
     "menu.unread": "Non lus",
@@ -1020,13 +1636,27 @@ var translations = map[string]string{
     "menu.refresh_all_feeds": "Actualiser les abonnements en arrière-plan",
     "menu.edit_feed": "Modifier",
     "menu.edit_category": "Modifier",
+    "menu.labels": "Libellés",
+    "menu.create_label": "Créer un libellé",
+    "menu.edit_label": "Modifier",
+    "menu.label_entries": "Articles",
+    "menu.smart_folders": "Dossiers intelligents",
+    "menu.highlights": "Passages surlignés",
+    "menu.edit_highlight": "Modifier la note",
+    "menu.create_smart_folder": "Créer un dossier intelligent",
+    "menu.edit_smart_folder": "Modifier",
+    "menu.smart_folder_entries": "Articles",
+    "menu.save_search": "Enregistrer cette recherche",
     "menu.add_feed": "Ajouter un abonnement",
     "menu.add_user": "Ajouter un utilisateur",
     "menu.flush_history": "Supprimer l'historique",
     "menu.feed_entries": "Articles",
     "menu.api_keys": "Clés d'API",
     "menu.create_api_key": "Créer une nouvelle clé d'API",
+    "menu.filter_rules": "Filtres",
+    "menu.create_filter_rule": "Ajouter une règle de filtrage",
     "menu.shared_entries": "Articles partagés",
+    "menu.back_to_entry": "Retour à l'article",
     "search.label": "Recherche",
     "search.placeholder": "Recherche...",
     "pagination.next": "Suivant",
@@ -1057,10 +1687,36 @@ var translations = map[string]string{
     "entry.unshare.label": "Enlever le partage",
     "entry.shared_entry.title": "Ouvrir le lien public",
     "entry.shared_entry.label": "Partage",
+    "entry.cluster.also_covered_by": "Également couvert par :",
+    "entry.labels.edit": "Libellés",
+    "entry.highlight.label": "Surligner",
+    "entry.highlight.title": "Surligner le passage sélectionné",
+    "entry.highlight.note": "Note (facultative) :",
+    "entry.highlight.toast.empty": "Sélectionnez d'abord un passage de l'article",
+    "entry.revisions.updated": [
+        "Mis à jour (%d version)",
+        "Mis à jour (%d versions)"
+    ],
     "page.shared_entries.title": "Articles partagés",
     "page.unread.title": "Non lus",
     "page.starred.title": "Favoris",
     "page.categories.title": "Catégories",
+    "page.labels.title": "Libellés",
+    "page.highlights.title": "Passages surlignés",
+    "page.edit_highlight.title": "Modifier le passage surligné",
+    "page.labels.entry_count": [
+        "%d article",
+        "%d articles"
+    ],
+    "page.edit_label.title": "Modification du libellé : %s",
+    "page.smart_folders.title": "Dossiers intelligents",
+    "page.smart_folders.unread_count": [
+        "%d article non lu",
+        "%d articles non lus"
+    ],
+    "page.new_smart_folder.title": "Nouveau dossier intelligent",
+    "page.edit_smart_folder.title": "Modification du dossier intelligent : %s",
+    "page.entry_labels.title": "Libellés de cet article",
     "page.categories.no_feed": "Aucun abonnement.",
     "page.categories.feeds": "Voir les abonnements",
     "page.categories.feed_count": [
@@ -1073,6 +1729,7 @@ var translations = map[string]string{
     "page.edit_user.title": "Modification de l'utilisateur : %s",
     "page.feeds.title": "Abonnements",
     "page.feeds.last_check": "Dernière vérification :",
+    "page.feeds.suppressed_count": "Supprimés par la liste d’autorisation : %d",
     "page.feeds.unread_counter": "Nombre d'entrées non lues",
     "page.feeds.read_counter": "Nombre d'entrées lues",
     "page.feeds.error_count": [
@@ -1094,13 +1751,44 @@ var translations = map[string]string{
     "page.add_feed.submit": "Trouver un abonnement",
     "page.add_feed.legend.advanced_options": "Options avancées",
     "page.add_feed.choose_feed": "Choisissez un abonnement",
+    "page.add_feed.legend.web_page": "Règles de la page web",
+    "page.add_feed.legend.page_monitor": "Surveillance de la page",
+    "page.add_feed.preview": "Aperçu",
+    "page.add_feed.preview_title": "Éléments extraits",
+    "page.add_feed.preview_empty": "Aucun élément ne correspond à ces sélecteurs.",
     "page.edit_feed.title": "Modification de l'abonnement : %s",
     "page.edit_feed.last_check": "Dernière vérification :",
     "page.edit_feed.last_modified_header": "En-tête LastModified :",
     "page.edit_feed.etag_header": "En-tête ETag :",
     "page.edit_feed.no_header": "Aucune",
+    "page.edit_feed.icon": "Icône :",
+    "page.edit_feed.suppressed_count": "Articles supprimés par la liste d’autorisation :",
+    "page.edit_feed.deduplicate.help": "Les entrées reconnues comme identiques par la stratégie d'identité sont fusionnées, la plus ancienne est conservée avec l'état lu, les favoris et les libellés des autres.",
+    "page.edit_feed.effective_settings": "Paramètres effectifs",
+    "page.edit_feed.effective_settings.help": "Paramètres utilisés lors de l'actualisation de l'abonnement, ceux qui sont vides proviennent de la catégorie « %s ».",
+    "page.edit_feed.inherited": "de la catégorie",
+    "page.edit_feed.enabled": "Activé",
+    "page.edit_feed.disabled": "Désactivé",
+    "page.edit_feed.legend.preview": "Options de l’aperçu",
+    "page.edit_feed.preview.title": "Aperçu",
+    "page.edit_feed.preview.summary": "%d articles sur %d seraient supprimés, rien n’a été enregistré.",
+    "page.edit_feed.preview.suppressed": "Supprimé par la liste d’autorisation",
+    "page.edit_feed.preview.removed": "Supprimé par une règle de filtrage",
+    "page.edit_feed.preview.read": "Marqué comme lu",
+    "page.edit_feed.preview.kept": "Conservé",
+    "page.edit_feed.preview.starred": "Favori",
+    "page.edit_feed.preview.matched_rules": "Règles de filtrage correspondantes : %d",
+    "page.edit_feed.preview.content_changed": "Afficher les modifications du contenu",
+    "page.edit_feed.preview.content_unchanged": "Le contenu n’est pas modifié.",
+    "page.entry_revisions.title": "Versions précédentes",
+    "page.entry_revisions.help": "L'éditeur a mis à jour cet article. Chaque version est comparée à celle qui l'a remplacée, de la plus récente à la plus ancienne.",
+    "page.entry_revisions.replaced": "Remplacée",
+    "page.entry_revisions.content_changed": "Afficher les modifications du contenu",
+    "page.entry_revisions.content_unchanged": "Seul le titre a été modifié.",
     "page.edit_feed.last_parsing_error": "Dernière erreur d'analyse",
+    "page.edit_feed.newsletter_address": "Les messages envoyés à cette adresse sont ajoutés à cet abonnement :",
     "page.entry.attachments": "Pièces Jointes",
+    "page.entry.highlights": "Passages surlignés",
     "page.keyboard_shortcuts.title": "Raccourcis clavier",
     "page.keyboard_shortcuts.subtitle.sections": "Naviguation entre les sections",
     "page.keyboard_shortcuts.subtitle.items": "Naviguation entre les éléments",
@@ -1156,6 +1844,8 @@ var translations = map[string]string{
     "page.integration.bookmarklet": "Bookmarklet",
     "page.integration.bookmarklet.name": "Ajouter à Miniflux",
     "page.integration.bookmarklet.instructions": "Glisser-déposer ce lien dans vos favoris.",
+    "page.integration.newsletter": "Lettres d'information",
+    "page.integration.newsletter.help": "Abonnez-vous aux lettres d'information avec cette adresse, les messages de chaque expéditeur sont ajoutés à leur propre abonnement :",
     "page.integration.bookmarklet.help": "Ce lien spécial vous permet de vous abonner à un site web directement en utilisant un marque page dans votre navigateur web.",
     "page.sessions.title": "Sessions",
     "page.sessions.table.date": "Date",
@@ -1171,14 +1861,31 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Actions",
     "page.api_keys.never_used": "Jamais utilisé",
     "page.new_api_key.title": "Nouvelle clé d'API",
+    "page.filter_rules.title": "Règles de filtrage",
+    "page.filter_rules.table.scope": "S'applique à",
+    "page.filter_rules.table.condition": "Condition",
+    "page.filter_rules.table.action": "Action",
+    "page.filter_rules.table.hits": "Articles concernés",
+    "page.filter_rules.table.error": "Erreur",
+    "page.filter_rules.ignored": "Cette règle est ignorée tant qu'elle n'est pas corrigée :",
+    "page.filter_rules.table.actions": "Actions",
+    "page.new_filter_rule.title": "Nouvelle règle de filtrage",
+    "page.edit_filter_rule.title": "Modifier la règle de filtrage",
     "alert.no_shared_entry": "Il n'y a pas d'article partagé.",
     "alert.no_bookmark": "Il n'y a aucun favoris pour le moment.",
     "alert.no_category": "Il n'y a aucune catégorie.",
+    "alert.no_label": "Vous n'avez aucun libellé.",
+    "alert.no_highlight": "Vous n'avez aucun passage surligné, sélectionnez un passage d'un article pour le surligner.",
+    "alert.no_label_entry": "Il n'y a aucun article avec ce libellé.",
+    "alert.no_smart_folder": "Il n'y a aucun dossier intelligent. Enregistrez une recherche pour en créer un.",
+    "alert.no_smart_folder_entry": "Aucun article ne correspond à ce dossier intelligent.",
     "alert.no_category_entry": "Il n'y a aucun article dans cette catégorie.",
     "alert.no_feed_entry": "Il n'y a aucun article pour cet abonnement.",
     "alert.no_feed": "Vous n'avez aucun abonnement.",
     "alert.no_feed_in_category": "Il n'y a pas d'abonnement pour cette catégorie.",
+    "alert.no_filter_rule": "Il n'y a aucune règle de filtrage.",
     "alert.no_history": "Il n'y a aucun historique pour le moment.",
+    "alert.no_entry_revision": "Cet article n'a pas été mis à jour.",
     "alert.feed_error": "Il y a un problème avec cet abonnement",
     "alert.no_search_result": "Il n'y a aucun résultat pour cette recherche.",
     "alert.no_unread_entry": "Il n'y a rien de nouveau à lire.",
@@ -1187,12 +1894,26 @@ var translations = map[string]string{
     "alert.account_linked": "Votre compte externe est maintenant associé !",
     "alert.pocket_linked": "Votre compte Pocket est maintenant connecté !",
     "alert.prefs_saved": "Préférences sauvegardées !",
+    "alert.feed_icon_refreshed": "L'icône a été mise à jour.",
+    "alert.feed_deduplicated": "Entrées en double supprimées : %d.",
     "error.unlink_account_without_password": "Vous devez définir un mot de passe sinon vous ne pourrez plus vous connecter par la suite.",
     "error.duplicate_linked_account": "Il y a déjà quelqu'un d'associé avec ce provider !",
     "error.duplicate_fever_username": "Il y a déjà quelqu'un d'autre avec le même nom d'utilisateur Fever !",
     "error.pocket_request_token": "Impossible de récupérer le jeton d'accès depuis Pocket !",
     "error.pocket_access_token": "Impossible de récupérer le jeton d'accès depuis Pocket !",
+    "error.feed_icon_not_found": "Impossible de trouver l'icône de ce site web.",
+    "error.unable_to_deduplicate_feed": "Impossible de supprimer les entrées en double de cet abonnement.",
     "error.category_already_exists": "Cette catégorie existe déjà.",
+    "error.label_already_exists": "Ce libellé existe déjà.",
+    "error.unable_to_create_label": "Impossible de créer ce libellé.",
+    "error.unable_to_update_label": "Impossible de mettre à jour ce libellé.",
+    "error.unable_to_update_highlight": "Impossible de mettre à jour ce passage surligné.",
+    "error.smart_folder_already_exists": "Ce dossier intelligent existe déjà.",
+    "error.unable_to_create_smart_folder": "Impossible de créer ce dossier intelligent.",
+    "error.unable_to_update_smart_folder": "Impossible de mettre à jour ce dossier intelligent.",
+    "error.smart_folder_invalid_status": "Le statut doit être non lu ou lu.",
+    "error.smart_folder_invalid_date": "Les dates doivent utiliser le format AAAA-MM-JJ.",
+    "error.smart_folder_invalid_date_range": "La date de début doit précéder la date de fin.",
     "error.unable_to_create_category": "Impossible de créer cette catégorie.",
     "error.unable_to_update_category": "Impossible de mettre à jour cette catégorie.",
     "error.user_already_exists": "Cet utilisateur existe déjà.",
@@ -1208,27 +1929,91 @@ var translations = map[string]string{
     "error.password_min_length": "Vous devez utiliser au moins 6 caractères pour le mot de passe.",
     "error.settings_mandatory_fields": "Le nom d'utilisateur, le thème, la langue et le fuseau horaire sont obligatoire.",
     "error.feed_mandatory_fields": "L'URL et la catégorie sont obligatoire.",
+    "error.web_page_rules_invalid": "Le sélecteur des éléments et un sélecteur de titre ou de lien sont obligatoires.",
+    "error.content_extractors_invalid": "Extracteur de contenu inconnu ou délai invalide.",
+    "error.filter_mode_invalid": "Mode de filtrage invalide.",
+    "error.entry_identity_invalid": "Identité des entrées invalide.",
+    "error.no_entry_to_preview": "Il n’y a aucun article à prévisualiser.",
+    "error.scraper_rules_invalid": "Règles d'extraction invalides, le nombre de pages doit être compris entre 1 et 20.",
+    "error.rewrite_rules_invalid": "Règles de réécriture invalides, vérifiez les noms des règles, les arguments entre guillemets et les expressions régulières.",
+    "error.page_monitor_threshold_invalid": "Le seuil de changement doit être compris entre 0 et 100.",
+    "error.local_sources_forbidden": "Les sources locales sont désactivées ou réservées aux administrateurs.",
     "error.user_mandatory_fields": "Le nom d'utilisateur est obligatoire.",
     "error.api_key_already_exists": "Cette clé d'API existe déjà.",
     "error.unable_to_create_api_key": "Impossible de créer cette clé d'API.",
+    "error.filter_rule_invalid": "Règle de filtrage invalide, vérifiez la valeur de la condition, l'expression régulière ou la durée.",
+    "error.entry_revisions_limit_invalid": "Le nombre de versions à conserver doit être compris entre 0 et %d.",
+    "error.unable_to_create_filter_rule": "Impossible de créer cette règle de filtrage.",
+    "error.unable_to_update_filter_rule": "Impossible de mettre à jour cette règle de filtrage.",
     "form.feed.label.title": "Titre",
     "form.feed.label.site_url": "URL du site web",
     "form.feed.label.feed_url": "URL du flux",
     "form.feed.label.category": "Catégorie",
     "form.feed.label.crawler": "Récupérer le contenu original",
+    "form.feed.label.crawler_override": "Ignorer le réglage de la catégorie pour le contenu original",
     "form.feed.label.feed_username": "Nom d'utilisateur du flux",
     "form.feed.label.feed_password": "Mot de passe du flux",
     "form.feed.label.user_agent": "Remplacer l'agent utilisateur par défaut",
     "form.feed.label.scraper_rules": "Règles pour récupérer le contenu original",
     "form.feed.label.rewrite_rules": "Règles de réécriture",
-    "form.feed.label.title_filter": "Filtre de titre",
-    "form.feed.label.content_filter": "Filtre de contenu",
+    "form.feed.label.use_mercury": "Utiliser Mercury Parser",
+    "form.feed.label.content_extractors": "Extracteurs de contenu",
+    "form.feed.help.content_extractors": "Liste des extracteurs essayés dans l’ordre, séparés par des virgules, chacun peut avoir un délai en secondes, par exemple « scraper, readability:10 ». Extracteurs disponibles : %s.",
+    "form.feed.label.filter_mode": "Mode de filtrage",
+    "form.feed.filter_mode.inherit": "Identique à la catégorie",
+    "form.feed.help.preview": "Applique le mode de filtrage, les règles de filtrage, les extracteurs de contenu, les règles d’extraction et de réécriture de ce formulaire aux derniers articles de l’abonnement sans rien enregistrer.",
+    "form.feed.label.preview_refetch": "Télécharger l’abonnement à nouveau au lieu d’utiliser les articles enregistrés",
+    "form.feed.label.preview_crawl": "Récupérer le contenu original avec les extracteurs de contenu (plus lent)",
+    "form.feed.help.scraper_rules": "Sélecteurs CSS séparés par des points-virgules. Utilisez « exclude: sélecteur » pour retirer des éléments, « next » ou « next: sélecteur » pour suivre la pagination et « pages: nombre » pour limiter le nombre de pages.",
+    "form.feed.help.rewrite_rules": "Liste de règles séparées par des virgules, par exemple : nl2br, replace(\"regex\", \"remplacement\"), replace_title(\"regex\", \"remplacement\"), remove(\"sélecteur CSS\"), add_class(\"sélecteur CSS\", \"nom\"). Les classes ajoutées sont préfixées par « rewrite- ».",
+    "form.category.help.content_extractors": "Utilisés par les abonnements de cette catégorie sans extracteurs de contenu définis. Extracteurs disponibles : %s.",
+    "form.category.help.default_settings": "Les paramètres suivants sont utilisés par les abonnements de cette catégorie qui ne définissent pas les leurs. Lorsque le crawler est activé ici, il l'est pour tous les abonnements de la catégorie.",
+    "form.category.label.filter_mode": "Mode de filtrage",
+    "form.filter_mode.block": "Garder tous les articles sauf ceux supprimés par les règles de filtrage",
+    "form.filter_mode.allowlist": "Garder seulement les articles correspondant à une règle « garder » (liste d’autorisation)",
+    "form.filter_mode.help": "En mode liste d’autorisation, les nouveaux articles ne correspondant à aucune règle avec l’action « garder » sont supprimés, tous s’il n’existe aucune règle de ce type.",
+    "form.feed.label.entry_identity": "Identité des entrées",
+    "form.feed.entry_identity.default": "Par défaut (identifiant de l'élément du flux)",
+    "form.feed.entry_identity.guid": "GUID de l'élément",
+    "form.feed.entry_identity.url": "URL",
+    "form.feed.entry_identity.canonical_url": "URL canonique (sans protocole, « www. » ni barre oblique finale)",
+    "form.feed.entry_identity.title_date": "Titre et date de publication",
+    "form.feed.entry_identity.content": "Contenu",
+    "form.feed.help.entry_identity": "Définit comment les entrées sont reconnues lors de l'actualisation de l'abonnement. La modifier supprime les entrées en double de l'abonnement. Le contenu ne doit pas être utilisé avec le crawler, et le titre et la date seulement avec les flux qui fournissent des dates de publication.",
+    "form.feed.label.source_type": "Type de source",
+    "form.feed.source_type.feed": "Flux (RSS, Atom, JSON)",
+    "form.feed.source_type.web_page": "Page web (sélecteurs CSS)",
+    "form.feed.source_type.page_monitor": "Changements d'une page web",
+    "form.feed.source_type.file": "Fichier local (URL file://)",
+    "form.feed.source_type.command": "Sortie d'une commande (URL file:// d'un exécutable)",
+    "form.feed.label.item_selector": "Sélecteur des éléments",
+    "form.feed.label.title_selector": "Sélecteur du titre",
+    "form.feed.label.link_selector": "Sélecteur du lien",
+    "form.feed.label.date_selector": "Sélecteur de la date",
+    "form.feed.label.summary_selector": "Sélecteur du résumé",
+    "form.feed.label.monitor_selector": "Éléments surveillés (sélecteur CSS, optionnel)",
+    "form.feed.label.monitor_threshold": "Pourcentage minimum de mots modifiés",
     "form.feed.label.disabled": "Ne pas actualiser ce flux",
     "form.category.label.title": "Titre",
+    "form.label.label.title": "Titre",
+    "form.highlight.label.note": "Note",
+    "form.smart_folder.label.title": "Titre",
+    "form.smart_folder.label.search_query": "Termes de recherche",
+    "form.smart_folder.label.feeds": "Abonnements",
+    "form.smart_folder.label.categories": "Catégories",
+    "form.smart_folder.label.labels": "Libellés",
+    "form.smart_folder.help.selection": "Laissez une liste vide pour ne pas filtrer dessus. Un article correspond à une liste lorsqu'il correspond à l'un des éléments sélectionnés.",
+    "form.smart_folder.label.status": "Statut",
+    "form.smart_folder.status.all": "Tous les articles",
+    "form.smart_folder.status.unread": "Articles non lus",
+    "form.smart_folder.status.read": "Articles lus",
+    "form.smart_folder.label.published_after": "Publié à partir du",
+    "form.smart_folder.label.published_before": "Publié avant le",
     "form.user.label.username": "Nom d'utilisateur",
     "form.user.label.password": "Mot de passe",
     "form.user.label.confirmation": "Confirmation du mot de passe",
     "form.user.label.admin": "Administrateur",
+    "form.user.label.mercury_api_url": "Mercury API URL",
     "form.prefs.label.language": "Langue",
     "form.prefs.label.timezone": "Fuseau horaire",
     "form.prefs.label.theme": "Thème",
@@ -1236,6 +2021,9 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Ancien éléments en premier",
     "form.prefs.select.recent_first": "Éléments récents en premier",
     "form.prefs.label.keyboard_shortcuts": "Activer les raccourcis clavier",
+    "form.prefs.label.entry_revisions_limit": "Versions précédentes conservées pour chaque article mis à jour",
+    "form.prefs.help.entry_revisions_limit": "Indiquez 0 pour ne plus conserver l'ancien titre et l'ancien contenu des articles mis à jour.",
+    "form.prefs.label.unread_on_update": "Marquer les articles comme non lus lorsque leur contenu change de manière importante",
     "form.prefs.label.custom_css": "CSS personnalisé",
     "form.import.label.file": "Fichier OPML",
     "form.import.label.url": "URL",
@@ -1264,6 +2052,36 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_endpoint": "URL de l'API de Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "Clé d'API de Nunux Keeper",
     "form.api_key.label.description": "Libellé de la clé d'API",
+    "form.filter_rule.label.scope": "S'applique à",
+    "form.filter_rule.scope.all": "Tous les abonnements",
+    "form.filter_rule.scope.categories": "Catégories",
+    "form.filter_rule.scope.feeds": "Abonnements",
+    "form.filter_rule.label.field": "Champ",
+    "form.filter_rule.label.operator": "Opérateur",
+    "form.filter_rule.label.value": "Valeur",
+    "form.filter_rule.help.value": "Les comparaisons ne tiennent pas compte de la casse. Utilisez une expression régulière avec « correspond à » et une durée comme 12h, 7d ou 2w avec la date.",
+    "form.filter_rule.label.action": "Action",
+    "form.filter_rule.label.action_value": "Étiquette",
+    "form.filter_rule.help.action_value": "Utilisé uniquement par l'action « Ajouter une étiquette ».",
+    "filter_rule.field.title": "Titre",
+    "filter_rule.field.content": "Contenu",
+    "filter_rule.field.author": "Auteur",
+    "filter_rule.field.url": "URL",
+    "filter_rule.field.tags": "Étiquettes",
+    "filter_rule.field.date": "Date",
+    "filter_rule.operator.contains": "contient",
+    "filter_rule.operator.not_contains": "ne contient pas",
+    "filter_rule.operator.equals": "est",
+    "filter_rule.operator.matches": "correspond à",
+    "filter_rule.operator.not_matches": "ne correspond pas à",
+    "filter_rule.operator.older_than": "est plus ancienne que",
+    "filter_rule.operator.newer_than": "est plus récente que",
+    "filter_rule.action.remove": "Supprimer l'article",
+    "filter_rule.action.read": "Marquer comme lu",
+    "filter_rule.action.star": "Ajouter aux favoris",
+    "filter_rule.action.tag": "Ajouter une étiquette",
+    "filter_rule.action.integration": "Envoyer aux services tiers",
+    "filter_rule.action.keep": "Garder (mode liste d’autorisation)",
     "form.submit.loading": "Chargement...",
     "form.submit.saving": "Sauvegarde en cours...",
     "time_elapsed.not_yet": "pas encore",
@@ -1327,11 +2145,14 @@ var translations = map[string]string{
     "action.remove": "Elimina",
     "action.remove_feed": "Elimina questo feed",
     "action.update": "Aggiorna",
+    "action.preview": "Preview",
     "action.edit": "Modifica",
     "action.download": "Scarica",
     "action.import": "Importa",
     "action.login": "Accedi",
     "action.home_screen": "Aggiungere alla schermata Home",
+    "action.refresh_icon": "Download icon again",
+    "action.deduplicate": "Remove duplicate entries",
     "tooltip.keyboard_shortcuts": "Scorciatoia da tastiera: %s",
     "tooltip.logged_user": "Autenticato come %s",
     "menu.unread": "Da leggere",
@@ -1357,13 +2178,27 @@ var translations = map[string]string{
     "menu.refresh_all_feeds": "Aggiorna tutti i feed in background",
     "menu.edit_feed": "Modifica",
     "menu.edit_category": "Modifica",
+    "menu.labels": "Labels",
+    "menu.create_label": "Create a label",
+    "menu.edit_label": "Edit",
+    "menu.label_entries": "Entries",
+    "menu.smart_folders": "Smart Folders",
+    "menu.highlights": "Highlights",
+    "menu.edit_highlight": "Edit note",
+    "menu.create_smart_folder": "Create a smart folder",
+    "menu.edit_smart_folder": "Edit",
+    "menu.smart_folder_entries": "Entries",
+    "menu.save_search": "Save this search",
     "menu.add_feed": "Aggiungi feed",
     "menu.add_user": "Aggiungi utente",
     "menu.flush_history": "Svuota la cronologia",
     "menu.feed_entries": "Articoli",
     "menu.api_keys": "Chiavi API",
     "menu.create_api_key": "Crea una nuova chiave API",
+    "menu.filter_rules": "Filters",
+    "menu.create_filter_rule": "Add a filter rule",
     "menu.shared_entries": "Voci condivise",
+    "menu.back_to_entry": "Back to the entry",
     "search.label": "Cerca",
     "search.placeholder": "Cerca...",
     "pagination.next": "Successivo",
@@ -1394,10 +2229,36 @@ var translations = map[string]string{
     "entry.unshare.label": "Unshare",
     "entry.shared_entry.title": "Apri il link pubblico",
     "entry.shared_entry.label": "Condivisione",
+    "entry.cluster.also_covered_by": "Also covered by:",
+    "entry.labels.edit": "Labels",
+    "entry.highlight.label": "Highlight",
+    "entry.highlight.title": "Highlight the selected passage",
+    "entry.highlight.note": "Note (optional):",
+    "entry.highlight.toast.empty": "Select a passage of the article first",
+    "entry.revisions.updated": [
+        "Updated (%d version)",
+        "Updated (%d versions)"
+    ],
     "page.shared_entries.title": "Voci condivise",
     "page.unread.title": "Da leggere",
     "page.starred.title": "Preferiti",
     "page.categories.title": "Categorie",
+    "page.labels.title": "Labels",
+    "page.highlights.title": "Highlights",
+    "page.edit_highlight.title": "Edit Highlight",
+    "page.labels.entry_count": [
+        "%d article",
+        "%d articles"
+    ],
+    "page.edit_label.title": "Edit Label: %s",
+    "page.smart_folders.title": "Smart Folders",
+    "page.smart_folders.unread_count": [
+        "%d unread article",
+        "%d unread articles"
+    ],
+    "page.new_smart_folder.title": "New Smart Folder",
+    "page.edit_smart_folder.title": "Edit Smart Folder: %s",
+    "page.entry_labels.title": "Labels of this entry",
     "page.categories.no_feed": "Nessun feed.",
     "page.categories.feeds": "Vedi abbonamenti",
     "page.categories.feed_count": [
@@ -1410,6 +2271,7 @@ var translations = map[string]string{
     "page.edit_user.title": "Modifica utente: %s",
     "page.feeds.title": "Feed",
     "page.feeds.last_check": "Ultimo controllo:",
+    "page.feeds.suppressed_count": "Suppressed by the allowlist: %d",
     "page.feeds.unread_counter": "Numero di voci non lette",
     "page.feeds.read_counter": "Numero di voci lette",
     "page.feeds.error_count": [
@@ -1431,13 +2293,44 @@ var translations = map[string]string{
     "page.add_feed.submit": "Abbonati al feed",
     "page.add_feed.legend.advanced_options": "Opzioni avanzate",
     "page.add_feed.choose_feed": "Scegli un feed",
+    "page.add_feed.legend.web_page": "Web Page Rules",
+    "page.add_feed.legend.page_monitor": "Page Monitoring",
+    "page.add_feed.preview": "Preview",
+    "page.add_feed.preview_title": "Extracted Items",
+    "page.add_feed.preview_empty": "No item matches these selectors.",
     "page.edit_feed.title": "Modifica feed: %s",
     "page.edit_feed.last_check": "Ultimo controllo:",
     "page.edit_feed.last_modified_header": "Header LastModified:",
     "page.edit_feed.etag_header": "Header ETag:",
     "page.edit_feed.no_header": "Nessun header",
+    "page.edit_feed.icon": "Icon:",
+    "page.edit_feed.suppressed_count": "Entries suppressed by the allowlist:",
+    "page.edit_feed.deduplicate.help": "Entries recognized as the same entry by the identity strategy are merged, the oldest one is kept with the read and starred states and the tags of the others.",
+    "page.edit_feed.effective_settings": "Effective Settings",
+    "page.edit_feed.effective_settings.help": "Settings used when the feed is refreshed, the empty ones are taken from the category \"%s\".",
+    "page.edit_feed.inherited": "from the category",
+    "page.edit_feed.enabled": "Enabled",
+    "page.edit_feed.disabled": "Disabled",
+    "page.edit_feed.legend.preview": "Preview Options",
+    "page.edit_feed.preview.title": "Preview",
+    "page.edit_feed.preview.summary": "%d of %d entries would be removed, nothing has been saved.",
+    "page.edit_feed.preview.suppressed": "Suppressed by the allowlist",
+    "page.edit_feed.preview.removed": "Removed by a filter rule",
+    "page.edit_feed.preview.read": "Marked as read",
+    "page.edit_feed.preview.kept": "Kept",
+    "page.edit_feed.preview.starred": "Starred",
+    "page.edit_feed.preview.matched_rules": "Matched filter rules: %d",
+    "page.edit_feed.preview.content_changed": "Show the content changes",
+    "page.edit_feed.preview.content_unchanged": "The content is unchanged.",
+    "page.entry_revisions.title": "Previous versions",
+    "page.entry_revisions.help": "The publisher has updated this entry. Each version is compared with the one that replaced it, the most recent first.",
+    "page.entry_revisions.replaced": "Replaced",
+    "page.entry_revisions.content_changed": "Show the changes of the content",
+    "page.entry_revisions.content_unchanged": "Only the title has changed.",
     "page.edit_feed.last_parsing_error": "Ultimo errore di parsing",
+    "page.edit_feed.newsletter_address": "Messages sent to this address are delivered to this feed:",
     "page.entry.attachments": "Allegati",
+    "page.entry.highlights": "Highlights",
     "page.keyboard_shortcuts.title": "Scorciatoie da tastiera",
     "page.keyboard_shortcuts.subtitle.sections": "Navigazione sezioni",
     "page.keyboard_shortcuts.subtitle.items": "Navigazione articoli",
@@ -1493,6 +2386,8 @@ var translations = map[string]string{
     "page.integration.bookmarklet": "Segnalibro",
     "page.integration.bookmarklet.name": "Aggiungi a Miniflux",
     "page.integration.bookmarklet.instructions": "Trascina questo collegamento sui tuoi segnalibri.",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address, the messages of each sender are delivered to their own feed:",
     "page.integration.bookmarklet.help": "Questo collegamento speciale ti consente di abbonarti ad un sito web semplicemente usando un segnalibro del tuo browser.",
     "page.sessions.title": "Sessioni",
     "page.sessions.table.date": "Data",
@@ -1508,14 +2403,31 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Azioni",
     "page.api_keys.never_used": "Mai usato",
     "page.new_api_key.title": "Nuova chiave API",
+    "page.filter_rules.title": "Filter Rules",
+    "page.filter_rules.table.scope": "Applies To",
+    "page.filter_rules.table.condition": "Condition",
+    "page.filter_rules.table.action": "Action",
+    "page.filter_rules.table.hits": "Matched Articles",
+    "page.filter_rules.table.error": "Error",
+    "page.filter_rules.ignored": "This rule is ignored until it is fixed:",
+    "page.filter_rules.table.actions": "Actions",
+    "page.new_filter_rule.title": "New Filter Rule",
+    "page.edit_filter_rule.title": "Edit Filter Rule",
     "alert.no_shared_entry": "Non ci sono voci condivise.",
     "alert.no_bookmark": "Nessun preferito disponibile.",
     "alert.no_category": "Nessuna categoria disponibile.",
+    "alert.no_label": "You don't have any label.",
+    "alert.no_highlight": "There are no highlights. Select a passage of an article to highlight it.",
+    "alert.no_label_entry": "There are no articles with this label.",
+    "alert.no_smart_folder": "There are no smart folders. Save a search to create one.",
+    "alert.no_smart_folder_entry": "There are no articles matching this smart folder.",
     "alert.no_category_entry": "Questa categoria non contiene alcun articolo.",
     "alert.no_feed_entry": "Questo feed non contiene alcun articolo.",
     "alert.no_feed": "Nessun feed disponibile.",
     "alert.no_feed_in_category": "Non esiste un abbonamento per questa categoria.",
+    "alert.no_filter_rule": "There is no filter rule.",
     "alert.no_history": "La tua cronologia al momento è vuota.",
+    "alert.no_entry_revision": "This entry has not been updated.",
     "alert.feed_error": "Sembra ci sia un problema con questo feed",
     "alert.no_search_result": "La ricerca non ha prodotto risultati.",
     "alert.no_unread_entry": "Nessun articolo da leggere.",
@@ -1524,12 +2436,26 @@ var translations = map[string]string{
     "alert.account_linked": "Il tuo account esterno ora è collegato!",
     "alert.pocket_linked": "Il tuo account Pocket ora è collegato!",
     "alert.prefs_saved": "Preferenze salvate!",
+    "alert.feed_icon_refreshed": "The icon has been updated.",
+    "alert.feed_deduplicated": "Duplicate entries removed: %d.",
     "error.unlink_account_without_password": "Devi scegliere una password altrimenti la prossima volta non riuscirai ad accedere.",
     "error.duplicate_linked_account": "Esiste già un account configurato per questo servizio!",
     "error.duplicate_fever_username": "Esiste già un account Fever con lo stesso nome utente!",
     "error.pocket_request_token": "Non sono riuscito ad ottenere il request token da Pocket!",
     "error.pocket_access_token": "Non sono riuscito ad ottenere l'access token da Pocket!",
+    "error.feed_icon_not_found": "Unable to find the icon of this website.",
+    "error.unable_to_deduplicate_feed": "Unable to remove the duplicate entries of this feed.",
     "error.category_already_exists": "Questa categoria esiste già.",
+    "error.label_already_exists": "This label already exists.",
+    "error.unable_to_create_label": "Unable to create this label.",
+    "error.unable_to_update_label": "Unable to update this label.",
+    "error.unable_to_update_highlight": "Unable to update this highlight.",
+    "error.smart_folder_already_exists": "This smart folder already exists.",
+    "error.unable_to_create_smart_folder": "Unable to create this smart folder.",
+    "error.unable_to_update_smart_folder": "Unable to update this smart folder.",
+    "error.smart_folder_invalid_status": "The status must be unread or read.",
+    "error.smart_folder_invalid_date": "The dates must use the format YYYY-MM-DD.",
+    "error.smart_folder_invalid_date_range": "The start date must be before the end date.",
     "error.unable_to_create_category": "Non sono riuscito ad aggiungere questa categoria.",
     "error.unable_to_update_category": "Non sono riuscito ad aggiornare questa categoria.",
     "error.user_already_exists": "Questo utente esiste già.",
@@ -1545,24 +2471,91 @@ var translations = map[string]string{
     "error.password_min_length": "La password deve contenere almeno 6 caratteri.",
     "error.settings_mandatory_fields": "Il nome utente, il tema, la lingua ed il fuso orario sono campi obbligatori.",
     "error.feed_mandatory_fields": "L'URL e la categoria sono obbligatori.",
+    "error.web_page_rules_invalid": "The item selector and a title or link selector are mandatory.",
+    "error.content_extractors_invalid": "Unknown content extractor or invalid time limit.",
+    "error.filter_mode_invalid": "Invalid filter mode.",
+    "error.entry_identity_invalid": "Invalid entry identity.",
+    "error.no_entry_to_preview": "There is no entry to preview.",
+    "error.scraper_rules_invalid": "Invalid scraper rules, the number of pages must be between 1 and 20.",
+    "error.rewrite_rules_invalid": "Invalid rewrite rules, check the rule names, the quoted arguments and the regular expressions.",
+    "error.page_monitor_threshold_invalid": "The change threshold must be between 0 and 100.",
+    "error.local_sources_forbidden": "Local sources are disabled or reserved to administrators.",
     "error.user_mandatory_fields": "Il nome utente è obbligatorio.",
     "error.api_key_already_exists": "Questa chiave API esiste già.",
     "error.unable_to_create_api_key": "Impossibile creare questa chiave API.",
+    "error.filter_rule_invalid": "Invalid filter rule, check the value of the condition, the regular expression or the duration.",
+    "error.entry_revisions_limit_invalid": "The number of versions to keep must be between 0 and %d.",
+    "error.unable_to_create_filter_rule": "Unable to create this filter rule.",
+    "error.unable_to_update_filter_rule": "Unable to update this filter rule.",
     "form.feed.label.title": "Titolo",
     "form.feed.label.site_url": "URL del sito",
     "form.feed.label.feed_url": "URL del feed",
     "form.feed.label.category": "Categoria",
     "form.feed.label.crawler": "Scarica il contenuto integrale",
+    "form.feed.label.crawler_override": "Ignore the crawler setting of the category",
     "form.feed.label.feed_username": "Nome utente del feed",
     "form.feed.label.feed_password": "Password del feed",
     "form.feed.label.user_agent": "Usa user agent personalizzato",
     "form.feed.label.scraper_rules": "Regole di estrazione del contenuto",
     "form.feed.label.rewrite_rules": "Regole di impaginazione del contenuto",
+    "form.feed.label.use_mercury": "Use Mercury Parser",
+    "form.feed.label.content_extractors": "Content extractors",
+    "form.feed.help.content_extractors": "Comma separated list of extractors tried in order, each one can have a time limit in seconds, for example \"scraper, readability:10\". Available extractors: %s.",
+    "form.feed.label.filter_mode": "Filter Mode",
+    "form.feed.filter_mode.inherit": "Same as the category",
+    "form.feed.help.preview": "Applies the filter mode, the filter rules, the content extractors, the scraper and rewrite rules of this form to the latest entries of the feed without saving anything.",
+    "form.feed.label.preview_refetch": "Download the feed again instead of using the stored entries",
+    "form.feed.label.preview_crawl": "Fetch the original content with the content extractors (slower)",
+    "form.feed.help.scraper_rules": "CSS selectors separated by semicolons. Use \"exclude: selector\" to remove elements, \"next\" or \"next: selector\" to follow the pagination and \"pages: number\" to limit the number of pages.",
+    "form.feed.help.rewrite_rules": "Comma separated list of rules, for example: nl2br, replace(\"regex\", \"replacement\"), replace_title(\"regex\", \"replacement\"), remove(\"CSS selector\"), add_class(\"CSS selector\", \"name\"). The added class names are prefixed with \"rewrite-\".",
+    "form.category.help.content_extractors": "Used by the feeds of this category without their own content extractors. Available extractors: %s.",
+    "form.category.help.default_settings": "The following settings are used by the feeds of this category that do not define their own. When the crawler is enabled here, it is enabled for all the feeds of the category.",
+    "form.category.label.filter_mode": "Filter Mode",
+    "form.filter_mode.block": "Keep all entries except the ones removed by the filter rules",
+    "form.filter_mode.allowlist": "Keep only the entries matching a \"keep\" rule (allowlist)",
+    "form.filter_mode.help": "In allowlist mode, new entries not matching any filter rule with the action \"keep\" are removed, all of them if there is no such rule.",
+    "form.feed.label.entry_identity": "Entry Identity",
+    "form.feed.entry_identity.default": "Default (identifier of the feed item)",
+    "form.feed.entry_identity.guid": "GUID of the item",
+    "form.feed.entry_identity.url": "URL",
+    "form.feed.entry_identity.canonical_url": "Canonical URL (without scheme, \"www.\" and trailing slash)",
+    "form.feed.entry_identity.title_date": "Title and publication date",
+    "form.feed.entry_identity.content": "Content",
+    "form.feed.help.entry_identity": "Defines how the entries are recognized when the feed is refreshed. Changing it removes the duplicate entries of the feed. The content should not be used with the crawler, and the title and date only with feeds providing publication dates.",
+    "form.feed.label.disabled": "Do not refresh this feed",
+    "form.feed.label.source_type": "Source Type",
+    "form.feed.source_type.feed": "Feed (RSS, Atom, JSON)",
+    "form.feed.source_type.web_page": "Web page (CSS selectors)",
+    "form.feed.source_type.page_monitor": "Web page changes",
+    "form.feed.source_type.file": "Local file (file:// URL)",
+    "form.feed.source_type.command": "Command output (file:// URL of an executable)",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.summary_selector": "Summary Selector",
+    "form.feed.label.monitor_selector": "Monitored Elements (CSS selector, optional)",
+    "form.feed.label.monitor_threshold": "Minimum Percentage of Changed Words",
     "form.category.label.title": "Titolo",
+    "form.label.label.title": "Title",
+    "form.highlight.label.note": "Note",
+    "form.smart_folder.label.title": "Title",
+    "form.smart_folder.label.search_query": "Search terms",
+    "form.smart_folder.label.feeds": "Feeds",
+    "form.smart_folder.label.categories": "Categories",
+    "form.smart_folder.label.labels": "Labels",
+    "form.smart_folder.help.selection": "Leave a list empty to not filter on it. An article matches a list when it matches one of the selected items.",
+    "form.smart_folder.label.status": "Status",
+    "form.smart_folder.status.all": "All articles",
+    "form.smart_folder.status.unread": "Unread articles",
+    "form.smart_folder.status.read": "Read articles",
+    "form.smart_folder.label.published_after": "Published from",
+    "form.smart_folder.label.published_before": "Published before",
     "form.user.label.username": "Nome utente",
     "form.user.label.password": "Password",
     "form.user.label.confirmation": "Conferma password",
     "form.user.label.admin": "Amministratore",
+    "form.user.label.mercury_api_url": "Mercury API URL",
     "form.prefs.label.language": "Lingua",
     "form.prefs.label.timezone": "Fuso orario",
     "form.prefs.label.theme": "Tema",
@@ -1570,6 +2563,9 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Prima i più recenti",
     "form.prefs.select.recent_first": "Prima i più vecchi",
     "form.prefs.label.keyboard_shortcuts": "Abilita le scorciatoie da tastiera",
+    "form.prefs.label.entry_revisions_limit": "Previous versions kept for each updated entry",
+    "form.prefs.help.entry_revisions_limit": "Set to 0 to stop keeping the previous title and content of updated entries.",
+    "form.prefs.label.unread_on_update": "Mark entries as unread again when their content changes substantially",
     "form.prefs.label.custom_css": "CSS personalizzati",
     "form.import.label.file": "File OPML",
     "form.import.label.url": "URL",
@@ -1598,6 +2594,36 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_endpoint": "Endpoint dell'API di Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "API key dell'account Nunux Keeper",
     "form.api_key.label.description": "Etichetta chiave API",
+    "form.filter_rule.label.scope": "Applies To",
+    "form.filter_rule.scope.all": "All feeds",
+    "form.filter_rule.scope.categories": "Categories",
+    "form.filter_rule.scope.feeds": "Feeds",
+    "form.filter_rule.label.field": "Field",
+    "form.filter_rule.label.operator": "Operator",
+    "form.filter_rule.label.value": "Value",
+    "form.filter_rule.help.value": "The comparisons are case-insensitive. Use a regular expression with \"matches\", and a duration such as 12h, 7d or 2w with the date.",
+    "form.filter_rule.label.action": "Action",
+    "form.filter_rule.label.action_value": "Tag",
+    "form.filter_rule.help.action_value": "Only used by the action \"Add a tag\".",
+    "filter_rule.field.title": "Title",
+    "filter_rule.field.content": "Content",
+    "filter_rule.field.author": "Author",
+    "filter_rule.field.url": "URL",
+    "filter_rule.field.tags": "Tags",
+    "filter_rule.field.date": "Date",
+    "filter_rule.operator.contains": "contains",
+    "filter_rule.operator.not_contains": "does not contain",
+    "filter_rule.operator.equals": "is",
+    "filter_rule.operator.matches": "matches",
+    "filter_rule.operator.not_matches": "does not match",
+    "filter_rule.operator.older_than": "is older than",
+    "filter_rule.operator.newer_than": "is newer than",
+    "filter_rule.action.remove": "Remove the article",
+    "filter_rule.action.read": "Mark as read",
+    "filter_rule.action.star": "Star",
+    "filter_rule.action.tag": "Add a tag",
+    "filter_rule.action.integration": "Send to the integrations",
+    "filter_rule.action.keep": "Keep (allowlist mode)",
     "form.submit.loading": "Caricamento in corso...",
     "form.submit.saving": "Salvataggio in corso...",
     "time_elapsed.not_yet": "non ancora",
@@ -1641,11 +2667,14 @@ var translations = map[string]string{
     "action.remove": "削除",
     "action.remove_feed": "このフィードを削除",
     "action.update": "更新",
+    "action.preview": "Preview",
     "action.edit": "編集",
     "action.download": "ダウンロード",
     "action.import": "インポート",
     "action.login": "ログイン",
     "action.home_screen": "ホームスクリーンに追加",
+    "action.refresh_icon": "Download icon again",
+    "action.deduplicate": "Remove duplicate entries",
     "tooltip.keyboard_shortcuts": "キーボード・ショートカット: %s",
     "tooltip.logged_user": "%s としてログイン中",
     "menu.unread": "未読",
@@ -1671,13 +2700,27 @@ var translations = map[string]string{
     "menu.refresh_all_feeds": "全てのフィードをバックグラウンドで更新",
     "menu.edit_feed": "編集",
     "menu.edit_category": "編集",
+    "menu.labels": "Labels",
+    "menu.create_label": "Create a label",
+    "menu.edit_label": "Edit",
+    "menu.label_entries": "Entries",
+    "menu.smart_folders": "Smart Folders",
+    "menu.highlights": "Highlights",
+    "menu.edit_highlight": "Edit note",
+    "menu.create_smart_folder": "Create a smart folder",
+    "menu.edit_smart_folder": "Edit",
+    "menu.smart_folder_entries": "Entries",
+    "menu.save_search": "Save this search",
     "menu.add_feed": "フィードを購読する",
     "menu.add_user": "ユーザーを追加",
     "menu.flush_history": "履歴を更新",
     "menu.feed_entries": "記事一覧",
     "menu.api_keys": "APIキー",
     "menu.create_api_key": "新しいAPIキーを作成する",
+    "menu.filter_rules": "Filters",
+    "menu.create_filter_rule": "Add a filter rule",
     "menu.shared_entries": "共有エントリ",
+    "menu.back_to_entry": "Back to the entry",
     "search.label": "検索",
     "search.placeholder": "…を検索",
     "pagination.next": "次",
@@ -1708,10 +2751,36 @@ var translations = map[string]string{
     "entry.unshare.label": "共有解除",
     "entry.shared_entry.title": "公開リンクを開く",
     "entry.shared_entry.label": "共有する",
+    "entry.cluster.also_covered_by": "Also covered by:",
+    "entry.labels.edit": "Labels",
+    "entry.highlight.label": "Highlight",
+    "entry.highlight.title": "Highlight the selected passage",
+    "entry.highlight.note": "Note (optional):",
+    "entry.highlight.toast.empty": "Select a passage of the article first",
+    "entry.revisions.updated": [
+        "Updated (%d version)",
+        "Updated (%d versions)"
+    ],
     "page.shared_entries.title": "共有エントリ",
     "page.unread.title": "未読",
     "page.starred.title": "星付き",
     "page.categories.title": "カテゴリ",
+    "page.labels.title": "Labels",
+    "page.highlights.title": "Highlights",
+    "page.edit_highlight.title": "Edit Highlight",
+    "page.labels.entry_count": [
+        "%d article",
+        "%d articles"
+    ],
+    "page.edit_label.title": "Edit Label: %s",
+    "page.smart_folders.title": "Smart Folders",
+    "page.smart_folders.unread_count": [
+        "%d unread article",
+        "%d unread articles"
+    ],
+    "page.new_smart_folder.title": "New Smart Folder",
+    "page.edit_smart_folder.title": "Edit Smart Folder: %s",
+    "page.entry_labels.title": "Labels of this entry",
     "page.categories.no_feed": "フィード無し",
     "page.categories.feeds": "フィード購読を見る",
     "page.categories.feed_count": [
@@ -1724,6 +2793,7 @@ var translations = map[string]string{
     "page.edit_user.title": "ユーザーを編集: %s",
     "page.feeds.title": "フィード一覧",
     "page.feeds.last_check": "最終チェック:",
+    "page.feeds.suppressed_count": "Suppressed by the allowlist: %d",
     "page.feeds.unread_counter": "未読記事の数",
     "page.feeds.read_counter": "既読記事の数",
     "page.feeds.error_count": [
@@ -1745,13 +2815,44 @@ var translations = map[string]string{
     "page.add_feed.submit": "購読フィードを探して追加",
     "page.add_feed.legend.advanced_options": "追加の設定",
     "page.add_feed.choose_feed": "購読を選択",
+    "page.add_feed.legend.web_page": "Web Page Rules",
+    "page.add_feed.legend.page_monitor": "Page Monitoring",
+    "page.add_feed.preview": "Preview",
+    "page.add_feed.preview_title": "Extracted Items",
+    "page.add_feed.preview_empty": "No item matches these selectors.",
     "page.edit_feed.title": "フィード(%s)を編集",
     "page.edit_feed.last_check": "最終チェック:",
     "page.edit_feed.last_modified_header": "最後に更新されたヘッダー:",
     "page.edit_feed.etag_header": "ETag ヘッダー:",
     "page.edit_feed.no_header": " なし",
+    "page.edit_feed.icon": "Icon:",
+    "page.edit_feed.suppressed_count": "Entries suppressed by the allowlist:",
+    "page.edit_feed.deduplicate.help": "Entries recognized as the same entry by the identity strategy are merged, the oldest one is kept with the read and starred states and the tags of the others.",
+    "page.edit_feed.effective_settings": "Effective Settings",
+    "page.edit_feed.effective_settings.help": "Settings used when the feed is refreshed, the empty ones are taken from the category \"%s\".",
+    "page.edit_feed.inherited": "from the category",
+    "page.edit_feed.enabled": "Enabled",
+    "page.edit_feed.disabled": "Disabled",
+    "page.edit_feed.legend.preview": "Preview Options",
+    "page.edit_feed.preview.title": "Preview",
+    "page.edit_feed.preview.summary": "%d of %d entries would be removed, nothing has been saved.",
+    "page.edit_feed.preview.suppressed": "Suppressed by the allowlist",
+    "page.edit_feed.preview.removed": "Removed by a filter rule",
+    "page.edit_feed.preview.read": "Marked as read",
+    "page.edit_feed.preview.kept": "Kept",
+    "page.edit_feed.preview.starred": "Starred",
+    "page.edit_feed.preview.matched_rules": "Matched filter rules: %d",
+    "page.edit_feed.preview.content_changed": "Show the content changes",
+    "page.edit_feed.preview.content_unchanged": "The content is unchanged.",
+    "page.entry_revisions.title": "Previous versions",
+    "page.entry_revisions.help": "The publisher has updated this entry. Each version is compared with the one that replaced it, the most recent first.",
+    "page.entry_revisions.replaced": "Replaced",
+    "page.entry_revisions.content_changed": "Show the changes of the content",
+    "page.entry_revisions.content_unchanged": "Only the title has changed.",
     "page.edit_feed.last_parsing_error": "最新の解析エラー",
+    "page.edit_feed.newsletter_address": "Messages sent to this address are delivered to this feed:",
     "page.entry.attachments": "添付物",
+    "page.entry.highlights": "Highlights",
     "page.keyboard_shortcuts.title": "キーボード・ショートカット",
     "page.keyboard_shortcuts.subtitle.sections": "セクション 移動",
     "page.keyboard_shortcuts.subtitle.items": "アイテム 移動",
@@ -1807,6 +2908,8 @@ var translations = map[string]string{
     "page.integration.bookmarklet": "ブックマークレット",
     "page.integration.bookmarklet.name": "Miniflux に追加",
     "page.integration.bookmarklet.instructions": "このリンクをブラウザのブックマークへドラッグしてください。",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address, the messages of each sender are delivered to their own feed:",
     "page.integration.bookmarklet.help": "この特別なリンクを使ってブラウザから直接ウェブサイトのフィードを購読できます。",
     "page.sessions.title": "セッション",
     "page.sessions.table.date": "日付",
@@ -1822,14 +2925,31 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "アクション",
     "page.api_keys.never_used": "使われたことがない",
     "page.new_api_key.title": "新しいAPIキー",
+    "page.filter_rules.title": "Filter Rules",
+    "page.filter_rules.table.scope": "Applies To",
+    "page.filter_rules.table.condition": "Condition",
+    "page.filter_rules.table.action": "Action",
+    "page.filter_rules.table.hits": "Matched Articles",
+    "page.filter_rules.table.error": "Error",
+    "page.filter_rules.ignored": "This rule is ignored until it is fixed:",
+    "page.filter_rules.table.actions": "Actions",
+    "page.new_filter_rule.title": "New Filter Rule",
+    "page.edit_filter_rule.title": "Edit Filter Rule",
     "alert.no_shared_entry": "共有エントリはありません。",
     "alert.no_bookmark": "現在星付きはありません。",
     "alert.no_category": "カテゴリが存在しません。",
+    "alert.no_label": "You don't have any label.",
+    "alert.no_highlight": "There are no highlights. Select a passage of an article to highlight it.",
+    "alert.no_label_entry": "There are no articles with this label.",
+    "alert.no_smart_folder": "There are no smart folders. Save a search to create one.",
+    "alert.no_smart_folder_entry": "There are no articles matching this smart folder.",
     "alert.no_category_entry": "このカテゴリには記事がありません。",
     "alert.no_feed_entry": "このフィードには記事がありません。",
     "alert.no_feed": "何も購読していません。",
     "alert.no_feed_in_category": "このカテゴリにはフィードの購読がありません。",
+    "alert.no_filter_rule": "There is no filter rule.",
     "alert.no_history": "現時点では履歴がありません。",
+    "alert.no_entry_revision": "This entry has not been updated.",
     "alert.feed_error": "このフィードには問題があります。",
     "alert.no_search_result": "検索で何も見つかりませんでした。",
     "alert.no_unread_entry": "未読の記事はありません。",
@@ -1838,12 +2958,26 @@ var translations = map[string]string{
     "alert.account_linked": "外部アカウントとリンクされました!",
     "alert.pocket_linked": "Pocket アカウントとリンクされました!",
     "alert.prefs_saved": "設定情報は保存されました!",
+    "alert.feed_icon_refreshed": "The icon has been updated.",
+    "alert.feed_deduplicated": "Duplicate entries removed: %d.",
     "error.unlink_account_without_password": "パスワードを設定しなければ再びログインすることはできません。",
     "error.duplicate_linked_account": "別なユーザーが既にこのサービスの同じユーザーとリンクしています。",
     "error.duplicate_fever_username": "既に同じ名前の Fever ユーザー名が使われています!",
     "error.pocket_request_token": "Pocket の request token が取得できません!",
     "error.pocket_access_token": "Pocket の access token が取得できません!",
+    "error.feed_icon_not_found": "Unable to find the icon of this website.",
+    "error.unable_to_deduplicate_feed": "Unable to remove the duplicate entries of this feed.",
     "error.category_already_exists": "このカテゴリは既に存在しています。",
+    "error.label_already_exists": "This label already exists.",
+    "error.unable_to_create_label": "Unable to create this label.",
+    "error.unable_to_update_label": "Unable to update this label.",
+    "error.unable_to_update_highlight": "Unable to update this highlight.",
+    "error.smart_folder_already_exists": "This smart folder already exists.",
+    "error.unable_to_create_smart_folder": "Unable to create this smart folder.",
+    "error.unable_to_update_smart_folder": "Unable to update this smart folder.",
+    "error.smart_folder_invalid_status": "The status must be unread or read.",
+    "error.smart_folder_invalid_date": "The dates must use the format YYYY-MM-DD.",
+    "error.smart_folder_invalid_date_range": "The start date must be before the end date.",
     "error.unable_to_create_category": "カテゴリを作成できません。",
     "error.unable_to_update_category": "カテゴリを更新できません。",
     "error.user_already_exists": "このユーザーは既に存在します。",
@@ -1859,25 +2993,91 @@ var translations = map[string]string{
     "error.password_min_length": "パスワードは6文字以上である必要があります。",
     "error.settings_mandatory_fields": "ユーザー名、テーマ、言語、タイムゾーンの全てが必要です。",
     "error.feed_mandatory_fields": "URL と カテゴリが必要です。",
+    "error.web_page_rules_invalid": "The item selector and a title or link selector are mandatory.",
+    "error.content_extractors_invalid": "Unknown content extractor or invalid time limit.",
+    "error.filter_mode_invalid": "Invalid filter mode.",
+    "error.entry_identity_invalid": "Invalid entry identity.",
+    "error.no_entry_to_preview": "There is no entry to preview.",
+    "error.scraper_rules_invalid": "Invalid scraper rules, the number of pages must be between 1 and 20.",
+    "error.rewrite_rules_invalid": "Invalid rewrite rules, check the rule names, the quoted arguments and the regular expressions.",
+    "error.page_monitor_threshold_invalid": "The change threshold must be between 0 and 100.",
+    "error.local_sources_forbidden": "Local sources are disabled or reserved to administrators.",
     "error.user_mandatory_fields": "ユーザー名が必要です。",
     "error.api_key_already_exists": "このAPIキーは既に存在します。",
     "error.unable_to_create_api_key": "このAPIキーを作成できません。",
+    "error.filter_rule_invalid": "Invalid filter rule, check the value of the condition, the regular expression or the duration.",
+    "error.entry_revisions_limit_invalid": "The number of versions to keep must be between 0 and %d.",
+    "error.unable_to_create_filter_rule": "Unable to create this filter rule.",
+    "error.unable_to_update_filter_rule": "Unable to update this filter rule.",
     "form.feed.label.title": "タイトル",
     "form.feed.label.site_url": "サイト URL",
     "form.feed.label.feed_url": "フィード URL",
     "form.feed.label.category": "カテゴリ",
     "form.feed.label.crawler": "オリジナルの内容を取得",
+    "form.feed.label.crawler_override": "Ignore the crawler setting of the category",
     "form.feed.label.feed_username": "フィードのユーザー名",
     "form.feed.label.feed_password": "フィードのパスワード",
     "form.feed.label.user_agent": "ディフォルトの User Agent を上書きする",
     "form.feed.label.scraper_rules": "スクラップルール",
     "form.feed.label.rewrite_rules": "Rewrite ルール",
+    "form.feed.label.use_mercury": "Use Mercury Parser",
+    "form.feed.label.content_extractors": "Content extractors",
+    "form.feed.help.content_extractors": "Comma separated list of extractors tried in order, each one can have a time limit in seconds, for example \"scraper, readability:10\". Available extractors: %s.",
+    "form.feed.label.filter_mode": "Filter Mode",
+    "form.feed.filter_mode.inherit": "Same as the category",
+    "form.feed.help.preview": "Applies the filter mode, the filter rules, the content extractors, the scraper and rewrite rules of this form to the latest entries of the feed without saving anything.",
+    "form.feed.label.preview_refetch": "Download the feed again instead of using the stored entries",
+    "form.feed.label.preview_crawl": "Fetch the original content with the content extractors (slower)",
+    "form.feed.help.scraper_rules": "CSS selectors separated by semicolons. Use \"exclude: selector\" to remove elements, \"next\" or \"next: selector\" to follow the pagination and \"pages: number\" to limit the number of pages.",
+    "form.feed.help.rewrite_rules": "Comma separated list of rules, for example: nl2br, replace(\"regex\", \"replacement\"), replace_title(\"regex\", \"replacement\"), remove(\"CSS selector\"), add_class(\"CSS selector\", \"name\"). The added class names are prefixed with \"rewrite-\".",
+    "form.category.help.content_extractors": "Used by the feeds of this category without their own content extractors. Available extractors: %s.",
+    "form.category.help.default_settings": "The following settings are used by the feeds of this category that do not define their own. When the crawler is enabled here, it is enabled for all the feeds of the category.",
+    "form.category.label.filter_mode": "Filter Mode",
+    "form.filter_mode.block": "Keep all entries except the ones removed by the filter rules",
+    "form.filter_mode.allowlist": "Keep only the entries matching a \"keep\" rule (allowlist)",
+    "form.filter_mode.help": "In allowlist mode, new entries not matching any filter rule with the action \"keep\" are removed, all of them if there is no such rule.",
+    "form.feed.label.entry_identity": "Entry Identity",
+    "form.feed.entry_identity.default": "Default (identifier of the feed item)",
+    "form.feed.entry_identity.guid": "GUID of the item",
+    "form.feed.entry_identity.url": "URL",
+    "form.feed.entry_identity.canonical_url": "Canonical URL (without scheme, \"www.\" and trailing slash)",
+    "form.feed.entry_identity.title_date": "Title and publication date",
+    "form.feed.entry_identity.content": "Content",
+    "form.feed.help.entry_identity": "Defines how the entries are recognized when the feed is refreshed. Changing it removes the duplicate entries of the feed. The content should not be used with the crawler, and the title and date only with feeds providing publication dates.",
+    "form.feed.label.source_type": "Source Type",
+    "form.feed.source_type.feed": "Feed (RSS, Atom, JSON)",
+    "form.feed.source_type.web_page": "Web page (CSS selectors)",
+    "form.feed.source_type.page_monitor": "Web page changes",
+    "form.feed.source_type.file": "Local file (file:// URL)",
+    "form.feed.source_type.command": "Command output (file:// URL of an executable)",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.summary_selector": "Summary Selector",
+    "form.feed.label.monitor_selector": "Monitored Elements (CSS selector, optional)",
+    "form.feed.label.monitor_threshold": "Minimum Percentage of Changed Words",
     "form.feed.label.disabled": "このフィードを更新しない",
     "form.category.label.title": "タイトル",
+    "form.label.label.title": "Title",
+    "form.highlight.label.note": "Note",
+    "form.smart_folder.label.title": "Title",
+    "form.smart_folder.label.search_query": "Search terms",
+    "form.smart_folder.label.feeds": "Feeds",
+    "form.smart_folder.label.categories": "Categories",
+    "form.smart_folder.label.labels": "Labels",
+    "form.smart_folder.help.selection": "Leave a list empty to not filter on it. An article matches a list when it matches one of the selected items.",
+    "form.smart_folder.label.status": "Status",
+    "form.smart_folder.status.all": "All articles",
+    "form.smart_folder.status.unread": "Unread articles",
+    "form.smart_folder.status.read": "Read articles",
+    "form.smart_folder.label.published_after": "Published from",
+    "form.smart_folder.label.published_before": "Published before",
     "form.user.label.username": "ユーザー名",
     "form.user.label.password": "パスワード",
     "form.user.label.confirmation": "パスワード確認",
     "form.user.label.admin": "管理者",
+    "form.user.label.mercury_api_url": "Mercury API URL",
     "form.prefs.label.language": "言語",
     "form.prefs.label.timezone": "タイムゾーン",
     "form.prefs.label.theme": "テーマ",
@@ -1885,6 +3085,9 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "古い記事を最初に",
     "form.prefs.select.recent_first": "新しい記事を最初に",
     "form.prefs.label.keyboard_shortcuts": "キーボード・ショートカットを有効にする",
+    "form.prefs.label.entry_revisions_limit": "Previous versions kept for each updated entry",
+    "form.prefs.help.entry_revisions_limit": "Set to 0 to stop keeping the previous title and content of updated entries.",
+    "form.prefs.label.unread_on_update": "Mark entries as unread again when their content changes substantially",
     "form.prefs.label.custom_css": "カスタムCSS",
     "form.import.label.file": "OPML ファイル",
     "form.import.label.url": "URL",
@@ -1913,6 +3116,36 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper の API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper の API key",
     "form.api_key.label.description": "APIキーラベル",
+    "form.filter_rule.label.scope": "Applies To",
+    "form.filter_rule.scope.all": "All feeds",
+    "form.filter_rule.scope.categories": "Categories",
+    "form.filter_rule.scope.feeds": "Feeds",
+    "form.filter_rule.label.field": "Field",
+    "form.filter_rule.label.operator": "Operator",
+    "form.filter_rule.label.value": "Value",
+    "form.filter_rule.help.value": "The comparisons are case-insensitive. Use a regular expression with \"matches\", and a duration such as 12h, 7d or 2w with the date.",
+    "form.filter_rule.label.action": "Action",
+    "form.filter_rule.label.action_value": "Tag",
+    "form.filter_rule.help.action_value": "Only used by the action \"Add a tag\".",
+    "filter_rule.field.title": "Title",
+    "filter_rule.field.content": "Content",
+    "filter_rule.field.author": "Author",
+    "filter_rule.field.url": "URL",
+    "filter_rule.field.tags": "Tags",
+    "filter_rule.field.date": "Date",
+    "filter_rule.operator.contains": "contains",
+    "filter_rule.operator.not_contains": "does not contain",
+    "filter_rule.operator.equals": "is",
+    "filter_rule.operator.matches": "matches",
+    "filter_rule.operator.not_matches": "does not match",
+    "filter_rule.operator.older_than": "is older than",
+    "filter_rule.operator.newer_than": "is newer than",
+    "filter_rule.action.remove": "Remove the article",
+    "filter_rule.action.read": "Mark as read",
+    "filter_rule.action.star": "Star",
+    "filter_rule.action.tag": "Add a tag",
+    "filter_rule.action.integration": "Send to the integrations",
+    "filter_rule.action.keep": "Keep (allowlist mode)",
     "form.submit.loading": "読み込み中…",
     "form.submit.saving": "保存中…",
     "time_elapsed.not_yet": "未来",
@@ -1956,11 +3189,14 @@ var translations = map[string]string{
     "action.remove": "Verwijderen",
     "action.remove_feed": "Verwijder deze feed",
     "action.update": "Updaten",
+    "action.preview": "Preview",
     "action.edit": "Bewerken",
     "action.download": "Download",
     "action.import": "Importeren",
     "action.login": "Inloggen",
     "action.home_screen": "Toevoegen aan startscherm",
+    "action.refresh_icon": "Download icon again",
+    "action.deduplicate": "Remove duplicate entries",
     "tooltip.keyboard_shortcuts": "Sneltoets: %s",
     "tooltip.logged_user": "Ingelogd als %s",
     "menu.unread": "Ongelezen",
@@ -1986,13 +3222,27 @@ var translations = map[string]string{
     "menu.refresh_all_feeds": "Vernieuw alle feeds in de achtergrond",
     "menu.edit_feed": "Bewerken",
     "menu.edit_category": "Bewerken",
+    "menu.labels": "Labels",
+    "menu.create_label": "Create a label",
+    "menu.edit_label": "Edit",
+    "menu.label_entries": "Entries",
+    "menu.smart_folders": "Smart Folders",
+    "menu.highlights": "Highlights",
+    "menu.edit_highlight": "Edit note",
+    "menu.create_smart_folder": "Create a smart folder",
+    "menu.edit_smart_folder": "Edit",
+    "menu.smart_folder_entries": "Entries",
+    "menu.save_search": "Save this search",
     "menu.add_feed": "Feed toevoegen",
     "menu.add_user": "Gebruiker toevoegen",
     "menu.flush_history": "Verwijder geschiedenis",
     "menu.feed_entries": "Lidwoord",
     "menu.api_keys": "API-sleutels",
     "menu.create_api_key": "Maak een nieuwe API-sleutel",
+    "menu.filter_rules": "Filters",
+    "menu.create_filter_rule": "Add a filter rule",
     "menu.shared_entries": "Gedeelde vermeldingen",
+    "menu.back_to_entry": "Back to the entry",
     "search.label": "Zoeken",
     "search.placeholder": "Zoeken...",
     "pagination.next": "Volgende",
@@ -2023,10 +3273,36 @@ var translations = map[string]string{
     "entry.unshare.label": "Delen ongedaan maken",
     "entry.shared_entry.title": "Open de openbare link",
     "entry.shared_entry.label": "Delen",
+    "entry.cluster.also_covered_by": "Also covered by:",
+    "entry.labels.edit": "Labels",
+    "entry.highlight.label": "Highlight",
+    "entry.highlight.title": "Highlight the selected passage",
+    "entry.highlight.note": "Note (optional):",
+    "entry.highlight.toast.empty": "Select a passage of the article first",
+    "entry.revisions.updated": [
+        "Updated (%d version)",
+        "Updated (%d versions)"
+    ],
     "page.shared_entries.title": "Gedeelde vermeldingen",
     "page.unread.title": "Ongelezen",
     "page.starred.title": "Favorieten",
     "page.categories.title": "Categorieën",
+    "page.labels.title": "Labels",
+    "page.highlights.title": "Highlights",
+    "page.edit_highlight.title": "Edit Highlight",
+    "page.labels.entry_count": [
+        "%d article",
+        "%d articles"
+    ],
+    "page.edit_label.title": "Edit Label: %s",
+    "page.smart_folders.title": "Smart Folders",
+    "page.smart_folders.unread_count": [
+        "%d unread article",
+        "%d unread articles"
+    ],
+    "page.new_smart_folder.title": "New Smart Folder",
+    "page.edit_smart_folder.title": "Edit Smart Folder: %s",
+    "page.entry_labels.title": "Labels of this entry",
     "page.categories.no_feed": "Geen feeds.",
     "page.categories.feeds": "Zie abonnementen",
     "page.categories.feed_count": [
@@ -2039,6 +3315,7 @@ var translations = map[string]string{
     "page.edit_user.title": "Bewerk gebruiker: %s",
     "page.feeds.title": "Feeds",
     "page.feeds.last_check": "Laatste update:",
+    "page.feeds.suppressed_count": "Suppressed by the allowlist: %d",
     "page.feeds.unread_counter": "Aantal ongelezen vermeldingen",
     "page.feeds.read_counter": "Aantal gelezen vermeldingen",
     "page.feeds.error_count": [
@@ -2061,13 +3338,44 @@ var translations = map[string]string{
     "page.add_feed.submit": "Feed zoeken",
     "page.add_feed.legend.advanced_options": "Geavanceerde mogelijkheden",
     "page.add_feed.choose_feed": "Feed kiezen",
+    "page.add_feed.legend.web_page": "Web Page Rules",
+    "page.add_feed.legend.page_monitor": "Page Monitoring",
+    "page.add_feed.preview": "Preview",
+    "page.add_feed.preview_title": "Extracted Items",
+    "page.add_feed.preview_empty": "No item matches these selectors.",
     "page.edit_feed.title": "Bewerken van feed: %s",
     "page.edit_feed.last_check": "Laatste update:",
     "page.edit_feed.last_modified_header": "LastModified-header:",
     "page.edit_feed.etag_header": "ETAG-header:",
     "page.edit_feed.no_header": "Geen",
+    "page.edit_feed.icon": "Icon:",
+    "page.edit_feed.suppressed_count": "Entries suppressed by the allowlist:",
+    "page.edit_feed.deduplicate.help": "Entries recognized as the same entry by the identity strategy are merged, the oldest one is kept with the read and starred states and the tags of the others.",
+    "page.edit_feed.effective_settings": "Effective Settings",
+    "page.edit_feed.effective_settings.help": "Settings used when the feed is refreshed, the empty ones are taken from the category \"%s\".",
+    "page.edit_feed.inherited": "from the category",
+    "page.edit_feed.enabled": "Enabled",
+    "page.edit_feed.disabled": "Disabled",
+    "page.edit_feed.legend.preview": "Preview Options",
+    "page.edit_feed.preview.title": "Preview",
+    "page.edit_feed.preview.summary": "%d of %d entries would be removed, nothing has been saved.",
+    "page.edit_feed.preview.suppressed": "Suppressed by the allowlist",
+    "page.edit_feed.preview.removed": "Removed by a filter rule",
+    "page.edit_feed.preview.read": "Marked as read",
+    "page.edit_feed.preview.kept": "Kept",
+    "page.edit_feed.preview.starred": "Starred",
+    "page.edit_feed.preview.matched_rules": "Matched filter rules: %d",
+    "page.edit_feed.preview.content_changed": "Show the content changes",
+    "page.edit_feed.preview.content_unchanged": "The content is unchanged.",
+    "page.entry_revisions.title": "Previous versions",
+    "page.entry_revisions.help": "The publisher has updated this entry. Each version is compared with the one that replaced it, the most recent first.",
+    "page.entry_revisions.replaced": "Replaced",
+    "page.entry_revisions.content_changed": "Show the changes of the content",
+    "page.entry_revisions.content_unchanged": "Only the title has changed.",
     "page.edit_feed.last_parsing_error": "Laatste parse error",
+    "page.edit_feed.newsletter_address": "Messages sent to this address are delivered to this feed:",
     "page.entry.attachments": "Bijlagen",
+    "page.entry.highlights": "Highlights",
     "page.keyboard_shortcuts.title": "Sneltoetsen",
     "page.keyboard_shortcuts.subtitle.sections": "Naviguatie tussen menu's",
     "page.keyboard_shortcuts.subtitle.items": "Navigatie tussen items",
@@ -2122,6 +3430,8 @@ var translations = map[string]string{
     "page.integration.bookmarklet": "Bookmarklet",
     "page.integration.bookmarklet.name": "Toevoegen aan Miniflux",
     "page.integration.bookmarklet.instructions": "Sleep deze link naar je bookmarks.",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address, the messages of each sender are delivered to their own feed:",
     "page.integration.bookmarklet.help": "Gebruik deze link als bookmark in je browser om je direct te abboneren op een website.",
     "page.sessions.title": "Sessies",
     "page.sessions.table.date": "Datum",
@@ -2137,14 +3447,31 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Acties",
     "page.api_keys.never_used": "Nooit gebruikt",
     "page.new_api_key.title": "Nieuwe API-sleutel",
+    "page.filter_rules.title": "Filter Rules",
+    "page.filter_rules.table.scope": "Applies To",
+    "page.filter_rules.table.condition": "Condition",
+    "page.filter_rules.table.action": "Action",
+    "page.filter_rules.table.hits": "Matched Articles",
+    "page.filter_rules.table.error": "Error",
+    "page.filter_rules.ignored": "This rule is ignored until it is fixed:",
+    "page.filter_rules.table.actions": "Actions",
+    "page.new_filter_rule.title": "New Filter Rule",
+    "page.edit_filter_rule.title": "Edit Filter Rule",
     "alert.no_shared_entry": "Er is geen gedeelde toegang.",
     "alert.no_bookmark": "Er zijn op dit moment geen favorieten.",
     "alert.no_category": "Er zijn geen categorieën.",
+    "alert.no_label": "You don't have any label.",
+    "alert.no_highlight": "There are no highlights. Select a passage of an article to highlight it.",
+    "alert.no_label_entry": "There are no articles with this label.",
+    "alert.no_smart_folder": "There are no smart folders. Save a search to create one.",
+    "alert.no_smart_folder_entry": "There are no articles matching this smart folder.",
     "alert.no_category_entry": "Deze categorie bevat geen feeds.",
     "alert.no_feed_entry": "Er zijn geen artikelen in deze feed.",
     "alert.no_feed": "Je hebt nog geen feeds geabboneerd staan.",
     "alert.no_feed_in_category": "Er is geen abonnement voor deze categorie.",
+    "alert.no_filter_rule": "There is no filter rule.",
     "alert.no_history": "Geschiedenis is op dit moment leeg.",
+    "alert.no_entry_revision": "This entry has not been updated.",
     "alert.feed_error": "Er is een probleem met deze feed",
     "alert.no_search_result": "Er is geen resultaat voor deze zoekopdracht.",
     "alert.no_unread_entry": "Er zijn geen ongelezen artikelen.",
@@ -2153,12 +3480,26 @@ var translations = map[string]string{
     "alert.account_linked": "Uw externe account is nu gekoppeld!",
     "alert.pocket_linked": "Uw Pocket-account is nu gekoppeld!",
     "alert.prefs_saved": "Instellingen opgeslagen!",
+    "alert.feed_icon_refreshed": "The icon has been updated.",
+    "alert.feed_deduplicated": "Duplicate entries removed: %d.",
     "error.unlink_account_without_password": "U moet een wachtwoord definiëren anders kunt u zich niet opnieuw aanmelden.",
     "error.duplicate_linked_account": "Er is al iemand geregistreerd met deze provider!",
     "error.duplicate_fever_username": "Er is al iemand met dezelfde Fever gebruikersnaam!",
     "error.pocket_request_token": "Kon geen aanvraagtoken ophalen van Pocket!",
     "error.pocket_access_token": "Kon geen toegangstoken ophalen van Pocket!",
+    "error.feed_icon_not_found": "Unable to find the icon of this website.",
+    "error.unable_to_deduplicate_feed": "Unable to remove the duplicate entries of this feed.",
     "error.category_already_exists": "Deze categorie bestaat al.",
+    "error.label_already_exists": "This label already exists.",
+    "error.unable_to_create_label": "Unable to create this label.",
+    "error.unable_to_update_label": "Unable to update this label.",
+    "error.unable_to_update_highlight": "Unable to update this highlight.",
+    "error.smart_folder_already_exists": "This smart folder already exists.",
+    "error.unable_to_create_smart_folder": "Unable to create this smart folder.",
+    "error.unable_to_update_smart_folder": "Unable to update this smart folder.",
+    "error.smart_folder_invalid_status": "The status must be unread or read.",
+    "error.smart_folder_invalid_date": "The dates must use the format YYYY-MM-DD.",
+    "error.smart_folder_invalid_date_range": "The start date must be before the end date.",
     "error.unable_to_create_category": "Kan deze categorie niet maken.",
     "error.unable_to_update_category": "Kon categorie niet updaten.",
     "error.user_already_exists": "Deze gebruiker bestaat al.",
@@ -2174,24 +3515,91 @@ var translations = map[string]string{
     "error.password_min_length": "Je moet minstens 6 tekens gebruiken.",
     "error.settings_mandatory_fields": "Gebruikersnaam, skin, taal en tijdzone zijn verplicht.",
     "error.feed_mandatory_fields": "The URL en de categorie zijn verplicht.",
+    "error.web_page_rules_invalid": "The item selector and a title or link selector are mandatory.",
+    "error.content_extractors_invalid": "Unknown content extractor or invalid time limit.",
+    "error.filter_mode_invalid": "Invalid filter mode.",
+    "error.entry_identity_invalid": "Invalid entry identity.",
+    "error.no_entry_to_preview": "There is no entry to preview.",
+    "error.scraper_rules_invalid": "Invalid scraper rules, the number of pages must be between 1 and 20.",
+    "error.rewrite_rules_invalid": "Invalid rewrite rules, check the rule names, the quoted arguments and the regular expressions.",
+    "error.page_monitor_threshold_invalid": "The change threshold must be between 0 and 100.",
+    "error.local_sources_forbidden": "Local sources are disabled or reserved to administrators.",
     "error.user_mandatory_fields": "Gebruikersnaam is verplicht",
     "error.api_key_already_exists": "This API Key already exists.",
     "error.unable_to_create_api_key": "Kan deze API-sleutel niet maken.",
+    "error.filter_rule_invalid": "Invalid filter rule, check the value of the condition, the regular expression or the duration.",
+    "error.entry_revisions_limit_invalid": "The number of versions to keep must be between 0 and %d.",
+    "error.unable_to_create_filter_rule": "Unable to create this filter rule.",
+    "error.unable_to_update_filter_rule": "Unable to update this filter rule.",
     "form.feed.label.title": "Naam",
     "form.feed.label.site_url": "Website URL",
     "form.feed.label.feed_url": "Feed URL",
     "form.feed.label.category": "Categorie",
     "form.feed.label.crawler": "Download originele content",
+    "form.feed.label.crawler_override": "Ignore the crawler setting of the category",
     "form.feed.label.feed_username": "Feed-gebruikersnaam",
     "form.feed.label.feed_password": "Feed wachtwoord",
     "form.feed.label.user_agent": "Standaard User Agent overschrijven",
     "form.feed.label.scraper_rules": "Scraper regels",
     "form.feed.label.rewrite_rules": "Rewrite regels",
+    "form.feed.label.use_mercury": "Use Mercury Parser",
+    "form.feed.label.content_extractors": "Content extractors",
+    "form.feed.help.content_extractors": "Comma separated list of extractors tried in order, each one can have a time limit in seconds, for example \"scraper, readability:10\". Available extractors: %s.",
+    "form.feed.label.filter_mode": "Filter Mode",
+    "form.feed.filter_mode.inherit": "Same as the category",
+    "form.feed.help.preview": "Applies the filter mode, the filter rules, the content extractors, the scraper and rewrite rules of this form to the latest entries of the feed without saving anything.",
+    "form.feed.label.preview_refetch": "Download the feed again instead of using the stored entries",
+    "form.feed.label.preview_crawl": "Fetch the original content with the content extractors (slower)",
+    "form.feed.help.scraper_rules": "CSS selectors separated by semicolons. Use \"exclude: selector\" to remove elements, \"next\" or \"next: selector\" to follow the pagination and \"pages: number\" to limit the number of pages.",
+    "form.feed.help.rewrite_rules": "Comma separated list of rules, for example: nl2br, replace(\"regex\", \"replacement\"), replace_title(\"regex\", \"replacement\"), remove(\"CSS selector\"), add_class(\"CSS selector\", \"name\"). The added class names are prefixed with \"rewrite-\".",
+    "form.category.help.content_extractors": "Used by the feeds of this category without their own content extractors. Available extractors: %s.",
+    "form.category.help.default_settings": "The following settings are used by the feeds of this category that do not define their own. When the crawler is enabled here, it is enabled for all the feeds of the category.",
+    "form.category.label.filter_mode": "Filter Mode",
+    "form.filter_mode.block": "Keep all entries except the ones removed by the filter rules",
+    "form.filter_mode.allowlist": "Keep only the entries matching a \"keep\" rule (allowlist)",
+    "form.filter_mode.help": "In allowlist mode, new entries not matching any filter rule with the action \"keep\" are removed, all of them if there is no such rule.",
+    "form.feed.label.entry_identity": "Entry Identity",
+    "form.feed.entry_identity.default": "Default (identifier of the feed item)",
+    "form.feed.entry_identity.guid": "GUID of the item",
+    "form.feed.entry_identity.url": "URL",
+    "form.feed.entry_identity.canonical_url": "Canonical URL (without scheme, \"www.\" and trailing slash)",
+    "form.feed.entry_identity.title_date": "Title and publication date",
+    "form.feed.entry_identity.content": "Content",
+    "form.feed.help.entry_identity": "Defines how the entries are recognized when the feed is refreshed. Changing it removes the duplicate entries of the feed. The content should not be used with the crawler, and the title and date only with feeds providing publication dates.",
+    "form.feed.label.disabled": "Do not refresh this feed",
+    "form.feed.label.source_type": "Source Type",
+    "form.feed.source_type.feed": "Feed (RSS, Atom, JSON)",
+    "form.feed.source_type.web_page": "Web page (CSS selectors)",
+    "form.feed.source_type.page_monitor": "Web page changes",
+    "form.feed.source_type.file": "Local file (file:// URL)",
+    "form.feed.source_type.command": "Command output (file:// URL of an executable)",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.summary_selector": "Summary Selector",
+    "form.feed.label.monitor_selector": "Monitored Elements (CSS selector, optional)",
+    "form.feed.label.monitor_threshold": "Minimum Percentage of Changed Words",
     "form.category.label.title": "Naam",
+    "form.label.label.title": "Title",
+    "form.highlight.label.note": "Note",
+    "form.smart_folder.label.title": "Title",
+    "form.smart_folder.label.search_query": "Search terms",
+    "form.smart_folder.label.feeds": "Feeds",
+    "form.smart_folder.label.categories": "Categories",
+    "form.smart_folder.label.labels": "Labels",
+    "form.smart_folder.help.selection": "Leave a list empty to not filter on it. An article matches a list when it matches one of the selected items.",
+    "form.smart_folder.label.status": "Status",
+    "form.smart_folder.status.all": "All articles",
+    "form.smart_folder.status.unread": "Unread articles",
+    "form.smart_folder.status.read": "Read articles",
+    "form.smart_folder.label.published_after": "Published from",
+    "form.smart_folder.label.published_before": "Published before",
     "form.user.label.username": "Gebruikersnaam",
     "form.user.label.password": "Wachtwoord",
     "form.user.label.confirmation": "Bevestig wachtwoord",
     "form.user.label.admin": "Administrator",
+    "form.user.label.mercury_api_url": "Mercury API URL",
     "form.prefs.label.language": "Taal",
     "form.prefs.label.timezone": "Tijdzone",
     "form.prefs.label.theme": "Skin",
@@ -2199,6 +3607,9 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Oudere items eerst",
     "form.prefs.select.recent_first": "Recente items eerst",
     "form.prefs.label.keyboard_shortcuts": "Schakel sneltoetsen in",
+    "form.prefs.label.entry_revisions_limit": "Previous versions kept for each updated entry",
+    "form.prefs.help.entry_revisions_limit": "Set to 0 to stop keeping the previous title and content of updated entries.",
+    "form.prefs.label.unread_on_update": "Mark entries as unread again when their content changes substantially",
     "form.prefs.label.custom_css": "Aangepaste CSS",
     "form.import.label.file": "OPML-bestand",
     "form.import.label.url": "URL",
@@ -2227,6 +3638,36 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper URL",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API-sleutel",
     "form.api_key.label.description": "API-sleutellabel",
+    "form.filter_rule.label.scope": "Applies To",
+    "form.filter_rule.scope.all": "All feeds",
+    "form.filter_rule.scope.categories": "Categories",
+    "form.filter_rule.scope.feeds": "Feeds",
+    "form.filter_rule.label.field": "Field",
+    "form.filter_rule.label.operator": "Operator",
+    "form.filter_rule.label.value": "Value",
+    "form.filter_rule.help.value": "The comparisons are case-insensitive. Use a regular expression with \"matches\", and a duration such as 12h, 7d or 2w with the date.",
+    "form.filter_rule.label.action": "Action",
+    "form.filter_rule.label.action_value": "Tag",
+    "form.filter_rule.help.action_value": "Only used by the action \"Add a tag\".",
+    "filter_rule.field.title": "Title",
+    "filter_rule.field.content": "Content",
+    "filter_rule.field.author": "Author",
+    "filter_rule.field.url": "URL",
+    "filter_rule.field.tags": "Tags",
+    "filter_rule.field.date": "Date",
+    "filter_rule.operator.contains": "contains",
+    "filter_rule.operator.not_contains": "does not contain",
+    "filter_rule.operator.equals": "is",
+    "filter_rule.operator.matches": "matches",
+    "filter_rule.operator.not_matches": "does not match",
+    "filter_rule.operator.older_than": "is older than",
+    "filter_rule.operator.newer_than": "is newer than",
+    "filter_rule.action.remove": "Remove the article",
+    "filter_rule.action.read": "Mark as read",
+    "filter_rule.action.star": "Star",
+    "filter_rule.action.tag": "Add a tag",
+    "filter_rule.action.integration": "Send to the integrations",
+    "filter_rule.action.keep": "Keep (allowlist mode)",
     "form.submit.loading": "Laden...",
     "form.submit.saving": "Opslaag...",
     "time_elapsed.not_yet": "in de toekomst",
@@ -2288,11 +3729,14 @@ var translations = map[string]string{
     "action.remove": "Usuń",
     "action.remove_feed": "Usuń ten kanał",
     "action.update": "Zaktualizuj",
+    "action.preview": "Preview",
     "action.edit": "Edytuj",
     "action.download": "Pobierz",
     "action.import": "Importuj",
     "action.login": "Zaloguj się",
     "action.home_screen": "Dodaj do ekranu głównego",
+    "action.refresh_icon": "Download icon again",
+    "action.deduplicate": "Remove duplicate entries",
     "tooltip.keyboard_shortcuts": "Skróty klawiszowe: %s",
     "tooltip.logged_user": "Zalogowany jako %s",
     "menu.unread": "Nieprzeczytane",
@@ -2318,13 +3762,27 @@ var translations = map[string]string{
     "menu.refresh_all_feeds": "Odśwież wszystkie subskrypcje w tle",
     "menu.edit_feed": "Edytuj",
     "menu.edit_category": "Edytuj",
+    "menu.labels": "Labels",
+    "menu.create_label": "Create a label",
+    "menu.edit_label": "Edit",
+    "menu.label_entries": "Entries",
+    "menu.smart_folders": "Smart Folders",
+    "menu.highlights": "Highlights",
+    "menu.edit_highlight": "Edit note",
+    "menu.create_smart_folder": "Create a smart folder",
+    "menu.edit_smart_folder": "Edit",
+    "menu.smart_folder_entries": "Entries",
+    "menu.save_search": "Save this search",
     "menu.add_feed": "Dodaj subskrypcję",
     "menu.add_user": "Dodaj użytkownika",
     "menu.flush_history": "Usuń historię",
     "menu.feed_entries": "Artykuły",
     "menu.api_keys": "Klucze API",
     "menu.create_api_key": "Utwórz nowy klucz API",
+    "menu.filter_rules": "Filters",
+    "menu.create_filter_rule": "Add a filter rule",
     "menu.shared_entries": "Udostępnione wpisy",
+    "menu.back_to_entry": "Back to the entry",
     "search.label": "Szukaj",
     "search.placeholder": "Szukaj...",
     "pagination.next": "Następny",
@@ -2355,10 +3813,39 @@ var translations = map[string]string{
     "entry.unshare.label": "Unshare",
     "entry.shared_entry.title": "Otwórz publiczny link",
     "entry.shared_entry.label": "Udostępnianie",
+    "entry.cluster.also_covered_by": "Also covered by:",
+    "entry.labels.edit": "Labels",
+    "entry.highlight.label": "Highlight",
+    "entry.highlight.title": "Highlight the selected passage",
+    "entry.highlight.note": "Note (optional):",
+    "entry.highlight.toast.empty": "Select a passage of the article first",
+    "entry.revisions.updated": [
+        "Updated (%d version)",
+        "Updated (%d versions)",
+        "Updated (%d versions)"
+    ],
     "page.shared_entries.title": "Udostępnione wpisy",
     "page.unread.title": "Nieprzeczytane",
     "page.starred.title": "Oznaczone gwiazdką",
     "page.categories.title": "Kategorie",
+    "page.labels.title": "Labels",
+    "page.highlights.title": "Highlights",
+    "page.edit_highlight.title": "Edit Highlight",
+    "page.labels.entry_count": [
+        "%d article",
+        "%d articles",
+        "%d articles"
+    ],
+    "page.edit_label.title": "Edit Label: %s",
+    "page.smart_folders.title": "Smart Folders",
+    "page.smart_folders.unread_count": [
+        "%d unread article",
+        "%d unread articles",
+        "%d unread articles"
+    ],
+    "page.new_smart_folder.title": "New Smart Folder",
+    "page.edit_smart_folder.title": "Edit Smart Folder: %s",
+    "page.entry_labels.title": "Labels of this entry",
     "page.categories.no_feed": "Brak kanałów.",
     "page.categories.feeds": "Zobacz subskrypcje",
     "page.categories.feed_count": [
@@ -2372,6 +3859,7 @@ var translations = map[string]string{
     "page.edit_user.title": "Edytuj użytkownika: %s",
     "page.feeds.title": "Kanały",
     "page.feeds.last_check": "Ostatnia aktualizacja:",
+    "page.feeds.suppressed_count": "Suppressed by the allowlist: %d",
     "page.feeds.unread_counter": "Liczba nieprzeczytanych wpisów",
     "page.feeds.read_counter": "Liczba przeczytanych wpisów",
     "page.feeds.error_count": [
@@ -2394,13 +3882,44 @@ var translations = map[string]string{
     "page.add_feed.submit": "Znajdź subskrypcję",
     "page.add_feed.legend.advanced_options": "Zaawansowane opcje",
     "page.add_feed.choose_feed": "Wybierz subskrypcję",
+    "page.add_feed.legend.web_page": "Web Page Rules",
+    "page.add_feed.legend.page_monitor": "Page Monitoring",
+    "page.add_feed.preview": "Preview",
+    "page.add_feed.preview_title": "Extracted Items",
+    "page.add_feed.preview_empty": "No item matches these selectors.",
     "page.edit_feed.title": "Edytuj kanał: %s",
     "page.edit_feed.last_check": "Ostatnia aktualizacja:",
     "page.edit_feed.last_modified_header": "Ostatnio zmienione:",
     "page.edit_feed.etag_header": "Nagłówek ETag:",
     "page.edit_feed.no_header": "Brak",
+    "page.edit_feed.icon": "Icon:",
+    "page.edit_feed.suppressed_count": "Entries suppressed by the allowlist:",
+    "page.edit_feed.deduplicate.help": "Entries recognized as the same entry by the identity strategy are merged, the oldest one is kept with the read and starred states and the tags of the others.",
+    "page.edit_feed.effective_settings": "Effective Settings",
+    "page.edit_feed.effective_settings.help": "Settings used when the feed is refreshed, the empty ones are taken from the category \"%s\".",
+    "page.edit_feed.inherited": "from the category",
+    "page.edit_feed.enabled": "Enabled",
+    "page.edit_feed.disabled": "Disabled",
+    "page.edit_feed.legend.preview": "Preview Options",
+    "page.edit_feed.preview.title": "Preview",
+    "page.edit_feed.preview.summary": "%d of %d entries would be removed, nothing has been saved.",
+    "page.edit_feed.preview.suppressed": "Suppressed by the allowlist",
+    "page.edit_feed.preview.removed": "Removed by a filter rule",
+    "page.edit_feed.preview.read": "Marked as read",
+    "page.edit_feed.preview.kept": "Kept",
+    "page.edit_feed.preview.starred": "Starred",
+    "page.edit_feed.preview.matched_rules": "Matched filter rules: %d",
+    "page.edit_feed.preview.content_changed": "Show the content changes",
+    "page.edit_feed.preview.content_unchanged": "The content is unchanged.",
+    "page.entry_revisions.title": "Previous versions",
+    "page.entry_revisions.help": "The publisher has updated this entry. Each version is compared with the one that replaced it, the most recent first.",
+    "page.entry_revisions.replaced": "Replaced",
+    "page.entry_revisions.content_changed": "Show the changes of the content",
+    "page.entry_revisions.content_unchanged": "Only the title has changed.",
     "page.edit_feed.last_parsing_error": "Ostatni błąd analizy",
+    "page.edit_feed.newsletter_address": "Messages sent to this address are delivered to this feed:",
     "page.entry.attachments": "Załączniki",
+    "page.entry.highlights": "Highlights",
     "page.keyboard_shortcuts.title": "Skróty klawiszowe",
     "page.keyboard_shortcuts.subtitle.sections": "Nawigacja między punktami menu",
     "page.keyboard_shortcuts.subtitle.items": "Nawigacja między artykułami",
@@ -2456,6 +3975,8 @@ var translations = map[string]string{
     "page.integration.bookmarklet": "Bookmarklet",
     "page.integration.bookmarklet.name": "Dodaj do Miniflux",
     "page.integration.bookmarklet.instructions": "Przeciągnij i upuść to łącze do zakładek.",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address, the messages of each sender are delivered to their own feed:",
     "page.integration.bookmarklet.help": "Ten link umożliwia subskrypcję strony internetowej bezpośrednio za pomocą zakładki w przeglądarce internetowej.",
     "page.sessions.title": "Sesje",
     "page.sessions.table.date": "Data",
@@ -2471,14 +3992,31 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Działania",
     "page.api_keys.never_used": "Nigdy nie używany",
     "page.new_api_key.title": "Nowy klucz API",
+    "page.filter_rules.title": "Filter Rules",
+    "page.filter_rules.table.scope": "Applies To",
+    "page.filter_rules.table.condition": "Condition",
+    "page.filter_rules.table.action": "Action",
+    "page.filter_rules.table.hits": "Matched Articles",
+    "page.filter_rules.table.error": "Error",
+    "page.filter_rules.ignored": "This rule is ignored until it is fixed:",
+    "page.filter_rules.table.actions": "Actions",
+    "page.new_filter_rule.title": "New Filter Rule",
+    "page.edit_filter_rule.title": "Edit Filter Rule",
     "alert.no_shared_entry": "Brak wspólnego wpisu.",
     "alert.no_bookmark": "Obecnie nie ma żadnych zakładek.",
     "alert.no_category": "Nie ma żadnej kategorii!",
+    "alert.no_label": "You don't have any label.",
+    "alert.no_highlight": "There are no highlights. Select a passage of an article to highlight it.",
+    "alert.no_label_entry": "There are no articles with this label.",
+    "alert.no_smart_folder": "There are no smart folders. Save a search to create one.",
+    "alert.no_smart_folder_entry": "There are no articles matching this smart folder.",
     "alert.no_category_entry": "W tej kategorii nie ma żadnych artykułów",
     "alert.no_feed_entry": "Nie ma artykułu dla tego kanału.",
     "alert.no_feed": "Nie masz żadnej subskrypcji.",
     "alert.no_feed_in_category": "Nie ma subskrypcji dla tej kategorii.",
+    "alert.no_filter_rule": "There is no filter rule.",
     "alert.no_history": "Obecnie nie ma żadnej historii.",
+    "alert.no_entry_revision": "This entry has not been updated.",
     "alert.feed_error": "Z tym kanałem jest problem",
     "alert.no_search_result": "Brak wyników dla tego wyszukiwania.",
     "alert.no_unread_entry": "Nie ma żadnych nieprzeczytanych artykułów.",
@@ -2487,12 +4025,26 @@ var translations = map[string]string{
     "alert.account_linked": "Twoje konto zewnętrzne jest teraz połączone!",
     "alert.pocket_linked": "Twoje konto Pocket jest teraz połączone!",
     "alert.prefs_saved": "Ustawienia zapisane!",
+    "alert.feed_icon_refreshed": "The icon has been updated.",
+    "alert.feed_deduplicated": "Duplicate entries removed: %d.",
     "error.unlink_account_without_password": "Musisz zdefiniować hasło, inaczej nie będziesz mógł się ponownie zalogować.",
     "error.duplicate_linked_account": "Już ktoś jest powiązany z tym dostawcą!",
     "error.duplicate_fever_username": "Już ktoś inny używa tej nazwy użytkownika Fever!",
     "error.pocket_request_token": "Nie można pobrać tokena żądania z Pocket!",
     "error.pocket_access_token": "Nie można pobrać tokena dostępu z Pocket!",
+    "error.feed_icon_not_found": "Unable to find the icon of this website.",
+    "error.unable_to_deduplicate_feed": "Unable to remove the duplicate entries of this feed.",
     "error.category_already_exists": "Ta kategoria już istnieje.",
+    "error.label_already_exists": "This label already exists.",
+    "error.unable_to_create_label": "Unable to create this label.",
+    "error.unable_to_update_label": "Unable to update this label.",
+    "error.unable_to_update_highlight": "Unable to update this highlight.",
+    "error.smart_folder_already_exists": "This smart folder already exists.",
+    "error.unable_to_create_smart_folder": "Unable to create this smart folder.",
+    "error.unable_to_update_smart_folder": "Unable to update this smart folder.",
+    "error.smart_folder_invalid_status": "The status must be unread or read.",
+    "error.smart_folder_invalid_date": "The dates must use the format YYYY-MM-DD.",
+    "error.smart_folder_invalid_date_range": "The start date must be before the end date.",
     "error.unable_to_create_category": "Ta kategoria nie mogła zostać utworzona.",
     "error.unable_to_update_category": "Ta kategoria nie mogła zostać zaktualizowana.",
     "error.user_already_exists": "Ten użytkownik już istnieje.",
@@ -2508,30 +4060,100 @@ var translations = map[string]string{
     "error.password_min_length": "Musisz użyć co najmniej 6 znaków.",
     "error.settings_mandatory_fields": "Pola nazwy użytkownika, tematu, języka i strefy czasowej są obowiązkowe.",
     "error.feed_mandatory_fields": "URL i kategoria są obowiązkowe.",
+    "error.web_page_rules_invalid": "The item selector and a title or link selector are mandatory.",
+    "error.content_extractors_invalid": "Unknown content extractor or invalid time limit.",
+    "error.filter_mode_invalid": "Invalid filter mode.",
+    "error.entry_identity_invalid": "Invalid entry identity.",
+    "error.no_entry_to_preview": "There is no entry to preview.",
+    "error.scraper_rules_invalid": "Invalid scraper rules, the number of pages must be between 1 and 20.",
+    "error.rewrite_rules_invalid": "Invalid rewrite rules, check the rule names, the quoted arguments and the regular expressions.",
+    "error.page_monitor_threshold_invalid": "The change threshold must be between 0 and 100.",
+    "error.local_sources_forbidden": "Local sources are disabled or reserved to administrators.",
     "error.user_mandatory_fields": "Nazwa użytkownika jest obowiązkowa.",
     "error.api_key_already_exists": "Deze API-sleutel bestaat al.",
     "error.unable_to_create_api_key": "Nie można utworzyć tego klucza API.",
+    "error.filter_rule_invalid": "Invalid filter rule, check the value of the condition, the regular expression or the duration.",
+    "error.entry_revisions_limit_invalid": "The number of versions to keep must be between 0 and %d.",
+    "error.unable_to_create_filter_rule": "Unable to create this filter rule.",
+    "error.unable_to_update_filter_rule": "Unable to update this filter rule.",
     "form.feed.label.title": "Tytuł",
     "form.feed.label.site_url": "URL strony",
     "form.feed.label.feed_url": "URL kanału",
     "form.feed.label.category": "Kategoria",
     "form.feed.label.crawler": "Pobierz oryginalną treść",
+    "form.feed.label.crawler_override": "Ignore the crawler setting of the category",
     "form.feed.label.feed_username": "Subskrypcję nazwa użytkownika",
     "form.feed.label.feed_password": "Subskrypcję Hasło",
     "form.feed.label.user_agent": "Zastąp domyślny agent użytkownika",
     "form.feed.label.scraper_rules": "Zasady ekstrakcji",
     "form.feed.label.rewrite_rules": "Reguły zapisu",
+    "form.feed.label.use_mercury": "Use Mercury Parser",
+    "form.feed.label.content_extractors": "Content extractors",
+    "form.feed.help.content_extractors": "Comma separated list of extractors tried in order, each one can have a time limit in seconds, for example \"scraper, readability:10\". Available extractors: %s.",
+    "form.feed.label.filter_mode": "Filter Mode",
+    "form.feed.filter_mode.inherit": "Same as the category",
+    "form.feed.help.preview": "Applies the filter mode, the filter rules, the content extractors, the scraper and rewrite rules of this form to the latest entries of the feed without saving anything.",
+    "form.feed.label.preview_refetch": "Download the feed again instead of using the stored entries",
+    "form.feed.label.preview_crawl": "Fetch the original content with the content extractors (slower)",
+    "form.feed.help.scraper_rules": "CSS selectors separated by semicolons. Use \"exclude: selector\" to remove elements, \"next\" or \"next: selector\" to follow the pagination and \"pages: number\" to limit the number of pages.",
+    "form.feed.help.rewrite_rules": "Comma separated list of rules, for example: nl2br, replace(\"regex\", \"replacement\"), replace_title(\"regex\", \"replacement\"), remove(\"CSS selector\"), add_class(\"CSS selector\", \"name\"). The added class names are prefixed with \"rewrite-\".",
+    "form.category.help.content_extractors": "Used by the feeds of this category without their own content extractors. Available extractors: %s.",
+    "form.category.help.default_settings": "The following settings are used by the feeds of this category that do not define their own. When the crawler is enabled here, it is enabled for all the feeds of the category.",
+    "form.category.label.filter_mode": "Filter Mode",
+    "form.filter_mode.block": "Keep all entries except the ones removed by the filter rules",
+    "form.filter_mode.allowlist": "Keep only the entries matching a \"keep\" rule (allowlist)",
+    "form.filter_mode.help": "In allowlist mode, new entries not matching any filter rule with the action \"keep\" are removed, all of them if there is no such rule.",
+    "form.feed.label.entry_identity": "Entry Identity",
+    "form.feed.entry_identity.default": "Default (identifier of the feed item)",
+    "form.feed.entry_identity.guid": "GUID of the item",
+    "form.feed.entry_identity.url": "URL",
+    "form.feed.entry_identity.canonical_url": "Canonical URL (without scheme, \"www.\" and trailing slash)",
+    "form.feed.entry_identity.title_date": "Title and publication date",
+    "form.feed.entry_identity.content": "Content",
+    "form.feed.help.entry_identity": "Defines how the entries are recognized when the feed is refreshed. Changing it removes the duplicate entries of the feed. The content should not be used with the crawler, and the title and date only with feeds providing publication dates.",
+    "form.feed.label.disabled": "Do not refresh this feed",
+    "form.feed.label.source_type": "Source Type",
+    "form.feed.source_type.feed": "Feed (RSS, Atom, JSON)",
+    "form.feed.source_type.web_page": "Web page (CSS selectors)",
+    "form.feed.source_type.page_monitor": "Web page changes",
+    "form.feed.source_type.file": "Local file (file:// URL)",
+    "form.feed.source_type.command": "Command output (file:// URL of an executable)",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.summary_selector": "Summary Selector",
+    "form.feed.label.monitor_selector": "Monitored Elements (CSS selector, optional)",
+    "form.feed.label.monitor_threshold": "Minimum Percentage of Changed Words",
     "form.category.label.title": "Tytuł",
+    "form.label.label.title": "Title",
+    "form.highlight.label.note": "Note",
+    "form.smart_folder.label.title": "Title",
+    "form.smart_folder.label.search_query": "Search terms",
+    "form.smart_folder.label.feeds": "Feeds",
+    "form.smart_folder.label.categories": "Categories",
+    "form.smart_folder.label.labels": "Labels",
+    "form.smart_folder.help.selection": "Leave a list empty to not filter on it. An article matches a list when it matches one of the selected items.",
+    "form.smart_folder.label.status": "Status",
+    "form.smart_folder.status.all": "All articles",
+    "form.smart_folder.status.unread": "Unread articles",
+    "form.smart_folder.status.read": "Read articles",
+    "form.smart_folder.label.published_after": "Published from",
+    "form.smart_folder.label.published_before": "Published before",
     "form.user.label.username": "Nazwa użytkownika",
     "form.user.label.password": "Hasło",
     "form.user.label.confirmation": "Potwierdzenie hasła",
     "form.user.label.admin": "Administrator",
+    "form.user.label.mercury_api_url": "Mercury API URL",
     "form.prefs.label.language": "Język",
     "form.prefs.label.timezone": "Strefa czasowa",
     "form.prefs.label.theme": "Wygląd",
     "form.prefs.label.entry_sorting": "Sortowanie artykułów",
     "form.prefs.select.older_first": "Najstarsze wpisy jako pierwsze",
     "form.prefs.label.keyboard_shortcuts": "Włącz skróty klawiaturowe",
+    "form.prefs.label.entry_revisions_limit": "Previous versions kept for each updated entry",
+    "form.prefs.help.entry_revisions_limit": "Set to 0 to stop keeping the previous title and content of updated entries.",
+    "form.prefs.label.unread_on_update": "Mark entries as unread again when their content changes substantially",
     "form.prefs.select.recent_first": "Najnowsze wpisy jako pierwsze",
     "form.prefs.label.custom_css": "Niestandardowy CSS",
     "form.import.label.file": "Plik OPML",
@@ -2561,6 +4183,36 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper URL",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API key",
     "form.api_key.label.description": "Etykieta klucza API",
+    "form.filter_rule.label.scope": "Applies To",
+    "form.filter_rule.scope.all": "All feeds",
+    "form.filter_rule.scope.categories": "Categories",
+    "form.filter_rule.scope.feeds": "Feeds",
+    "form.filter_rule.label.field": "Field",
+    "form.filter_rule.label.operator": "Operator",
+    "form.filter_rule.label.value": "Value",
+    "form.filter_rule.help.value": "The comparisons are case-insensitive. Use a regular expression with \"matches\", and a duration such as 12h, 7d or 2w with the date.",
+    "form.filter_rule.label.action": "Action",
+    "form.filter_rule.label.action_value": "Tag",
+    "form.filter_rule.help.action_value": "Only used by the action \"Add a tag\".",
+    "filter_rule.field.title": "Title",
+    "filter_rule.field.content": "Content",
+    "filter_rule.field.author": "Author",
+    "filter_rule.field.url": "URL",
+    "filter_rule.field.tags": "Tags",
+    "filter_rule.field.date": "Date",
+    "filter_rule.operator.contains": "contains",
+    "filter_rule.operator.not_contains": "does not contain",
+    "filter_rule.operator.equals": "is",
+    "filter_rule.operator.matches": "matches",
+    "filter_rule.operator.not_matches": "does not match",
+    "filter_rule.operator.older_than": "is older than",
+    "filter_rule.operator.newer_than": "is newer than",
+    "filter_rule.action.remove": "Remove the article",
+    "filter_rule.action.read": "Mark as read",
+    "filter_rule.action.star": "Star",
+    "filter_rule.action.tag": "Add a tag",
+    "filter_rule.action.integration": "Send to the integrations",
+    "filter_rule.action.keep": "Keep (allowlist mode)",
     "form.submit.loading": "Ładowanie...",
     "form.submit.saving": "Zapisywanie...",
     "time_elapsed.not_yet": "jeszcze nie",
@@ -2628,11 +4280,14 @@ var translations = map[string]string{
     "action.remove": "Удалить",
     "action.remove_feed": "Удалить эту подписку",
     "action.update": "Обновить",
+    "action.preview": "Preview",
     "action.edit": "Изменить",
     "action.download": "Загрузить",
     "action.import": "Импорт",
     "action.login": "Войти",
     "action.home_screen": "Добавить на домашний экран",
+    "action.refresh_icon": "Download icon again",
+    "action.deduplicate": "Remove duplicate entries",
     "tooltip.keyboard_shortcuts": "Сочетания клавиш: %s",
     "tooltip.logged_user": "Авторизован как %s",
     "menu.unread": "Непрочитанное",
@@ -2658,13 +4313,27 @@ var translations = map[string]string{
     "menu.refresh_all_feeds": "Обновить все подписки в фоне",
     "menu.edit_feed": "Изменить",
     "menu.edit_category": "Изменить",
+    "menu.labels": "Labels",
+    "menu.create_label": "Create a label",
+    "menu.edit_label": "Edit",
+    "menu.label_entries": "Entries",
+    "menu.smart_folders": "Smart Folders",
+    "menu.highlights": "Highlights",
+    "menu.edit_highlight": "Edit note",
+    "menu.create_smart_folder": "Create a smart folder",
+    "menu.edit_smart_folder": "Edit",
+    "menu.smart_folder_entries": "Entries",
+    "menu.save_search": "Save this search",
     "menu.add_feed": "Добавить подписку",
     "menu.add_user": "Добавить пользователя",
     "menu.flush_history": "Отчистить историю",
     "menu.feed_entries": "статьи",
     "menu.api_keys": "API-ключи",
     "menu.create_api_key": "Создать новый ключ API",
+    "menu.filter_rules": "Filters",
+    "menu.create_filter_rule": "Add a filter rule",
     "menu.shared_entries": "Общие записи",
+    "menu.back_to_entry": "Back to the entry",
     "search.label": "Поиск",
     "search.placeholder": "Поиск…",
     "pagination.next": "Следующая",
@@ -2695,10 +4364,39 @@ var translations = map[string]string{
     "entry.unshare.label": "Удалить из открытого списка",
     "entry.shared_entry.title": "Открыть публичную ссылку",
     "entry.shared_entry.label": "обмен",
+    "entry.cluster.also_covered_by": "Also covered by:",
+    "entry.labels.edit": "Labels",
+    "entry.highlight.label": "Highlight",
+    "entry.highlight.title": "Highlight the selected passage",
+    "entry.highlight.note": "Note (optional):",
+    "entry.highlight.toast.empty": "Select a passage of the article first",
+    "entry.revisions.updated": [
+        "Updated (%d version)",
+        "Updated (%d versions)",
+        "Updated (%d versions)"
+    ],
     "page.shared_entries.title": "Общие записи",
     "page.unread.title": "Непрочитанное",
     "page.starred.title": "Избранное",
     "page.categories.title": "Категории",
+    "page.labels.title": "Labels",
+    "page.highlights.title": "Highlights",
+    "page.edit_highlight.title": "Edit Highlight",
+    "page.labels.entry_count": [
+        "%d article",
+        "%d articles",
+        "%d articles"
+    ],
+    "page.edit_label.title": "Edit Label: %s",
+    "page.smart_folders.title": "Smart Folders",
+    "page.smart_folders.unread_count": [
+        "%d unread article",
+        "%d unread articles",
+        "%d unread articles"
+    ],
+    "page.new_smart_folder.title": "New Smart Folder",
+    "page.edit_smart_folder.title": "Edit Smart Folder: %s",
+    "page.entry_labels.title": "Labels of this entry",
     "page.categories.no_feed": "Нет подписок.",
     "page.categories.feeds": "Посмотреть подписку",
     "page.categories.feed_count": [
@@ -2712,6 +4410,7 @@ var translations = map[string]string{
     "page.edit_user.title": "Изменить пользователя: %s",
     "page.feeds.title": "Подписки",
     "page.feeds.last_check": "Последняя проверка:",
+    "page.feeds.suppressed_count": "Suppressed by the allowlist: %d",
     "page.feeds.unread_counter": "Количество непрочитанных записей",
     "page.feeds.read_counter": "Количество прочитанных записей",
     "page.feeds.error_count": [
@@ -2734,13 +4433,44 @@ var translations = map[string]string{
     "page.add_feed.submit": "Найти подписку",
     "page.add_feed.legend.advanced_options": "Расширенные настройки",
     "page.add_feed.choose_feed": "Выбрать подписку",
+    "page.add_feed.legend.web_page": "Web Page Rules",
+    "page.add_feed.legend.page_monitor": "Page Monitoring",
+    "page.add_feed.preview": "Preview",
+    "page.add_feed.preview_title": "Extracted Items",
+    "page.add_feed.preview_empty": "No item matches these selectors.",
     "page.edit_feed.title": "Изменить подписку: %s",
     "page.edit_feed.last_check": "Последняя проверка:",
     "page.edit_feed.last_modified_header": "Заголовок LastModified:",
     "page.edit_feed.etag_header": "Заголовок ETag:",
     "page.edit_feed.no_header": "Отсутствует",
+    "page.edit_feed.icon": "Icon:",
+    "page.edit_feed.suppressed_count": "Entries suppressed by the allowlist:",
+    "page.edit_feed.deduplicate.help": "Entries recognized as the same entry by the identity strategy are merged, the oldest one is kept with the read and starred states and the tags of the others.",
+    "page.edit_feed.effective_settings": "Effective Settings",
+    "page.edit_feed.effective_settings.help": "Settings used when the feed is refreshed, the empty ones are taken from the category \"%s\".",
+    "page.edit_feed.inherited": "from the category",
+    "page.edit_feed.enabled": "Enabled",
+    "page.edit_feed.disabled": "Disabled",
+    "page.edit_feed.legend.preview": "Preview Options",
+    "page.edit_feed.preview.title": "Preview",
+    "page.edit_feed.preview.summary": "%d of %d entries would be removed, nothing has been saved.",
+    "page.edit_feed.preview.suppressed": "Suppressed by the allowlist",
+    "page.edit_feed.preview.removed": "Removed by a filter rule",
+    "page.edit_feed.preview.read": "Marked as read",
+    "page.edit_feed.preview.kept": "Kept",
+    "page.edit_feed.preview.starred": "Starred",
+    "page.edit_feed.preview.matched_rules": "Matched filter rules: %d",
+    "page.edit_feed.preview.content_changed": "Show the content changes",
+    "page.edit_feed.preview.content_unchanged": "The content is unchanged.",
+    "page.entry_revisions.title": "Previous versions",
+    "page.entry_revisions.help": "The publisher has updated this entry. Each version is compared with the one that replaced it, the most recent first.",
+    "page.entry_revisions.replaced": "Replaced",
+    "page.entry_revisions.content_changed": "Show the changes of the content",
+    "page.entry_revisions.content_unchanged": "Only the title has changed.",
     "page.edit_feed.last_parsing_error": "Последняя ошибка парсинга",
+    "page.edit_feed.newsletter_address": "Messages sent to this address are delivered to this feed:",
     "page.entry.attachments": "Вложения",
+    "page.entry.highlights": "Highlights",
     "page.keyboard_shortcuts.title": "Сочетания клавиш",
     "page.keyboard_shortcuts.subtitle.sections": "Навигация по секциям",
     "page.keyboard_shortcuts.subtitle.items": "Навигация по элементам",
@@ -2796,6 +4526,8 @@ var translations = map[string]string{
     "page.integration.bookmarklet": "Букмарклет",
     "page.integration.bookmarklet.name": "Добавить в Miniflux",
     "page.integration.bookmarklet.instructions": "Перетащите эту ссылку в ваши закладки.",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address, the messages of each sender are delivered to their own feed:",
     "page.integration.bookmarklet.help": "Эта специальная ссылка позволит вам подписаться на сайт, используя обыкновенную закладку в вашем браузере.",
     "page.sessions.title": "Сессии",
     "page.sessions.table.date": "Время",
@@ -2811,14 +4543,31 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Действия",
     "page.api_keys.never_used": "Никогда не использовался",
     "page.new_api_key.title": "Новый ключ API",
+    "page.filter_rules.title": "Filter Rules",
+    "page.filter_rules.table.scope": "Applies To",
+    "page.filter_rules.table.condition": "Condition",
+    "page.filter_rules.table.action": "Action",
+    "page.filter_rules.table.hits": "Matched Articles",
+    "page.filter_rules.table.error": "Error",
+    "page.filter_rules.ignored": "This rule is ignored until it is fixed:",
+    "page.filter_rules.table.actions": "Actions",
+    "page.new_filter_rule.title": "New Filter Rule",
+    "page.edit_filter_rule.title": "Edit Filter Rule",
     "alert.no_shared_entry": "Там нет общей записи.",
     "alert.no_bookmark": "Нет закладок на данный момент.",
     "alert.no_category": "Категории отсутствуют.",
+    "alert.no_label": "You don't have any label.",
+    "alert.no_highlight": "There are no highlights. Select a passage of an article to highlight it.",
+    "alert.no_label_entry": "There are no articles with this label.",
+    "alert.no_smart_folder": "There are no smart folders. Save a search to create one.",
+    "alert.no_smart_folder_entry": "There are no articles matching this smart folder.",
     "alert.no_category_entry": "В этой категории нет статей.",
     "alert.no_feed_entry": "В этой подписке отсутствуют статьи.",
     "alert.no_feed": "У вас нет ни одной подписки.",
     "alert.no_feed_in_category": "Для этой категории нет подписки.",
+    "alert.no_filter_rule": "There is no filter rule.",
     "alert.no_history": "Истории пока нет.",
+    "alert.no_entry_revision": "This entry has not been updated.",
     "alert.feed_error": "С этой подпиской есть проблема",
     "alert.no_search_result": "Нет результатов для данного поискового запроса.",
     "alert.no_unread_entry": "Нет непрочитанных статей.",
@@ -2827,12 +4576,26 @@ var translations = map[string]string{
     "alert.account_linked": "Ваш внешний аккаунт теперь привязан!",
     "alert.pocket_linked": "Ваш Pocket аккаунт теперь привязан!",
     "alert.prefs_saved": "Предпочтения сохранены!",
+    "alert.feed_icon_refreshed": "The icon has been updated.",
+    "alert.feed_deduplicated": "Duplicate entries removed: %d.",
     "error.unlink_account_without_password": "Вы должны установить пароль, иначе вы не сможете войти снова.",
     "error.duplicate_linked_account": "Уже есть кто-то, кто ассоциирован с этим аккаунтом!",
     "error.duplicate_fever_username": "Уже есть кто-то с таким же именем пользователя Fever!",
     "error.pocket_request_token": "Не удается извлечь request token из Pocket!",
     "error.pocket_access_token": "Не удается извлечь access token из Pocket!",
+    "error.feed_icon_not_found": "Unable to find the icon of this website.",
+    "error.unable_to_deduplicate_feed": "Unable to remove the duplicate entries of this feed.",
     "error.category_already_exists": "Эта категория уже существует.",
+    "error.label_already_exists": "This label already exists.",
+    "error.unable_to_create_label": "Unable to create this label.",
+    "error.unable_to_update_label": "Unable to update this label.",
+    "error.unable_to_update_highlight": "Unable to update this highlight.",
+    "error.smart_folder_already_exists": "This smart folder already exists.",
+    "error.unable_to_create_smart_folder": "Unable to create this smart folder.",
+    "error.unable_to_update_smart_folder": "Unable to update this smart folder.",
+    "error.smart_folder_invalid_status": "The status must be unread or read.",
+    "error.smart_folder_invalid_date": "The dates must use the format YYYY-MM-DD.",
+    "error.smart_folder_invalid_date_range": "The start date must be before the end date.",
     "error.unable_to_create_category": "Не удается создать эту категорию.",
     "error.unable_to_update_category": "Не удается обновить эту категорию.",
     "error.user_already_exists": "Этот пользователь уже существует.",
@@ -2848,24 +4611,91 @@ var translations = map[string]string{
     "error.password_min_length": "Вы должны использовать минимум 6 символов.",
     "error.settings_mandatory_fields": "Имя пользователя, тема, язык и часовой пояс обязательны.",
     "error.feed_mandatory_fields": "URL и категория обязательны.",
+    "error.web_page_rules_invalid": "The item selector and a title or link selector are mandatory.",
+    "error.content_extractors_invalid": "Unknown content extractor or invalid time limit.",
+    "error.filter_mode_invalid": "Invalid filter mode.",
+    "error.entry_identity_invalid": "Invalid entry identity.",
+    "error.no_entry_to_preview": "There is no entry to preview.",
+    "error.scraper_rules_invalid": "Invalid scraper rules, the number of pages must be between 1 and 20.",
+    "error.rewrite_rules_invalid": "Invalid rewrite rules, check the rule names, the quoted arguments and the regular expressions.",
+    "error.page_monitor_threshold_invalid": "The change threshold must be between 0 and 100.",
+    "error.local_sources_forbidden": "Local sources are disabled or reserved to administrators.",
     "error.user_mandatory_fields": "Имя пользователя обязательно.",
     "error.api_key_already_exists": "Этот ключ API уже существует.",
     "error.unable_to_create_api_key": "Невозможно создать этот ключ API.",
+    "error.filter_rule_invalid": "Invalid filter rule, check the value of the condition, the regular expression or the duration.",
+    "error.entry_revisions_limit_invalid": "The number of versions to keep must be between 0 and %d.",
+    "error.unable_to_create_filter_rule": "Unable to create this filter rule.",
+    "error.unable_to_update_filter_rule": "Unable to update this filter rule.",
     "form.feed.label.title": "Название",
     "form.feed.label.site_url": "URL сайта",
     "form.feed.label.feed_url": "URL подписки",
     "form.feed.label.category": "Категория",
     "form.feed.label.crawler": "Извлечь оригинальное содержимое",
+    "form.feed.label.crawler_override": "Ignore the crawler setting of the category",
     "form.feed.label.feed_username": "Имя пользователя подписки",
     "form.feed.label.feed_password": "Пароль подписки",
     "form.feed.label.user_agent": "Переопределить User Agent по умолчанию",
     "form.feed.label.scraper_rules": "Правила Scraper",
     "form.feed.label.rewrite_rules": "Правила Rewrite",
+    "form.feed.label.use_mercury": "Use Mercury Parser",
+    "form.feed.label.content_extractors": "Content extractors",
+    "form.feed.help.content_extractors": "Comma separated list of extractors tried in order, each one can have a time limit in seconds, for example \"scraper, readability:10\". Available extractors: %s.",
+    "form.feed.label.filter_mode": "Filter Mode",
+    "form.feed.filter_mode.inherit": "Same as the category",
+    "form.feed.help.preview": "Applies the filter mode, the filter rules, the content extractors, the scraper and rewrite rules of this form to the latest entries of the feed without saving anything.",
+    "form.feed.label.preview_refetch": "Download the feed again instead of using the stored entries",
+    "form.feed.label.preview_crawl": "Fetch the original content with the content extractors (slower)",
+    "form.feed.help.scraper_rules": "CSS selectors separated by semicolons. Use \"exclude: selector\" to remove elements, \"next\" or \"next: selector\" to follow the pagination and \"pages: number\" to limit the number of pages.",
+    "form.feed.help.rewrite_rules": "Comma separated list of rules, for example: nl2br, replace(\"regex\", \"replacement\"), replace_title(\"regex\", \"replacement\"), remove(\"CSS selector\"), add_class(\"CSS selector\", \"name\"). The added class names are prefixed with \"rewrite-\".",
+    "form.category.help.content_extractors": "Used by the feeds of this category without their own content extractors. Available extractors: %s.",
+    "form.category.help.default_settings": "The following settings are used by the feeds of this category that do not define their own. When the crawler is enabled here, it is enabled for all the feeds of the category.",
+    "form.category.label.filter_mode": "Filter Mode",
+    "form.filter_mode.block": "Keep all entries except the ones removed by the filter rules",
+    "form.filter_mode.allowlist": "Keep only the entries matching a \"keep\" rule (allowlist)",
+    "form.filter_mode.help": "In allowlist mode, new entries not matching any filter rule with the action \"keep\" are removed, all of them if there is no such rule.",
+    "form.feed.label.entry_identity": "Entry Identity",
+    "form.feed.entry_identity.default": "Default (identifier of the feed item)",
+    "form.feed.entry_identity.guid": "GUID of the item",
+    "form.feed.entry_identity.url": "URL",
+    "form.feed.entry_identity.canonical_url": "Canonical URL (without scheme, \"www.\" and trailing slash)",
+    "form.feed.entry_identity.title_date": "Title and publication date",
+    "form.feed.entry_identity.content": "Content",
+    "form.feed.help.entry_identity": "Defines how the entries are recognized when the feed is refreshed. Changing it removes the duplicate entries of the feed. The content should not be used with the crawler, and the title and date only with feeds providing publication dates.",
+    "form.feed.label.disabled": "Do not refresh this feed",
+    "form.feed.label.source_type": "Source Type",
+    "form.feed.source_type.feed": "Feed (RSS, Atom, JSON)",
+    "form.feed.source_type.web_page": "Web page (CSS selectors)",
+    "form.feed.source_type.page_monitor": "Web page changes",
+    "form.feed.source_type.file": "Local file (file:// URL)",
+    "form.feed.source_type.command": "Command output (file:// URL of an executable)",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.summary_selector": "Summary Selector",
+    "form.feed.label.monitor_selector": "Monitored Elements (CSS selector, optional)",
+    "form.feed.label.monitor_threshold": "Minimum Percentage of Changed Words",
     "form.category.label.title": "Название",
+    "form.label.label.title": "Title",
+    "form.highlight.label.note": "Note",
+    "form.smart_folder.label.title": "Title",
+    "form.smart_folder.label.search_query": "Search terms",
+    "form.smart_folder.label.feeds": "Feeds",
+    "form.smart_folder.label.categories": "Categories",
+    "form.smart_folder.label.labels": "Labels",
+    "form.smart_folder.help.selection": "Leave a list empty to not filter on it. An article matches a list when it matches one of the selected items.",
+    "form.smart_folder.label.status": "Status",
+    "form.smart_folder.status.all": "All articles",
+    "form.smart_folder.status.unread": "Unread articles",
+    "form.smart_folder.status.read": "Read articles",
+    "form.smart_folder.label.published_after": "Published from",
+    "form.smart_folder.label.published_before": "Published before",
     "form.user.label.username": "Имя пользователя",
     "form.user.label.password": "Пароль",
     "form.user.label.confirmation": "Подтверждение пароля",
     "form.user.label.admin": "Администратор",
+    "form.user.label.mercury_api_url": "Mercury API URL",
     "form.prefs.label.language": "Язык",
     "form.prefs.label.timezone": "Часовой пояс",
     "form.prefs.label.theme": "Тема",
@@ -2873,6 +4703,9 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Сначала старые записи",
     "form.prefs.select.recent_first": "Сначала последние записи",
     "form.prefs.label.keyboard_shortcuts": "Включить сочетания клавиш",
+    "form.prefs.label.entry_revisions_limit": "Previous versions kept for each updated entry",
+    "form.prefs.help.entry_revisions_limit": "Set to 0 to stop keeping the previous title and content of updated entries.",
+    "form.prefs.label.unread_on_update": "Mark entries as unread again when their content changes substantially",
     "form.prefs.label.custom_css": "Пользовательские CSS",
     "form.import.label.file": "OPML файл",
     "form.import.label.url": "URL",
@@ -2901,6 +4734,36 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_endpoint": "Конечная точка Nunux Keeper API",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API key",
     "form.api_key.label.description": "APIキーラベル",
+    "form.filter_rule.label.scope": "Applies To",
+    "form.filter_rule.scope.all": "All feeds",
+    "form.filter_rule.scope.categories": "Categories",
+    "form.filter_rule.scope.feeds": "Feeds",
+    "form.filter_rule.label.field": "Field",
+    "form.filter_rule.label.operator": "Operator",
+    "form.filter_rule.label.value": "Value",
+    "form.filter_rule.help.value": "The comparisons are case-insensitive. Use a regular expression with \"matches\", and a duration such as 12h, 7d or 2w with the date.",
+    "form.filter_rule.label.action": "Action",
+    "form.filter_rule.label.action_value": "Tag",
+    "form.filter_rule.help.action_value": "Only used by the action \"Add a tag\".",
+    "filter_rule.field.title": "Title",
+    "filter_rule.field.content": "Content",
+    "filter_rule.field.author": "Author",
+    "filter_rule.field.url": "URL",
+    "filter_rule.field.tags": "Tags",
+    "filter_rule.field.date": "Date",
+    "filter_rule.operator.contains": "contains",
+    "filter_rule.operator.not_contains": "does not contain",
+    "filter_rule.operator.equals": "is",
+    "filter_rule.operator.matches": "matches",
+    "filter_rule.operator.not_matches": "does not match",
+    "filter_rule.operator.older_than": "is older than",
+    "filter_rule.operator.newer_than": "is newer than",
+    "filter_rule.action.remove": "Remove the article",
+    "filter_rule.action.read": "Mark as read",
+    "filter_rule.action.star": "Star",
+    "filter_rule.action.tag": "Add a tag",
+    "filter_rule.action.integration": "Send to the integrations",
+    "filter_rule.action.keep": "Keep (allowlist mode)",
     "form.submit.loading": "Загрузка…",
     "form.submit.saving": "Сохранение…",
     "time_elapsed.not_yet": "ещё нет",
@@ -2950,11 +4813,14 @@ var translations = map[string]string{
     "action.remove": "删除",
     "action.remove_feed": "删除此源",
     "action.update": "更新",
+    "action.preview": "Preview",
     "action.edit": "编辑",
     "action.download": "下载",
     "action.import": "导入",
     "action.login": "登陆",
     "action.home_screen": "添加到主屏幕",
+    "action.refresh_icon": "Download icon again",
+    "action.deduplicate": "Remove duplicate entries",
     "tooltip.keyboard_shortcuts": "快捷键: %s",
     "tooltip.logged_user": "当前登录 %s",
     "menu.unread": "未读",
@@ -2980,13 +4846,27 @@ var translations = map[string]string{
     "menu.refresh_all_feeds": "在后台更新全部源",
     "menu.edit_feed": "编辑",
     "menu.edit_category": "编辑",
+    "menu.labels": "Labels",
+    "menu.create_label": "Create a label",
+    "menu.edit_label": "Edit",
+    "menu.label_entries": "Entries",
+    "menu.smart_folders": "Smart Folders",
+    "menu.highlights": "Highlights",
+    "menu.edit_highlight": "Edit note",
+    "menu.create_smart_folder": "Create a smart folder",
+    "menu.edit_smart_folder": "Edit",
+    "menu.smart_folder_entries": "Entries",
+    "menu.save_search": "Save this search",
     "menu.add_feed": "新增订阅",
     "menu.add_user": "新建用户",
     "menu.flush_history": "清理历史",
     "menu.feed_entries": "文章",
     "menu.api_keys": "API密钥",
     "menu.create_api_key": "创建一个新的API密钥",
+    "menu.filter_rules": "Filters",
+    "menu.create_filter_rule": "Add a filter rule",
     "menu.shared_entries": "共享条目",
+    "menu.back_to_entry": "Back to the entry",
     "search.label": "搜索",
     "search.placeholder": "搜索…",
     "pagination.next": "下一页",
@@ -3017,10 +4897,36 @@ var translations = map[string]string{
     "entry.unshare.label": "取消分享",
     "entry.shared_entry.title": "打开公共链接",
     "entry.shared_entry.label": "分享分享",
+    "entry.cluster.also_covered_by": "Also covered by:",
+    "entry.labels.edit": "Labels",
+    "entry.highlight.label": "Highlight",
+    "entry.highlight.title": "Highlight the selected passage",
+    "entry.highlight.note": "Note (optional):",
+    "entry.highlight.toast.empty": "Select a passage of the article first",
+    "entry.revisions.updated": [
+        "Updated (%d version)",
+        "Updated (%d versions)"
+    ],
     "page.shared_entries.title": "共享条目",
     "page.unread.title": "未读",
     "page.starred.title": "星标",
     "page.categories.title": "分类",
+    "page.labels.title": "Labels",
+    "page.highlights.title": "Highlights",
+    "page.edit_highlight.title": "Edit Highlight",
+    "page.labels.entry_count": [
+        "%d article",
+        "%d articles"
+    ],
+    "page.edit_label.title": "Edit Label: %s",
+    "page.smart_folders.title": "Smart Folders",
+    "page.smart_folders.unread_count": [
+        "%d unread article",
+        "%d unread articles"
+    ],
+    "page.new_smart_folder.title": "New Smart Folder",
+    "page.edit_smart_folder.title": "Edit Smart Folder: %s",
+    "page.entry_labels.title": "Labels of this entry",
     "page.categories.no_feed": "没有源",
     "page.categories.feeds": "查看订阅",
     "page.categories.feed_count": [
@@ -3032,6 +4938,7 @@ var translations = map[string]string{
     "page.edit_user.title": "编辑用户 : %s",
     "page.feeds.title": "源",
     "page.feeds.last_check": "最后检查时间：",
+    "page.feeds.suppressed_count": "Suppressed by the allowlist: %d",
     "page.feeds.unread_counter": "未读条目数",
     "page.feeds.read_counter": "读取条目数",
     "page.feeds.error_count": [
@@ -3052,13 +4959,44 @@ var translations = map[string]string{
     "page.add_feed.submit": "查找订阅",
     "page.add_feed.legend.advanced_options": "高级选项",
     "page.add_feed.choose_feed": "选择一个订阅",
+    "page.add_feed.legend.web_page": "Web Page Rules",
+    "page.add_feed.legend.page_monitor": "Page Monitoring",
+    "page.add_feed.preview": "Preview",
+    "page.add_feed.preview_title": "Extracted Items",
+    "page.add_feed.preview_empty": "No item matches these selectors.",
     "page.edit_feed.title": "编辑源 : %s",
     "page.edit_feed.last_check": "最后检查时间：",
     "page.edit_feed.last_modified_header": "最后修改的 Header：",
     "page.edit_feed.etag_header": "ETag 标题：",
     "page.edit_feed.no_header": "无",
+    "page.edit_feed.icon": "Icon:",
+    "page.edit_feed.suppressed_count": "Entries suppressed by the allowlist:",
+    "page.edit_feed.deduplicate.help": "Entries recognized as the same entry by the identity strategy are merged, the oldest one is kept with the read and starred states and the tags of the others.",
+    "page.edit_feed.effective_settings": "Effective Settings",
+    "page.edit_feed.effective_settings.help": "Settings used when the feed is refreshed, the empty ones are taken from the category \"%s\".",
+    "page.edit_feed.inherited": "from the category",
+    "page.edit_feed.enabled": "Enabled",
+    "page.edit_feed.disabled": "Disabled",
+    "page.edit_feed.legend.preview": "Preview Options",
+    "page.edit_feed.preview.title": "Preview",
+    "page.edit_feed.preview.summary": "%d of %d entries would be removed, nothing has been saved.",
+    "page.edit_feed.preview.suppressed": "Suppressed by the allowlist",
+    "page.edit_feed.preview.removed": "Removed by a filter rule",
+    "page.edit_feed.preview.read": "Marked as read",
+    "page.edit_feed.preview.kept": "Kept",
+    "page.edit_feed.preview.starred": "Starred",
+    "page.edit_feed.preview.matched_rules": "Matched filter rules: %d",
+    "page.edit_feed.preview.content_changed": "Show the content changes",
+    "page.edit_feed.preview.content_unchanged": "The content is unchanged.",
+    "page.entry_revisions.title": "Previous versions",
+    "page.entry_revisions.help": "The publisher has updated this entry. Each version is compared with the one that replaced it, the most recent first.",
+    "page.entry_revisions.replaced": "Replaced",
+    "page.entry_revisions.content_changed": "Show the changes of the content",
+    "page.entry_revisions.content_unchanged": "Only the title has changed.",
     "page.edit_feed.last_parsing_error": "最后一次解析错误",
+    "page.edit_feed.newsletter_address": "Messages sent to this address are delivered to this feed:",
     "page.entry.attachments": "附件",
+    "page.entry.highlights": "Highlights",
     "page.keyboard_shortcuts.title": "快捷键",
     "page.keyboard_shortcuts.subtitle.sections": "分区导航",
     "page.keyboard_shortcuts.subtitle.items": "条目导航",
@@ -3114,6 +5052,8 @@ var translations = map[string]string{
     "page.integration.bookmarklet": "书签小应用",
     "page.integration.bookmarklet.name": "新增到Miniflux",
     "page.integration.bookmarklet.instructions": "拖动这个链接到书签",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address, the messages of each sender are delivered to their own feed:",
     "page.integration.bookmarklet.help": "你可以打开这个特殊的书签来直接订阅网站",
     "page.sessions.title": "会话",
     "page.sessions.table.date": "日期",
@@ -3129,28 +5069,59 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "操作",
     "page.api_keys.never_used": "没用过",
     "page.new_api_key.title": "新的API密钥",
+    "page.filter_rules.title": "Filter Rules",
+    "page.filter_rules.table.scope": "Applies To",
+    "page.filter_rules.table.condition": "Condition",
+    "page.filter_rules.table.action": "Action",
+    "page.filter_rules.table.hits": "Matched Articles",
+    "page.filter_rules.table.error": "Error",
+    "page.filter_rules.ignored": "This rule is ignored until it is fixed:",
+    "page.filter_rules.table.actions": "Actions",
+    "page.new_filter_rule.title": "New Filter Rule",
+    "page.edit_filter_rule.title": "Edit Filter Rule",
     "alert.no_shared_entry": "没有共享条目。",
     "alert.no_bookmark": "目前没有书签",
     "alert.no_category": "目前没有分类",
+    "alert.no_label": "You don't have any label.",
+    "alert.no_highlight": "There are no highlights. Select a passage of an article to highlight it.",
+    "alert.no_label_entry": "There are no articles with this label.",
+    "alert.no_smart_folder": "There are no smart folders. Save a search to create one.",
+    "alert.no_smart_folder_entry": "There are no articles matching this smart folder.",
     "alert.no_category_entry": "该分类下没有文章",
     "alert.no_feed_entry": "该源中没有文章",
     "alert.no_feed": "目前没有订阅",
     "alert.no_history": "目前没有历史",
+    "alert.no_entry_revision": "This entry has not been updated.",
     "alert.feed_error": "该源存在问题",
     "alert.no_search_result": "该搜索没有结果",
     "alert.no_feed_in_category": "没有该类别的订阅。",
+    "alert.no_filter_rule": "There is no filter rule.",
     "alert.no_unread_entry": "目前没有未读文章",
     "alert.no_user": "您是目前仅有的用户",
     "alert.account_unlinked": "您的外部帐户现已解除关联！",
     "alert.account_linked": "您的外部账号已关联！",
     "alert.pocket_linked": "您的Pocket帐户现已关联",
     "alert.prefs_saved": "设置已存储！",
+    "alert.feed_icon_refreshed": "The icon has been updated.",
+    "alert.feed_deduplicated": "Duplicate entries removed: %d.",
     "error.unlink_account_without_password": "您必须定义密码，否则您将无法再次登录。",
     "error.duplicate_linked_account": "该 Provider 已被关联！",
     "error.duplicate_fever_username": "Fever 用户名已被占用！",
     "error.pocket_request_token": "无法从 Pocket 获取请求令牌！",
     "error.pocket_access_token": "无法从 Pocket 获取访问令牌！",
+    "error.feed_icon_not_found": "Unable to find the icon of this website.",
+    "error.unable_to_deduplicate_feed": "Unable to remove the duplicate entries of this feed.",
     "error.category_already_exists": "分类已存在",
+    "error.label_already_exists": "This label already exists.",
+    "error.unable_to_create_label": "Unable to create this label.",
+    "error.unable_to_update_label": "Unable to update this label.",
+    "error.unable_to_update_highlight": "Unable to update this highlight.",
+    "error.smart_folder_already_exists": "This smart folder already exists.",
+    "error.unable_to_create_smart_folder": "Unable to create this smart folder.",
+    "error.unable_to_update_smart_folder": "Unable to update this smart folder.",
+    "error.smart_folder_invalid_status": "The status must be unread or read.",
+    "error.smart_folder_invalid_date": "The dates must use the format YYYY-MM-DD.",
+    "error.smart_folder_invalid_date_range": "The start date must be before the end date.",
     "error.unable_to_create_category": "无法建立这个分类",
     "error.unable_to_update_category": "无法更新该分类",
     "error.user_already_exists": "用户已存在",
@@ -3166,24 +5137,91 @@ var translations = map[string]string{
     "error.password_min_length": "请至少使用6个字符",
     "error.settings_mandatory_fields": "必须填写用户名、主题、语言以及时区",
     "error.feed_mandatory_fields": "必须填写 URL 和分类",
+    "error.web_page_rules_invalid": "The item selector and a title or link selector are mandatory.",
+    "error.content_extractors_invalid": "Unknown content extractor or invalid time limit.",
+    "error.filter_mode_invalid": "Invalid filter mode.",
+    "error.entry_identity_invalid": "Invalid entry identity.",
+    "error.no_entry_to_preview": "There is no entry to preview.",
+    "error.scraper_rules_invalid": "Invalid scraper rules, the number of pages must be between 1 and 20.",
+    "error.rewrite_rules_invalid": "Invalid rewrite rules, check the rule names, the quoted arguments and the regular expressions.",
+    "error.page_monitor_threshold_invalid": "The change threshold must be between 0 and 100.",
+    "error.local_sources_forbidden": "Local sources are disabled or reserved to administrators.",
     "error.user_mandatory_fields": "必须填写用户名",
     "error.api_key_already_exists": "此API密钥已存在。",
     "error.unable_to_create_api_key": "无法创建此API密钥。",
+    "error.filter_rule_invalid": "Invalid filter rule, check the value of the condition, the regular expression or the duration.",
+    "error.entry_revisions_limit_invalid": "The number of versions to keep must be between 0 and %d.",
+    "error.unable_to_create_filter_rule": "Unable to create this filter rule.",
+    "error.unable_to_update_filter_rule": "Unable to update this filter rule.",
     "form.feed.label.title": "标题",
     "form.feed.label.site_url": "站点 URL",
     "form.feed.label.feed_url": "源 URL",
     "form.feed.label.category": "类别",
     "form.feed.label.crawler": "获取原始内容",
+    "form.feed.label.crawler_override": "Ignore the crawler setting of the category",
     "form.feed.label.feed_username": "源用户名",
     "form.feed.label.feed_password": "源密码",
     "form.feed.label.user_agent": "覆盖默认 User-Agent",
     "form.feed.label.scraper_rules": "Scraper 规则",
     "form.feed.label.rewrite_rules": "重写规则",
+    "form.feed.label.use_mercury": "Use Mercury Parser",
+    "form.feed.label.content_extractors": "Content extractors",
+    "form.feed.help.content_extractors": "Comma separated list of extractors tried in order, each one can have a time limit in seconds, for example \"scraper, readability:10\". Available extractors: %s.",
+    "form.feed.label.filter_mode": "Filter Mode",
+    "form.feed.filter_mode.inherit": "Same as the category",
+    "form.feed.help.preview": "Applies the filter mode, the filter rules, the content extractors, the scraper and rewrite rules of this form to the latest entries of the feed without saving anything.",
+    "form.feed.label.preview_refetch": "Download the feed again instead of using the stored entries",
+    "form.feed.label.preview_crawl": "Fetch the original content with the content extractors (slower)",
+    "form.feed.help.scraper_rules": "CSS selectors separated by semicolons. Use \"exclude: selector\" to remove elements, \"next\" or \"next: selector\" to follow the pagination and \"pages: number\" to limit the number of pages.",
+    "form.feed.help.rewrite_rules": "Comma separated list of rules, for example: nl2br, replace(\"regex\", \"replacement\"), replace_title(\"regex\", \"replacement\"), remove(\"CSS selector\"), add_class(\"CSS selector\", \"name\"). The added class names are prefixed with \"rewrite-\".",
+    "form.category.help.content_extractors": "Used by the feeds of this category without their own content extractors. Available extractors: %s.",
+    "form.category.help.default_settings": "The following settings are used by the feeds of this category that do not define their own. When the crawler is enabled here, it is enabled for all the feeds of the category.",
+    "form.category.label.filter_mode": "Filter Mode",
+    "form.filter_mode.block": "Keep all entries except the ones removed by the filter rules",
+    "form.filter_mode.allowlist": "Keep only the entries matching a \"keep\" rule (allowlist)",
+    "form.filter_mode.help": "In allowlist mode, new entries not matching any filter rule with the action \"keep\" are removed, all of them if there is no such rule.",
+    "form.feed.label.entry_identity": "Entry Identity",
+    "form.feed.entry_identity.default": "Default (identifier of the feed item)",
+    "form.feed.entry_identity.guid": "GUID of the item",
+    "form.feed.entry_identity.url": "URL",
+    "form.feed.entry_identity.canonical_url": "Canonical URL (without scheme, \"www.\" and trailing slash)",
+    "form.feed.entry_identity.title_date": "Title and publication date",
+    "form.feed.entry_identity.content": "Content",
+    "form.feed.help.entry_identity": "Defines how the entries are recognized when the feed is refreshed. Changing it removes the duplicate entries of the feed. The content should not be used with the crawler, and the title and date only with feeds providing publication dates.",
+    "form.feed.label.disabled": "Do not refresh this feed",
+    "form.feed.label.source_type": "Source Type",
+    "form.feed.source_type.feed": "Feed (RSS, Atom, JSON)",
+    "form.feed.source_type.web_page": "Web page (CSS selectors)",
+    "form.feed.source_type.page_monitor": "Web page changes",
+    "form.feed.source_type.file": "Local file (file:// URL)",
+    "form.feed.source_type.command": "Command output (file:// URL of an executable)",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.summary_selector": "Summary Selector",
+    "form.feed.label.monitor_selector": "Monitored Elements (CSS selector, optional)",
+    "form.feed.label.monitor_threshold": "Minimum Percentage of Changed Words",
     "form.category.label.title": "标题",
+    "form.label.label.title": "Title",
+    "form.highlight.label.note": "Note",
+    "form.smart_folder.label.title": "Title",
+    "form.smart_folder.label.search_query": "Search terms",
+    "form.smart_folder.label.feeds": "Feeds",
+    "form.smart_folder.label.categories": "Categories",
+    "form.smart_folder.label.labels": "Labels",
+    "form.smart_folder.help.selection": "Leave a list empty to not filter on it. An article matches a list when it matches one of the selected items.",
+    "form.smart_folder.label.status": "Status",
+    "form.smart_folder.status.all": "All articles",
+    "form.smart_folder.status.unread": "Unread articles",
+    "form.smart_folder.status.read": "Read articles",
+    "form.smart_folder.label.published_after": "Published from",
+    "form.smart_folder.label.published_before": "Published before",
     "form.user.label.username": "用户名",
     "form.user.label.password": "密码",
     "form.user.label.confirmation": "确认",
     "form.user.label.admin": "管理员",
+    "form.user.label.mercury_api_url": "Mercury API URL",
     "form.prefs.label.language": "语言",
     "form.prefs.label.timezone": "时区",
     "form.prefs.label.theme": "主题",
@@ -3191,6 +5229,9 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "旧->新",
     "form.prefs.select.recent_first": "新->旧",
     "form.prefs.label.keyboard_shortcuts": "启用键盘快捷键",
+    "form.prefs.label.entry_revisions_limit": "Previous versions kept for each updated entry",
+    "form.prefs.help.entry_revisions_limit": "Set to 0 to stop keeping the previous title and content of updated entries.",
+    "form.prefs.label.unread_on_update": "Mark entries as unread again when their content changes substantially",
     "form.prefs.label.custom_css": "自定义CSS",
     "form.import.label.file": "OPML 文件",
     "form.import.label.url": "URL",
@@ -3219,6 +5260,36 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API 密钥",
     "form.api_key.label.description": "API密钥标签",
+    "form.filter_rule.label.scope": "Applies To",
+    "form.filter_rule.scope.all": "All feeds",
+    "form.filter_rule.scope.categories": "Categories",
+    "form.filter_rule.scope.feeds": "Feeds",
+    "form.filter_rule.label.field": "Field",
+    "form.filter_rule.label.operator": "Operator",
+    "form.filter_rule.label.value": "Value",
+    "form.filter_rule.help.value": "The comparisons are case-insensitive. Use a regular expression with \"matches\", and a duration such as 12h, 7d or 2w with the date.",
+    "form.filter_rule.label.action": "Action",
+    "form.filter_rule.label.action_value": "Tag",
+    "form.filter_rule.help.action_value": "Only used by the action \"Add a tag\".",
+    "filter_rule.field.title": "Title",
+    "filter_rule.field.content": "Content",
+    "filter_rule.field.author": "Author",
+    "filter_rule.field.url": "URL",
+    "filter_rule.field.tags": "Tags",
+    "filter_rule.field.date": "Date",
+    "filter_rule.operator.contains": "contains",
+    "filter_rule.operator.not_contains": "does not contain",
+    "filter_rule.operator.equals": "is",
+    "filter_rule.operator.matches": "matches",
+    "filter_rule.operator.not_matches": "does not match",
+    "filter_rule.operator.older_than": "is older than",
+    "filter_rule.operator.newer_than": "is newer than",
+    "filter_rule.action.remove": "Remove the article",
+    "filter_rule.action.read": "Mark as read",
+    "filter_rule.action.star": "Star",
+    "filter_rule.action.tag": "Add a tag",
+    "filter_rule.action.integration": "Send to the integrations",
+    "filter_rule.action.keep": "Keep (allowlist mode)",
     "form.submit.loading": "载入中…",
     "form.submit.saving": "保存中…",
     "time_elapsed.not_yet": "尚未",
@@ -3266,14 +5337,14 @@ var translations = map[string]string{
 }
 
 var translationsChecksums = map[string]string{
-	"de_DE": "60cf0319382faa6e8eb36a622c2b259bece5f60dbf3b439f8777684d37db73d2",
-	"en_US": "87bff3e8d6b1aad4fb17775c7ef2089ee0fe019e0d986770ffbaa932843b2d6f",
-	"es_ES": "a81ec58ebcf92c0083f2bd98e5d2cdba574670901ad9f468f386f1760b28fab8",
-	"fr_FR": "3667c9b754b2a116dc8a91815f2971f64beb4b4ec994bf3ae6998fed94c8b065",
-	"it_IT": "fa194dcc2e0fc4ad84be7cb4f654151f84187b51f52a896400d1bba302c0a17e",
-	"ja_JP": "96101b6f5b073284304f052548da30334c3488324022d46c889f5dd85f4ae1df",
-	"nl_NL": "cd86d0b11e0456bb224a9bcff38c6e32488d8a837014e498928e67302d89ea32",
-	"pl_PL": "e18cedd396d802add6b90b7d6d3618e5ee8bc47310d075abc2ca8dee7bfd984b",
-	"ru_RU": "2333d0ae72217af5e0742247fba93942dc85e6392e7e8ecd64e5b8a89e7c461c",
-	"zh_CN": "5b445569c12b43388eb316ce7863855d244d0b8c72783c10e7572113cf3184bd",
+	"de_DE": "326c6a794543f29152f99cda4472c862cd27d34378abce09c3e3af9b01404d86",
+	"en_US": "07ca1f9912a33c6f7473abc4b8494e05c24abbf8c8e9d2aabfa4f70c63167467",
+	"es_ES": "5044f88ada957aeff3b44c867a71c648bb578dc7af297a3851c123e3d0e63130",
+	"fr_FR": "a15f3da516f52cc2fc52f80fcab2ead6bab01c400095aacf8b2b4186626e8b9e",
+	"it_IT": "82223dde53e9e4a8e60987076b97c7b8c1553708cc85e4bfd1ac5238b10b51f6",
+	"ja_JP": "56333b84cc4c03d9a5cb6e07c489a6c49ffaa5ecef5b02d128c619da5215faf6",
+	"nl_NL": "462aaa7535a66bdaeb469913fd172b69ea3479dd01de35c73e8c06af2cc5d0a0",
+	"pl_PL": "7712370efaffc558d01fb903e54fd4a82dab9caee8de57c234aa8acf4b06d9bf",
+	"ru_RU": "5db590c279d6778a5ab6005567846679a77a53bce87e8647806d8ec691a8bf8e",
+	"zh_CN": "4fc1079d742fefb738734f480279b1f0ae6ef13526f5823c50207e13f3987a01",
 }
@@ -10,11 +10,19 @@ import (
 )
 
 // Category represents a category in the system.
+//
+// The settings of the category are used by its feeds when they don't define their own.
 type Category struct {
-	ID        int64  `json:"id,omitempty"`
-	Title     string `json:"title,omitempty"`
-	UserID    int64  `json:"user_id,omitempty"`
-	FeedCount int    `json:"nb_feeds,omitempty"`
+	ID                int64  `json:"id,omitempty"`
+	Title             string `json:"title,omitempty"`
+	UserID            int64  `json:"user_id,omitempty"`
+	FeedCount         int    `json:"nb_feeds,omitempty"`
+	ContentExtractors string `json:"content_extractors,omitempty"`
+	FilterMode        string `json:"filter_mode,omitempty"`
+	Crawler           bool   `json:"crawler,omitempty"`
+	UserAgent         string `json:"user_agent,omitempty"`
+	ScraperRules      string `json:"scraper_rules,omitempty"`
+	RewriteRules      string `json:"rewrite_rules,omitempty"`
 }
 
 func (c *Category) String() string {
@@ -6,6 +6,7 @@ package model // import "miniflux.app/model"
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -20,21 +21,60 @@ const (
 
 // Entry represents a feed item in the system.
 type Entry struct {
-	ID          int64         `json:"id"`
-	UserID      int64         `json:"user_id"`
-	FeedID      int64         `json:"feed_id"`
-	Status      string        `json:"status"`
-	Hash        string        `json:"hash"`
-	Title       string        `json:"title"`
-	URL         string        `json:"url"`
-	CommentsURL string        `json:"comments_url"`
-	Date        time.Time     `json:"published_at"`
-	Content     string        `json:"content"`
-	Author      string        `json:"author"`
-	ShareCode   string        `json:"share_code"`
-	Starred     bool          `json:"starred"`
-	Enclosures  EnclosureList `json:"enclosures,omitempty"`
-	Feed        *Feed         `json:"feed,omitempty"`
+	ID             int64               `json:"id"`
+	UserID         int64               `json:"user_id"`
+	FeedID         int64               `json:"feed_id"`
+	Status         string              `json:"status"`
+	Hash           string              `json:"hash"`
+	GUIDHash       string              `json:"-"`
+	IdentityHashes EntryIdentityHashes `json:"-"`
+	Title          string              `json:"title"`
+	URL            string              `json:"url"`
+	CommentsURL    string              `json:"comments_url"`
+	Date           time.Time           `json:"published_at"`
+	DateUnknown    bool                `json:"-"`
+	Content        string              `json:"content"`
+	Author         string              `json:"author"`
+	ShareCode      string              `json:"share_code"`
+	Starred        bool                `json:"starred"`
+	Tags           []string            `json:"tags"`
+	Labels         Labels              `json:"labels"`
+	Highlights     Highlights          `json:"highlights"`
+	ClusterID      int64               `json:"cluster_id,omitempty"`
+	RevisionCount  int                 `json:"revision_count"`
+	Enclosures     EnclosureList       `json:"enclosures,omitempty"`
+	Feed           *Feed               `json:"feed,omitempty"`
+}
+
+// SetDate sets the publication date of the entry, the current time is used when the date is unknown.
+func (e *Entry) SetDate(date time.Time) {
+	e.DateUnknown = date.IsZero()
+	if e.DateUnknown {
+		date = time.Now()
+	}
+
+	e.Date = date
+}
+
+// AddTags appends the tags not already assigned to the entry, the comparison is case-insensitive.
+func (e *Entry) AddTags(tags ...string) {
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(tag), " ")
+		if tag != "" && !e.HasTag(tag) {
+			e.Tags = append(e.Tags, tag)
+		}
+	}
+}
+
+// HasTag returns true if the tag is assigned to the entry.
+func (e *Entry) HasTag(tag string) bool {
+	for _, entryTag := range e.Tags {
+		if strings.EqualFold(entryTag, tag) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // Entries represents a list of entries.
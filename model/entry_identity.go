@@ -0,0 +1,81 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Entry identity strategies, they define how the entries of a feed are recognized when the feed is refreshed.
+const (
+	// EntryIdentityGUID uses the identifier computed by the parser, usually the GUID of the item.
+	EntryIdentityGUID = "guid"
+
+	// EntryIdentityURL uses the URL of the entry.
+	EntryIdentityURL = "url"
+
+	// EntryIdentityCanonicalURL uses the URL of the entry without scheme, "www." prefix, fragment and trailing slash.
+	EntryIdentityCanonicalURL = "canonical_url"
+
+	// EntryIdentityTitleDate uses the title and the publication date of the entry.
+	EntryIdentityTitleDate = "title_date"
+
+	// EntryIdentityContent uses the text of the entry content.
+	EntryIdentityContent = "content"
+)
+
+// EntryIdentities contains the supported entry identity strategies.
+var EntryIdentities = []string{
+	EntryIdentityGUID,
+	EntryIdentityURL,
+	EntryIdentityCanonicalURL,
+	EntryIdentityTitleDate,
+	EntryIdentityContent,
+}
+
+// EntryIdentityHashes contains the hash of an entry for each identity strategy.
+//
+// The hashes are computed when the entry is received, before the content is crawled or rewritten.
+type EntryIdentityHashes map[string]string
+
+// Value converts the hashes to JSON.
+func (h EntryIdentityHashes) Value() (driver.Value, error) {
+	if h == nil {
+		return []byte("{}"), nil
+	}
+
+	j, err := json.Marshal(h)
+	return j, err
+}
+
+// Scan converts raw JSON data.
+func (h *EntryIdentityHashes) Scan(src interface{}) error {
+	if src == nil {
+		return nil
+	}
+
+	source, ok := src.([]byte)
+	if !ok {
+		return errors.New("entry identity hashes: unable to assert type of src")
+	}
+
+	if err := json.Unmarshal(source, h); err != nil {
+		return fmt.Errorf("entry identity hashes: %v", err)
+	}
+
+	return nil
+}
+
+// ValidateEntryIdentity makes sure the entry identity strategy is supported, empty means the default one.
+func ValidateEntryIdentity(identity string) error {
+	if identity != "" && !inStringList(identity, EntryIdentities) {
+		return fmt.Errorf(`Invalid entry identity, valid values are: %v`, EntryIdentities)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateEntryIdentity(t *testing.T) {
+	for _, identity := range append([]string{""}, EntryIdentities...) {
+		if err := ValidateEntryIdentity(identity); err != nil {
+			t.Errorf(`The entry identity %q should be valid: %v`, identity, err)
+		}
+	}
+
+	if err := ValidateEntryIdentity("hash"); err == nil {
+		t.Error(`Unknown entry identities should be rejected`)
+	}
+}
+
+func TestEntryIdentityHashesScan(t *testing.T) {
+	var hashes EntryIdentityHashes
+	if err := hashes.Scan([]byte(`{"url":"a","content":"b"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if hashes[EntryIdentityURL] != "a" || hashes[EntryIdentityContent] != "b" {
+		t.Errorf(`Unexpected hashes: %v`, hashes)
+	}
+
+	if err := hashes.Scan("invalid"); err == nil {
+		t.Error(`Scanning an invalid source should generate an error`)
+	}
+}
+
+func TestEntryIdentityHashesValue(t *testing.T) {
+	var hashes EntryIdentityHashes
+	value, err := hashes.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(value.([]byte)) != "{}" {
+		t.Errorf(`Empty hashes should be stored as an empty object, got %s`, value)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// DefaultEntryRevisionsLimit is the number of revisions kept for each entry unless the user changes it.
+const DefaultEntryRevisionsLimit = 5
+
+// MaxEntryRevisionsLimit is the highest number of revisions that can be kept for each entry.
+const MaxEntryRevisionsLimit = 50
+
+// EntryRevision represents the title and the content of an entry before the publisher changed them.
+type EntryRevision struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	EntryID   int64     `json:"entry_id"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EntryRevisions represents a list of revisions, the most recent first.
+type EntryRevisions []*EntryRevision
@@ -69,3 +69,23 @@ func TestGetOppositeDirection(t *testing.T) {
 		t.Errorf(`An invalid direction should return "asc"`)
 	}
 }
+
+func TestEntryAddTags(t *testing.T) {
+	entry := &Entry{}
+	entry.AddTags("Go", " Open   Source ", "", "go", "Linux")
+
+	expected := []string{"Go", "Open Source", "Linux"}
+	if len(entry.Tags) != len(expected) {
+		t.Fatalf(`Unexpected tags: %v`, entry.Tags)
+	}
+
+	for i := range expected {
+		if entry.Tags[i] != expected[i] {
+			t.Errorf(`Unexpected tag at position %d: %q instead of %q`, i, entry.Tags[i], expected[i])
+		}
+	}
+
+	if !entry.HasTag("LINUX") {
+		t.Error(`The comparison of tags should be case-insensitive`)
+	}
+}
@@ -13,31 +13,36 @@ import (
 
 // Feed represents a feed in the application.
 type Feed struct {
-	ID                 int64     `json:"id"`
-	UserID             int64     `json:"user_id"`
-	FeedURL            string    `json:"feed_url"`
-	SiteURL            string    `json:"site_url"`
-	Title              string    `json:"title"`
-	CheckedAt          time.Time `json:"checked_at"`
-	EtagHeader         string    `json:"etag_header"`
-	LastModifiedHeader string    `json:"last_modified_header"`
-	ParsingErrorMsg    string    `json:"parsing_error_message"`
-	ParsingErrorCount  int       `json:"parsing_error_count"`
-	ScraperRules       string    `json:"scraper_rules"`
-	RewriteRules       string    `json:"rewrite_rules"`
-	TitleFilter        string    `json:"title_filter"`
-	ContentFilter      string    `json:"content_filter"`
-	Crawler            bool      `json:"crawler"`
-	UseMercury         bool      `json:"use_mercury"`
-	UserAgent          string    `json:"user_agent"`
-	Username           string    `json:"username"`
-	Password           string    `json:"password"`
-	Disabled           bool      `json:"disabled"`
-	Category           *Category `json:"category,omitempty"`
-	Entries            Entries   `json:"entries,omitempty"`
-	Icon               *FeedIcon `json:"icon"`
-	UnreadCount        int       `json:"-"`
-	ReadCount          int       `json:"-"`
+	ID                 int64          `json:"id"`
+	UserID             int64          `json:"user_id"`
+	FeedURL            string         `json:"feed_url"`
+	SiteURL            string         `json:"site_url"`
+	Title              string         `json:"title"`
+	CheckedAt          time.Time      `json:"checked_at"`
+	EtagHeader         string         `json:"etag_header"`
+	LastModifiedHeader string         `json:"last_modified_header"`
+	ParsingErrorMsg    string         `json:"parsing_error_message"`
+	ParsingErrorCount  int            `json:"parsing_error_count"`
+	ScraperRules       string         `json:"scraper_rules"`
+	RewriteRules       string         `json:"rewrite_rules"`
+	Crawler            bool           `json:"crawler"`
+	CrawlerOverride    bool           `json:"crawler_override"`
+	UseMercury         bool           `json:"use_mercury"`
+	ContentExtractors  string         `json:"content_extractors"`
+	FilterMode         string         `json:"filter_mode"`
+	EntryIdentity      string         `json:"entry_identity"`
+	SuppressedCount    int64          `json:"suppressed_count"`
+	UserAgent          string         `json:"user_agent"`
+	Username           string         `json:"username"`
+	Password           string         `json:"password"`
+	Disabled           bool           `json:"disabled"`
+	SourceType         string         `json:"source_type"`
+	SourceSettings     SourceSettings `json:"source_settings"`
+	Category           *Category      `json:"category,omitempty"`
+	Entries            Entries        `json:"entries,omitempty"`
+	Icon               *FeedIcon      `json:"icon"`
+	UnreadCount        int            `json:"-"`
+	ReadCount          int            `json:"-"`
 }
 
 func (f *Feed) String() string {
@@ -73,6 +78,95 @@ func (f *Feed) WithBrowsingParameters(crawler bool, userAgent, username, passwor
 	f.RewriteRules = rewriteRules
 }
 
+// WithSource defines how the feed content is obtained.
+func (f *Feed) WithSource(sourceType string, settings SourceSettings) {
+	if sourceType == "" {
+		sourceType = FeedSourceTypeFeed
+	}
+
+	f.SourceType = sourceType
+	f.SourceSettings = settings
+}
+
+// IsWebPage returns true if entries are extracted from a plain web page.
+func (f *Feed) IsWebPage() bool {
+	return f.SourceType == FeedSourceTypeWebPage
+}
+
+// IsPageMonitor returns true if entries are created when the web page changes.
+func (f *Feed) IsPageMonitor() bool {
+	return f.SourceType == FeedSourceTypePageMonitor
+}
+
+// IsLocal returns true if the feed is read from a local file or command.
+func (f *Feed) IsLocal() bool {
+	return IsLocalSourceType(f.SourceType)
+}
+
+// IsNewsletter returns true if entries are received by email instead of being fetched.
+func (f *Feed) IsNewsletter() bool {
+	return f.SourceType == FeedSourceTypeNewsletter
+}
+
+// EffectiveCrawler returns true if the original content is downloaded for the feed or for all the feeds of its category.
+// The setting of the category is ignored when the feed overrides it.
+func (f *Feed) EffectiveCrawler() bool {
+	if f.CrawlerOverride || f.Category == nil {
+		return f.Crawler
+	}
+
+	return f.Crawler || f.Category.Crawler
+}
+
+// EffectiveUserAgent returns the user agent of the feed, or of its category when the feed has none.
+func (f *Feed) EffectiveUserAgent() string {
+	if f.UserAgent == "" && f.Category != nil {
+		return f.Category.UserAgent
+	}
+
+	return f.UserAgent
+}
+
+// EffectiveScraperRules returns the scraper rules of the feed, or of its category when the feed has none.
+func (f *Feed) EffectiveScraperRules() string {
+	if f.ScraperRules == "" && f.Category != nil {
+		return f.Category.ScraperRules
+	}
+
+	return f.ScraperRules
+}
+
+// EffectiveRewriteRules returns the rewrite rules of the feed, or of its category when the feed has none.
+func (f *Feed) EffectiveRewriteRules() string {
+	if f.RewriteRules == "" && f.Category != nil {
+		return f.Category.RewriteRules
+	}
+
+	return f.RewriteRules
+}
+
+// EffectiveContentExtractors returns the content extractors of the feed, or of its category when the feed has none.
+func (f *Feed) EffectiveContentExtractors() string {
+	if f.ContentExtractors == "" && f.Category != nil {
+		return f.Category.ContentExtractors
+	}
+
+	return f.ContentExtractors
+}
+
+// EffectiveFilterMode returns the filter mode of the feed, or of its category when the feed has none.
+func (f *Feed) EffectiveFilterMode() string {
+	if f.FilterMode != "" {
+		return f.FilterMode
+	}
+
+	if f.Category != nil && f.Category.FilterMode != "" {
+		return f.Category.FilterMode
+	}
+
+	return FilterModeBlock
+}
+
 // WithError adds a new error message and increment the error counter.
 func (f *Feed) WithError(message string) {
 	f.ParsingErrorCount++
@@ -94,5 +188,21 @@ func (f *Feed) CheckedNow() {
 	}
 }
 
+// FeedCreationRequest contains the parameters required to subscribe to a new feed.
+type FeedCreationRequest struct {
+	FeedURL        string
+	CategoryID     int64
+	UserAgent      string
+	Username       string
+	Password       string
+	Crawler        bool
+	ScraperRules   string
+	RewriteRules   string
+	SourceType     string
+	SourceSettings SourceSettings
+	FilterMode     string
+	EntryIdentity  string
+}
+
 // Feeds is a list of feed
 type Feeds []*Feed
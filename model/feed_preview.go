@@ -0,0 +1,65 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+// Number of entries used by default and at most to preview the settings of a feed.
+const (
+	DefaultPreviewLimit = 10
+	MaxPreviewLimit     = 50
+)
+
+// FeedPreviewRequest contains the options used to preview the settings of a feed.
+type FeedPreviewRequest struct {
+	// Limit is the number of entries to preview.
+	Limit int
+
+	// Refetch downloads the feed again instead of using the stored entries.
+	Refetch bool
+
+	// Crawl downloads the web page of each entry with the content extractors of the feed.
+	Crawl bool
+}
+
+// EntryLimit returns the number of entries to preview, within the allowed range.
+func (f *FeedPreviewRequest) EntryLimit() int {
+	switch {
+	case f.Limit <= 0:
+		return DefaultPreviewLimit
+	case f.Limit > MaxPreviewLimit:
+		return MaxPreviewLimit
+	default:
+		return f.Limit
+	}
+}
+
+// EntryPreview shows how an entry would look like with the settings of the feed, nothing is saved.
+type EntryPreview struct {
+	EntryID         int64    `json:"entry_id"`
+	URL             string   `json:"url"`
+	OriginalTitle   string   `json:"original_title"`
+	Title           string   `json:"title"`
+	OriginalContent string   `json:"original_content"`
+	Content         string   `json:"content"`
+	ContentDiff     string   `json:"content_diff"`
+	Status          string   `json:"status"`
+	Starred         bool     `json:"starred"`
+	Tags            []string `json:"tags"`
+	MatchedRules    []int64  `json:"matched_rules"`
+	Suppressed      bool     `json:"suppressed"`
+	Error           string   `json:"error,omitempty"`
+}
+
+// IsRemoved returns true if the entry would be removed by the filter rules.
+func (e *EntryPreview) IsRemoved() bool {
+	return e.Status == EntryStatusRemoved
+}
+
+// TitleChanged returns true if the rewrite rules change the title.
+func (e *EntryPreview) TitleChanged() bool {
+	return e.Title != e.OriginalTitle
+}
+
+// EntryPreviews is a list of entry previews.
+type EntryPreviews []*EntryPreview
@@ -0,0 +1,24 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestFeedPreviewRequestEntryLimit(t *testing.T) {
+	scenarios := map[int]int{
+		-1:                  DefaultPreviewLimit,
+		0:                   DefaultPreviewLimit,
+		5:                   5,
+		MaxPreviewLimit:     MaxPreviewLimit,
+		MaxPreviewLimit + 1: MaxPreviewLimit,
+	}
+
+	for limit, expected := range scenarios {
+		request := &FeedPreviewRequest{Limit: limit}
+		if result := request.EntryLimit(); result != expected {
+			t.Errorf(`Unexpected limit for %d: got %d instead of %d`, limit, result, expected)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Feed source types.
+const (
+	FeedSourceTypeFeed        = "feed"
+	FeedSourceTypeWebPage     = "web_page"
+	FeedSourceTypePageMonitor = "page_monitor"
+	FeedSourceTypeFile        = "file"
+	FeedSourceTypeCommand     = "command"
+	FeedSourceTypeNewsletter  = "newsletter"
+)
+
+// SourceSettings contains the settings specific to the feed source type.
+type SourceSettings struct {
+	WebPage     *WebPageRules        `json:"web_page,omitempty"`
+	PageMonitor *PageMonitorSettings `json:"page_monitor,omitempty"`
+	Newsletter  *NewsletterSettings  `json:"newsletter,omitempty"`
+}
+
+// Value converts the source settings to JSON.
+func (s SourceSettings) Value() (driver.Value, error) {
+	j, err := json.Marshal(s)
+	return j, err
+}
+
+// Scan converts raw JSON data.
+func (s *SourceSettings) Scan(src interface{}) error {
+	if src == nil {
+		return nil
+	}
+
+	source, ok := src.([]byte)
+	if !ok {
+		return errors.New("source settings: unable to assert type of src")
+	}
+
+	if err := json.Unmarshal(source, s); err != nil {
+		return fmt.Errorf("source settings: %v", err)
+	}
+
+	return nil
+}
+
+// WebPageRules contains the CSS selectors used to turn a web page into entries.
+//
+// The item selector is applied to the whole page, the other selectors are
+// applied to each matching item.
+type WebPageRules struct {
+	ItemSelector    string `json:"item_selector"`
+	TitleSelector   string `json:"title_selector"`
+	LinkSelector    string `json:"link_selector"`
+	DateSelector    string `json:"date_selector"`
+	SummarySelector string `json:"summary_selector"`
+}
+
+// Validate makes sure the mandatory selectors are defined.
+func (w *WebPageRules) Validate() error {
+	if w == nil || w.ItemSelector == "" {
+		return errors.New("The item selector is mandatory")
+	}
+
+	if w.TitleSelector == "" && w.LinkSelector == "" {
+		return errors.New("A title or a link selector is required")
+	}
+
+	return nil
+}
+
+// PageMonitorSettings defines how a monitored page is compared with its previous snapshot.
+type PageMonitorSettings struct {
+	// Selector narrows the comparison to the matching elements, the whole page is used when empty.
+	Selector string `json:"selector"`
+
+	// Threshold is the minimum percentage of changed words required to create an entry.
+	Threshold int `json:"threshold"`
+}
+
+// Validate makes sure the threshold is a valid percentage.
+func (p *PageMonitorSettings) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	if p.Threshold < 0 || p.Threshold > 100 {
+		return errors.New("The threshold must be between 0 and 100")
+	}
+
+	return nil
+}
+
+// PageSnapshot represents the last known text of a monitored page.
+type PageSnapshot struct {
+	FeedID    int64
+	Content   string
+	CreatedAt time.Time
+}
+
+// ValidateFeedSourceType makes sure the feed source type is valid.
+func ValidateFeedSourceType(sourceType string) error {
+	switch sourceType {
+	case "", FeedSourceTypeFeed, FeedSourceTypeWebPage, FeedSourceTypePageMonitor, FeedSourceTypeFile, FeedSourceTypeCommand:
+		return nil
+	}
+
+	return fmt.Errorf(
+		`Invalid source type, valid values are: "%s", "%s", "%s", "%s" and "%s"`,
+		FeedSourceTypeFeed,
+		FeedSourceTypeWebPage,
+		FeedSourceTypePageMonitor,
+		FeedSourceTypeFile,
+		FeedSourceTypeCommand,
+	)
+}
+
+// IsLocalSourceType returns true if the feed is read from the local filesystem instead of the network.
+func IsLocalSourceType(sourceType string) bool {
+	return sourceType == FeedSourceTypeFile || sourceType == FeedSourceTypeCommand
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateWebPageRules(t *testing.T) {
+	scenarios := map[*WebPageRules]bool{
+		nil:                                    false,
+		&WebPageRules{}:                        false,
+		&WebPageRules{ItemSelector: "article"}: false,
+		&WebPageRules{TitleSelector: "h2"}:     false,
+		&WebPageRules{ItemSelector: "article", TitleSelector: "h2"}: true,
+		&WebPageRules{ItemSelector: "article", LinkSelector: "a"}:   true,
+	}
+
+	for rules, valid := range scenarios {
+		if err := rules.Validate(); (err == nil) != valid {
+			t.Errorf(`Unexpected validation result for %+v: %v`, rules, err)
+		}
+	}
+}
+
+func TestValidateFeedSourceType(t *testing.T) {
+	for _, sourceType := range []string{"", FeedSourceTypeFeed, FeedSourceTypeWebPage} {
+		if err := ValidateFeedSourceType(sourceType); err != nil {
+			t.Errorf(`The source type %q should be valid`, sourceType)
+		}
+	}
+
+	if err := ValidateFeedSourceType("unknown"); err == nil {
+		t.Error(`An invalid source type should generate an error`)
+	}
+}
+
+func TestSourceSettingsScan(t *testing.T) {
+	var settings SourceSettings
+	if err := settings.Scan([]byte(`{"web_page":{"item_selector":"li","title_selector":"h2"}}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if settings.WebPage == nil || settings.WebPage.ItemSelector != "li" || settings.WebPage.TitleSelector != "h2" {
+		t.Errorf(`Unexpected settings: %+v`, settings.WebPage)
+	}
+
+	if err := settings.Scan("invalid"); err == nil {
+		t.Error(`Scanning an invalid source should generate an error`)
+	}
+}
+
+func TestValidatePageMonitorSettings(t *testing.T) {
+	var settings *PageMonitorSettings
+	if err := settings.Validate(); err != nil {
+		t.Errorf(`Settings should be optional: %v`, err)
+	}
+
+	settings = &PageMonitorSettings{Threshold: 101}
+	if err := settings.Validate(); err == nil {
+		t.Error(`A threshold above 100 should be rejected`)
+	}
+
+	settings = &PageMonitorSettings{Selector: "#content", Threshold: 10}
+	if err := settings.Validate(); err != nil {
+		t.Errorf(`Valid settings should be accepted: %v`, err)
+	}
+}
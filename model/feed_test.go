@@ -107,3 +107,62 @@ func TestFeedCheckedNow(t *testing.T) {
 		t.Error(`The checked date must be set`)
 	}
 }
+
+func TestFeedEffectiveFilterMode(t *testing.T) {
+	feed := &Feed{}
+	if mode := feed.EffectiveFilterMode(); mode != FilterModeBlock {
+		t.Errorf(`The default filter mode should be %q, got %q`, FilterModeBlock, mode)
+	}
+
+	feed.WithCategoryID(1)
+	feed.Category.FilterMode = FilterModeAllowlist
+	if mode := feed.EffectiveFilterMode(); mode != FilterModeAllowlist {
+		t.Errorf(`The filter mode of the category should be used, got %q`, mode)
+	}
+
+	feed.FilterMode = FilterModeBlock
+	if mode := feed.EffectiveFilterMode(); mode != FilterModeBlock {
+		t.Errorf(`The filter mode of the feed should take precedence, got %q`, mode)
+	}
+}
+
+func TestFeedEffectiveSettings(t *testing.T) {
+	feed := &Feed{}
+	if feed.EffectiveCrawler() || feed.EffectiveUserAgent() != "" || feed.EffectiveScraperRules() != "" || feed.EffectiveRewriteRules() != "" {
+		t.Error(`A feed without category should not have any default setting`)
+	}
+
+	feed.Category = &Category{Crawler: true, UserAgent: "Category UA", ScraperRules: "article", RewriteRules: "nl2br", ContentExtractors: "readability"}
+	if !feed.EffectiveCrawler() {
+		t.Error(`The crawler of the category should be used`)
+	}
+
+	if feed.EffectiveUserAgent() != "Category UA" || feed.EffectiveScraperRules() != "article" || feed.EffectiveRewriteRules() != "nl2br" || feed.EffectiveContentExtractors() != "readability" {
+		t.Error(`The settings of the category should be used`)
+	}
+
+	feed.UserAgent = "Feed UA"
+	feed.ScraperRules = "main"
+	feed.RewriteRules = "add_image_title"
+	feed.ContentExtractors = "scraper"
+	if feed.EffectiveUserAgent() != "Feed UA" || feed.EffectiveScraperRules() != "main" || feed.EffectiveRewriteRules() != "add_image_title" || feed.EffectiveContentExtractors() != "scraper" {
+		t.Error(`The settings of the feed should take precedence`)
+	}
+}
+
+func TestFeedCrawlerOverride(t *testing.T) {
+	feed := &Feed{Category: &Category{Crawler: true}}
+	if !feed.EffectiveCrawler() {
+		t.Error(`The crawler of the category should be used`)
+	}
+
+	feed.CrawlerOverride = true
+	if feed.EffectiveCrawler() {
+		t.Error(`The feed should be able to disable the crawler of its category`)
+	}
+
+	feed.Crawler = true
+	if !feed.EffectiveCrawler() {
+		t.Error(`The crawler of the feed should be used`)
+	}
+}
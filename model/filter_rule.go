@@ -0,0 +1,172 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Filter rule fields.
+const (
+	FilterFieldTitle   = "title"
+	FilterFieldContent = "content"
+	FilterFieldAuthor  = "author"
+	FilterFieldURL     = "url"
+	FilterFieldTags    = "tags"
+	FilterFieldDate    = "date"
+)
+
+// Filter rule operators, the text operators are case-insensitive.
+const (
+	FilterOperatorContains    = "contains"
+	FilterOperatorNotContains = "not_contains"
+	FilterOperatorEquals      = "equals"
+	FilterOperatorMatches     = "matches"
+	FilterOperatorNotMatches  = "not_matches"
+	FilterOperatorOlderThan   = "older_than"
+	FilterOperatorNewerThan   = "newer_than"
+)
+
+// Filter rule actions.
+const (
+	FilterActionRemove      = "remove"
+	FilterActionMarkAsRead  = "read"
+	FilterActionStar        = "star"
+	FilterActionTag         = "tag"
+	FilterActionIntegration = "integration"
+	FilterActionKeep        = "keep"
+)
+
+// Filter modes of feeds and categories.
+const (
+	// FilterModeBlock keeps all the entries except the ones removed by the rules.
+	FilterModeBlock = "block"
+
+	// FilterModeAllowlist removes all the entries not matching a rule with the action "keep".
+	FilterModeAllowlist = "allowlist"
+)
+
+// FilterFields contains the fields supported by the filter rules.
+var FilterFields = []string{
+	FilterFieldTitle,
+	FilterFieldContent,
+	FilterFieldAuthor,
+	FilterFieldURL,
+	FilterFieldTags,
+	FilterFieldDate,
+}
+
+// FilterOperators contains the operators supported by the filter rules.
+var FilterOperators = []string{
+	FilterOperatorContains,
+	FilterOperatorNotContains,
+	FilterOperatorEquals,
+	FilterOperatorMatches,
+	FilterOperatorNotMatches,
+	FilterOperatorOlderThan,
+	FilterOperatorNewerThan,
+}
+
+// FilterActions contains the actions supported by the filter rules.
+var FilterActions = []string{
+	FilterActionRemove,
+	FilterActionMarkAsRead,
+	FilterActionStar,
+	FilterActionTag,
+	FilterActionIntegration,
+	FilterActionKeep,
+}
+
+// FilterRule represents a condition evaluated on new entries and the action applied when it matches.
+//
+// A rule without category and feed applies to all the feeds of the user.
+type FilterRule struct {
+	ID          int64      `json:"id"`
+	UserID      int64      `json:"user_id"`
+	CategoryID  int64      `json:"category_id"`
+	FeedID      int64      `json:"feed_id"`
+	Field       string     `json:"field"`
+	Operator    string     `json:"operator"`
+	Value       string     `json:"value"`
+	Action      string     `json:"action"`
+	ActionValue string     `json:"action_value"`
+	ErrorMsg    string     `json:"error_message"`
+	HitCount    int64      `json:"hit_count"`
+	LastHitAt   *time.Time `json:"last_hit_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+func (f *FilterRule) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Condition=%s %s %q, Action=%s", f.ID, f.UserID, f.Field, f.Operator, f.Value, f.Action)
+}
+
+// Scope returns the kind of scope of the rule: "feed", "category" or "user".
+func (f *FilterRule) Scope() string {
+	switch {
+	case f.FeedID > 0:
+		return "feed"
+	case f.CategoryID > 0:
+		return "category"
+	default:
+		return "user"
+	}
+}
+
+// ValidateFilterRule makes sure the field, the operator and the action of the rule are consistent.
+//
+// The value itself is validated by the filter package.
+func (f FilterRule) ValidateFilterRule() error {
+	if !inStringList(f.Field, FilterFields) {
+		return fmt.Errorf(`Invalid filter field, valid values are: %v`, FilterFields)
+	}
+
+	if !inStringList(f.Operator, FilterOperators) {
+		return fmt.Errorf(`Invalid filter operator, valid values are: %v`, FilterOperators)
+	}
+
+	isDateOperator := f.Operator == FilterOperatorOlderThan || f.Operator == FilterOperatorNewerThan
+	if (f.Field == FilterFieldDate) != isDateOperator {
+		return errors.New(`The operators "older_than" and "newer_than" are only valid with the field "date"`)
+	}
+
+	if !inStringList(f.Action, FilterActions) {
+		return fmt.Errorf(`Invalid filter action, valid values are: %v`, FilterActions)
+	}
+
+	if f.Action == FilterActionTag && f.ActionValue == "" {
+		return errors.New(`The tag is mandatory for the action "tag"`)
+	}
+
+	if f.CategoryID > 0 && f.FeedID > 0 {
+		return errors.New(`A filter rule cannot be scoped to a category and a feed`)
+	}
+
+	return nil
+}
+
+// ValidateFilterMode makes sure the filter mode is valid, an empty mode means the default mode.
+func ValidateFilterMode(mode string) error {
+	switch mode {
+	case "", FilterModeBlock, FilterModeAllowlist:
+		return nil
+	}
+
+	return fmt.Errorf(`Invalid filter mode, valid values are: "%s" and "%s"`, FilterModeBlock, FilterModeAllowlist)
+}
+
+// FilterRules represents a list of filter rules.
+type FilterRules []*FilterRule
+
+func inStringList(needle string, haystack []string) bool {
+	for _, element := range haystack {
+		if element == needle {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,15 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// GeminiCertificate represents the certificate of a Gemini host, trusted on first use.
+type GeminiCertificate struct {
+	Host        string
+	Fingerprint string
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
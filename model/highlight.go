@@ -0,0 +1,56 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Highlight represents a passage of an entry selected by the user, with an optional note.
+//
+// The passage is located with the text around it, like a text quote selector of the Web Annotation model.
+type Highlight struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	EntryID   int64     `json:"entry_id"`
+	Quote     string    `json:"quote"`
+	Prefix    string    `json:"prefix"`
+	Suffix    string    `json:"suffix"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// These fields are only set when the highlights are listed without their entry.
+	EntryTitle string `json:"entry_title,omitempty"`
+	EntryURL   string `json:"entry_url,omitempty"`
+	FeedID     int64  `json:"feed_id,omitempty"`
+	FeedTitle  string `json:"feed_title,omitempty"`
+}
+
+func (h *Highlight) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, EntryID=%d", h.ID, h.UserID, h.EntryID)
+}
+
+// ValidateHighlight makes sure the highlight can be saved.
+func (h Highlight) ValidateHighlight() error {
+	if strings.TrimSpace(h.Quote) == "" {
+		return errors.New("The quote is mandatory")
+	}
+
+	if h.UserID == 0 {
+		return errors.New("The userID is mandatory")
+	}
+
+	if h.EntryID == 0 {
+		return errors.New("The entryID is mandatory")
+	}
+
+	return nil
+}
+
+// Highlights represents a list of highlights.
+type Highlights []*Highlight
@@ -0,0 +1,31 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateHighlight(t *testing.T) {
+	scenarios := []struct {
+		highlight Highlight
+		valid     bool
+	}{
+		{Highlight{UserID: 1, EntryID: 2, Quote: "passage"}, true},
+		{Highlight{UserID: 1, EntryID: 2, Quote: "passage", Note: "note"}, true},
+		{Highlight{UserID: 1, EntryID: 2, Quote: " \n "}, false},
+		{Highlight{EntryID: 2, Quote: "passage"}, false},
+		{Highlight{UserID: 1, Quote: "passage"}, false},
+	}
+
+	for i, scenario := range scenarios {
+		err := scenario.highlight.ValidateHighlight()
+		if scenario.valid && err != nil {
+			t.Errorf(`Scenario #%d should be valid: %v`, i, err)
+		}
+
+		if !scenario.valid && err == nil {
+			t.Errorf(`Scenario #%d should be invalid`, i)
+		}
+	}
+}
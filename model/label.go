@@ -0,0 +1,48 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Label represents a user-defined label attached to entries.
+type Label struct {
+	ID         int64  `json:"id"`
+	UserID     int64  `json:"user_id"`
+	Title      string `json:"title"`
+	EntryCount int    `json:"entry_count,omitempty"`
+}
+
+func (l *Label) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Title=%s", l.ID, l.UserID, l.Title)
+}
+
+// ValidateLabel makes sure the label can be saved.
+func (l Label) ValidateLabel() error {
+	if l.Title == "" {
+		return errors.New("The title is mandatory")
+	}
+
+	if l.UserID == 0 {
+		return errors.New("The userID is mandatory")
+	}
+
+	return nil
+}
+
+// Labels represents a list of labels.
+type Labels []*Label
+
+// Titles returns the title of each label.
+func (l Labels) Titles() []string {
+	titles := make([]string, 0, len(l))
+	for _, label := range l {
+		titles = append(titles, label.Title)
+	}
+
+	return titles
+}
@@ -0,0 +1,35 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateLabel(t *testing.T) {
+	if err := (Label{UserID: 1}).ValidateLabel(); err == nil {
+		t.Error(`A label without title should be rejected`)
+	}
+
+	if err := (Label{Title: "Later"}).ValidateLabel(); err == nil {
+		t.Error(`A label without user should be rejected`)
+	}
+
+	if err := (Label{UserID: 1, Title: "Later"}).ValidateLabel(); err != nil {
+		t.Errorf(`A valid label should be accepted: %v`, err)
+	}
+}
+
+func TestLabelTitles(t *testing.T) {
+	labels := Labels{{ID: 1, Title: "Later"}, {ID: 2, Title: "Work"}}
+	if titles := labels.Titles(); !reflect.DeepEqual(titles, []string{"Later", "Work"}) {
+		t.Errorf(`Unexpected titles: %v`, titles)
+	}
+
+	if titles := (Labels{}).Titles(); len(titles) != 0 {
+		t.Errorf(`An empty list should not have any title: %v`, titles)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NewsletterSettings identifies the sender of the messages delivered to a newsletter feed.
+type NewsletterSettings struct {
+	Sender string `json:"sender"`
+}
+
+// NewsletterImage represents an inline image received with a newsletter.
+type NewsletterImage struct {
+	ID        int64
+	UserID    int64
+	EntryID   int64
+	Token     string
+	MimeType  string
+	Content   []byte
+	CreatedAt time.Time
+}
+
+// NewsletterAddress returns the email address of a user, messages sent to a feed address are delivered to this feed.
+func NewsletterAddress(token, domain string, feedID int64) string {
+	if feedID > 0 {
+		return fmt.Sprintf("%s+%d@%s", token, feedID, domain)
+	}
+
+	return token + "@" + domain
+}
+
+// ParseNewsletterAddress returns the user token and the optional feed ID of a newsletter address.
+func ParseNewsletterAddress(address string) (token string, feedID int64, err error) {
+	index := strings.LastIndex(address, "@")
+	if index <= 0 {
+		return "", 0, fmt.Errorf("invalid newsletter address: %q", address)
+	}
+
+	token = strings.ToLower(address[:index])
+	if plus := strings.Index(token, "+"); plus >= 0 {
+		feedID, err = strconv.ParseInt(token[plus+1:], 10, 64)
+		if err != nil || feedID <= 0 {
+			return "", 0, fmt.Errorf("invalid newsletter address: %q", address)
+		}
+		token = token[:plus]
+	}
+
+	if token == "" {
+		return "", 0, fmt.Errorf("invalid newsletter address: %q", address)
+	}
+
+	return token, feedID, nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestNewsletterAddress(t *testing.T) {
+	if address := NewsletterAddress("abc123", "example.org", 0); address != "abc123@example.org" {
+		t.Errorf(`Unexpected address: %q`, address)
+	}
+
+	if address := NewsletterAddress("abc123", "example.org", 42); address != "abc123+42@example.org" {
+		t.Errorf(`Unexpected address: %q`, address)
+	}
+}
+
+func TestParseNewsletterAddress(t *testing.T) {
+	token, feedID, err := ParseNewsletterAddress("ABC123+42@example.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if token != "abc123" || feedID != 42 {
+		t.Errorf(`Unexpected result: %q, %d`, token, feedID)
+	}
+
+	token, feedID, err = ParseNewsletterAddress("abc123@example.org")
+	if err != nil || token != "abc123" || feedID != 0 {
+		t.Errorf(`Unexpected result: %q, %d, %v`, token, feedID, err)
+	}
+
+	for _, address := range []string{"", "example.org", "@example.org", "abc123+x@example.org", "+42@example.org"} {
+		if _, _, err := ParseNewsletterAddress(address); err == nil {
+			t.Errorf(`The address %q should be rejected`, address)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// SmartFolder represents a saved search shown like a category.
+//
+// An entry belongs to the folder when it matches every criterion that is set.
+// Among the feeds, categories and labels of a criterion, one match is enough.
+type SmartFolder struct {
+	ID              int64      `json:"id"`
+	UserID          int64      `json:"user_id"`
+	Title           string     `json:"title"`
+	SearchQuery     string     `json:"search_query"`
+	FeedIDs         []int64    `json:"feed_ids"`
+	CategoryIDs     []int64    `json:"category_ids"`
+	LabelIDs        []int64    `json:"label_ids"`
+	Status          string     `json:"status"`
+	PublishedAfter  *time.Time `json:"published_after"`
+	PublishedBefore *time.Time `json:"published_before"`
+	UnreadCount     int        `json:"unread_count"`
+}
+
+func (s *SmartFolder) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Title=%s", s.ID, s.UserID, s.Title)
+}
+
+// ValidateSmartFolder makes sure the smart folder can be saved.
+func (s SmartFolder) ValidateSmartFolder() error {
+	if s.Title == "" {
+		return errors.New("The title is mandatory")
+	}
+
+	if s.UserID == 0 {
+		return errors.New("The userID is mandatory")
+	}
+
+	switch s.Status {
+	case "", EntryStatusUnread, EntryStatusRead:
+	default:
+		return fmt.Errorf(`Invalid status, valid status values are: "", "%s" and "%s"`, EntryStatusUnread, EntryStatusRead)
+	}
+
+	if s.PublishedAfter != nil && s.PublishedBefore != nil && !s.PublishedAfter.Before(*s.PublishedBefore) {
+		return errors.New("The start of the date range must be before its end")
+	}
+
+	return nil
+}
+
+// SmartFolders represents a list of smart folders.
+type SmartFolders []*SmartFolder
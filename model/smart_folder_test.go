@@ -0,0 +1,40 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateSmartFolder(t *testing.T) {
+	now := time.Now()
+	yesterday := now.AddDate(0, 0, -1)
+
+	scenarios := []struct {
+		folder SmartFolder
+		valid  bool
+	}{
+		{SmartFolder{UserID: 1, Title: "News"}, true},
+		{SmartFolder{UserID: 1}, false},
+		{SmartFolder{Title: "News"}, false},
+		{SmartFolder{UserID: 1, Title: "News", Status: EntryStatusUnread}, true},
+		{SmartFolder{UserID: 1, Title: "News", Status: EntryStatusRemoved}, false},
+		{SmartFolder{UserID: 1, Title: "News", PublishedAfter: &yesterday, PublishedBefore: &now}, true},
+		{SmartFolder{UserID: 1, Title: "News", PublishedAfter: &now, PublishedBefore: &yesterday}, false},
+		{SmartFolder{UserID: 1, Title: "News", PublishedAfter: &now}, true},
+	}
+
+	for i, scenario := range scenarios {
+		err := scenario.folder.ValidateSmartFolder()
+		if scenario.valid && err != nil {
+			t.Errorf(`Scenario #%d should be valid: %v`, i, err)
+		}
+
+		if !scenario.valid && err == nil {
+			t.Errorf(`Scenario #%d should be invalid`, i)
+		}
+	}
+}
@@ -73,7 +73,7 @@ type atom03Entry struct {
 func (a *atom03Entry) Transform() *model.Entry {
 	entry := new(model.Entry)
 	entry.URL = a.Links.originalLink()
-	entry.Date = a.entryDate()
+	entry.SetDate(a.entryDate())
 	entry.Author = a.Author.String()
 	entry.Hash = a.entryHash()
 	entry.Content = a.entryContent()
@@ -112,13 +112,13 @@ func (a *atom03Entry) entryDate() time.Time {
 		result, err := date.Parse(dateText)
 		if err != nil {
 			logger.Error("atom: %v", err)
-			return time.Now()
+			return time.Time{}
 		}
 
 		return result
 	}
 
-	return time.Now()
+	return time.Time{}
 }
 
 func (a *atom03Entry) entryHash() string {
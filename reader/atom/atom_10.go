@@ -64,30 +64,49 @@ func (a *atom10Feed) Transform() *model.Feed {
 }
 
 type atom10Entry struct {
-	ID        string     `xml:"id"`
-	Title     atom10Text `xml:"title"`
-	Published string     `xml:"published"`
-	Updated   string     `xml:"updated"`
-	Links     atomLinks  `xml:"link"`
-	Summary   atom10Text `xml:"summary"`
-	Content   atom10Text `xml:"http://www.w3.org/2005/Atom content"`
-	Author    atomPerson `xml:"author"`
+	ID         string           `xml:"id"`
+	Title      atom10Text       `xml:"title"`
+	Published  string           `xml:"published"`
+	Updated    string           `xml:"updated"`
+	Links      atomLinks        `xml:"link"`
+	Summary    atom10Text       `xml:"summary"`
+	Content    atom10Text       `xml:"http://www.w3.org/2005/Atom content"`
+	Author     atomPerson       `xml:"author"`
+	Categories []atom10Category `xml:"category"`
 	media.Element
 }
 
+type atom10Category struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
+}
+
 func (a *atom10Entry) Transform() *model.Entry {
 	entry := new(model.Entry)
 	entry.URL = a.Links.originalLink()
-	entry.Date = a.entryDate()
+	entry.SetDate(a.entryDate())
 	entry.Author = a.Author.String()
 	entry.Hash = a.entryHash()
 	entry.Content = a.entryContent()
 	entry.Title = a.entryTitle()
 	entry.Enclosures = a.entryEnclosures()
 	entry.CommentsURL = a.entryCommentsURL()
+	entry.AddTags(a.entryTags()...)
 	return entry
 }
 
+func (a *atom10Entry) entryTags() []string {
+	var tags []string
+	for _, category := range a.Categories {
+		if category.Label != "" {
+			tags = append(tags, category.Label)
+		} else {
+			tags = append(tags, category.Term)
+		}
+	}
+	return tags
+}
+
 func (a *atom10Entry) entryTitle() string {
 	return sanitizer.StripTags(a.Title.String())
 }
@@ -125,13 +144,13 @@ func (a *atom10Entry) entryDate() time.Time {
 		result, err := date.Parse(dateText)
 		if err != nil {
 			logger.Error("atom: %v", err)
-			return time.Now()
+			return time.Time{}
 		}
 
 		return result
 	}
 
-	return time.Now()
+	return time.Time{}
 }
 
 func (a *atom10Entry) entryHash() string {
@@ -937,3 +937,29 @@ func TestAbsoluteCommentsURL(t *testing.T) {
 		t.Errorf("Incorrect entry comments URL, got: %s", feed.Entries[0].CommentsURL)
 	}
 }
+
+func TestParseEntryWithCategories(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom">
+		<title>Example Feed</title>
+		<link href="http://example.org/"/>
+		<entry>
+			<title>Test</title>
+			<link href="http://example.org/test"/>
+			<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+			<updated>2003-12-13T18:30:02Z</updated>
+			<category term="go" label="Go"/>
+			<category term="linux"/>
+		</entry>
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := feed.Entries[0].Tags
+	if len(tags) != 2 || tags[0] != "Go" || tags[1] != "linux" {
+		t.Errorf("Incorrect entry tags, got: %v", tags)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package diff // import "miniflux.app/reader/diff"
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// Operation types.
+const (
+	Equal = iota
+	Insert
+	Delete
+)
+
+// Above this number of comparisons, the changed region is reported as entirely replaced.
+const maxComparisons = 4 * 1024 * 1024
+
+const lineBreak = "\n"
+
+// Change represents a word that has been kept, added or removed.
+type Change struct {
+	Operation int
+	Text      string
+}
+
+// Changes is a list of changes.
+type Changes []Change
+
+// Words returns the list of word changes required to go from the previous text to the current one.
+func Words(previous, current string) Changes {
+	a := tokenize(previous)
+	b := tokenize(current)
+
+	var prefix, suffix Changes
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		prefix = append(prefix, Change{Equal, a[0]})
+		a, b = a[1:], b[1:]
+	}
+
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		suffix = append(Changes{{Equal, a[len(a)-1]}}, suffix...)
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+
+	changes := prefix
+	changes = append(changes, compare(a, b)...)
+	return append(changes, suffix...)
+}
+
+// HasChanges returns true if at least one word has been added or removed.
+func (c Changes) HasChanges() bool {
+	for _, change := range c {
+		if change.Operation != Equal {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ratio returns the percentage of words that have been added or removed.
+func (c Changes) Ratio() int {
+	var total, changed int
+	for _, change := range c {
+		if change.Text == lineBreak {
+			continue
+		}
+
+		total++
+		if change.Operation != Equal {
+			changed++
+		}
+	}
+
+	if total == 0 {
+		return 0
+	}
+
+	return changed * 100 / total
+}
+
+// HTML renders the changes with <ins> and <del> elements.
+func (c Changes) HTML() string {
+	var builder strings.Builder
+	current := Equal
+	needSpace := false
+
+	for _, change := range c {
+		if change.Operation != current {
+			closeTag(&builder, current)
+			if needSpace && change.Text != lineBreak {
+				builder.WriteString(" ")
+			}
+			needSpace = false
+			openTag(&builder, change.Operation)
+			current = change.Operation
+		}
+
+		if change.Text == lineBreak {
+			builder.WriteString("<br>")
+			needSpace = false
+			continue
+		}
+
+		if needSpace {
+			builder.WriteString(" ")
+		}
+
+		builder.WriteString(html.EscapeString(change.Text))
+		needSpace = true
+	}
+
+	closeTag(&builder, current)
+	return builder.String()
+}
+
+func openTag(builder *strings.Builder, operation int) {
+	switch operation {
+	case Insert:
+		builder.WriteString("<ins>")
+	case Delete:
+		builder.WriteString("<del>")
+	}
+}
+
+func closeTag(builder *strings.Builder, operation int) {
+	switch operation {
+	case Insert:
+		builder.WriteString("</ins>")
+	case Delete:
+		builder.WriteString("</del>")
+	}
+}
+
+// tokenize splits the text in words, line breaks are kept as separate tokens.
+func tokenize(text string) []string {
+	var tokens []string
+	for i, line := range strings.Split(text, lineBreak) {
+		if i > 0 {
+			tokens = append(tokens, lineBreak)
+		}
+
+		tokens = append(tokens, strings.FieldsFunc(line, unicode.IsSpace)...)
+	}
+
+	return tokens
+}
+
+// compare computes the longest common subsequence of both lists of words.
+func compare(a, b []string) Changes {
+	var changes Changes
+
+	if len(a)*len(b) > maxComparisons {
+		for _, word := range a {
+			changes = append(changes, Change{Delete, word})
+		}
+
+		for _, word := range b {
+			changes = append(changes, Change{Insert, word})
+		}
+
+		return changes
+	}
+
+	// lengths[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lengths := make([][]int32, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int32, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			changes = append(changes, Change{Equal, a[i]})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			changes = append(changes, Change{Delete, a[i]})
+			i++
+		default:
+			changes = append(changes, Change{Insert, b[j]})
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		changes = append(changes, Change{Delete, a[i]})
+	}
+
+	for ; j < len(b); j++ {
+		changes = append(changes, Change{Insert, b[j]})
+	}
+
+	return changes
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package diff // import "miniflux.app/reader/diff"
+
+import "testing"
+
+func TestWordsWithoutChanges(t *testing.T) {
+	changes := Words("All systems  operational", "All systems operational")
+	if changes.HasChanges() {
+		t.Errorf(`Whitespace differences should be ignored: %v`, changes)
+	}
+
+	if changes.Ratio() != 0 {
+		t.Errorf(`Unexpected ratio: %d`, changes.Ratio())
+	}
+}
+
+func TestWordsHTML(t *testing.T) {
+	changes := Words("API is operational\nWeb is operational", "API is degraded\nWeb is operational")
+
+	expected := `API is <del>operational</del> <ins>degraded</ins><br>Web is operational`
+	if result := changes.HTML(); result != expected {
+		t.Errorf(`Unexpected HTML: got %q instead of %q`, result, expected)
+	}
+}
+
+func TestWordsHTMLEscaping(t *testing.T) {
+	changes := Words("", "<script>alert(1)</script>")
+
+	expected := `<ins>&lt;script&gt;alert(1)&lt;/script&gt;</ins>`
+	if result := changes.HTML(); result != expected {
+		t.Errorf(`Unexpected HTML: got %q instead of %q`, result, expected)
+	}
+}
+
+func TestWordsRatio(t *testing.T) {
+	changes := Words("one two three four five six seven eight nine ten", "one two three four five six seven eight nine eleven")
+
+	// 10 kept or removed words plus one added word.
+	if ratio := changes.Ratio(); ratio != 18 {
+		t.Errorf(`Unexpected ratio: %d`, ratio)
+	}
+}
+
+func TestWordsAddedAndRemovedLines(t *testing.T) {
+	changes := Words("first\nsecond\nthird", "first\nthird\nfourth")
+
+	expected := `first<br><del>second<br></del>third<ins><br>fourth</ins>`
+	if result := changes.HTML(); result != expected {
+		t.Errorf(`Unexpected HTML: got %q instead of %q`, result, expected)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package extractor // import "miniflux.app/reader/extractor"
+
+import (
+	"bytes"
+	"errors"
+
+	"miniflux.app/reader/date"
+	"miniflux.app/reader/mercury"
+	"miniflux.app/reader/readability"
+	"miniflux.app/reader/scraper"
+)
+
+// Names of the built-in extractors.
+const (
+	Readability = "readability"
+	Scraper     = "scraper"
+	Mercury     = "mercury"
+)
+
+func init() {
+	Register(&readabilityExtractor{})
+	Register(&scraperExtractor{})
+	Register(&mercuryExtractor{})
+}
+
+// readabilityExtractor finds the main content of the page with the Readability algorithm.
+type readabilityExtractor struct{}
+
+func (e *readabilityExtractor) Name() string {
+	return Readability
+}
+
+func (e *readabilityExtractor) Extract(request *Request) (*readability.Article, error) {
+	effectiveURL, page, err := request.Page()
+	if err != nil {
+		return nil, err
+	}
+
+	return readability.Extract(effectiveURL, bytes.NewReader(page))
+}
+
+// scraperExtractor keeps the elements matching the scraper rules of the feed or the predefined rules of the website.
+type scraperExtractor struct{}
+
+func (e *scraperExtractor) Name() string {
+	return Scraper
+}
+
+func (e *scraperExtractor) Extract(request *Request) (*readability.Article, error) {
+	effectiveURL, page, err := request.Page()
+	if err != nil {
+		return nil, err
+	}
+
+	return scraper.ExtractWithRules(effectiveURL, page, request.ScraperRules, request.UserAgent)
+}
+
+// mercuryExtractor delegates the extraction to a Mercury/Postlight compatible parser API.
+type mercuryExtractor struct{}
+
+func (e *mercuryExtractor) Name() string {
+	return Mercury
+}
+
+func (e *mercuryExtractor) Extract(request *Request) (*readability.Article, error) {
+	if request.MercuryAPIURL == "" {
+		return nil, errors.New("the Mercury API URL is not defined in the settings")
+	}
+
+	response, err := mercury.Fetch(request.URL, request.MercuryAPIURL)
+	if err != nil {
+		return nil, err
+	}
+
+	article := &readability.Article{
+		Title:    response.Title,
+		Byline:   response.Author,
+		Excerpt:  response.Excerpt,
+		ImageURL: response.LeadImageURL,
+		Content:  response.Content,
+	}
+
+	if response.PubDate != "" {
+		if publishedAt, err := date.Parse(response.PubDate); err == nil {
+			article.PublishedAt = publishedAt
+		}
+	}
+
+	return article, nil
+}
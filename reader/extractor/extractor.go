@@ -0,0 +1,239 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package extractor // import "miniflux.app/reader/extractor"
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/logger"
+	"miniflux.app/reader/readability"
+	"miniflux.app/reader/scraper"
+)
+
+var errNoContent = errors.New("no content found")
+
+// ContentExtractor downloads the original content of an entry.
+type ContentExtractor interface {
+	// Name identifies the extractor in the chains defined by the users.
+	Name() string
+
+	// Extract returns the article found at the URL of the request.
+	Extract(request *Request) (*readability.Article, error)
+}
+
+// Request contains the entry URL and the feed settings used by the extractors.
+type Request struct {
+	URL           string
+	UserAgent     string
+	ScraperRules  string
+	MercuryAPIURL string
+
+	mutex        sync.Mutex
+	effectiveURL string
+	page         []byte
+}
+
+// Page downloads the web page of the request, the page is shared by the extractors of the chain.
+func (r *Request) Page() (string, []byte, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.page == nil {
+		effectiveURL, page, err := scraper.Download(r.URL, r.UserAgent)
+		if err != nil {
+			return "", nil, err
+		}
+
+		r.effectiveURL = effectiveURL
+		r.page = page
+	}
+
+	return r.effectiveURL, r.page, nil
+}
+
+var (
+	registryMutex sync.RWMutex
+	registry      = make(map[string]ContentExtractor)
+)
+
+// Register makes an extractor available to the chains, an extractor with the same name is replaced.
+func Register(extractor ContentExtractor) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	registry[extractor.Name()] = extractor
+}
+
+// Get returns the extractor registered with the given name.
+func Get(name string) (ContentExtractor, bool) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	extractor, found := registry[name]
+	return extractor, found
+}
+
+// Names returns the sorted names of the registered extractors.
+func Names() []string {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	var names []string
+	for name := range registry {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// Step is an extractor of a chain with its time limit.
+type Step struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (s Step) String() string {
+	if s.Timeout > 0 {
+		return fmt.Sprintf("%s:%d", s.Name, int(s.Timeout/time.Second))
+	}
+	return s.Name
+}
+
+// Chain is the ordered list of extractors to try until one of them returns some content.
+type Chain []Step
+
+func (c Chain) String() string {
+	var steps []string
+	for _, step := range c {
+		steps = append(steps, step.String())
+	}
+	return strings.Join(steps, ", ")
+}
+
+// Contains returns true if the chain uses the given extractor.
+func (c Chain) Contains(name string) bool {
+	for _, step := range c {
+		if step.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseChain parses a comma separated list of extractor names.
+// Each name can be followed by a time limit in seconds, for example "mercury:30, readability".
+func ParseChain(value string) (Chain, error) {
+	var chain Chain
+
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		var step Step
+		parts := strings.SplitN(item, ":", 2)
+		step.Name = strings.ToLower(strings.TrimSpace(parts[0]))
+
+		if _, found := Get(step.Name); !found {
+			return nil, fmt.Errorf("extractor: unknown content extractor %q", step.Name)
+		}
+
+		if len(parts) == 2 {
+			seconds, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+			if err != nil || seconds <= 0 {
+				return nil, fmt.Errorf("extractor: invalid time limit for %q", step.Name)
+			}
+			step.Timeout = time.Duration(seconds) * time.Second
+		}
+
+		chain = append(chain, step)
+	}
+
+	return chain, nil
+}
+
+// Failure is the error returned by one extractor of a chain.
+type Failure struct {
+	Extractor string
+	Err       error
+}
+
+// Error lists the failures of all the extractors of a chain.
+type Error struct {
+	Failures []Failure
+}
+
+func (e *Error) Error() string {
+	var messages []string
+	for _, failure := range e.Failures {
+		messages = append(messages, fmt.Sprintf("%s: %v", failure.Extractor, failure.Err))
+	}
+	return "extractor: " + strings.Join(messages, "; ")
+}
+
+// Run tries the extractors of the chain in order and returns the first article with some content.
+func Run(chain Chain, request *Request) (*readability.Article, error) {
+	extractionErr := &Error{}
+
+	for _, step := range chain {
+		article, err := runStep(step, request)
+		if err == nil && strings.TrimSpace(article.Content) == "" {
+			err = errNoContent
+		}
+
+		if err != nil {
+			logger.Debug("[Extractor] %s failed for %q: %v", step.Name, request.URL, err)
+			extractionErr.Failures = append(extractionErr.Failures, Failure{Extractor: step.Name, Err: err})
+			continue
+		}
+
+		logger.Debug("[Extractor] Content of %q extracted with %s", request.URL, step.Name)
+		return article, nil
+	}
+
+	if len(extractionErr.Failures) == 0 {
+		extractionErr.Failures = append(extractionErr.Failures, Failure{Extractor: "chain", Err: errors.New("no content extractor defined")})
+	}
+
+	return nil, extractionErr
+}
+
+type result struct {
+	article *readability.Article
+	err     error
+}
+
+func runStep(step Step, request *Request) (*readability.Article, error) {
+	extractor, found := Get(step.Name)
+	if !found {
+		return nil, fmt.Errorf("unknown content extractor %q", step.Name)
+	}
+
+	timeout := step.Timeout
+	if timeout == 0 {
+		timeout = time.Duration(config.Opts.HTTPClientTimeout()) * time.Second
+	}
+
+	// The channel is buffered to let the extractor finish in the background after the time limit.
+	done := make(chan result, 1)
+	go func() {
+		article, err := extractor.Extract(request)
+		done <- result{article, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.article, r.err
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("time limit exceeded (%v)", timeout)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package extractor // import "miniflux.app/reader/extractor"
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/reader/readability"
+)
+
+type fakeExtractor struct {
+	name    string
+	content string
+	err     error
+	delay   time.Duration
+	calls   int
+}
+
+func (f *fakeExtractor) Name() string {
+	return f.name
+}
+
+func (f *fakeExtractor) Extract(request *Request) (*readability.Article, error) {
+	f.calls++
+	time.Sleep(f.delay)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &readability.Article{Content: f.content}, nil
+}
+
+func setupConfig(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+}
+
+func TestBuiltinExtractors(t *testing.T) {
+	for _, name := range []string{Readability, Scraper, Mercury} {
+		if _, found := Get(name); !found {
+			t.Errorf(`The extractor %q should be registered`, name)
+		}
+	}
+}
+
+func TestParseChain(t *testing.T) {
+	chain, err := ParseChain(" Scraper, readability:10 ,, mercury ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Chain{{Name: Scraper}, {Name: Readability, Timeout: 10 * time.Second}, {Name: Mercury}}
+	if len(chain) != len(expected) {
+		t.Fatalf(`Unexpected chain: %v`, chain)
+	}
+
+	for i := range expected {
+		if chain[i] != expected[i] {
+			t.Errorf(`Unexpected step #%d: %v instead of %v`, i, chain[i], expected[i])
+		}
+	}
+
+	if chain.String() != "scraper, readability:10, mercury" {
+		t.Errorf(`Unexpected string: %q`, chain.String())
+	}
+
+	if !chain.Contains(Mercury) {
+		t.Error(`The chain should contain mercury`)
+	}
+
+	if chain, err := ParseChain(""); err != nil || len(chain) != 0 {
+		t.Errorf(`An empty value should return an empty chain: %v, %v`, chain, err)
+	}
+}
+
+func TestParseInvalidChain(t *testing.T) {
+	for _, value := range []string{"unknown", "readability:abc", "readability:0", "readability, mercury:-1"} {
+		if _, err := ParseChain(value); err == nil {
+			t.Errorf(`The chain %q should be invalid`, value)
+		}
+	}
+}
+
+func TestRunWithFallback(t *testing.T) {
+	setupConfig(t)
+
+	failing := &fakeExtractor{name: "test-failing", err: errors.New("boom")}
+	empty := &fakeExtractor{name: "test-empty"}
+	working := &fakeExtractor{name: "test-working", content: "<p>Content</p>"}
+	unused := &fakeExtractor{name: "test-unused", content: "<p>Other</p>"}
+	for _, extractor := range []*fakeExtractor{failing, empty, working, unused} {
+		Register(extractor)
+	}
+
+	chain, err := ParseChain("test-failing, test-empty, test-working, test-unused")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	article, err := Run(chain, &Request{URL: "https://example.org/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if article.Content != "<p>Content</p>" {
+		t.Errorf(`Unexpected content: %q`, article.Content)
+	}
+
+	if failing.calls != 1 || empty.calls != 1 || working.calls != 1 || unused.calls != 0 {
+		t.Errorf(`The extractors should be called in order until one succeeds`)
+	}
+}
+
+func TestRunReportsErrors(t *testing.T) {
+	setupConfig(t)
+
+	Register(&fakeExtractor{name: "test-error", err: errors.New("boom")})
+	Register(&fakeExtractor{name: "test-slow", content: "<p>Content</p>", delay: 2 * time.Second})
+
+	chain, err := ParseChain("test-error, test-slow:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = Run(chain, &Request{URL: "https://example.org/"})
+	if err == nil {
+		t.Fatal(`An error should be returned when all the extractors fail`)
+	}
+
+	extractionErr, ok := err.(*Error)
+	if !ok || len(extractionErr.Failures) != 2 {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if extractionErr.Failures[0].Extractor != "test-error" || extractionErr.Failures[1].Extractor != "test-slow" {
+		t.Errorf(`Unexpected failures: %v`, extractionErr.Failures)
+	}
+
+	if !strings.Contains(err.Error(), "test-error: boom") || !strings.Contains(err.Error(), "time limit exceeded") {
+		t.Errorf(`Unexpected error message: %v`, err)
+	}
+}
@@ -6,17 +6,24 @@ package feed // import "miniflux.app/reader/feed"
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"miniflux.app/config"
 	"miniflux.app/errors"
 	"miniflux.app/http/client"
+	"miniflux.app/integration"
 	"miniflux.app/locale"
 	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/reader/browser"
+	"miniflux.app/reader/gemini"
 	"miniflux.app/reader/icon"
+	"miniflux.app/reader/local"
+	"miniflux.app/reader/monitor"
 	"miniflux.app/reader/parser"
 	"miniflux.app/reader/processor"
+	"miniflux.app/reader/webpage"
 	"miniflux.app/storage"
 	"miniflux.app/timer"
 )
@@ -25,6 +32,8 @@ var (
 	errDuplicate        = "This feed already exists (%s)"
 	errNotFound         = "Feed %d not found"
 	errCategoryNotFound = "Category not found for this user"
+	errPageSnapshot     = "Unable to load the previous page snapshot: %v"
+	errIconNotFound     = "Unable to find the website icon: %v"
 )
 
 // Handler contains all the logic to create and refresh feeds.
@@ -33,17 +42,27 @@ type Handler struct {
 }
 
 // CreateFeed fetch, parse and store a new feed.
-func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool, userAgent, username, password, scraperRules, rewriteRules string) (*model.Feed, error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:CreateFeed] feedUrl=%s", url))
+func (h *Handler) CreateFeed(userID int64, feedCreationRequest *model.FeedCreationRequest) (*model.Feed, error) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:CreateFeed] feedUrl=%s", feedCreationRequest.FeedURL))
 
-	if !h.store.CategoryExists(userID, categoryID) {
+	category, storeErr := h.store.Category(userID, feedCreationRequest.CategoryID)
+	if storeErr != nil {
+		return nil, storeErr
+	}
+
+	if category == nil {
 		return nil, errors.NewLocalizedError(errCategoryNotFound)
 	}
 
-	request := client.New(url)
-	request.WithCredentials(username, password)
+	userAgent := feedCreationRequest.UserAgent
+	if userAgent == "" {
+		userAgent = category.UserAgent
+	}
+
+	request := client.New(feedCreationRequest.FeedURL)
+	request.WithCredentials(feedCreationRequest.Username, feedCreationRequest.Password)
 	request.WithUserAgent(userAgent)
-	response, requestErr := browser.Exec(request)
+	response, requestErr := h.fetch(request, feedCreationRequest.FeedURL, feedCreationRequest.SourceType)
 	if requestErr != nil {
 		return nil, requestErr
 	}
@@ -52,14 +71,24 @@ func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool,
 		return nil, errors.NewLocalizedError(errDuplicate, response.EffectiveURL)
 	}
 
-	subscription, parseErr := parser.ParseFeed(response.BodyAsString())
+	subscription, page, parseErr := h.parseResponse(0, response, feedCreationRequest.SourceType, &feedCreationRequest.SourceSettings)
 	if parseErr != nil {
 		return nil, parseErr
 	}
 
 	subscription.UserID = userID
-	subscription.WithCategoryID(categoryID)
-	subscription.WithBrowsingParameters(crawler, userAgent, username, password, scraperRules, rewriteRules)
+	subscription.Category = category
+	subscription.WithBrowsingParameters(
+		feedCreationRequest.Crawler,
+		feedCreationRequest.UserAgent,
+		feedCreationRequest.Username,
+		feedCreationRequest.Password,
+		feedCreationRequest.ScraperRules,
+		feedCreationRequest.RewriteRules,
+	)
+	subscription.WithSource(feedCreationRequest.SourceType, feedCreationRequest.SourceSettings)
+	subscription.FilterMode = feedCreationRequest.FilterMode
+	subscription.EntryIdentity = feedCreationRequest.EntryIdentity
 	subscription.WithClientResponse(response)
 	subscription.CheckedNow()
 
@@ -69,8 +98,21 @@ func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool,
 		return nil, storeErr
 	}
 
+	entriesToSend, storeErr := h.store.UpdateEntries(userID, subscription.ID, subscription.Entries, false)
+	if storeErr != nil {
+		return nil, storeErr
+	}
+
+	h.sendEntries(userID, entriesToSend)
+
 	logger.Debug("[Handler:CreateFeed] Feed saved with ID: %d", subscription.ID)
 
+	if page != nil {
+		if storeErr := h.store.UpdatePageSnapshot(subscription.ID, page.Text); storeErr != nil {
+			return nil, storeErr
+		}
+	}
+
 	checkFeedIcon(h.store, subscription.ID, subscription.SiteURL)
 	return subscription, nil
 }
@@ -90,13 +132,18 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 		return errors.NewLocalizedError(errNotFound, feedID)
 	}
 
+	// Newsletters are delivered by the mail service, there is nothing to fetch.
+	if originalFeed.IsNewsletter() {
+		return nil
+	}
+
 	originalFeed.CheckedNow()
 
 	request := client.New(originalFeed.FeedURL)
 	request.WithCredentials(originalFeed.Username, originalFeed.Password)
 	request.WithCacheHeaders(originalFeed.EtagHeader, originalFeed.LastModifiedHeader)
-	request.WithUserAgent(originalFeed.UserAgent)
-	response, requestErr := browser.Exec(request)
+	request.WithUserAgent(originalFeed.EffectiveUserAgent())
+	response, requestErr := h.fetch(request, originalFeed.FeedURL, originalFeed.SourceType)
 	if requestErr != nil {
 		originalFeed.WithError(requestErr.Localize(printer))
 		h.store.UpdateFeedError(originalFeed)
@@ -106,7 +153,7 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 	if response.IsModified(originalFeed.EtagHeader, originalFeed.LastModifiedHeader) {
 		logger.Debug("[Handler:RefreshFeed] Feed #%d has been modified", feedID)
 
-		updatedFeed, parseErr := parser.ParseFeed(response.BodyAsString())
+		updatedFeed, page, parseErr := h.parseResponse(originalFeed.ID, response, originalFeed.SourceType, &originalFeed.SourceSettings)
 		if parseErr != nil {
 			originalFeed.WithError(parseErr.Localize(printer))
 			h.store.UpdateFeedError(originalFeed)
@@ -117,11 +164,24 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 		processor.ProcessFeedEntries(h.store, originalFeed)
 
 		// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries).
-		if storeErr := h.store.UpdateEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, !originalFeed.Crawler, originalFeed.TitleFilter, originalFeed.ContentFilter); storeErr != nil {
+		entriesToSend, storeErr := h.store.UpdateEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, !originalFeed.EffectiveCrawler())
+		if storeErr != nil {
 			originalFeed.WithError(storeErr.Error())
 			h.store.UpdateFeedError(originalFeed)
 			return storeErr
 		}
+
+		h.sendEntries(originalFeed.UserID, entriesToSend)
+
+		// The snapshot is saved only once the change has been stored as an entry.
+		if page != nil {
+			if storeErr := h.store.UpdatePageSnapshot(originalFeed.ID, page.Text); storeErr != nil {
+				originalFeed.WithError(storeErr.Error())
+				h.store.UpdateFeedError(originalFeed)
+				return storeErr
+			}
+		}
+
 		// We update caching headers only if the feed has been modified,
 		// because some websites don't return the same headers when replying with a 304.
 		originalFeed.WithClientResponse(response)
@@ -130,22 +190,6 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 		logger.Debug("[Handler:RefreshFeed] Feed #%d not modified", feedID)
 	}
 
-	if originalFeed.TitleFilter != "" {
-		if storeErr := h.store.FilterByTitle(originalFeed.UserID, originalFeed.ID, originalFeed.TitleFilter); storeErr != nil {
-			originalFeed.WithError(storeErr.Error())
-			h.store.UpdateFeedError(originalFeed)
-			return storeErr
-		}
-	}
-
-	if originalFeed.ContentFilter != "" {
-		if storeErr := h.store.FilterByContent(originalFeed.UserID, originalFeed.ID, originalFeed.ContentFilter); storeErr != nil {
-			originalFeed.WithError(storeErr.Error())
-			h.store.UpdateFeedError(originalFeed)
-			return storeErr
-		}
-	}
-
 	originalFeed.ResetErrorCounter()
 
 	if storeErr := h.store.UpdateFeed(originalFeed); storeErr != nil {
@@ -157,22 +201,130 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 	return nil
 }
 
+// fetch downloads the feed, local sources are read from the filesystem instead.
+func (h *Handler) fetch(request *client.Client, feedURL, sourceType string) (*client.Response, *errors.LocalizedError) {
+	if model.IsLocalSourceType(sourceType) {
+		return local.Exec(sourceType, feedURL)
+	}
+
+	if gemini.IsGeminiURL(feedURL) {
+		return gemini.NewClient(h.store).Fetch(feedURL)
+	}
+
+	return browser.Exec(request)
+}
+
+// parseResponse converts the response body to a feed according to the source type.
+// Monitored pages are also returned, the new snapshot must be saved once the entries are stored.
+func (h *Handler) parseResponse(feedID int64, response *client.Response, sourceType string, settings *model.SourceSettings) (*model.Feed, *monitor.Page, *errors.LocalizedError) {
+	switch sourceType {
+	case model.FeedSourceTypeWebPage:
+		feed, parseErr := webpage.Parse(response.EffectiveURL, strings.NewReader(response.BodyAsString()), settings.WebPage)
+		return feed, nil, parseErr
+	case model.FeedSourceTypePageMonitor:
+		return h.comparePage(feedID, response, settings.PageMonitor)
+	default:
+		if gemini.IsGemtext(response.ContentType) {
+			feed, parseErr := gemini.ParseIndex(response.EffectiveURL, strings.NewReader(response.BodyAsString()))
+			return feed, nil, parseErr
+		}
+
+		feed, parseErr := parser.ParseFeed(response.EffectiveURL, response.BodyAsString())
+		return feed, nil, parseErr
+	}
+}
+
+// comparePage creates an entry when the monitored page differs from the previous snapshot.
+// The page is returned only when its snapshot must be saved.
+func (h *Handler) comparePage(feedID int64, response *client.Response, settings *model.PageMonitorSettings) (*model.Feed, *monitor.Page, *errors.LocalizedError) {
+	if settings == nil {
+		settings = &model.PageMonitorSettings{}
+	}
+
+	page, parseErr := monitor.Parse(response.EffectiveURL, strings.NewReader(response.BodyAsString()), settings.Selector)
+	if parseErr != nil {
+		return nil, nil, parseErr
+	}
+
+	var snapshot *model.PageSnapshot
+	if feedID > 0 {
+		var storeErr error
+		snapshot, storeErr = h.store.PageSnapshot(feedID)
+		if storeErr != nil {
+			return nil, nil, errors.NewLocalizedError(errPageSnapshot, storeErr)
+		}
+	}
+
+	feed := page.Feed()
+	if snapshot == nil {
+		feed.Entries = model.Entries{page.FirstEntry()}
+		return feed, page, nil
+	}
+
+	if entry := page.Compare(snapshot.Content, settings.Threshold); entry != nil {
+		feed.Entries = model.Entries{entry}
+		return feed, page, nil
+	}
+
+	// The previous snapshot is kept when the change is below the threshold,
+	// otherwise a page changing a little at each refresh would never create an entry.
+	return feed, nil, nil
+}
+
+// sendEntries sends the entries selected by the filter rules to the integrations of the user.
+func (h *Handler) sendEntries(userID int64, entries model.Entries) {
+	if len(entries) == 0 {
+		return
+	}
+
+	settings, err := h.store.Integration(userID)
+	if err != nil {
+		logger.Error("[Handler:SendEntries] %v", err)
+		return
+	}
+
+	integration.SendEntries(entries, settings)
+}
+
 // NewFeedHandler returns a feed handler.
 func NewFeedHandler(store *storage.Storage) *Handler {
 	return &Handler{store}
 }
 
+// RefreshFeedIcon downloads the icon of the feed website again.
+func (h *Handler) RefreshFeedIcon(userID, feedID int64) error {
+	feed, storeErr := h.store.FeedByID(userID, feedID)
+	if storeErr != nil {
+		return storeErr
+	}
+
+	if feed == nil {
+		return errors.NewLocalizedError(errNotFound, feedID)
+	}
+
+	icon, err := icon.FindIcon(feed.SiteURL)
+	if err != nil {
+		h.store.UpdateIconCheckedAt(feedID)
+		return errors.NewLocalizedError(errIconNotFound, err)
+	}
+
+	return h.store.UpdateFeedIcon(feedID, icon)
+}
+
+// checkFeedIcon downloads the icon of feeds without icon, and refreshes the icon periodically.
 func checkFeedIcon(store *storage.Storage, feedID int64, websiteURL string) {
-	if !store.HasIcon(feedID) {
-		icon, err := icon.FindIcon(websiteURL)
-		if err != nil {
-			logger.Debug("CheckFeedIcon: %v (feedID=%d websiteURL=%s)", err, feedID, websiteURL)
-		} else if icon == nil {
-			logger.Debug("CheckFeedIcon: No icon found (feedID=%d websiteURL=%s)", feedID, websiteURL)
-		} else {
-			if err := store.CreateFeedIcon(feedID, icon); err != nil {
-				logger.Debug("CheckFeedIcon: %v (feedID=%d websiteURL=%s)", err, feedID, websiteURL)
-			}
-		}
+	if !store.IconCheckNeeded(feedID, config.Opts.IconRefreshDays()) {
+		return
+	}
+
+	icon, err := icon.FindIcon(websiteURL)
+	if err != nil {
+		logger.Debug("CheckFeedIcon: %v (feedID=%d websiteURL=%s)", err, feedID, websiteURL)
+		store.UpdateIconCheckedAt(feedID)
+		return
+	}
+
+	if err := store.UpdateFeedIcon(feedID, icon); err != nil {
+		logger.Debug("CheckFeedIcon: %v (feedID=%d websiteURL=%s)", err, feedID, websiteURL)
 	}
 }
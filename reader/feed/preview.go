@@ -0,0 +1,104 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package feed // import "miniflux.app/reader/feed"
+
+import (
+	"fmt"
+	"time"
+
+	"miniflux.app/http/client"
+	"miniflux.app/model"
+	"miniflux.app/reader/diff"
+	"miniflux.app/reader/filter"
+	"miniflux.app/reader/processor"
+	"miniflux.app/timer"
+)
+
+// PreviewFeed shows what the settings of the feed would do to its latest entries, nothing is saved.
+//
+// The stored entries are used unless the feed must be downloaded again.
+// When rules is nil, the filter rules stored for the feed are evaluated.
+func (h *Handler) PreviewFeed(feed *model.Feed, rules model.FilterRules, previewRequest *model.FeedPreviewRequest) (model.EntryPreviews, error) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:PreviewFeed] feedID=%d", feed.ID))
+
+	if rules == nil {
+		var storeErr error
+		rules, storeErr = h.store.FeedFilterRules(feed.UserID, feed.ID)
+		if storeErr != nil {
+			return nil, storeErr
+		}
+	}
+
+	entries, err := h.previewEntries(feed, previewRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	entryFilter := filter.New(rules, feed.EffectiveFilterMode())
+	previews := make(model.EntryPreviews, 0, len(entries))
+	for _, original := range entries {
+		entry := *original
+		entry.Status = model.EntryStatusUnread
+		entry.Starred = false
+		entry.Tags = append([]string(nil), original.Tags...)
+
+		preview := &model.EntryPreview{EntryID: original.ID, OriginalTitle: original.Title, OriginalContent: original.Content}
+		if err := processor.PreviewEntry(h.store, feed, &entry, previewRequest.Crawl); err != nil {
+			preview.Error = err.Error()
+		}
+
+		result := entryFilter.Apply(&entry)
+		preview.URL = entry.URL
+		preview.Title = entry.Title
+		preview.Content = entry.Content
+		preview.Status = entry.Status
+		preview.Starred = entry.Starred
+		preview.Tags = entry.Tags
+		preview.MatchedRules = result.MatchedRules
+		preview.Suppressed = result.Suppressed
+
+		if changes := diff.Words(original.Content, entry.Content); changes.HasChanges() {
+			preview.ContentDiff = changes.HTML()
+		}
+
+		previews = append(previews, preview)
+	}
+
+	return previews, nil
+}
+
+// previewEntries returns the latest stored entries of the feed, or the entries of the feed downloaded again.
+func (h *Handler) previewEntries(feed *model.Feed, previewRequest *model.FeedPreviewRequest) (model.Entries, error) {
+	limit := previewRequest.EntryLimit()
+
+	if !previewRequest.Refetch || feed.IsNewsletter() {
+		builder := h.store.NewEntryQueryBuilder(feed.UserID)
+		builder.WithFeedID(feed.ID)
+		builder.WithOrder(model.DefaultSortingOrder)
+		builder.WithDirection("desc")
+		builder.WithLimit(limit)
+		return builder.GetEntries()
+	}
+
+	request := client.New(feed.FeedURL)
+	request.WithCredentials(feed.Username, feed.Password)
+	request.WithUserAgent(feed.EffectiveUserAgent())
+	response, requestErr := h.fetch(request, feed.FeedURL, feed.SourceType)
+	if requestErr != nil {
+		return nil, requestErr
+	}
+
+	updatedFeed, _, parseErr := h.parseResponse(feed.ID, response, feed.SourceType, &feed.SourceSettings)
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	entries := updatedFeed.Entries
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	return entries, nil
+}
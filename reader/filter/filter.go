@@ -0,0 +1,231 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package filter // import "miniflux.app/reader/filter"
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+var durationRegex = regexp.MustCompile(`^(\d+)\s*(h|d|w)$`)
+
+// Result contains the outcome of the rules evaluated on an entry.
+type Result struct {
+	// MatchedRules contains the ID of the rules matching the entry.
+	MatchedRules []int64
+
+	// SendToIntegrations is true if the entry must be sent to the integrations of the user.
+	SendToIntegrations bool
+
+	// Suppressed is true if the entry was removed because it does not match any "keep" rule in allowlist mode.
+	Suppressed bool
+}
+
+// Filter evaluates a list of rules, the regular expressions are compiled only once.
+type Filter struct {
+	rules     []*rule
+	allowlist bool
+}
+
+type rule struct {
+	*model.FilterRule
+	regex    *regexp.Regexp
+	duration time.Duration
+}
+
+// New returns a Filter for the given rules and filter mode, invalid rules are ignored.
+func New(rules model.FilterRules, mode string) *Filter {
+	f := &Filter{allowlist: mode == model.FilterModeAllowlist}
+	for _, filterRule := range rules {
+		if err := filterRule.ValidateFilterRule(); err != nil {
+			logger.Debug("[Filter] Ignoring rule #%d: %v", filterRule.ID, err)
+			continue
+		}
+
+		r, err := compile(filterRule)
+		if err != nil {
+			logger.Debug("[Filter] Ignoring rule #%d: %v", filterRule.ID, err)
+			continue
+		}
+
+		f.rules = append(f.rules, r)
+	}
+
+	return f
+}
+
+// ValidateRule makes sure the rule is consistent and its value can be evaluated.
+func ValidateRule(filterRule *model.FilterRule) error {
+	if err := filterRule.ValidateFilterRule(); err != nil {
+		return err
+	}
+
+	_, err := compile(filterRule)
+	return err
+}
+
+// IsEmpty returns true if there is no rule to evaluate.
+//
+// In allowlist mode, the filter is never empty: without "keep" rule, all the entries are removed.
+func (f *Filter) IsEmpty() bool {
+	return len(f.rules) == 0 && !f.allowlist
+}
+
+// Apply evaluates the rules on the entry and applies the actions of the matching rules.
+//
+// The removal takes precedence over the other status changes.
+func (f *Filter) Apply(entry *model.Entry) *Result {
+	result := &Result{}
+	now := time.Now()
+	kept := false
+
+	for _, r := range f.rules {
+		if !r.match(entry, now) {
+			continue
+		}
+
+		result.MatchedRules = append(result.MatchedRules, r.ID)
+
+		switch r.Action {
+		case model.FilterActionRemove:
+			entry.Status = model.EntryStatusRemoved
+		case model.FilterActionMarkAsRead:
+			if entry.Status != model.EntryStatusRemoved {
+				entry.Status = model.EntryStatusRead
+			}
+		case model.FilterActionStar:
+			entry.Starred = true
+		case model.FilterActionTag:
+			entry.AddTags(r.ActionValue)
+		case model.FilterActionIntegration:
+			result.SendToIntegrations = true
+		case model.FilterActionKeep:
+			kept = true
+		}
+	}
+
+	if f.allowlist && !kept {
+		entry.Status = model.EntryStatusRemoved
+		result.Suppressed = true
+	}
+
+	if entry.Status == model.EntryStatusRemoved {
+		result.SendToIntegrations = false
+	}
+
+	return result
+}
+
+func compile(filterRule *model.FilterRule) (*rule, error) {
+	r := &rule{FilterRule: filterRule}
+
+	switch filterRule.Operator {
+	case model.FilterOperatorMatches, model.FilterOperatorNotMatches:
+		regex, err := regexp.Compile("(?i)" + filterRule.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %v", filterRule.Value, err)
+		}
+		r.regex = regex
+	case model.FilterOperatorOlderThan, model.FilterOperatorNewerThan:
+		duration, err := parseDuration(filterRule.Value)
+		if err != nil {
+			return nil, err
+		}
+		r.duration = duration
+	default:
+		if strings.TrimSpace(filterRule.Value) == "" {
+			return nil, fmt.Errorf("the value of the operator %q cannot be empty", filterRule.Operator)
+		}
+	}
+
+	return r, nil
+}
+
+func (r *rule) match(entry *model.Entry, now time.Time) bool {
+	switch r.Field {
+	case model.FilterFieldTitle:
+		return r.matchText(entry.Title)
+	case model.FilterFieldContent:
+		return r.matchText(entry.Content)
+	case model.FilterFieldAuthor:
+		return r.matchText(entry.Author)
+	case model.FilterFieldURL:
+		return r.matchText(entry.URL)
+	case model.FilterFieldTags:
+		return r.matchText(entry.Tags...)
+	case model.FilterFieldDate:
+		age := now.Sub(entry.Date)
+		if r.Operator == model.FilterOperatorOlderThan {
+			return age > r.duration
+		}
+		return age <= r.duration
+	}
+
+	return false
+}
+
+// matchText evaluates the operator on the values, the rule matches if one of the values matches
+// or, for the negative operators, if none of them matches.
+func (r *rule) matchText(values ...string) bool {
+	containsFold := func(value string) bool {
+		return strings.Contains(strings.ToLower(value), strings.ToLower(r.Value))
+	}
+
+	switch r.Operator {
+	case model.FilterOperatorNotContains:
+		return !matchAny(values, containsFold)
+	case model.FilterOperatorNotMatches:
+		return !matchAny(values, r.regex.MatchString)
+	case model.FilterOperatorContains:
+		return matchAny(values, containsFold)
+	case model.FilterOperatorEquals:
+		return matchAny(values, func(value string) bool {
+			return strings.EqualFold(strings.TrimSpace(value), strings.TrimSpace(r.Value))
+		})
+	case model.FilterOperatorMatches:
+		return matchAny(values, r.regex.MatchString)
+	}
+
+	return false
+}
+
+func matchAny(values []string, fn func(string) bool) bool {
+	for _, value := range values {
+		if fn(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseDuration parses a number of hours, days or weeks, for example "12h", "7d" or "2w".
+func parseDuration(value string) (time.Duration, error) {
+	matches := durationRegex.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value)))
+	if matches == nil {
+		return 0, fmt.Errorf(`invalid duration %q, the expected format is a number followed by "h", "d" or "w"`, value)
+	}
+
+	number, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %v", value, err)
+	}
+
+	duration := time.Duration(number) * time.Hour
+	switch matches[2] {
+	case "d":
+		duration *= 24
+	case "w":
+		duration *= 24 * 7
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package filter // import "miniflux.app/reader/filter"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func newEntry() *model.Entry {
+	return &model.Entry{
+		Title:   "Go 1.14 is released",
+		Content: "<p>The latest Go release brings improvements to modules.</p>",
+		Author:  "The Go Team",
+		URL:     "https://blog.golang.org/go1.14",
+		Date:    time.Now().Add(-48 * time.Hour),
+		Tags:    []string{"Release", "Go"},
+	}
+}
+
+func TestRuleMatching(t *testing.T) {
+	scenarios := []struct {
+		field    string
+		operator string
+		value    string
+		expected bool
+	}{
+		{model.FilterFieldTitle, model.FilterOperatorContains, "RELEASED", true},
+		{model.FilterFieldTitle, model.FilterOperatorContains, "Rust", false},
+		{model.FilterFieldTitle, model.FilterOperatorNotContains, "Rust", true},
+		{model.FilterFieldTitle, model.FilterOperatorEquals, " go 1.14 is released", true},
+		{model.FilterFieldTitle, model.FilterOperatorMatches, `^go [0-9.]+`, true},
+		{model.FilterFieldTitle, model.FilterOperatorNotMatches, `^go [0-9.]+`, false},
+		{model.FilterFieldContent, model.FilterOperatorContains, "modules", true},
+		{model.FilterFieldAuthor, model.FilterOperatorEquals, "the go team", true},
+		{model.FilterFieldURL, model.FilterOperatorMatches, `^https://blog\.golang\.org/`, true},
+		{model.FilterFieldTags, model.FilterOperatorEquals, "go", true},
+		{model.FilterFieldTags, model.FilterOperatorEquals, "golang", false},
+		{model.FilterFieldTags, model.FilterOperatorNotContains, "sponsored", true},
+		{model.FilterFieldTags, model.FilterOperatorNotMatches, `^rel`, false},
+		{model.FilterFieldDate, model.FilterOperatorOlderThan, "1d", true},
+		{model.FilterFieldDate, model.FilterOperatorOlderThan, "1w", false},
+		{model.FilterFieldDate, model.FilterOperatorNewerThan, "72h", true},
+		{model.FilterFieldDate, model.FilterOperatorNewerThan, "24h", false},
+	}
+
+	for _, scenario := range scenarios {
+		rule := &model.FilterRule{ID: 1, Field: scenario.field, Operator: scenario.operator, Value: scenario.value, Action: model.FilterActionStar}
+		if err := ValidateRule(rule); err != nil {
+			t.Fatalf(`The rule %v should be valid: %v`, rule, err)
+		}
+
+		entry := newEntry()
+		result := New(model.FilterRules{rule}, model.FilterModeBlock).Apply(entry)
+		if matched := len(result.MatchedRules) == 1; matched != scenario.expected {
+			t.Errorf(`Unexpected result for %s %s %q: got %v instead of %v`, scenario.field, scenario.operator, scenario.value, matched, scenario.expected)
+		}
+	}
+}
+
+func TestRuleActions(t *testing.T) {
+	rules := model.FilterRules{
+		{ID: 1, Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: "go", Action: model.FilterActionMarkAsRead},
+		{ID: 2, Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: "go", Action: model.FilterActionStar},
+		{ID: 3, Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: "go", Action: model.FilterActionTag, ActionValue: "Golang"},
+		{ID: 4, Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: "go", Action: model.FilterActionIntegration},
+		{ID: 5, Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: "rust", Action: model.FilterActionRemove},
+	}
+
+	entry := newEntry()
+	result := New(rules, model.FilterModeBlock).Apply(entry)
+
+	if len(result.MatchedRules) != 4 || result.MatchedRules[3] != 4 {
+		t.Errorf(`Unexpected matched rules: %v`, result.MatchedRules)
+	}
+
+	if entry.Status != model.EntryStatusRead {
+		t.Errorf(`The entry should be marked as read, got %q`, entry.Status)
+	}
+
+	if !entry.Starred {
+		t.Error(`The entry should be starred`)
+	}
+
+	if !entry.HasTag("golang") || len(entry.Tags) != 3 {
+		t.Errorf(`Unexpected tags: %v`, entry.Tags)
+	}
+
+	if !result.SendToIntegrations {
+		t.Error(`The entry should be sent to the integrations`)
+	}
+}
+
+func TestRemovalTakesPrecedence(t *testing.T) {
+	rules := model.FilterRules{
+		{ID: 1, Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: "go", Action: model.FilterActionRemove},
+		{ID: 2, Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: "go", Action: model.FilterActionMarkAsRead},
+		{ID: 3, Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: "go", Action: model.FilterActionIntegration},
+	}
+
+	entry := newEntry()
+	result := New(rules, model.FilterModeBlock).Apply(entry)
+
+	if entry.Status != model.EntryStatusRemoved {
+		t.Errorf(`The entry should be removed, got %q`, entry.Status)
+	}
+
+	if result.SendToIntegrations {
+		t.Error(`A removed entry should not be sent to the integrations`)
+	}
+}
+
+func TestInvalidRules(t *testing.T) {
+	rules := []*model.FilterRule{
+		{Field: "unknown", Operator: model.FilterOperatorContains, Value: "a", Action: model.FilterActionRemove},
+		{Field: model.FilterFieldTitle, Operator: "unknown", Value: "a", Action: model.FilterActionRemove},
+		{Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: "a", Action: "unknown"},
+		{Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: " ", Action: model.FilterActionRemove},
+		{Field: model.FilterFieldTitle, Operator: model.FilterOperatorMatches, Value: "(a", Action: model.FilterActionRemove},
+		{Field: model.FilterFieldTitle, Operator: model.FilterOperatorMatches, Value: `\mgo\M`, Action: model.FilterActionRemove},
+		{Field: model.FilterFieldTitle, Operator: model.FilterOperatorOlderThan, Value: "1d", Action: model.FilterActionRemove},
+		{Field: model.FilterFieldDate, Operator: model.FilterOperatorContains, Value: "2020", Action: model.FilterActionRemove},
+		{Field: model.FilterFieldDate, Operator: model.FilterOperatorOlderThan, Value: "1 month", Action: model.FilterActionRemove},
+		{Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: "a", Action: model.FilterActionTag},
+		{Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: "a", Action: model.FilterActionRemove, CategoryID: 1, FeedID: 1},
+	}
+
+	for _, rule := range rules {
+		if err := ValidateRule(rule); err == nil {
+			t.Errorf(`The rule %v should be invalid`, rule)
+		}
+	}
+
+	if f := New(rules, model.FilterModeBlock); !f.IsEmpty() {
+		t.Error(`The invalid rules should be ignored`)
+	}
+}
+
+func TestAllowlistMode(t *testing.T) {
+	rules := model.FilterRules{
+		{ID: 1, Field: model.FilterFieldTags, Operator: model.FilterOperatorEquals, Value: "go", Action: model.FilterActionKeep},
+		{ID: 2, Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: "released", Action: model.FilterActionIntegration},
+	}
+
+	entry := newEntry()
+	result := New(rules, model.FilterModeAllowlist).Apply(entry)
+	if result.Suppressed || entry.Status == model.EntryStatusRemoved {
+		t.Error(`The entry matching a keep rule should not be suppressed`)
+	}
+
+	if !result.SendToIntegrations {
+		t.Error(`The entry should be sent to the integrations`)
+	}
+
+	entry = newEntry()
+	entry.Tags = []string{"Rust"}
+	result = New(rules, model.FilterModeAllowlist).Apply(entry)
+	if !result.Suppressed || entry.Status != model.EntryStatusRemoved {
+		t.Error(`The entry not matching any keep rule should be suppressed`)
+	}
+
+	if result.SendToIntegrations {
+		t.Error(`A suppressed entry should not be sent to the integrations`)
+	}
+}
+
+func TestAllowlistModeWithoutKeepRule(t *testing.T) {
+	f := New(nil, model.FilterModeAllowlist)
+	if f.IsEmpty() {
+		t.Error(`The filter should not be empty in allowlist mode`)
+	}
+
+	entry := newEntry()
+	if result := f.Apply(entry); !result.Suppressed {
+		t.Error(`All the entries should be suppressed without keep rule`)
+	}
+}
+
+func TestKeepRuleInBlockMode(t *testing.T) {
+	rules := model.FilterRules{
+		{ID: 1, Field: model.FilterFieldTitle, Operator: model.FilterOperatorContains, Value: "rust", Action: model.FilterActionKeep},
+	}
+
+	entry := newEntry()
+	if result := New(rules, model.FilterModeBlock).Apply(entry); result.Suppressed || entry.Status == model.EntryStatusRemoved {
+		t.Error(`The keep rules should have no effect in block mode`)
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gemini // import "miniflux.app/reader/gemini"
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"html"
+	"io"
+	"io/ioutil"
+	"net"
+	url_parser "net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/errors"
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+const (
+	defaultPort     = "1965"
+	maxRedirects    = 5
+	maxHeaderLength = 1024
+)
+
+var (
+	errInvalidURL          = "Invalid Gemini URL: %q"
+	errRequestFailed       = "Unable to open this link: %v"
+	errInvalidResponse     = "Invalid response from the Gemini server: %q"
+	errTooManyRedirects    = "Too many redirects"
+	errInputRequired       = "This Gemini resource requires an input: %q"
+	errCertificateRequired = "This Gemini resource requires a client certificate: %q"
+	errResourceNotFound    = "Resource not found (%d), this feed doesn't exists anymore, check the feed URL"
+	errServerFailure       = "Unable to fetch this resource (Status Code = %d): %q"
+	errCertificateExpired  = "The certificate of %q has expired"
+	errCertificateChanged  = "The certificate of %q doesn't match the certificate trusted on first use"
+	errCertificateStore    = "Unable to verify the certificate of %q: %v"
+	errTooLarge            = "This feed is larger than the maximum size allowed (%d bytes)"
+	errEncoding            = "Unable to normalize encoding: %q"
+	errEmptyFeed           = "This feed is empty"
+	errUnsupportedType     = "Unsupported content type: %q"
+)
+
+// CertificateStore keeps the certificates trusted on first use.
+type CertificateStore interface {
+	GeminiCertificate(host string) (*model.GeminiCertificate, error)
+	UpdateGeminiCertificate(certificate *model.GeminiCertificate) error
+}
+
+// Client fetches resources over the Gemini protocol.
+type Client struct {
+	store       CertificateStore
+	timeout     time.Duration
+	maxBodySize int64
+}
+
+// NewClient returns a Gemini client pinning certificates in the given store.
+func NewClient(store CertificateStore) *Client {
+	return &Client{
+		store:       store,
+		timeout:     time.Duration(config.Opts.HTTPClientTimeout()) * time.Second,
+		maxBodySize: config.Opts.HTTPClientMaxBodySize(),
+	}
+}
+
+// IsGeminiURL returns true if the URL uses the Gemini protocol.
+func IsGeminiURL(websiteURL string) bool {
+	return strings.HasPrefix(strings.ToLower(websiteURL), "gemini://")
+}
+
+// Fetch requests the resource, follows redirects and returns the response body.
+//
+// The status code of successful responses is 200, the content type is the MIME type sent by the server.
+func (c *Client) Fetch(geminiURL string) (*client.Response, *errors.LocalizedError) {
+	currentURL := geminiURL
+
+	for i := 0; i <= maxRedirects; i++ {
+		status, meta, body, err := c.request(currentURL)
+		if err != nil {
+			return nil, err
+		}
+
+		switch status / 10 {
+		case 1:
+			return nil, errors.NewLocalizedError(errInputRequired, currentURL)
+		case 2:
+			return newResponse(currentURL, meta, body)
+		case 3:
+			redirectURL, parseErr := resolveURL(currentURL, meta)
+			if parseErr != nil || !IsGeminiURL(redirectURL) {
+				return nil, errors.NewLocalizedError(errInvalidResponse, meta)
+			}
+
+			logger.Debug("[Gemini:Fetch] Redirect from %s to %s", currentURL, redirectURL)
+			currentURL = redirectURL
+		case 5:
+			if status == 51 || status == 52 {
+				return nil, errors.NewLocalizedError(errResourceNotFound, status)
+			}
+			return nil, errors.NewLocalizedError(errServerFailure, status, meta)
+		case 6:
+			return nil, errors.NewLocalizedError(errCertificateRequired, currentURL)
+		default:
+			return nil, errors.NewLocalizedError(errServerFailure, status, meta)
+		}
+	}
+
+	return nil, errors.NewLocalizedError(errTooManyRedirects)
+}
+
+// FetchContent downloads a Gemini page and returns its content as HTML.
+func (c *Client) FetchContent(geminiURL string) (string, error) {
+	response, err := c.Fetch(geminiURL)
+	if err != nil {
+		return "", err
+	}
+
+	body := response.BodyAsString()
+	switch {
+	case IsGemtext(response.ContentType):
+		return ToHTML(response.EffectiveURL, body), nil
+	case strings.HasPrefix(response.ContentType, "text/"):
+		return "<pre>" + html.EscapeString(body) + "</pre>", nil
+	default:
+		return "", errors.NewLocalizedError(errUnsupportedType, response.ContentType)
+	}
+}
+
+func (c *Client) request(geminiURL string) (int, string, []byte, *errors.LocalizedError) {
+	u, err := url_parser.Parse(geminiURL)
+	if err != nil || u.Scheme != "gemini" || u.Hostname() == "" {
+		return 0, "", nil, errors.NewLocalizedError(errInvalidURL, geminiURL)
+	}
+
+	address := u.Host
+	if u.Port() == "" {
+		address = net.JoinHostPort(u.Hostname(), defaultPort)
+	}
+
+	dialer := &net.Dialer{Timeout: c.timeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", address, &tls.Config{
+		ServerName: u.Hostname(),
+		MinVersion: tls.VersionTLS12,
+
+		// Gemini servers use self-signed certificates, they are pinned by verifyCertificate instead.
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		return 0, "", nil, errors.NewLocalizedError(errRequestFailed, err)
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(c.timeout))
+
+	certificates := conn.ConnectionState().PeerCertificates
+	if len(certificates) == 0 {
+		return 0, "", nil, errors.NewLocalizedError(errCertificateChanged, u.Host)
+	}
+
+	if verifyErr := c.verifyCertificate(u.Host, certificates[0]); verifyErr != nil {
+		return 0, "", nil, verifyErr
+	}
+
+	if _, err := io.WriteString(conn, u.String()+"\r\n"); err != nil {
+		return 0, "", nil, errors.NewLocalizedError(errRequestFailed, err)
+	}
+
+	reader := bufio.NewReader(conn)
+	status, meta, headerErr := readHeader(reader)
+	if headerErr != nil {
+		return 0, "", nil, headerErr
+	}
+
+	if status/10 != 2 {
+		return status, meta, nil, nil
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(reader, c.maxBodySize+1))
+	if err != nil {
+		return 0, "", nil, errors.NewLocalizedError(errRequestFailed, err)
+	}
+
+	if int64(len(body)) > c.maxBodySize {
+		return 0, "", nil, errors.NewLocalizedError(errTooLarge, c.maxBodySize)
+	}
+
+	return status, meta, body, nil
+}
+
+// verifyCertificate trusts the certificate seen on first use, a new certificate is accepted only once the pinned one has expired.
+func (c *Client) verifyCertificate(host string, certificate *x509.Certificate) *errors.LocalizedError {
+	now := time.Now()
+	if now.After(certificate.NotAfter) {
+		return errors.NewLocalizedError(errCertificateExpired, host)
+	}
+
+	checksum := sha256.Sum256(certificate.Raw)
+	fingerprint := hex.EncodeToString(checksum[:])
+
+	pinned, err := c.store.GeminiCertificate(host)
+	if err != nil {
+		return errors.NewLocalizedError(errCertificateStore, host, err)
+	}
+
+	if pinned != nil && pinned.Fingerprint == fingerprint {
+		return nil
+	}
+
+	if pinned != nil && now.Before(pinned.ExpiresAt) {
+		return errors.NewLocalizedError(errCertificateChanged, host)
+	}
+
+	logger.Debug("[Gemini:verifyCertificate] Trusting certificate %s for %s", fingerprint, host)
+	err = c.store.UpdateGeminiCertificate(&model.GeminiCertificate{
+		Host:        host,
+		Fingerprint: fingerprint,
+		ExpiresAt:   certificate.NotAfter,
+	})
+	if err != nil {
+		return errors.NewLocalizedError(errCertificateStore, host, err)
+	}
+
+	return nil
+}
+
+// readHeader parses the response header: a two digits status code, a space and a meta string.
+func readHeader(reader *bufio.Reader) (int, string, *errors.LocalizedError) {
+	var line []byte
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return 0, "", errors.NewLocalizedError(errInvalidResponse, string(line))
+		}
+
+		if b == '\n' {
+			break
+		}
+
+		line = append(line, b)
+		if len(line) > maxHeaderLength+3 {
+			return 0, "", errors.NewLocalizedError(errInvalidResponse, string(line))
+		}
+	}
+
+	header := strings.TrimSuffix(string(line), "\r")
+	if len(header) < 2 {
+		return 0, "", errors.NewLocalizedError(errInvalidResponse, header)
+	}
+
+	status, err := strconv.Atoi(header[:2])
+	if err != nil || status < 10 {
+		return 0, "", errors.NewLocalizedError(errInvalidResponse, header)
+	}
+
+	return status, strings.TrimSpace(header[2:]), nil
+}
+
+func newResponse(geminiURL, meta string, body []byte) (*client.Response, *errors.LocalizedError) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, errors.NewLocalizedError(errEmptyFeed)
+	}
+
+	// The default MIME type is defined by the specification.
+	if meta == "" {
+		meta = "text/gemini; charset=utf-8"
+	}
+
+	response := &client.Response{
+		Body:          bytes.NewReader(body),
+		StatusCode:    200,
+		EffectiveURL:  geminiURL,
+		ContentType:   meta,
+		ContentLength: int64(len(body)),
+	}
+
+	if err := response.EnsureUnicodeBody(); err != nil {
+		return nil, errors.NewLocalizedError(errEncoding, err)
+	}
+
+	return response, nil
+}
+
+func resolveURL(baseURL, input string) (string, error) {
+	base, err := url_parser.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url_parser.Parse(strings.TrimSpace(input))
+	if err != nil {
+		return "", err
+	}
+
+	return base.ResolveReference(u).String(), nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gemini // import "miniflux.app/reader/gemini"
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+)
+
+type memoryStore map[string]*model.GeminiCertificate
+
+func (m memoryStore) GeminiCertificate(host string) (*model.GeminiCertificate, error) {
+	return m[host], nil
+}
+
+func (m memoryStore) UpdateGeminiCertificate(certificate *model.GeminiCertificate) error {
+	m[certificate.Host] = certificate
+	return nil
+}
+
+type testServer struct {
+	listener net.Listener
+	host     string
+}
+
+// startServer runs a Gemini server answering each path with the given header and body.
+func startServer(t *testing.T, routes map[string][2]string) *testServer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func(conn net.Conn) {
+				defer conn.Close()
+
+				line, err := bufio.NewReader(conn).ReadString('\n')
+				if err != nil {
+					return
+				}
+
+				path := strings.TrimSpace(line)
+				if i := strings.Index(path[len("gemini://"):], "/"); i >= 0 {
+					path = path[len("gemini://")+i:]
+				}
+
+				route, found := routes[path]
+				if !found {
+					route = [2]string{"51 Not found", ""}
+				}
+
+				conn.Write([]byte(route[0] + "\r\n" + route[1]))
+			}(conn)
+		}
+	}()
+
+	return &testServer{listener: listener, host: listener.Addr().String()}
+}
+
+func (s *testServer) URL(path string) string {
+	return "gemini://" + s.host + path
+}
+
+func (s *testServer) Close() {
+	s.listener.Close()
+}
+
+func setupConfig(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	setupConfig(t)
+	server := startServer(t, map[string][2]string{
+		"/atom.xml": {"20 application/atom+xml", `<?xml version="1.0" encoding="utf-8"?><feed xmlns="http://www.w3.org/2005/Atom"></feed>`},
+		"/old":      {"31 /gemlog/", ""},
+		"/gemlog/":  {"20 text/gemini", "# My Gemlog\n"},
+	})
+	defer server.Close()
+
+	store := make(memoryStore)
+	c := NewClient(store)
+
+	response, err := c.Fetch(server.URL("/atom.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.ContentType != "application/atom+xml" || !strings.Contains(response.BodyAsString(), "<feed") {
+		t.Errorf(`Unexpected response: %v`, response)
+	}
+
+	response, err = c.Fetch(server.URL("/old"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.EffectiveURL != server.URL("/gemlog/") || !IsGemtext(response.ContentType) {
+		t.Errorf(`The redirect should be followed: %v`, response)
+	}
+
+	if _, err := c.Fetch(server.URL("/missing")); err == nil {
+		t.Error(`Missing resources should return an error`)
+	}
+
+	if store[server.host] == nil || store[server.host].Fingerprint == "" {
+		t.Error(`The certificate should be pinned on first use`)
+	}
+}
+
+func TestFetchWithChangedCertificate(t *testing.T) {
+	setupConfig(t)
+	server := startServer(t, map[string][2]string{"/": {"20 text/gemini", "# Gemlog\n"}})
+	defer server.Close()
+
+	store := memoryStore{
+		server.host: {Host: server.host, Fingerprint: "previous", ExpiresAt: time.Now().Add(24 * time.Hour)},
+	}
+
+	if _, err := NewClient(store).Fetch(server.URL("/")); err == nil {
+		t.Fatal(`A different certificate should be rejected`)
+	}
+
+	store[server.host].ExpiresAt = time.Now().Add(-time.Hour)
+	if _, err := NewClient(store).Fetch(server.URL("/")); err != nil {
+		t.Fatalf(`A new certificate should be trusted once the previous one has expired: %v`, err)
+	}
+
+	if store[server.host].Fingerprint == "previous" {
+		t.Error(`The new certificate should be pinned`)
+	}
+}
+
+func TestFetchContent(t *testing.T) {
+	setupConfig(t)
+	server := startServer(t, map[string][2]string{
+		"/post.gmi": {"20 text/gemini; charset=utf-8", "# Title\nSome <text>\n=> other.gmi Other post\n"},
+		"/image":    {"20 image/png", "PNG"},
+	})
+	defer server.Close()
+
+	c := NewClient(make(memoryStore))
+	content, err := c.FetchContent(server.URL("/post.gmi"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<h1>Title</h1><p>Some &lt;text&gt;</p><p><a href="` + server.URL("/other.gmi") + `">Other post</a></p>`
+	if content != expected {
+		t.Errorf(`Unexpected content: got %q instead of %q`, content, expected)
+	}
+
+	if _, err := c.FetchContent(server.URL("/image")); err == nil {
+		t.Error(`Binary content should be rejected`)
+	}
+}
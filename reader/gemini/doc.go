@@ -0,0 +1,12 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package gemini implements a client for the Gemini protocol and a parser for gemtext documents.
+
+See https://gemini.circumlunar.space/docs/specification.html for the specification.
+
+*/
+package gemini // import "miniflux.app/reader/gemini"
@@ -0,0 +1,190 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gemini // import "miniflux.app/reader/gemini"
+
+import (
+	"html"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+// Links of subscribable pages start with an ISO 8601 date, see gemini://gemini.circumlunar.space/docs/companion/subscription.gmi
+var entryLinkRegex = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})\s*[-:–—]?\s*(.*)$`)
+
+// IsGemtext returns true if the MIME type is text/gemini.
+func IsGemtext(contentType string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(contentType)), "text/gemini")
+}
+
+// ParseIndex returns a normalized feed struct from a gemtext page following the subscription convention:
+// the first level 1 heading is the feed title and each link labelled with a date is an entry.
+func ParseIndex(baseURL string, data io.Reader) (*model.Feed, *errors.LocalizedError) {
+	buffer, err := ioutil.ReadAll(data)
+	if err != nil {
+		return nil, errors.NewLocalizedError("Unable to read gemtext page: %q", err)
+	}
+
+	feed := new(model.Feed)
+	feed.FeedURL = baseURL
+	feed.SiteURL = baseURL
+
+	preformatted := false
+	for _, line := range splitLines(string(buffer)) {
+		if strings.HasPrefix(line, "```") {
+			preformatted = !preformatted
+			continue
+		}
+
+		switch {
+		case preformatted:
+			continue
+		case feed.Title == "" && strings.HasPrefix(line, "# "):
+			feed.Title = strings.TrimSpace(line[2:])
+		case strings.HasPrefix(line, "=>"):
+			link, label := parseLink(baseURL, line)
+			if entry := transformLink(link, label); entry != nil {
+				feed.Entries = append(feed.Entries, entry)
+			}
+		}
+	}
+
+	if feed.Title == "" {
+		feed.Title = baseURL
+	}
+
+	return feed, nil
+}
+
+func transformLink(link, label string) *model.Entry {
+	matches := entryLinkRegex.FindStringSubmatch(label)
+	if link == "" || matches == nil {
+		return nil
+	}
+
+	date, err := time.Parse("2006-01-02", matches[1])
+	if err != nil {
+		return nil
+	}
+
+	entry := new(model.Entry)
+	entry.URL = link
+	entry.Date = date
+	entry.Title = strings.TrimSpace(matches[2])
+	entry.Hash = crypto.Hash(link)
+
+	if entry.Title == "" {
+		entry.Title = link
+	}
+
+	return entry
+}
+
+// ToHTML converts a gemtext document to HTML, the text is always escaped.
+func ToHTML(baseURL, text string) string {
+	var builder strings.Builder
+	var block string
+
+	openBlock := func(name string) {
+		if block == name {
+			return
+		}
+
+		closeBlock(&builder, block)
+		block = name
+		if name != "" {
+			builder.WriteString("<" + name + ">")
+		}
+	}
+
+	for _, line := range splitLines(text) {
+		if strings.HasPrefix(line, "```") {
+			if block == "pre" {
+				openBlock("")
+			} else {
+				openBlock("pre")
+			}
+			continue
+		}
+
+		if block == "pre" {
+			builder.WriteString(html.EscapeString(line))
+			builder.WriteString("\n")
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "=>"):
+			openBlock("")
+			link, label := parseLink(baseURL, line)
+			if link == "" {
+				continue
+			}
+
+			if label == "" {
+				label = link
+			}
+
+			builder.WriteString(`<p><a href="` + html.EscapeString(link) + `">` + html.EscapeString(label) + `</a></p>`)
+		case strings.HasPrefix(line, "###"):
+			openBlock("")
+			builder.WriteString("<h3>" + html.EscapeString(strings.TrimSpace(line[3:])) + "</h3>")
+		case strings.HasPrefix(line, "##"):
+			openBlock("")
+			builder.WriteString("<h2>" + html.EscapeString(strings.TrimSpace(line[2:])) + "</h2>")
+		case strings.HasPrefix(line, "#"):
+			openBlock("")
+			builder.WriteString("<h1>" + html.EscapeString(strings.TrimSpace(line[1:])) + "</h1>")
+		case strings.HasPrefix(line, "* "):
+			openBlock("ul")
+			builder.WriteString("<li>" + html.EscapeString(strings.TrimSpace(line[2:])) + "</li>")
+		case strings.HasPrefix(line, ">"):
+			if block == "blockquote" {
+				builder.WriteString("<br>")
+			}
+			openBlock("blockquote")
+			builder.WriteString(html.EscapeString(strings.TrimSpace(line[1:])))
+		case strings.TrimSpace(line) == "":
+			openBlock("")
+		default:
+			openBlock("")
+			builder.WriteString("<p>" + html.EscapeString(line) + "</p>")
+		}
+	}
+
+	closeBlock(&builder, block)
+	return builder.String()
+}
+
+func closeBlock(builder *strings.Builder, block string) {
+	if block != "" {
+		builder.WriteString("</" + block + ">")
+	}
+}
+
+// parseLink returns the absolute URL and the label of a link line: "=> URL [label]".
+func parseLink(baseURL, line string) (string, string) {
+	fields := strings.Fields(strings.TrimPrefix(line, "=>"))
+	if len(fields) == 0 {
+		return "", ""
+	}
+
+	link, err := resolveURL(baseURL, fields[0])
+	if err != nil {
+		return "", ""
+	}
+
+	return link, strings.Join(fields[1:], " ")
+}
+
+func splitLines(text string) []string {
+	return strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n")
+}
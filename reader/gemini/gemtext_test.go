@@ -0,0 +1,90 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gemini // import "miniflux.app/reader/gemini"
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseIndex(t *testing.T) {
+	data := "# My Gemlog\r\n## Thoughts about things\r\n\r\n=> /about.gmi About me\r\n=> 2020-05-02-second.gmi 2020-05-02 - Second post\r\n=> gemini://example.org/gemlog/first.gmi 2020-05-01 First post\r\n```\n=> ignored.gmi 2020-01-01 Preformatted\n```\n"
+
+	feed, err := ParseIndex("gemini://example.org/gemlog/", strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "My Gemlog" {
+		t.Errorf(`Unexpected title: %q`, feed.Title)
+	}
+
+	if feed.FeedURL != "gemini://example.org/gemlog/" {
+		t.Errorf(`Unexpected feed URL: %q`, feed.FeedURL)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Unexpected number of entries: %d`, len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.Title != "Second post" {
+		t.Errorf(`Unexpected entry title: %q`, entry.Title)
+	}
+
+	if entry.URL != "gemini://example.org/gemlog/2020-05-02-second.gmi" {
+		t.Errorf(`Unexpected entry URL: %q`, entry.URL)
+	}
+
+	if !entry.Date.Equal(time.Date(2020, time.May, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected entry date: %v`, entry.Date)
+	}
+
+	if entry.Hash == "" || entry.Hash == feed.Entries[1].Hash {
+		t.Errorf(`Unexpected entry hash: %q`, entry.Hash)
+	}
+}
+
+func TestParseIndexWithoutTitle(t *testing.T) {
+	feed, err := ParseIndex("gemini://example.org/", strings.NewReader("=> post.gmi 2020-05-01\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "gemini://example.org/" {
+		t.Errorf(`Unexpected title: %q`, feed.Title)
+	}
+
+	if len(feed.Entries) != 1 || feed.Entries[0].Title != "gemini://example.org/post.gmi" {
+		t.Errorf(`Unexpected entries: %v`, feed.Entries)
+	}
+}
+
+func TestToHTML(t *testing.T) {
+	data := "# Title\n## Subtitle\n### Section\nA paragraph with <script>alert(1)</script>\n\n* one\n* two\n> quote\n> continued\n```go\nif a < b {\n```\n=> https://example.org/ Web link\n=> /relative\n"
+
+	expected := `<h1>Title</h1><h2>Subtitle</h2><h3>Section</h3>` +
+		`<p>A paragraph with &lt;script&gt;alert(1)&lt;/script&gt;</p>` +
+		`<ul><li>one</li><li>two</li></ul>` +
+		`<blockquote>quote<br>continued</blockquote>` +
+		"<pre>if a &lt; b {\n</pre>" +
+		`<p><a href="https://example.org/">Web link</a></p>` +
+		`<p><a href="gemini://example.org/relative">gemini://example.org/relative</a></p>`
+
+	if result := ToHTML("gemini://example.org/post.gmi", data); result != expected {
+		t.Errorf(`Unexpected HTML: got %q instead of %q`, result, expected)
+	}
+}
+
+func TestIsGemtext(t *testing.T) {
+	if !IsGemtext("text/gemini; lang=en") {
+		t.Error(`text/gemini should be detected`)
+	}
+
+	if IsGemtext("text/plain") {
+		t.Error(`text/plain is not gemtext`)
+	}
+}
@@ -6,9 +6,12 @@ package icon // import "miniflux.app/reader/icon"
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"sort"
+	"strconv"
 	"strings"
 
 	"miniflux.app/crypto"
@@ -20,6 +23,17 @@ import (
 	"github.com/PuerkitoBio/goquery"
 )
 
+// preferredSize is the icon size in pixels displayed on high density screens.
+const preferredSize = 48
+
+// candidate is an icon declared by the website.
+type candidate struct {
+	URL string
+
+	// Size is the largest dimension declared in the "sizes" attribute, 0 when unknown and -1 for scalable icons.
+	Size int
+}
+
 // FindIcon try to find the website's icon.
 func FindIcon(websiteURL string) (*model.Icon, error) {
 	rootURL := url.RootURL(websiteURL)
@@ -33,57 +47,184 @@ func FindIcon(websiteURL string) (*model.Icon, error) {
 		return nil, fmt.Errorf("unable to download website index page: status=%d", response.StatusCode)
 	}
 
-	iconURL, err := parseDocument(rootURL, response.Body)
+	candidates, manifestURL, err := parseDocument(rootURL, response.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	if strings.HasPrefix(iconURL, "data:") {
-		return parseImageDataURL(iconURL)
+	if manifestURL != "" {
+		candidates = append(candidates, findManifestIcons(manifestURL)...)
 	}
 
-	logger.Debug("[FindIcon] Fetching icon => %s", iconURL)
-	icon, err := downloadIcon(iconURL)
-	if err != nil {
-		return nil, err
-	}
+	candidates = append(sortCandidates(candidates), candidate{URL: url.RootURL(websiteURL) + "favicon.ico"})
 
-	return icon, nil
-}
+	for _, c := range candidates {
+		logger.Debug("[FindIcon] Fetching icon => %s", c.URL)
+
+		var icon *model.Icon
+		if strings.HasPrefix(c.URL, "data:") {
+			icon, err = parseImageDataURL(c.URL)
+		} else {
+			icon, err = downloadIcon(c.URL)
+		}
 
-func parseDocument(websiteURL string, data io.Reader) (string, error) {
-	queries := []string{
-		"link[rel='shortcut icon']",
-		"link[rel='Shortcut Icon']",
-		"link[rel='icon shortcut']",
-		"link[rel='icon']",
+		if err != nil {
+			logger.Debug("[FindIcon] %v", err)
+			continue
+		}
+
+		return resizeIcon(icon), nil
 	}
 
+	return nil, fmt.Errorf("unable to find any icon for %s", websiteURL)
+}
+
+// parseDocument returns the icons declared in the page and the URL of the web app manifest.
+func parseDocument(websiteURL string, data io.Reader) ([]candidate, string, error) {
 	doc, err := goquery.NewDocumentFromReader(data)
 	if err != nil {
-		return "", fmt.Errorf("unable to read document: %v", err)
+		return nil, "", fmt.Errorf("unable to read document: %v", err)
 	}
 
-	var iconURL string
-	for _, query := range queries {
-		doc.Find(query).Each(func(i int, s *goquery.Selection) {
-			if href, exists := s.Attr("href"); exists {
-				iconURL = href
-			}
-		})
+	var candidates []candidate
+	var manifestURL string
+	doc.Find("link[rel][href]").Each(func(i int, s *goquery.Selection) {
+		href, _ := s.Attr("href")
+		absoluteURL, err := url.AbsoluteURL(websiteURL, strings.TrimSpace(href))
+		if err != nil || href == "" {
+			return
+		}
 
-		if iconURL != "" {
+		rel, _ := s.Attr("rel")
+		for _, value := range strings.Fields(strings.ToLower(rel)) {
+			switch value {
+			case "icon":
+				sizes, _ := s.Attr("sizes")
+				candidates = append(candidates, candidate{URL: absoluteURL, Size: parseSizes(sizes)})
+			case "apple-touch-icon", "apple-touch-icon-precomposed":
+				// Apple touch icons are 180x180 pixels unless specified otherwise.
+				size := 180
+				if sizes, exists := s.Attr("sizes"); exists {
+					size = parseSizes(sizes)
+				}
+				candidates = append(candidates, candidate{URL: absoluteURL, Size: size})
+			case "manifest":
+				if manifestURL == "" {
+					manifestURL = absoluteURL
+				}
+			default:
+				continue
+			}
 			break
 		}
+	})
+
+	return candidates, manifestURL, nil
+}
+
+// findManifestIcons returns the icons declared in a web app manifest.
+func findManifestIcons(manifestURL string) []candidate {
+	clt := client.New(manifestURL)
+	response, err := clt.Get()
+	if err != nil || response.HasServerFailure() {
+		logger.Debug("[FindIcon] Unable to download web app manifest %s: %v", manifestURL, err)
+		return nil
+	}
+
+	var manifest struct {
+		Icons []struct {
+			Source  string `json:"src"`
+			Sizes   string `json:"sizes"`
+			Purpose string `json:"purpose"`
+		} `json:"icons"`
 	}
 
-	if iconURL == "" {
-		iconURL = url.RootURL(websiteURL) + "favicon.ico"
-	} else {
-		iconURL, _ = url.AbsoluteURL(websiteURL, iconURL)
+	if err := json.NewDecoder(response.Body).Decode(&manifest); err != nil {
+		logger.Debug("[FindIcon] Invalid web app manifest %s: %v", manifestURL, err)
+		return nil
 	}
 
-	return iconURL, nil
+	var candidates []candidate
+	for _, icon := range manifest.Icons {
+		// Maskable and monochrome icons are cropped or recolored by the platform.
+		if icon.Purpose != "" && !strings.Contains(icon.Purpose, "any") {
+			continue
+		}
+
+		if absoluteURL, err := url.AbsoluteURL(response.EffectiveURL, icon.Source); err == nil && icon.Source != "" {
+			candidates = append(candidates, candidate{URL: absoluteURL, Size: parseSizes(icon.Sizes)})
+		}
+	}
+
+	return candidates
+}
+
+// parseSizes returns the largest dimension of a "sizes" attribute like "16x16 32x32" or "any".
+func parseSizes(sizes string) int {
+	largest := 0
+	for _, value := range strings.Fields(strings.ToLower(sizes)) {
+		if value == "any" {
+			return -1
+		}
+
+		dimensions := strings.SplitN(value, "x", 2)
+		if len(dimensions) != 2 {
+			continue
+		}
+
+		width, errWidth := strconv.Atoi(dimensions[0])
+		height, errHeight := strconv.Atoi(dimensions[1])
+		if errWidth != nil || errHeight != nil {
+			continue
+		}
+
+		if width < height {
+			width = height
+		}
+
+		if width > largest {
+			largest = width
+		}
+	}
+
+	return largest
+}
+
+// sortCandidates orders the icons by preference: the smallest icon at least as large as the preferred size,
+// then scalable icons, then smaller icons from the largest, and finally icons of unknown size in document order.
+func sortCandidates(candidates []candidate) []candidate {
+	rank := func(c candidate) (int, int) {
+		switch {
+		case c.Size >= preferredSize:
+			return 0, c.Size
+		case c.Size < 0:
+			return 1, 0
+		case c.Size > 0:
+			return 2, -c.Size
+		default:
+			return 3, 0
+		}
+	}
+
+	sorted := make([]candidate, 0, len(candidates))
+	seen := make(map[string]bool)
+	for _, c := range candidates {
+		if !seen[c.URL] {
+			seen[c.URL] = true
+			sorted = append(sorted, c)
+		}
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		groupI, orderI := rank(sorted[i])
+		groupJ, orderJ := rank(sorted[j])
+		if groupI != groupJ {
+			return groupI < groupJ
+		}
+		return orderI < orderJ
+	})
+
+	return sorted
 }
 
 func downloadIcon(iconURL string) (*model.Icon, error) {
@@ -93,7 +234,7 @@ func downloadIcon(iconURL string) (*model.Icon, error) {
 		return nil, fmt.Errorf("unable to download iconURL: %v", err)
 	}
 
-	if response.HasServerFailure() {
+	if response.HasServerFailure() || response.IsNotFound() {
 		return nil, fmt.Errorf("unable to download icon: status=%d", response.StatusCode)
 	}
 
@@ -106,6 +247,11 @@ func downloadIcon(iconURL string) (*model.Icon, error) {
 		return nil, fmt.Errorf("downloaded icon is empty, iconURL=%s", iconURL)
 	}
 
+	// Some websites return an HTML error page with a successful status code.
+	if strings.HasPrefix(strings.ToLower(response.ContentType), "text/html") {
+		return nil, fmt.Errorf("downloaded icon is not an image, iconURL=%s", iconURL)
+	}
+
 	icon := &model.Icon{
 		Hash:     crypto.HashFromBytes(body),
 		MimeType: response.ContentType,
@@ -4,7 +4,21 @@
 
 package icon // import "miniflux.app/reader/icon"
 
-import "testing"
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+)
 
 func TestParseImageDataURL(t *testing.T) {
 	iconURL := "data:image/webp;base64,UklGRhQJAABXRUJQVlA4TAcJAAAvv8AvEIU1atuOza3OCSaanSeobUa17T61bdu2bVtRbdvtDmrb7gSTdibJXOG81/d9z/vsX3utCLi1bbuJ3hKeVEymRRuaSnCVSBWIBmwP410h0IHJXDyfZCfRNhklFS/sufGPbPHPjT0vVJRkhE1BwxFZ5EhDQVjkrEjIJokVOVHMhAuyyoUpUUCbDbLLhjbRFkO+kWG+GRLT0+YTWeaTNjEdW2SaLTEtU2SbOTGVnAuyzY0nYgobZJwtMZkxD2ScB2NiEg2yTkOQcULWOZFRIvOU1Mg8FS/IPC8ckHkOXJF5riRknoT/pb1t6iwPetFIH3jNY660i/khw/3dq4W09ZbNIbN1TjOeFD2iB2T1KmIM0x0yuhOxbod81vueWK0GQDa3IuZ1kM2bifkdZPM94s4CuRxN3GUhl2KvC7kUez3I5TjiLge5/Ji4s0AuBxPzO8jmbsS8GrLZ4G9itVoM8nkssW6CjLb3BDFGaoCcdnU/KXxMb8hrnZ18Ttr82UHqILvtrO50j/vOaDKpyY/ecKWNdYJst1MP/7fxHwtYyprWtrGNrG0pfcyqDjI7r22d6V4faCJttfjOa4Y6155WMwuUpsEw5spQjW62d7tvif+H4YapCAkFYkaofB1DNJEaIqFAzAgVdrCTkaS2SCgQM0Jla/uQ1BoJBWJGqKTBTaT2SCgQM0IFfXxMEkBCgZgR/I2MJSkgoUDMCPaWmkkSSCgQM4K7pmaSBhIKxIxgLqCRJIKEAjEjePWGk1SQUCBmBO8kksgoj0BCgZgRrDn8Q+zfDXKkzaxt0gb2coX3SMVNnnG85XSAlAIxI1hXEneEzbWH6fsYpJX4zV52mlXVQ2qBmBGcWY0jXquTdYC21/En8YY7z7q6QoqBmBGc44jXag8o7Ot3Yp0DiQZiRnDeI97FYGyglTj/mgvSDMSMYCxGvG91BWcQsa6BNAMxIxgHEe9gsBbVSpwxekCSgZgRjCHEGqcBvBeJtRckGYgZwfiGWA+CeSixnoAkAzEjFDcQ73AwBxCrST2kGIgZobgP8VYDs4MWYi0LKQZiRihej3izgvsZsfaEFAMxIxRvR6yJ2oP7IrFOhxQDMSMU70+sRrAfIdYNkGIgZoTi/Yn1I9gDiTUQUgzEjFC8P7F+BHsgsQZCioGYEYp3IlYj2A8TayCkGIgZoXgT4nUE91ViXQ0pBmJGKF6GePOC+w2xTocUAzEjFPcm3sZgdtNKrH0gxUDMCMZvxDoXzDWJtxqkGIgZwXicWO+CeT6xWvWCFAMxIxgnEm9xsNr5mlifQJKBmBGMJYl3K1hbEO8aSDIQM4JR52tiTbQMGPU+It56kGQgZgTndOJ9JEDxecT7XntIMhAzgjO7ZuI9rwGK9tJKvLMhzUDMCNZNxHxXP2izi0u0Em+cWSHNQMwI1hyaiDneXVbTHqad0zF+IO4FkGggZgTveOKP9qLbXOo813vYl8T/XW9INBAzgtfBf0ntdoBUAzEjmPP5m9TqVkg2EDOCu6ZmUps3dYFkAzEj2NtoIbV4z4yQbiBmBH9jY0j1R5gJEg7EjFBBHx+Taj+kAVIOxIxQSReXGU+q2ewYdZB0IGaEyhZzj4mkam/oD4kHYkaosI8PSJW+tb06SD0QM0JFnZyjhVRnuJ3UQ/qBmBEqWcQIUpU/3GAVKEUgZoQKttNEKh/nZWdaVXsoSSBmBP8kraToAdd51Pt+MoZM86v3PetOZ9hBfx2hRIGYEewzSeFZ6mBqnZ4mBShlIGYE9xBSeAOUPRAzgtlfCyn6UTcoeyBmBPNZUngalD4QM4LXjxRvDKUPxIzgnUCKl4XSB2JG8J4kxftB6QMxI3jfkeIfzQ9lD8SM4I0hxm/2UQ/lDsSM4I0i1p/usLul9IDyBmJG8D4jfpPvfekDwxS95RlPutMljrGlxdRD2oGYEbyHSU1a/Ncl1tcR0g3EjODtT2r2l1stC6kGYkbwehhDavi69SHNQMwI5mmkpk+YF1IMxIxgdvIBqWmj7SDBQMwIbl+NpLZnQHqBmBHsdTST2l4GyQViRvDXMprU9hhILRAzQgWLGkZqOsFqkFggZoRKOtrPd6SWX+oMaQViRqhgUcd7QTOp6dGQViBmBLeXw71Pav6LLpBUIGYEb1aXaSIp7AlJBWJGcDo50RiSxtOQVCBmBKOv90gqE/SClAIxIxRvbSxJZyNIqZ35mF2hcC8TSUJnQwm30krMH93jOJtYTX/zaXNhS5m0lq0c7GxDfWoi8R+B8vXRRKx/3GpVdVBBd1sYrImY70PpOhhJrEHmgIpncivxfofSHUCcJttBVU4g1hgoW72fiNFkFajSY8RC2XYkzh5QrRWJhbI9SIxXoGp1GokxHkpWbxwxNoPqDSPGL1CyZYgxXheo3hvEeBdKthMxPoYqfkaMB6BkJxHjVaheMIEYZ0HJziXGO1C9vYizBZTscmKM1R6q1cnnxJioN5TsLOKsCdW6ljhvQtmOIc7jUKVTiXUElG0HYu0O1ejhJmI1mxHKNoBYzTaFiuvs4mfi3Qql6+RfYk10tk5QUXube4OY4y0I5XuUmF/bUxdwO1jRxb4n9uVQwn2J/ZdbbWNWKGpnXhs42SMaSQXfC1DCHhpJJT97we0uca5jHeJYk45znmsN9JJP/UsqnGAtKOWFJJ2ToZwz+J2kcqs6KOkuJJGB2kNZ69xFkrhaeyhvF2+S2v/jICh1T6+TWn9qAJS8m8dITce7WAOUvs6xWkjtnrEYZGFpw0mNXrMB5KKdPXxNqj/OIMtDTjra0eukqhM9azcBsrOg03xMqvSLIXYzM2RqAfu600cmkIr+9oKL7GQRyFyDFe3hDHd4xcd+NZ601ehbIzzuNqfbyxrmhKx219Ns5jN5bj1N6g6pkZB5EldknisHZJ4DL8g8L9TIPBXPyDwlGSdknRMZQYOs0xCTKEjIOImCmMwKGWdDTCHnimxzJSemMkO2WRDTskWm2RHT0eUTWeaTLjE9Q/6QYX4YEm3RYYvssqVDFDDjgqxyYU4UM2JDQjZJbBgRFgVLzsgiZ5YUhE1GSc0Le+48kC0e3NnzQk1JRrQNAA=="
@@ -49,3 +63,219 @@ func TestParseInvalidImageDataURL(t *testing.T) {
 		t.Fatal(`We should detect malformed image data URL`)
 	}
 }
+
+func TestParseDocument(t *testing.T) {
+	html := `<html><head>
+		<link rel="shortcut icon" href="/favicon.ico">
+		<link rel="icon" type="image/png" sizes="16x16 32x32" href="/icon-32.png">
+		<link rel="apple-touch-icon" href="/apple-touch-icon.png">
+		<link rel="mask-icon" href="/mask.svg">
+		<link rel="manifest" href="/site.webmanifest">
+	</head></html>`
+
+	candidates, manifestURL, err := parseDocument("https://example.org/", strings.NewReader(html))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []candidate{
+		{URL: "https://example.org/favicon.ico", Size: 0},
+		{URL: "https://example.org/icon-32.png", Size: 32},
+		{URL: "https://example.org/apple-touch-icon.png", Size: 180},
+	}
+
+	if !reflect.DeepEqual(candidates, expected) {
+		t.Errorf(`Unexpected candidates: %v`, candidates)
+	}
+
+	if manifestURL != "https://example.org/site.webmanifest" {
+		t.Errorf(`Unexpected manifest URL: %q`, manifestURL)
+	}
+}
+
+func TestParseSizes(t *testing.T) {
+	scenarios := map[string]int{
+		"":            0,
+		"16x16":       16,
+		"16x16 64X64": 64,
+		"48x96":       96,
+		"any":         -1,
+		"invalid":     0,
+	}
+
+	for sizes, expected := range scenarios {
+		if result := parseSizes(sizes); result != expected {
+			t.Errorf(`Unexpected size for %q: got %d instead of %d`, sizes, result, expected)
+		}
+	}
+}
+
+func TestSortCandidates(t *testing.T) {
+	candidates := []candidate{
+		{URL: "unknown.ico", Size: 0},
+		{URL: "16.png", Size: 16},
+		{URL: "512.png", Size: 512},
+		{URL: "scalable.svg", Size: -1},
+		{URL: "32.png", Size: 32},
+		{URL: "64.png", Size: 64},
+		{URL: "64.png", Size: 64},
+	}
+
+	var urls []string
+	for _, c := range sortCandidates(candidates) {
+		urls = append(urls, c.URL)
+	}
+
+	expected := []string{"64.png", "512.png", "scalable.svg", "32.png", "16.png", "unknown.ico"}
+	if !reflect.DeepEqual(urls, expected) {
+		t.Errorf(`Unexpected order: %v`, urls)
+	}
+}
+
+func TestFindIconWithManifest(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.Write([]byte(`<html><head><link rel="icon" href="/small.png" sizes="16x16"><link rel="manifest" href="/manifest.json"></head></html>`))
+		case "/manifest.json":
+			w.Write([]byte(`{"icons": [{"src": "/maskable.png", "sizes": "192x192", "purpose": "maskable"}, {"src": "/missing.png", "sizes": "64x64"}, {"src": "/large.png", "sizes": "512x512"}]}`))
+		case "/large.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write(encodePNG(t, 512, 256))
+		case "/small.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write(encodePNG(t, 16, 16))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	icon, err := FindIcon(server.URL + "/blog/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(icon.Content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if icon.MimeType != "image/png" || img.Bounds().Dx() != maxSize || img.Bounds().Dy() != maxSize/2 {
+		t.Errorf(`The large icon should be selected and resized: %s %v`, icon.MimeType, img.Bounds())
+	}
+}
+
+func TestFindIconPrefersTouchIcon(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.Write([]byte(`<html><head><link rel="shortcut icon" href="/favicon.ico"><link rel="apple-touch-icon" href="/touch.png"></head></html>`))
+		case "/favicon.ico":
+			w.Header().Set("Content-Type", "image/x-icon")
+			w.Write([]byte("ico"))
+		case "/touch.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write(encodePNG(t, 180, 180))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	icon, err := FindIcon(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(icon.Content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if icon.MimeType != "image/png" || img.Bounds().Dx() != maxSize || img.Bounds().Dy() != maxSize {
+		t.Errorf(`The touch icon should be selected and resized: %s %v`, icon.MimeType, img.Bounds())
+	}
+
+	if !strings.HasPrefix(icon.DataURL(), "image/png;base64,iVBORw0KGgo") {
+		t.Errorf(`Unexpected icon data: %s`, icon.DataURL())
+	}
+}
+
+func TestFindIconFallsBackToFavicon(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.Write([]byte(`<html><head><link rel="manifest" href="/manifest.json"></head></html>`))
+		case "/manifest.json":
+			w.Write([]byte(`{"icons": [{"src": "/maskable.png", "sizes": "192x192", "purpose": "maskable"}]}`))
+		case "/favicon.ico":
+			w.Header().Set("Content-Type", "image/x-icon")
+			w.Write([]byte("ico"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	icon, err := FindIcon(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if icon.MimeType != "image/x-icon" || string(icon.Content) != "ico" {
+		t.Errorf(`The favicon should be selected: %s %q`, icon.MimeType, icon.Content)
+	}
+}
+
+func TestResizeIconKeepsSmallIcons(t *testing.T) {
+	icon := &model.Icon{Hash: "hash", MimeType: "image/png", Content: encodePNG(t, 64, 64)}
+	if result := resizeIcon(icon); result != icon {
+		t.Error(`Small icons should not be modified`)
+	}
+
+	icon = &model.Icon{Hash: "hash", MimeType: "image/x-icon", Content: []byte("not decodable")}
+	if result := resizeIcon(icon); result != icon {
+		t.Error(`Unsupported formats should not be modified`)
+	}
+}
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 200, A: 255})
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		t.Fatal(err)
+	}
+
+	return buffer.Bytes()
+}
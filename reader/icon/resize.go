@@ -0,0 +1,110 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package icon // import "miniflux.app/reader/icon"
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+
+	// Register the decoders of the common icon formats.
+	_ "image/gif"
+	_ "image/jpeg"
+
+	"miniflux.app/crypto"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+const (
+	// maxSize is the largest dimension in pixels of a stored icon, larger images are downscaled.
+	maxSize = 128
+
+	// maxDecodedPixels avoids allocating huge images declared by small files.
+	maxDecodedPixels = 4096 * 4096
+)
+
+// resizeIcon downscales PNG, JPEG and GIF icons larger than maxSize, other formats are returned unchanged.
+func resizeIcon(icon *model.Icon) *model.Icon {
+	config, _, err := image.DecodeConfig(bytes.NewReader(icon.Content))
+	if err != nil || (config.Width <= maxSize && config.Height <= maxSize) {
+		return icon
+	}
+
+	if config.Width*config.Height > maxDecodedPixels {
+		logger.Debug("[Icon:resizeIcon] Icon too large to be resized: %dx%d", config.Width, config.Height)
+		return icon
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(icon.Content))
+	if err != nil {
+		logger.Debug("[Icon:resizeIcon] Unable to decode icon: %v", err)
+		return icon
+	}
+
+	width, height := maxSize, maxSize
+	if config.Width > config.Height {
+		height = max(1, config.Height*maxSize/config.Width)
+	} else {
+		width = max(1, config.Width*maxSize/config.Height)
+	}
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, downscale(src, width, height)); err != nil {
+		logger.Debug("[Icon:resizeIcon] Unable to encode icon: %v", err)
+		return icon
+	}
+
+	logger.Debug("[Icon:resizeIcon] Icon resized from %dx%d to %dx%d", config.Width, config.Height, width, height)
+	return &model.Icon{
+		Hash:     crypto.HashFromBytes(buffer.Bytes()),
+		MimeType: "image/png",
+		Content:  buffer.Bytes(),
+	}
+}
+
+// downscale averages the source pixels covered by each destination pixel.
+func downscale(src image.Image, width, height int) image.Image {
+	bounds := src.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*bounds.Dy()/height)
+
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*bounds.Dx()/width)
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					count++
+				}
+			}
+
+			// The colors are premultiplied by the alpha channel.
+			pixel := color.RGBA64{
+				R: uint16(r / count),
+				G: uint16(g / count),
+				B: uint16(b / count),
+				A: uint16(a / count),
+			}
+			dst.Set(x, y, pixel)
+		}
+	}
+
+	return dst
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,10 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package identity computes the hash identifying an entry according to the identity strategy of its feed.
+
+*/
+package identity // import "miniflux.app/reader/identity"
@@ -0,0 +1,104 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package identity // import "miniflux.app/reader/identity"
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+)
+
+// EntryHash returns the hash of the entry according to the identity strategy of the feed.
+//
+// The hash computed by the parser is used with the default strategy, or when the entry doesn't have the required value.
+func EntryHash(strategy string, entry *model.Entry) string {
+	guidHash := entry.GUIDHash
+	if guidHash == "" {
+		guidHash = entry.Hash
+	}
+
+	var value string
+	switch strategy {
+	case model.EntryIdentityURL:
+		value = strings.TrimSpace(entry.URL)
+	case model.EntryIdentityCanonicalURL:
+		value = CanonicalURL(entry.URL)
+	case model.EntryIdentityTitleDate:
+		// The current time is used by the parsers when the date is unknown, it changes on each refresh.
+		if title := normalizeText(entry.Title); title != "" && !entry.DateUnknown {
+			value = title + "|" + entry.Date.UTC().Truncate(time.Second).Format(time.RFC3339)
+		}
+	case model.EntryIdentityContent:
+		value = normalizeText(sanitizer.StripTags(entry.Content))
+	}
+
+	if value == "" {
+		return guidHash
+	}
+
+	return crypto.Hash(strategy + ":" + value)
+}
+
+// EntryHashes returns the hash of the entry for each identity strategy.
+func EntryHashes(entry *model.Entry) model.EntryIdentityHashes {
+	hashes := make(model.EntryIdentityHashes, len(model.EntryIdentities))
+	for _, strategy := range model.EntryIdentities {
+		hashes[strategy] = EntryHash(strategy, entry)
+	}
+
+	return hashes
+}
+
+// StoredEntryHash returns the hash of a stored entry according to the identity strategy.
+//
+// The hashes computed when the entry was received are used, the stored title and content are modified by the processing.
+// Entries received before these hashes only keep the URL strategies, the stored URL is the one used to compute them.
+func StoredEntryHash(strategy string, entry *model.Entry) string {
+	if strategy == "" {
+		strategy = model.EntryIdentityGUID
+	}
+
+	if hash, found := entry.IdentityHashes[strategy]; found {
+		return hash
+	}
+
+	switch strategy {
+	case model.EntryIdentityURL, model.EntryIdentityCanonicalURL:
+		return EntryHash(strategy, entry)
+	default:
+		return EntryHash(model.EntryIdentityGUID, entry)
+	}
+}
+
+// CanonicalURL returns the URL without scheme, "www." prefix, default port, fragment and trailing slash.
+// The query string parameters are sorted.
+func CanonicalURL(rawURL string) string {
+	rawURL = strings.TrimSpace(rawURL)
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+
+	host := strings.ToLower(u.Hostname())
+	host = strings.TrimPrefix(host, "www.")
+	if port := u.Port(); port != "" && port != "80" && port != "443" {
+		host += ":" + port
+	}
+
+	canonicalURL := host + strings.TrimRight(u.EscapedPath(), "/")
+	if query := u.Query(); len(query) > 0 {
+		canonicalURL += "?" + query.Encode()
+	}
+
+	return canonicalURL
+}
+
+func normalizeText(text string) string {
+	return strings.ToLower(strings.Join(strings.Fields(text), " "))
+}
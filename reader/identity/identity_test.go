@@ -0,0 +1,133 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package identity // import "miniflux.app/reader/identity"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestCanonicalURL(t *testing.T) {
+	scenarios := map[string]string{
+		"https://www.Example.org/news/story/":      "example.org/news/story",
+		"http://example.org:80/news/story#comment": "example.org/news/story",
+		"https://example.org:8443/story":           "example.org:8443/story",
+		"https://example.org/story?b=2&a=1":        "example.org/story?a=1&b=2",
+		"https://example.org/":                     "example.org",
+		"not a URL":                                "not a URL",
+	}
+
+	for input, expected := range scenarios {
+		if output := CanonicalURL(input); output != expected {
+			t.Errorf(`Wrong canonical URL for %q, got %q instead of %q`, input, output, expected)
+		}
+	}
+}
+
+func TestEntryHashWithDefaultStrategy(t *testing.T) {
+	entry := &model.Entry{Hash: "parser", URL: "https://example.org/a"}
+	for _, strategy := range []string{"", model.EntryIdentityGUID} {
+		if hash := EntryHash(strategy, entry); hash != "parser" {
+			t.Errorf(`The hash of the parser should be used with the strategy %q, got %q`, strategy, hash)
+		}
+	}
+
+	entry.Hash = "changed"
+	entry.GUIDHash = "parser"
+	if hash := EntryHash(model.EntryIdentityGUID, entry); hash != "parser" {
+		t.Errorf(`The original hash of the parser should be used, got %q`, hash)
+	}
+}
+
+func TestEntryHashWithURLStrategies(t *testing.T) {
+	a := &model.Entry{Hash: "1", URL: "https://www.example.org/story/"}
+	b := &model.Entry{Hash: "2", URL: "http://example.org/story"}
+
+	if EntryHash(model.EntryIdentityURL, a) == EntryHash(model.EntryIdentityURL, b) {
+		t.Error(`Different URLs should have different hashes`)
+	}
+
+	if EntryHash(model.EntryIdentityCanonicalURL, a) != EntryHash(model.EntryIdentityCanonicalURL, b) {
+		t.Error(`Equivalent URLs should have the same canonical hash`)
+	}
+}
+
+func TestEntryHashWithTitleDateStrategy(t *testing.T) {
+	date := time.Date(2020, time.March, 1, 10, 0, 0, 0, time.UTC)
+	a := &model.Entry{Hash: "1", Title: "Breaking  News", Date: date}
+	b := &model.Entry{Hash: "2", Title: "breaking news", Date: date.In(time.FixedZone("EST", -5*3600))}
+	c := &model.Entry{Hash: "3", Title: "Breaking News", Date: date.Add(time.Hour)}
+
+	if EntryHash(model.EntryIdentityTitleDate, a) != EntryHash(model.EntryIdentityTitleDate, b) {
+		t.Error(`The same title published at the same time should have the same hash`)
+	}
+
+	if EntryHash(model.EntryIdentityTitleDate, a) == EntryHash(model.EntryIdentityTitleDate, c) {
+		t.Error(`Entries published at different times should have different hashes`)
+	}
+}
+
+func TestEntryHashWithTitleAndUnknownDate(t *testing.T) {
+	entry := &model.Entry{Hash: "parser", Title: "Breaking News"}
+	entry.SetDate(time.Time{})
+
+	if hash := EntryHash(model.EntryIdentityTitleDate, entry); hash != "parser" {
+		t.Errorf(`The hash of the parser should be used when the date is unknown, got %q`, hash)
+	}
+}
+
+func TestEntryHashWithContentStrategy(t *testing.T) {
+	a := &model.Entry{Hash: "1", Content: "<p>Some   <b>content</b></p>"}
+	b := &model.Entry{Hash: "2", Content: "<div>Some content</div>"}
+
+	if EntryHash(model.EntryIdentityContent, a) != EntryHash(model.EntryIdentityContent, b) {
+		t.Error(`The same text should have the same hash`)
+	}
+
+	empty := &model.Entry{Hash: "parser"}
+	if hash := EntryHash(model.EntryIdentityContent, empty); hash != "parser" {
+		t.Errorf(`The hash of the parser should be used when there is no content, got %q`, hash)
+	}
+}
+
+func TestEntryHashes(t *testing.T) {
+	entry := &model.Entry{Hash: "parser", URL: "https://example.org/a", Title: "Title", Content: "Content"}
+	hashes := EntryHashes(entry)
+
+	for _, strategy := range model.EntryIdentities {
+		if hashes[strategy] != EntryHash(strategy, entry) {
+			t.Errorf(`Wrong hash for the strategy %q`, strategy)
+		}
+	}
+}
+
+func TestStoredEntryHash(t *testing.T) {
+	received := &model.Entry{Hash: "parser", Title: "Title", Date: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Content: "<p>Content</p>"}
+	stored := &model.Entry{Hash: "parser", IdentityHashes: EntryHashes(received), Title: "Rewritten title", Content: "<p>Crawled content</p>"}
+
+	for _, strategy := range []string{model.EntryIdentityTitleDate, model.EntryIdentityContent} {
+		if hash := StoredEntryHash(strategy, stored); hash != EntryHash(strategy, received) {
+			t.Errorf(`The hash computed when the entry was received should be used with the strategy %q, got %q`, strategy, hash)
+		}
+	}
+
+	if hash := StoredEntryHash("", stored); hash != "parser" {
+		t.Errorf(`The hash of the parser should be used with the default strategy, got %q`, hash)
+	}
+}
+
+func TestStoredEntryHashWithoutIdentityHashes(t *testing.T) {
+	stored := &model.Entry{Hash: "parser", URL: "https://example.org/a", Title: "Title", Content: "Content"}
+
+	if hash := StoredEntryHash(model.EntryIdentityURL, stored); hash != EntryHash(model.EntryIdentityURL, stored) {
+		t.Errorf(`The stored URL should be used, got %q`, hash)
+	}
+
+	if hash := StoredEntryHash(model.EntryIdentityContent, stored); hash != "parser" {
+		t.Errorf(`The hash of the parser should be used when the content hash is unknown, got %q`, hash)
+	}
+}
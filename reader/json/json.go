@@ -41,6 +41,7 @@ type jsonItem struct {
 	DateModified  string           `json:"date_modified"`
 	Author        jsonAuthor       `json:"author"`
 	Attachments   []jsonAttachment `json:"attachments"`
+	Tags          []string         `json:"tags"`
 }
 
 type jsonAttachment struct {
@@ -88,14 +89,14 @@ func (j *jsonItem) GetDate() time.Time {
 			d, err := date.Parse(value)
 			if err != nil {
 				logger.Error("json: %v", err)
-				return time.Now()
+				return time.Time{}
 			}
 
 			return d
 		}
 	}
 
-	return time.Now()
+	return time.Time{}
 }
 
 func (j *jsonItem) GetAuthor() string {
@@ -153,12 +154,13 @@ func (j *jsonItem) GetEnclosures() model.EnclosureList {
 func (j *jsonItem) Transform() *model.Entry {
 	entry := new(model.Entry)
 	entry.URL = j.URL
-	entry.Date = j.GetDate()
+	entry.SetDate(j.GetDate())
 	entry.Author = j.GetAuthor()
 	entry.Hash = j.GetHash()
 	entry.Content = j.GetContent()
 	entry.Title = strings.TrimSpace(j.GetTitle())
 	entry.Enclosures = j.GetEnclosures()
+	entry.AddTags(j.Tags...)
 	return entry
 }
 
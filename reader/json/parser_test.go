@@ -416,3 +416,29 @@ func TestParseInvalidJSON(t *testing.T) {
 		t.Error("Parse should returns an error")
 	}
 }
+
+func TestParseTags(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1",
+		"title": "Example",
+		"home_page_url": "https://example.org/",
+		"items": [
+			{
+				"id": "1",
+				"url": "https://example.org/1",
+				"content_text": "Text",
+				"tags": ["Go", "Open Source", ""]
+			}
+		]
+	}`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := feed.Entries[0].Tags
+	if len(tags) != 2 || tags[0] != "Go" || tags[1] != "Open Source" {
+		t.Errorf("Incorrect entry tags, got: %v", tags)
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package local reads feeds from local files and from the output of commands.
+
+Only the files located in the directory defined by LOCAL_SOURCES_DIRECTORY can be used.
+
+*/
+package local // import "miniflux.app/reader/local"
@@ -0,0 +1,221 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package local // import "miniflux.app/reader/local"
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	url_parser "net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/errors"
+	"miniflux.app/http/client"
+	"miniflux.app/model"
+)
+
+var (
+	errDisabled       = "Local sources are disabled"
+	errInvalidSource  = "Local sources must be absolute file:// URLs: %q"
+	errForbiddenPath  = "This file is outside of the local sources directory: %q"
+	errUnreadable     = "Unable to read this file: %v"
+	errNotRegular     = "This local source is not a regular file: %q"
+	errCommandTimeout = "The command has been stopped after %d seconds"
+	errCommandFailed  = "The command failed: %v %s"
+	errTooLarge       = "This feed is larger than the maximum size allowed (%d bytes)"
+	errEmptyFeed      = "This feed is empty"
+	errEncoding       = "Unable to normalize encoding: %q"
+)
+
+// Maximum number of bytes of the error output reported when a command fails.
+const maxErrorOutput = 512
+
+// Exec reads the file, or runs the command, of a local source and returns the output as a response.
+func Exec(sourceType, sourceURL string) (*client.Response, *errors.LocalizedError) {
+	if !config.Opts.HasLocalSources() {
+		return nil, errors.NewLocalizedError(errDisabled)
+	}
+
+	directory, err := filepath.EvalSymlinks(config.Opts.LocalSourcesDirectory())
+	if err != nil {
+		return nil, errors.NewLocalizedError(errUnreadable, err)
+	}
+
+	filename, resolveErr := resolvePath(directory, sourceURL)
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	var data []byte
+	var lastModified string
+	var readErr *errors.LocalizedError
+	if sourceType == model.FeedSourceTypeCommand {
+		data, readErr = runCommand(directory, filename)
+	} else {
+		data, lastModified, readErr = readFile(filename)
+	}
+
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, errors.NewLocalizedError(errEmptyFeed)
+	}
+
+	response := &client.Response{
+		Body:          bytes.NewReader(data),
+		StatusCode:    http.StatusOK,
+		EffectiveURL:  sourceURL,
+		LastModified:  lastModified,
+		ContentType:   detectContentType(data),
+		ContentLength: int64(len(data)),
+	}
+
+	if err := response.EnsureUnicodeBody(); err != nil {
+		return nil, errors.NewLocalizedError(errEncoding, err)
+	}
+
+	return response, nil
+}
+
+// resolvePath converts the URL to a filename and makes sure the file is inside the directory, symlinks included.
+func resolvePath(directory, sourceURL string) (string, *errors.LocalizedError) {
+	u, err := url_parser.Parse(sourceURL)
+	if err != nil || u.Scheme != "file" || (u.Host != "" && u.Host != "localhost") || !filepath.IsAbs(u.Path) {
+		return "", errors.NewLocalizedError(errInvalidSource, sourceURL)
+	}
+
+	filename, err := filepath.EvalSymlinks(filepath.Clean(u.Path))
+	if err != nil {
+		return "", errors.NewLocalizedError(errUnreadable, err)
+	}
+
+	if !strings.HasPrefix(filename, directory+string(filepath.Separator)) {
+		return "", errors.NewLocalizedError(errForbiddenPath, sourceURL)
+	}
+
+	info, err := os.Stat(filename)
+	if err != nil {
+		return "", errors.NewLocalizedError(errUnreadable, err)
+	}
+
+	if !info.Mode().IsRegular() {
+		return "", errors.NewLocalizedError(errNotRegular, sourceURL)
+	}
+
+	return filename, nil
+}
+
+func readFile(filename string) ([]byte, string, *errors.LocalizedError) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, "", errors.NewLocalizedError(errUnreadable, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, "", errors.NewLocalizedError(errUnreadable, err)
+	}
+
+	data, readErr := readAll(f)
+	if readErr != nil {
+		return nil, "", readErr
+	}
+
+	return data, info.ModTime().UTC().Format(http.TimeFormat), nil
+}
+
+// runCommand executes the file without shell and without arguments, in a sandboxed environment.
+func runCommand(directory, filename string) ([]byte, *errors.LocalizedError) {
+	timeout := config.Opts.LocalSourcesTimeout()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	// The output is written to temporary files instead of pipes,
+	// otherwise a child process keeping a pipe open could block us after the timeout.
+	stdout, err := ioutil.TempFile("", "miniflux-stdout-")
+	if err != nil {
+		return nil, errors.NewLocalizedError(errCommandFailed, err, "")
+	}
+	defer os.Remove(stdout.Name())
+	defer stdout.Close()
+
+	stderr, err := ioutil.TempFile("", "miniflux-stderr-")
+	if err != nil {
+		return nil, errors.NewLocalizedError(errCommandFailed, err, "")
+	}
+	defer os.Remove(stderr.Name())
+	defer stderr.Close()
+
+	cmd := exec.CommandContext(ctx, filename)
+	cmd.Dir = directory
+	cmd.Env = sandboxEnvironment(directory)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err = cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, errors.NewLocalizedError(errCommandTimeout, timeout)
+	}
+
+	if err != nil {
+		stderr.Seek(0, io.SeekStart)
+		message, _ := ioutil.ReadAll(io.LimitReader(stderr, maxErrorOutput))
+		return nil, errors.NewLocalizedError(errCommandFailed, err, strings.TrimSpace(string(message)))
+	}
+
+	if _, err := stdout.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.NewLocalizedError(errUnreadable, err)
+	}
+
+	return readAll(stdout)
+}
+
+// sandboxEnvironment returns the environment variables of commands.
+// The environment of Miniflux is not inherited because it contains secrets like the database URL.
+func sandboxEnvironment(directory string) []string {
+	return []string{
+		"PATH=/usr/local/bin:/usr/bin:/bin",
+		"HOME=" + directory,
+		"TMPDIR=" + os.TempDir(),
+		"LANG=C.UTF-8",
+	}
+}
+
+func readAll(r io.Reader) ([]byte, *errors.LocalizedError) {
+	maxBodySize := config.Opts.HTTPClientMaxBodySize()
+	data, err := ioutil.ReadAll(io.LimitReader(r, maxBodySize+1))
+	if err != nil {
+		return nil, errors.NewLocalizedError(errUnreadable, err)
+	}
+
+	if int64(len(data)) > maxBodySize {
+		return nil, errors.NewLocalizedError(errTooLarge, maxBodySize)
+	}
+
+	return data, nil
+}
+
+// detectContentType helps the encoding detection, the encoding of XML documents is defined in the prolog.
+func detectContentType(data []byte) string {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(data, []byte("{")):
+		return "application/json"
+	case bytes.HasPrefix(data, []byte("<")):
+		return "application/xml"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package local // import "miniflux.app/reader/local"
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+)
+
+const feedData = `<?xml version="1.0" encoding="utf-8"?><rss version="2.0"><channel><title>Reports</title></channel></rss>`
+
+func setupDirectory(t *testing.T, timeout string) string {
+	directory, err := ioutil.TempDir("", "miniflux-local-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	os.Clearenv()
+	os.Setenv("LOCAL_SOURCES_DIRECTORY", directory)
+	os.Setenv("LOCAL_SOURCES_TIMEOUT", timeout)
+	os.Setenv("DATABASE_URL", "postgres://secret")
+
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	return directory
+}
+
+func writeFile(t *testing.T, filename, content string, mode os.FileMode) {
+	if err := ioutil.WriteFile(filename, []byte(content), mode); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExecWhenDisabled(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if _, err := Exec(model.FeedSourceTypeFile, "file:///etc/hostname"); err == nil {
+		t.Error(`Local sources should be disabled by default`)
+	}
+}
+
+func TestExecFile(t *testing.T) {
+	directory := setupDirectory(t, "5")
+	defer os.RemoveAll(directory)
+
+	writeFile(t, filepath.Join(directory, "feed.xml"), feedData, 0644)
+
+	response, err := Exec(model.FeedSourceTypeFile, "file://"+filepath.Join(directory, "feed.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if body := response.BodyAsString(); body != feedData {
+		t.Errorf(`Unexpected body: %q`, body)
+	}
+
+	if response.LastModified == "" || response.IsModified("", response.LastModified) {
+		t.Errorf(`Unchanged files should not be parsed again`)
+	}
+}
+
+func TestExecFileOutsideDirectory(t *testing.T) {
+	directory := setupDirectory(t, "5")
+	defer os.RemoveAll(directory)
+
+	outside, err := ioutil.TempFile("", "miniflux-outside-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(outside.Name())
+	outside.Close()
+
+	if err := os.Symlink(outside.Name(), filepath.Join(directory, "link.xml")); err != nil {
+		t.Fatal(err)
+	}
+
+	sources := []string{
+		"file://" + outside.Name(),
+		"file://" + filepath.Join(directory, "link.xml"),
+		"file://" + directory + "/../" + filepath.Base(outside.Name()),
+		"feed.xml",
+		"https://example.org/feed.xml",
+	}
+
+	for _, source := range sources {
+		if _, err := Exec(model.FeedSourceTypeFile, source); err == nil {
+			t.Errorf(`The source %q should be rejected`, source)
+		}
+	}
+}
+
+func TestExecCommand(t *testing.T) {
+	directory := setupDirectory(t, "5")
+	defer os.RemoveAll(directory)
+
+	script := "#!/bin/sh\necho '" + feedData + "'\necho \"<!-- $DATABASE_URL -->\"\n"
+	writeFile(t, filepath.Join(directory, "report.sh"), script, 0755)
+
+	response, err := Exec(model.FeedSourceTypeCommand, "file://"+filepath.Join(directory, "report.sh"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := response.BodyAsString()
+	if !strings.HasPrefix(body, feedData) {
+		t.Errorf(`Unexpected body: %q`, body)
+	}
+
+	if strings.Contains(body, "secret") {
+		t.Errorf(`The environment should not be inherited: %q`, body)
+	}
+}
+
+func TestExecCommandFailure(t *testing.T) {
+	directory := setupDirectory(t, "5")
+	defer os.RemoveAll(directory)
+
+	writeFile(t, filepath.Join(directory, "fail.sh"), "#!/bin/sh\necho 'database is down' >&2\nexit 2\n", 0755)
+
+	_, err := Exec(model.FeedSourceTypeCommand, "file://"+filepath.Join(directory, "fail.sh"))
+	if err == nil || !strings.Contains(err.Error(), "database is down") {
+		t.Errorf(`The error output should be reported: %v`, err)
+	}
+}
+
+func TestExecCommandTimeout(t *testing.T) {
+	directory := setupDirectory(t, "1")
+	defer os.RemoveAll(directory)
+
+	writeFile(t, filepath.Join(directory, "slow.sh"), "#!/bin/sh\nsleep 10\n", 0755)
+
+	_, err := Exec(model.FeedSourceTypeCommand, "file://"+filepath.Join(directory, "slow.sh"))
+	if err == nil || !strings.Contains(err.Error(), "stopped") {
+		t.Errorf(`The command should be stopped: %v`, err)
+	}
+}
@@ -8,30 +8,38 @@ import (
 	"miniflux.app/url"
 )
 
-type apiResponse struct {
-	Title string`json:"title"`
-	Author string`json:"author"`
-	PubDate string`json:"date_published"`
-	Content string`json:"content"`
-	URL string`json:"url"`
+// Response is the article returned by a Mercury/Postlight compatible parser API.
+type Response struct {
+	Title        string `json:"title"`
+	Author       string `json:"author"`
+	PubDate      string `json:"date_published"`
+	Excerpt      string `json:"excerpt"`
+	LeadImageURL string `json:"lead_image_url"`
+	Content      string `json:"content"`
+	URL          string `json:"url"`
 }
 
-func Fetch(entryURL, mercury_api string)  (string, error) {
+// Fetch asks the parser API to extract the article of the given URL.
+func Fetch(entryURL, mercuryAPIURL string) (*Response, error) {
 	params := map[string]string{
 		"url": entryURL,
 	}
 
-
-	clt := client.New(url.AddQueryString(mercury_api, params))
+	clt := client.New(url.AddQueryString(mercuryAPIURL, params))
 	resp, err := clt.Get()
 	if err != nil {
-		return "", fmt.Errorf("mercury: unable to fetch %s error: %v", entryURL, err)
+		return nil, fmt.Errorf("mercury: unable to fetch %s error: %v", entryURL, err)
+	}
+
+	if resp.HasServerFailure() {
+		return nil, fmt.Errorf("mercury: unable to fetch %s, status code: %d", entryURL, resp.StatusCode)
 	}
-	var apiResp apiResponse
+
+	var apiResp Response
 	decode := json.NewDecoder(resp.Body)
 	if err := decode.Decode(&apiResp); err != nil {
-		return "", fmt.Errorf("wallabag: unable to decode token response: %v", err)
+		return nil, fmt.Errorf("mercury: unable to decode parser response: %v", err)
 	}
 
-	return apiResp.Content, nil
+	return &apiResp, nil
 }
@@ -0,0 +1,12 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package microformats provides a parser for web pages using the h-feed and h-entry microformats.
+
+See http://microformats.org/wiki/microformats2 for the specification.
+
+*/
+package microformats // import "miniflux.app/reader/microformats"
@@ -0,0 +1,385 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package microformats // import "miniflux.app/reader/microformats"
+
+import (
+	"html"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"miniflux.app/crypto"
+	"miniflux.app/errors"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/date"
+	"miniflux.app/url"
+
+	"github.com/PuerkitoBio/goquery"
+	nethtml "golang.org/x/net/html"
+)
+
+// Titles longer than this limit are truncated, notes usually don't have a title.
+const maxTitleLength = 100
+
+var rootClassRegex = regexp.MustCompile(`^h-([a-z0-9]+-)?[a-z]+$`)
+
+// HasEntries returns true if the HTML document contains h-entry elements.
+func HasEntries(data string) bool {
+	if !strings.Contains(data, "h-entry") {
+		return false
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(data))
+	if err != nil {
+		return false
+	}
+
+	return doc.Find(".h-entry").Length() > 0
+}
+
+// Parse returns a normalized feed struct from a web page using the h-feed and h-entry microformats.
+//
+// The first h-feed of the page is used, otherwise all top-level h-entry elements are returned.
+func Parse(websiteURL string, data io.Reader) (*model.Feed, *errors.LocalizedError) {
+	doc, err := goquery.NewDocumentFromReader(data)
+	if err != nil {
+		return nil, errors.NewLocalizedError("Unable to parse microformats: %q", err)
+	}
+
+	baseURL := websiteURL
+	if href, exists := doc.Find("base[href]").First().Attr("href"); exists {
+		if absoluteURL, err := url.AbsoluteURL(websiteURL, strings.TrimSpace(href)); err == nil {
+			baseURL = absoluteURL
+		}
+	}
+
+	feed := new(model.Feed)
+	feed.FeedURL = websiteURL
+	feed.SiteURL = websiteURL
+
+	var feedAuthor string
+	root := doc.Find(".h-feed").First()
+	if root.Length() > 0 {
+		feed.Title = textValue(findProperty(root, "p-name"))
+		feedAuthor = authorValue(findProperty(root, "p-author"))
+	} else {
+		root = doc.Selection
+	}
+
+	if feed.Title == "" {
+		feed.Title = normalizeSpaces(doc.Find("title").First().Text())
+	}
+
+	if feed.Title == "" {
+		feed.Title = websiteURL
+	}
+
+	for _, node := range findEntries(root) {
+		entry := transformEntry(websiteURL, baseURL, goquery.NewDocumentFromNode(node).Selection)
+		if entry.Author == "" {
+			entry.Author = feedAuthor
+		}
+
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return feed, nil
+}
+
+func transformEntry(websiteURL, baseURL string, item *goquery.Selection) *model.Entry {
+	entry := new(model.Entry)
+	entry.URL = getURL(baseURL, item)
+	entry.Content = getContent(item)
+	entry.Title = getTitle(item, entry.Content)
+	entry.SetDate(getDate(item))
+	entry.Author = authorValue(findProperty(item, "p-author"))
+
+	if entry.Title == "" {
+		entry.Title = entry.URL
+	}
+
+	// Entries without their own link share the page URL, the hash must be based on something else.
+	uid := urlValue(baseURL, findProperty(item, "u-uid"))
+	switch {
+	case uid != "":
+		entry.Hash = crypto.Hash(uid)
+	case entry.URL != "" && entry.URL != websiteURL:
+		entry.Hash = crypto.Hash(entry.URL)
+	default:
+		entry.URL = websiteURL
+		entry.Hash = crypto.Hash(entry.Title + entry.Content)
+	}
+
+	return entry
+}
+
+func getURL(baseURL string, item *goquery.Selection) string {
+	if value := urlValue(baseURL, findProperty(item, "u-url")); value != "" {
+		return value
+	}
+
+	// Implied URL: the entry is a link, or contains a single link which is not another microformat.
+	node := item.Get(0)
+	if node.Data == "a" {
+		return urlValue(baseURL, item)
+	}
+
+	links := item.ChildrenFiltered("a[href]")
+	if links.Length() == 1 && !isRoot(links.Get(0)) {
+		return urlValue(baseURL, links)
+	}
+
+	return ""
+}
+
+func getContent(item *goquery.Selection) string {
+	if content := findProperty(item, "e-content"); content != nil {
+		value, _ := content.Html()
+		return strings.TrimSpace(value)
+	}
+
+	if summary := textValue(findProperty(item, "p-summary")); summary != "" {
+		return html.EscapeString(summary)
+	}
+
+	return ""
+}
+
+func getTitle(item *goquery.Selection, content string) string {
+	name := findProperty(item, "p-name")
+
+	// Notes often use the same element for the name and the content.
+	if name == nil || name.HasClass("e-content") {
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+		if err != nil {
+			return ""
+		}
+
+		return truncate(normalizeSpaces(doc.Text()))
+	}
+
+	return truncate(textValue(name))
+}
+
+func getDate(item *goquery.Selection) time.Time {
+	for _, property := range []string{"dt-published", "dt-updated"} {
+		value := dateValue(findProperty(item, property))
+		if value == "" {
+			continue
+		}
+
+		result, err := date.Parse(value)
+		if err != nil {
+			logger.Error("microformats: %v", err)
+			continue
+		}
+
+		return result
+	}
+
+	return time.Time{}
+}
+
+// findEntries returns the h-entry elements that are not nested in another h-entry.
+func findEntries(root *goquery.Selection) []*nethtml.Node {
+	var entries []*nethtml.Node
+
+	var walk func(*nethtml.Node)
+	walk = func(node *nethtml.Node) {
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type != nethtml.ElementNode {
+				continue
+			}
+
+			if hasClass(child, "h-entry") {
+				entries = append(entries, child)
+				continue
+			}
+
+			walk(child)
+		}
+	}
+
+	for _, node := range root.Nodes {
+		walk(node)
+	}
+
+	return entries
+}
+
+// findProperty returns the first element with the given property class.
+// Properties of nested microformats are ignored, but a nested microformat can be a property itself.
+func findProperty(root *goquery.Selection, class string) *goquery.Selection {
+	var found *nethtml.Node
+
+	var walk func(*nethtml.Node) bool
+	walk = func(node *nethtml.Node) bool {
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type != nethtml.ElementNode {
+				continue
+			}
+
+			if hasClass(child, class) {
+				found = child
+				return true
+			}
+
+			if !isRoot(child) && walk(child) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, node := range root.Nodes {
+		if walk(node) {
+			return goquery.NewDocumentFromNode(found).Selection
+		}
+	}
+
+	return nil
+}
+
+func textValue(property *goquery.Selection) string {
+	if property == nil {
+		return ""
+	}
+
+	switch property.Get(0).Data {
+	case "img", "area":
+		return normalizeSpaces(property.AttrOr("alt", ""))
+	case "abbr", "link":
+		if value, exists := property.Attr("title"); exists {
+			return normalizeSpaces(value)
+		}
+	case "data", "input":
+		if value, exists := property.Attr("value"); exists {
+			return normalizeSpaces(value)
+		}
+	}
+
+	return normalizeSpaces(property.Text())
+}
+
+func urlValue(baseURL string, property *goquery.Selection) string {
+	if property == nil {
+		return ""
+	}
+
+	var value string
+	switch property.Get(0).Data {
+	case "a", "area", "link":
+		value = property.AttrOr("href", "")
+	case "img", "audio", "video", "source", "iframe":
+		value = property.AttrOr("src", "")
+	case "object":
+		value = property.AttrOr("data", "")
+	default:
+		value = textValue(property)
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+
+	absoluteURL, err := url.AbsoluteURL(baseURL, value)
+	if err != nil {
+		return ""
+	}
+
+	return absoluteURL
+}
+
+func dateValue(property *goquery.Selection) string {
+	if property == nil {
+		return ""
+	}
+
+	switch property.Get(0).Data {
+	case "time", "ins", "del":
+		if value, exists := property.Attr("datetime"); exists {
+			return strings.TrimSpace(value)
+		}
+	case "abbr":
+		if value, exists := property.Attr("title"); exists {
+			return strings.TrimSpace(value)
+		}
+	case "data", "input":
+		if value, exists := property.Attr("value"); exists {
+			return strings.TrimSpace(value)
+		}
+	}
+
+	return normalizeSpaces(property.Text())
+}
+
+// authorValue returns the name of an author, which can be plain text or an h-card.
+func authorValue(property *goquery.Selection) string {
+	if property == nil {
+		return ""
+	}
+
+	if isRoot(property.Get(0)) {
+		if name := textValue(findProperty(property, "p-name")); name != "" {
+			return name
+		}
+	}
+
+	return textValue(property)
+}
+
+func hasClass(node *nethtml.Node, class string) bool {
+	for _, attr := range node.Attr {
+		if attr.Key != "class" {
+			continue
+		}
+
+		for _, value := range strings.Fields(attr.Val) {
+			if value == class {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isRoot(node *nethtml.Node) bool {
+	for _, attr := range node.Attr {
+		if attr.Key != "class" {
+			continue
+		}
+
+		for _, value := range strings.Fields(attr.Val) {
+			if rootClassRegex.MatchString(value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func truncate(text string) string {
+	if utf8.RuneCountInString(text) <= maxTitleLength {
+		return text
+	}
+
+	runes := []rune(text)[:maxTitleLength]
+	if index := strings.LastIndex(string(runes), " "); index > 0 {
+		return string(runes)[:index] + "…"
+	}
+
+	return string(runes) + "…"
+}
+
+func normalizeSpaces(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
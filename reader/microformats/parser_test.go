@@ -0,0 +1,168 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package microformats // import "miniflux.app/reader/microformats"
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const hfeedPage = `<html>
+<head><title>Page Title</title></head>
+<body>
+	<div class="h-feed">
+		<h1 class="p-name">Jane's Blog</h1>
+		<a class="p-author h-card" href="/">Jane Doe</a>
+		<article class="h-entry">
+			<h2><a class="p-name u-url" href="/2020/first-post">First Post</a></h2>
+			<time class="dt-published" datetime="2020-03-01T10:00:00Z">March 1st</time>
+			<div class="e-content"><p>Hello <b>world</b></p></div>
+		</article>
+		<article class="h-entry">
+			<span class="p-author h-card"><img class="u-photo" src="/bob.jpg" alt=""><span class="p-name">Bob</span></span>
+			<div class="p-name e-content">A short note without title</div>
+			<a class="u-url" href="https://example.org/notes/2"><time class="dt-published" datetime="2020-03-02T08:30:00+01:00">8:30</time></a>
+			<blockquote class="h-cite"><a class="p-name u-url" href="https://other.example.org/">Quoted post</a></blockquote>
+		</article>
+	</div>
+</body>
+</html>`
+
+func TestParseHFeed(t *testing.T) {
+	feed, err := Parse("https://example.org/blog/", strings.NewReader(hfeedPage))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "Jane's Blog" {
+		t.Errorf(`Unexpected feed title: %q`, feed.Title)
+	}
+
+	if feed.FeedURL != "https://example.org/blog/" || feed.SiteURL != "https://example.org/blog/" {
+		t.Errorf(`Unexpected feed URLs: %q, %q`, feed.FeedURL, feed.SiteURL)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Unexpected number of entries: %d`, len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.Title != "First Post" {
+		t.Errorf(`Unexpected title: %q`, entry.Title)
+	}
+
+	if entry.URL != "https://example.org/2020/first-post" {
+		t.Errorf(`Unexpected URL: %q`, entry.URL)
+	}
+
+	if entry.Content != "<p>Hello <b>world</b></p>" {
+		t.Errorf(`Unexpected content: %q`, entry.Content)
+	}
+
+	if !entry.Date.Equal(time.Date(2020, time.March, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected date: %v`, entry.Date)
+	}
+
+	if entry.Author != "Jane Doe" {
+		t.Errorf(`The feed author should be used when the entry has none: %q`, entry.Author)
+	}
+
+	if entry.Hash == "" {
+		t.Error(`Empty hash`)
+	}
+}
+
+func TestParseNote(t *testing.T) {
+	feed, err := Parse("https://example.org/blog/", strings.NewReader(hfeedPage))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := feed.Entries[1]
+	if entry.Title != "A short note without title" {
+		t.Errorf(`Unexpected title: %q`, entry.Title)
+	}
+
+	if entry.URL != "https://example.org/notes/2" {
+		t.Errorf(`Properties of nested microformats should be ignored: %q`, entry.URL)
+	}
+
+	if entry.Author != "Bob" {
+		t.Errorf(`Unexpected author: %q`, entry.Author)
+	}
+
+	if !entry.Date.Equal(time.Date(2020, time.March, 2, 7, 30, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected date: %v`, entry.Date)
+	}
+}
+
+func TestParseTopLevelEntries(t *testing.T) {
+	data := `<html>
+	<head><title>Notes</title><base href="https://cdn.example.org/"></head>
+	<body>
+		<div class="h-entry">
+			<p class="p-summary">Summary with &lt;tags&gt;</p>
+			<a href="notes/1">Permalink</a>
+		</div>
+		<div class="h-entry">
+			<p class="p-name">Without link</p>
+		</div>
+	</body>
+	</html>`
+
+	feed, err := Parse("https://example.org/", strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "Notes" {
+		t.Errorf(`The page title should be used: %q`, feed.Title)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Unexpected number of entries: %d`, len(feed.Entries))
+	}
+
+	if feed.Entries[0].URL != "https://cdn.example.org/notes/1" {
+		t.Errorf(`Unexpected implied URL: %q`, feed.Entries[0].URL)
+	}
+
+	if feed.Entries[0].Content != "Summary with &lt;tags&gt;" {
+		t.Errorf(`Unexpected content: %q`, feed.Entries[0].Content)
+	}
+
+	if feed.Entries[1].URL != "https://example.org/" {
+		t.Errorf(`Entries without link should use the page URL: %q`, feed.Entries[1].URL)
+	}
+
+	if feed.Entries[0].Hash == feed.Entries[1].Hash {
+		t.Error(`Entries should have different hashes`)
+	}
+}
+
+func TestParseLongNoteTitle(t *testing.T) {
+	data := `<div class="h-entry"><div class="e-content">` + strings.Repeat("word ", 50) + `</div></div>`
+
+	feed, err := Parse("https://example.org/", strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	title := feed.Entries[0].Title
+	if !strings.HasSuffix(title, "word…") || len(title) > maxTitleLength+len("…") {
+		t.Errorf(`Unexpected title: %q`, title)
+	}
+}
+
+func TestHasEntries(t *testing.T) {
+	if !HasEntries(hfeedPage) {
+		t.Error(`The page contains entries`)
+	}
+
+	if HasEntries(`<html><body><p class="h-entry-like">Text</p></body></html>`) {
+		t.Error(`The page doesn't contain entries`)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package monitor // import "miniflux.app/reader/monitor"
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/errors"
+	"miniflux.app/model"
+	"miniflux.app/reader/diff"
+
+	"github.com/PuerkitoBio/goquery"
+	nethtml "golang.org/x/net/html"
+)
+
+// Page represents the text snapshot of a monitored web page.
+type Page struct {
+	URL   string
+	Title string
+	Text  string
+}
+
+// Parse extracts the visible text of the page, or only the text of the elements matching the selector.
+func Parse(websiteURL string, data io.Reader, selector string) (*Page, *errors.LocalizedError) {
+	doc, err := goquery.NewDocumentFromReader(data)
+	if err != nil {
+		return nil, errors.NewLocalizedError("Unable to parse web page: %q", err)
+	}
+
+	page := &Page{URL: websiteURL}
+	page.Title = strings.Join(strings.Fields(doc.Find("title").First().Text()), " ")
+	if page.Title == "" {
+		page.Title = websiteURL
+	}
+
+	selection := doc.Find("body")
+	if selector != "" {
+		selection = doc.Find(selector)
+	}
+
+	var lines []string
+	selection.Each(func(i int, s *goquery.Selection) {
+		for _, node := range s.Nodes {
+			lines = append(lines, extractLines(node)...)
+		}
+	})
+
+	page.Text = strings.Join(lines, "\n")
+	return page, nil
+}
+
+// Feed returns a feed without entries for the monitored page.
+func (p *Page) Feed() *model.Feed {
+	return &model.Feed{
+		FeedURL: p.URL,
+		SiteURL: p.URL,
+		Title:   p.Title,
+	}
+}
+
+// FirstEntry returns the entry created when the monitoring starts.
+func (p *Page) FirstEntry() *model.Entry {
+	var content strings.Builder
+	for i, line := range strings.Split(p.Text, "\n") {
+		if i > 0 {
+			content.WriteString("<br>")
+		}
+		content.WriteString(html.EscapeString(line))
+	}
+
+	return p.newEntry(content.String())
+}
+
+// Compare returns an entry with the differences between the previous snapshot and the page.
+// No entry is returned when the percentage of changed words is below the threshold.
+func (p *Page) Compare(previous string, threshold int) *model.Entry {
+	changes := diff.Words(previous, p.Text)
+	if !changes.HasChanges() || changes.Ratio() < threshold {
+		return nil
+	}
+
+	return p.newEntry(changes.HTML())
+}
+
+func (p *Page) newEntry(content string) *model.Entry {
+	now := time.Now()
+
+	entry := new(model.Entry)
+	entry.URL = p.URL
+	entry.Title = fmt.Sprintf("%s (%s)", p.Title, now.Format("2006-01-02 15:04"))
+	entry.Date = now
+	entry.Content = content
+	// Each change is an event: the same text seen again later must create a new entry.
+	entry.Hash = crypto.Hash(p.URL + p.Text + now.Format(time.RFC3339Nano))
+	return entry
+}
+
+func extractLines(node *nethtml.Node) []string {
+	var lines []string
+	var current strings.Builder
+
+	flush := func() {
+		if line := strings.Join(strings.Fields(current.String()), " "); line != "" {
+			lines = append(lines, line)
+		}
+		current.Reset()
+	}
+
+	var walk func(*nethtml.Node)
+	walk = func(n *nethtml.Node) {
+		switch n.Type {
+		case nethtml.TextNode:
+			current.WriteString(n.Data)
+			current.WriteString(" ")
+			return
+		case nethtml.ElementNode:
+			if isIgnoredElement(n.Data) {
+				return
+			}
+		}
+
+		block := n.Type == nethtml.ElementNode && isBlockElement(n.Data)
+		if block {
+			flush()
+		}
+
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+
+		if block {
+			flush()
+		}
+	}
+
+	walk(node)
+	flush()
+	return lines
+}
+
+func isIgnoredElement(tagName string) bool {
+	switch tagName {
+	case "script", "style", "noscript", "template", "svg", "iframe", "head":
+		return true
+	}
+
+	return false
+}
+
+func isBlockElement(tagName string) bool {
+	switch tagName {
+	case "address", "article", "aside", "blockquote", "br", "dd", "details", "div", "dl", "dt",
+		"fieldset", "figcaption", "figure", "footer", "form", "h1", "h2", "h3", "h4", "h5", "h6",
+		"header", "hr", "li", "main", "nav", "ol", "p", "pre", "section", "summary", "table",
+		"td", "th", "tr", "ul":
+		return true
+	}
+
+	return false
+}
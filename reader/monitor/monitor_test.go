@@ -0,0 +1,96 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package monitor // import "miniflux.app/reader/monitor"
+
+import (
+	"strings"
+	"testing"
+)
+
+const statusPage = `<html>
+<head><title>Status</title><style>body { color: red; }</style></head>
+<body>
+	<nav>Home | Blog</nav>
+	<div id="components">
+		<h2>Components</h2>
+		<ul>
+			<li>API: <b>operational</b></li>
+			<li>Website: operational</li>
+		</ul>
+		<script>var updated = "10:32";</script>
+	</div>
+</body>
+</html>`
+
+func TestParseWholePage(t *testing.T) {
+	page, err := Parse("https://status.example.org/", strings.NewReader(statusPage), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if page.Title != "Status" {
+		t.Errorf(`Unexpected title: %q`, page.Title)
+	}
+
+	expected := "Home | Blog\nComponents\nAPI: operational\nWebsite: operational"
+	if page.Text != expected {
+		t.Errorf(`Unexpected text: got %q instead of %q`, page.Text, expected)
+	}
+}
+
+func TestParseWithSelector(t *testing.T) {
+	page, err := Parse("https://status.example.org/", strings.NewReader(statusPage), "#components ul")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "API: operational\nWebsite: operational"
+	if page.Text != expected {
+		t.Errorf(`Unexpected text: got %q instead of %q`, page.Text, expected)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	page := &Page{URL: "https://status.example.org/", Title: "Status", Text: "API: degraded\nWebsite: operational"}
+
+	entry := page.Compare("API: operational\nWebsite: operational", 0)
+	if entry == nil {
+		t.Fatal(`A change should create an entry`)
+	}
+
+	if entry.URL != page.URL || entry.Hash == "" {
+		t.Errorf(`Unexpected entry: %+v`, entry)
+	}
+
+	if !strings.Contains(entry.Content, "<del>operational</del> <ins>degraded</ins>") {
+		t.Errorf(`Unexpected content: %q`, entry.Content)
+	}
+
+	if entry := page.Compare(page.Text, 0); entry != nil {
+		t.Error(`No entry should be created without changes`)
+	}
+}
+
+func TestCompareWithThreshold(t *testing.T) {
+	page := &Page{URL: "https://status.example.org/", Text: "one two three four five six seven eight nine eleven"}
+	previous := "one two three four five six seven eight nine ten"
+
+	if entry := page.Compare(previous, 50); entry != nil {
+		t.Error(`Small changes should be ignored`)
+	}
+
+	if entry := page.Compare(previous, 10); entry == nil {
+		t.Error(`Changes above the threshold should create an entry`)
+	}
+}
+
+func TestFirstEntry(t *testing.T) {
+	page := &Page{URL: "https://status.example.org/", Title: "Status", Text: "API: <ok>\nWebsite: ok"}
+
+	entry := page.FirstEntry()
+	if entry.Content != "API: &lt;ok&gt;<br>Website: ok" {
+		t.Errorf(`Unexpected content: %q`, entry.Content)
+	}
+}
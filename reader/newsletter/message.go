@@ -0,0 +1,285 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/reader/newsletter"
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	url_parser "net/url"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html/charset"
+)
+
+const maxPartDepth = 10
+
+var wordDecoder = &mime.WordDecoder{CharsetReader: charset.NewReaderLabel}
+
+// Image is an inline image attached to a message.
+type Image struct {
+	ContentID string
+	MimeType  string
+	Data      []byte
+}
+
+// Message contains the parts of an email message used to create an entry.
+type Message struct {
+	MessageID string
+	From      *mail.Address
+	Subject   string
+	Date      time.Time
+	HTML      string
+	Text      string
+	Images    []*Image
+}
+
+type partHeader interface {
+	Get(key string) string
+}
+
+// Parse reads a MIME message, decodes its headers and keeps the first HTML and text parts and the inline images.
+func Parse(r io.Reader) (*Message, error) {
+	m, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("newsletter: unable to read message: %v", err)
+	}
+
+	addressParser := &mail.AddressParser{WordDecoder: wordDecoder}
+	from, err := addressParser.Parse(m.Header.Get("From"))
+	if err != nil {
+		return nil, fmt.Errorf("newsletter: invalid sender: %v", err)
+	}
+	from.Address = strings.ToLower(from.Address)
+
+	message := &Message{
+		MessageID: strings.Trim(strings.TrimSpace(m.Header.Get("Message-Id")), "<>"),
+		From:      from,
+		Subject:   decodeHeader(m.Header.Get("Subject")),
+		Date:      time.Now(),
+	}
+
+	if date, err := m.Header.Date(); err == nil {
+		message.Date = date
+	}
+
+	if err := message.walk(m.Header, m.Body, 0); err != nil {
+		return nil, err
+	}
+
+	if message.HTML == "" && message.Text == "" {
+		return nil, fmt.Errorf("newsletter: the message %q has no text content", message.MessageID)
+	}
+
+	return message, nil
+}
+
+func (m *Message) walk(header partHeader, body io.Reader, depth int) error {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		if depth >= maxPartDepth {
+			return nil
+		}
+
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("newsletter: invalid multipart message: %v", err)
+			}
+
+			if err := m.walk(part.Header, part, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	disposition, _, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	contentID := strings.Trim(strings.TrimSpace(header.Get("Content-Id")), "<>")
+
+	switch {
+	// SVG images are ignored because they are served from the application origin and could embed scripts.
+	case strings.HasPrefix(mediaType, "image/") && mediaType != "image/svg+xml" && (contentID != "" || disposition == "inline"):
+		data, err := ioutil.ReadAll(decodeTransfer(header, body))
+		if err != nil {
+			return fmt.Errorf("newsletter: unable to decode image: %v", err)
+		}
+
+		m.Images = append(m.Images, &Image{ContentID: contentID, MimeType: mediaType, Data: data})
+	case disposition == "attachment":
+		return nil
+	case mediaType == "text/html" && m.HTML == "":
+		text, err := decodeText(header, body, params["charset"])
+		if err != nil {
+			return err
+		}
+		m.HTML = text
+	case mediaType == "text/plain" && m.Text == "":
+		text, err := decodeText(header, body, params["charset"])
+		if err != nil {
+			return err
+		}
+		m.Text = text
+	}
+
+	return nil
+}
+
+// Entry converts the message to an entry, inline images are replaced by the URL returned by imageURL.
+func (m *Message) Entry(imageURL func(image *Image) string) *model.Entry {
+	entry := new(model.Entry)
+	entry.URL = "mailto:" + m.From.Address
+	entry.Date = m.Date
+	entry.Title = strings.TrimSpace(m.Subject)
+	entry.Author = m.From.Name
+
+	if entry.Title == "" {
+		entry.Title = m.From.Address
+	}
+
+	if entry.Author == "" {
+		entry.Author = m.From.Address
+	}
+
+	if m.MessageID != "" {
+		entry.Hash = crypto.Hash(m.MessageID)
+	} else {
+		entry.Hash = crypto.Hash(m.From.Address + m.Subject + m.Date.Format(time.RFC3339))
+	}
+
+	images := make(map[string]string)
+	for _, image := range m.Images {
+		url := imageURL(image)
+		if image.ContentID != "" {
+			images[image.ContentID] = url
+		}
+
+		entry.Enclosures = append(entry.Enclosures, &model.Enclosure{
+			URL:      url,
+			MimeType: image.MimeType,
+			Size:     int64(len(image.Data)),
+		})
+	}
+
+	if m.HTML != "" {
+		entry.Content = convertHTML(m.HTML, images)
+	} else {
+		entry.Content = convertText(m.Text)
+	}
+
+	return entry
+}
+
+// convertHTML keeps the body of the document and points the inline images to their new location.
+func convertHTML(content string, images map[string]string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return content
+	}
+
+	doc.Find("head, script, style").Remove()
+	doc.Find(`img[src^="cid:"]`).Each(func(i int, img *goquery.Selection) {
+		src, _ := img.Attr("src")
+		contentID, err := url_parser.PathUnescape(strings.TrimPrefix(src, "cid:"))
+		if err != nil {
+			contentID = strings.TrimPrefix(src, "cid:")
+		}
+
+		if url, found := images[contentID]; found {
+			img.SetAttr("src", url)
+		} else {
+			img.Remove()
+		}
+	})
+
+	body, err := doc.Find("body").Html()
+	if err != nil {
+		return content
+	}
+
+	return strings.TrimSpace(body)
+}
+
+// convertText turns a plain text message into paragraphs.
+func convertText(text string) string {
+	var builder strings.Builder
+	text = strings.Replace(text, "\r\n", "\n", -1)
+
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+
+		lines := strings.Split(paragraph, "\n")
+		for i := range lines {
+			lines[i] = html.EscapeString(strings.TrimSpace(lines[i]))
+		}
+
+		builder.WriteString("<p>" + strings.Join(lines, "<br>") + "</p>")
+	}
+
+	return builder.String()
+}
+
+func decodeText(header partHeader, body io.Reader, label string) (string, error) {
+	reader := decodeTransfer(header, body)
+	if label != "" && !strings.EqualFold(label, "utf-8") && !strings.EqualFold(label, "us-ascii") {
+		converted, err := charset.NewReaderLabel(label, reader)
+		if err == nil {
+			reader = converted
+		}
+	}
+
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("newsletter: unable to decode text: %v", err)
+	}
+
+	return string(bytes.ToValidUTF8(data, []byte("�"))), nil
+}
+
+// decodeTransfer decodes the body according to the Content-Transfer-Encoding header.
+//
+// The multipart reader already decodes quoted-printable parts and removes the header.
+func decodeTransfer(header partHeader, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(header.Get("Content-Transfer-Encoding"))) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+func decodeHeader(value string) string {
+	decoded, err := wordDecoder.DecodeHeader(value)
+	if err != nil {
+		return value
+	}
+
+	return decoded
+}
@@ -0,0 +1,128 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/reader/newsletter"
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const multipartMessage = "From: =?utf-8?q?Caf=C3=A9_Weekly?= <News@Example.org>\r\n" +
+	"To: abc123@reader.example.org\r\n" +
+	"Subject: =?iso-8859-1?q?Num=E9ro_42?=\r\n" +
+	"Date: Sat, 02 May 2020 10:00:00 +0000\r\n" +
+	"Message-ID: <42@example.org>\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/related; boundary=\"related\"\r\n" +
+	"\r\n" +
+	"--related\r\n" +
+	"Content-Type: multipart/alternative; boundary=\"alternative\"\r\n" +
+	"\r\n" +
+	"--alternative\r\n" +
+	"Content-Type: text/plain; charset=utf-8\r\n" +
+	"\r\n" +
+	"Plain version\r\n" +
+	"--alternative\r\n" +
+	"Content-Type: text/html; charset=iso-8859-1\r\n" +
+	"Content-Transfer-Encoding: quoted-printable\r\n" +
+	"\r\n" +
+	"<html><head><style>p{color:red}</style></head><body><p>Caf=E9</p><img src=3D\"cid:logo@example.org\"><img src=3D\"cid:missing\"></body></html>\r\n" +
+	"--alternative--\r\n" +
+	"--related\r\n" +
+	"Content-Type: image/png\r\n" +
+	"Content-Transfer-Encoding: base64\r\n" +
+	"Content-ID: <logo@example.org>\r\n" +
+	"\r\n" +
+	"iVBORw0K\r\nGgo=\r\n" +
+	"--related\r\n" +
+	"Content-Type: text/plain\r\n" +
+	"Content-Disposition: attachment; filename=\"notes.txt\"\r\n" +
+	"\r\n" +
+	"Attachment\r\n" +
+	"--related--\r\n"
+
+func TestParseMultipartMessage(t *testing.T) {
+	message, err := Parse(strings.NewReader(multipartMessage))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if message.From.Name != "Café Weekly" || message.From.Address != "news@example.org" {
+		t.Errorf(`Unexpected sender: %v`, message.From)
+	}
+
+	if message.Subject != "Numéro 42" {
+		t.Errorf(`Unexpected subject: %q`, message.Subject)
+	}
+
+	if message.MessageID != "42@example.org" {
+		t.Errorf(`Unexpected message ID: %q`, message.MessageID)
+	}
+
+	if !message.Date.Equal(time.Date(2020, time.May, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected date: %v`, message.Date)
+	}
+
+	if message.Text != "Plain version" {
+		t.Errorf(`Unexpected text part: %q`, message.Text)
+	}
+
+	if !strings.Contains(message.HTML, "<p>Café</p>") {
+		t.Errorf(`Unexpected HTML part: %q`, message.HTML)
+	}
+
+	if len(message.Images) != 1 || message.Images[0].ContentID != "logo@example.org" || string(message.Images[0].Data) != "\x89PNG\r\n\x1a\n" {
+		t.Fatalf(`Unexpected images: %v`, message.Images)
+	}
+
+	entry := message.Entry(func(image *Image) string {
+		return "https://reader.example.org/newsletter/image/token"
+	})
+
+	if entry.Title != "Numéro 42" || entry.Author != "Café Weekly" || entry.URL != "mailto:news@example.org" {
+		t.Errorf(`Unexpected entry: %v`, entry)
+	}
+
+	expected := `<p>Café</p><img src="https://reader.example.org/newsletter/image/token"/>`
+	if entry.Content != expected {
+		t.Errorf(`Unexpected content: got %q instead of %q`, entry.Content, expected)
+	}
+
+	if len(entry.Enclosures) != 1 || entry.Enclosures[0].MimeType != "image/png" || entry.Enclosures[0].Size != 8 {
+		t.Errorf(`Unexpected enclosures: %v`, entry.Enclosures)
+	}
+}
+
+func TestParseTextMessage(t *testing.T) {
+	data := "From: news@example.org\r\nSubject: Hello\r\n\r\nFirst line\r\nsecond <line>\r\n\r\nLast paragraph\r\n"
+
+	message, err := Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := message.Entry(nil)
+	if entry.Content != `<p>First line<br>second &lt;line&gt;</p><p>Last paragraph</p>` {
+		t.Errorf(`Unexpected content: %q`, entry.Content)
+	}
+
+	if entry.Author != "news@example.org" || entry.Hash == "" {
+		t.Errorf(`Unexpected entry: %v`, entry)
+	}
+}
+
+func TestParseMessageWithoutContent(t *testing.T) {
+	data := "From: news@example.org\r\nContent-Type: image/png\r\nContent-Disposition: attachment\r\n\r\nPNG\r\n"
+	if _, err := Parse(strings.NewReader(data)); err == nil {
+		t.Error(`Messages without text should be rejected`)
+	}
+}
+
+func TestParseMessageWithoutSender(t *testing.T) {
+	if _, err := Parse(strings.NewReader("Subject: Hello\r\n\r\nText\r\n")); err == nil {
+		t.Error(`Messages without sender should be rejected`)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/xml"
 	"strings"
 
+	"miniflux.app/reader/microformats"
 	rxml "miniflux.app/reader/xml"
 )
 
@@ -17,6 +18,7 @@ const (
 	FormatRSS     = "rss"
 	FormatAtom    = "atom"
 	FormatJSON    = "json"
+	FormatHFeed   = "h-feed"
 	FormatUnknown = "unknown"
 )
 
@@ -46,5 +48,9 @@ func DetectFeedFormat(data string) string {
 		}
 	}
 
+	if microformats.HasEntries(data) {
+		return FormatHFeed
+	}
+
 	return FormatUnknown
 }
@@ -67,6 +67,15 @@ func TestDetectJSON(t *testing.T) {
 	}
 }
 
+func TestDetectHFeed(t *testing.T) {
+	data := `<!DOCTYPE html><html><body><div class="h-feed"><article class="h-entry"><h1 class="p-name">Title</h1></article></div></body></html>`
+	format := DetectFeedFormat(data)
+
+	if format != FormatHFeed {
+		t.Errorf(`Wrong format detected: %q instead of %q`, format, FormatHFeed)
+	}
+}
+
 func TestDetectUnknown(t *testing.T) {
 	data := `
 	<!DOCTYPE html> <html> </html>
@@ -11,12 +11,14 @@ import (
 	"miniflux.app/model"
 	"miniflux.app/reader/atom"
 	"miniflux.app/reader/json"
+	"miniflux.app/reader/microformats"
 	"miniflux.app/reader/rdf"
 	"miniflux.app/reader/rss"
 )
 
 // ParseFeed analyzes the input data and returns a normalized feed object.
-func ParseFeed(data string) (*model.Feed, *errors.LocalizedError) {
+// The base URL is used to resolve relative links of web pages.
+func ParseFeed(baseURL, data string) (*model.Feed, *errors.LocalizedError) {
 	switch DetectFeedFormat(data) {
 	case FormatAtom:
 		return atom.Parse(strings.NewReader(data))
@@ -26,6 +28,8 @@ func ParseFeed(data string) (*model.Feed, *errors.LocalizedError) {
 		return json.Parse(strings.NewReader(data))
 	case FormatRDF:
 		return rdf.Parse(strings.NewReader(data))
+	case FormatHFeed:
+		return microformats.Parse(baseURL, strings.NewReader(data))
 	default:
 		return nil, errors.NewLocalizedError("Unsupported feed format")
 	}
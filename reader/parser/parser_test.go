@@ -34,7 +34,7 @@ func TestParseAtom(t *testing.T) {
 
 	</feed>`
 
-	feed, err := ParseFeed(data)
+	feed, err := ParseFeed("https://example.org/", data)
 	if err != nil {
 		t.Error(err)
 	}
@@ -60,7 +60,7 @@ func TestParseRSS(t *testing.T) {
 	</channel>
 	</rss>`
 
-	feed, err := ParseFeed(data)
+	feed, err := ParseFeed("https://example.org/", data)
 	if err != nil {
 		t.Error(err)
 	}
@@ -89,7 +89,7 @@ func TestParseRDF(t *testing.T) {
 		  </item>
 		</rdf:RDF>`
 
-	feed, err := ParseFeed(data)
+	feed, err := ParseFeed("https://example.org/", data)
 	if err != nil {
 		t.Error(err)
 	}
@@ -119,7 +119,7 @@ func TestParseJson(t *testing.T) {
 		]
 	}`
 
-	feed, err := ParseFeed(data)
+	feed, err := ParseFeed("https://example.org/", data)
 	if err != nil {
 		t.Error(err)
 	}
@@ -129,6 +129,31 @@ func TestParseJson(t *testing.T) {
 	}
 }
 
+func TestParseHFeed(t *testing.T) {
+	data := `<!DOCTYPE html>
+	<html>
+		<head><title>Notes</title></head>
+		<body>
+			<article class="h-entry">
+				<a class="p-name u-url" href="/notes/1">Title</a>
+			</article>
+		</body>
+	</html>`
+
+	feed, err := ParseFeed("https://example.org/", data)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if feed.Title != "Notes" {
+		t.Errorf("Incorrect title, got: %s", feed.Title)
+	}
+
+	if len(feed.Entries) != 1 || feed.Entries[0].URL != "https://example.org/notes/1" {
+		t.Errorf("Incorrect entries, got: %v", feed.Entries)
+	}
+}
+
 func TestParseUnknownFeed(t *testing.T) {
 	data := `
 		<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Transitional//EN" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-transitional.dtd">
@@ -142,14 +167,14 @@ func TestParseUnknownFeed(t *testing.T) {
 		</html>
 	`
 
-	_, err := ParseFeed(data)
+	_, err := ParseFeed("https://example.org/", data)
 	if err == nil {
 		t.Error("ParseFeed must returns an error")
 	}
 }
 
 func TestParseEmptyFeed(t *testing.T) {
-	_, err := ParseFeed("")
+	_, err := ParseFeed("https://example.org/", "")
 	if err == nil {
 		t.Error("ParseFeed must returns an error")
 	}
@@ -191,7 +216,7 @@ func TestDifferentEncodingWithResponse(t *testing.T) {
 			t.Fatalf(`Encoding error for %q: %v`, tc.filename, encodingErr)
 		}
 
-		feed, parseErr := ParseFeed(r.BodyAsString())
+		feed, parseErr := ParseFeed("https://example.org/", r.BodyAsString())
 		if parseErr != nil {
 			t.Fatalf(`Parsing error for %q - %q: %v`, tc.filename, tc.contentType, parseErr)
 		}
@@ -5,83 +5,255 @@
 package processor
 
 import (
-	"fmt"
+	"html"
+	"strings"
+
+	"miniflux.app/config"
 	"miniflux.app/logger"
 	"miniflux.app/model"
-	"miniflux.app/reader/mercury"
+	"miniflux.app/reader/extractor"
+	"miniflux.app/reader/gemini"
+	"miniflux.app/reader/identity"
+	"miniflux.app/reader/readability"
 	"miniflux.app/reader/rewrite"
 	"miniflux.app/reader/sanitizer"
-	"miniflux.app/reader/scraper"
+	"miniflux.app/reader/urlcleaner"
 	"miniflux.app/storage"
 )
 
 // ProcessFeedEntries downloads original web page for entries and apply filters.
+//
+// The settings of the category are used when the feed doesn't define its own.
+// The hash of the entries is computed according to the identity strategy of the feed.
 func ProcessFeedEntries(store *storage.Storage, feed *model.Feed) {
+	var chain extractor.Chain
+	var mercuryAPIURL string
+	if feed.EffectiveCrawler() || feed.UseMercury {
+		chain = contentExtractors(feed)
+		mercuryAPIURL = userMercuryAPIURL(store, chain, feed.UserID)
+	}
+
+	cleaner := urlcleaner.New(config.Opts.TrackingParameters())
 	for _, entry := range feed.Entries {
-		if feed.Crawler {
+		entry.GUIDHash = entry.Hash
+		entry.URL = cleanEntryURL(store, feed, cleaner, entry)
+		entry.CommentsURL = cleaner.CleanURL(entry.CommentsURL)
+
+		// The identity is computed before the content is crawled or rewritten,
+		// the existing entries are not crawled again and must keep the same hash on each refresh.
+		// The hashes of the other strategies are stored to merge the entries when the strategy changes.
+		entry.IdentityHashes = identity.EntryHashes(entry)
+		entry.Hash = identity.EntryHash(feed.EntryIdentity, entry)
+
+		// Gemini index pages only contain links, the content is downloaded for new entries.
+		if gemini.IsGeminiURL(entry.URL) && entry.Content == "" {
 			if !store.EntryURLExists(feed.ID, entry.URL) {
-				content, err := scraper.Fetch(entry.URL, feed.ScraperRules, feed.UserAgent)
+				content, err := gemini.NewClient(store).FetchContent(entry.URL)
 				if err != nil {
-					logger.Error(`[Filter] Unable to crawl this entry: %q => %v`, entry.URL, err)
-				} else if content != "" {
-					// We replace the entry content only if the scraper doesn't return any error.
+					logger.Error(`[Filter] Unable to fetch this Gemini entry: %q => %v`, entry.URL, err)
+				} else {
 					entry.Content = content
 				}
 			}
 		}
 
-		if feed.UseMercury {
+		if len(chain) > 0 && !gemini.IsGeminiURL(entry.URL) {
 			if !store.EntryURLExists(feed.ID, entry.URL) {
-				user, err := store.UserByID(feed.UserID)
-				if err != nil && user.MercuryAPIURL != "" {
-					content, err := mercury.Fetch(entry.URL, user.MercuryAPIURL)
-					if err != nil {
-						logger.Error(`[Merucry] Unable to crawl this entry: %q => %v`, entry.URL, err)
-					} else if content != "" {
-						// We replace the entry content only if the scraper doesn't return any error.
-						entry.Content = content
-					}
+				article, err := extractor.Run(chain, &extractor.Request{
+					URL:           entry.URL,
+					UserAgent:     feed.EffectiveUserAgent(),
+					ScraperRules:  feed.EffectiveScraperRules(),
+					MercuryAPIURL: mercuryAPIURL,
+				})
+				if err != nil {
+					logger.Error(`[Filter] Unable to crawl this entry: %q => %v`, entry.URL, err)
+				} else {
+					// We replace the entry content only if the extractors don't return any error.
+					updateEntryFromArticle(entry, article)
 				}
 			}
 		}
 
-		entry.Content = rewrite.Rewriter(entry.URL, entry.Content, feed.RewriteRules)
+		rewriteRules := feed.EffectiveRewriteRules()
+		entry.Content = rewrite.Rewriter(entry.URL, entry.Content, rewriteRules)
+		entry.Title = rewrite.TitleRewriter(entry.Title, rewriteRules)
 
 		// The sanitizer should always run at the end of the process to make sure unsafe HTML is filtered.
-		entry.Content = sanitizer.Sanitize(entry.URL, entry.Content)
+		entry.Content = sanitizeContent(entry.URL, entry.Content)
 	}
 }
 
 // ProcessEntryWebPage downloads the entry web page and apply rewrite rules.
 func ProcessEntryWebPage(entry *model.Entry, store *storage.Storage) error {
-	if entry.Feed.UseMercury {
-		user, err := store.UserByID(entry.UserID)
-		if err != nil {
-			return fmt.Errorf(`[Merucry] Unable to crawl this entry: %q => %v`, entry.URL, err)
-		}
-		if user.MercuryAPIURL == "" {
-			return fmt.Errorf(`[Merucry] Unable to crawl this entry: %q => %v`, entry.URL, "API URL NOT SET")
-		}
-		content, err := mercury.Fetch(entry.URL, user.MercuryAPIURL)
+	if gemini.IsGeminiURL(entry.URL) {
+		content, err := gemini.NewClient(store).FetchContent(entry.URL)
 		if err != nil {
 			return err
 		}
+
+		content = rewrite.Rewriter(entry.URL, content, entry.Feed.EffectiveRewriteRules())
+		content = sanitizeContent(entry.URL, content)
+
 		if content != "" {
 			entry.Content = content
 		}
-	} else {
-		content, err := scraper.Fetch(entry.URL, entry.Feed.ScraperRules, entry.Feed.UserAgent)
-		if err != nil {
-			return err
+
+		return nil
+	}
+
+	chain := contentExtractors(entry.Feed)
+	article, err := extractor.Run(chain, &extractor.Request{
+		URL:           entry.URL,
+		UserAgent:     entry.Feed.EffectiveUserAgent(),
+		ScraperRules:  entry.Feed.EffectiveScraperRules(),
+		MercuryAPIURL: userMercuryAPIURL(store, chain, entry.UserID),
+	})
+	if err != nil {
+		return err
+	}
+
+	rewriteRules := entry.Feed.EffectiveRewriteRules()
+	article.Content = rewrite.Rewriter(entry.URL, article.Content, rewriteRules)
+	article.Content = sanitizeContent(entry.URL, article.Content)
+	updateEntryFromArticle(entry, article)
+	entry.Title = rewrite.TitleRewriter(entry.Title, rewriteRules)
+
+	return nil
+}
+
+// PreviewEntry applies the content extractors and the rewrite rules of the feed to the entry, nothing is saved.
+//
+// The web page of the entry is downloaded only when crawl is true, an error is returned if the extraction fails
+// but the rewrite rules are applied anyway.
+func PreviewEntry(store *storage.Storage, feed *model.Feed, entry *model.Entry, crawl bool) error {
+	var err error
+	entry.URL = urlcleaner.New(config.Opts.TrackingParameters()).CleanURL(entry.URL)
+
+	if crawl && !gemini.IsGeminiURL(entry.URL) {
+		chain := contentExtractors(feed)
+		var article *readability.Article
+		article, err = extractor.Run(chain, &extractor.Request{
+			URL:           entry.URL,
+			UserAgent:     feed.EffectiveUserAgent(),
+			ScraperRules:  feed.EffectiveScraperRules(),
+			MercuryAPIURL: userMercuryAPIURL(store, chain, feed.UserID),
+		})
+		if err == nil {
+			updateEntryFromArticle(entry, article)
 		}
+	}
 
-		content = rewrite.Rewriter(entry.URL, content, entry.Feed.RewriteRules)
-		content = sanitizer.Sanitize(entry.URL, content)
+	rewriteRules := feed.EffectiveRewriteRules()
+	entry.Content = rewrite.Rewriter(entry.URL, entry.Content, rewriteRules)
+	entry.Title = rewrite.TitleRewriter(entry.Title, rewriteRules)
+	entry.Content = sanitizeContent(entry.URL, entry.Content)
+	return err
+}
 
-		if content != "" {
-			entry.Content = content
+// sanitizeContent resolves the lazy-loaded images, unless disabled, removes the tracking parameters of the links and unsafe HTML.
+func sanitizeContent(entryURL, content string) string {
+	if config.Opts.NormalizeImages() {
+		content = rewrite.NormalizeImages(content)
+	}
+
+	content = urlcleaner.New(config.Opts.TrackingParameters()).CleanContent(content)
+	return sanitizer.Sanitize(entryURL, content)
+}
+
+// entryURLFinder returns the URL saved for an existing entry of the feed.
+type entryURLFinder interface {
+	EntryURLByGUIDHash(feedID int64, guidHash string) string
+}
+
+// cleanEntryURL removes the tracking parameters from the entry URL.
+//
+// When enabled, the URLs of redirection services are replaced by their destination,
+// the URL already saved is reused for existing entries to avoid a request on each refresh.
+//
+// The saved URL is found with the hash of the parser, the identity of the entry may depend on its URL.
+func cleanEntryURL(store entryURLFinder, feed *model.Feed, cleaner *urlcleaner.Cleaner, entry *model.Entry) string {
+	entryURL := cleaner.CleanURL(entry.URL)
+	if !config.Opts.ResolveRedirectURLs() || !urlcleaner.IsRedirectWrapper(entryURL) {
+		return entryURL
+	}
+
+	if storedURL := store.EntryURLByGUIDHash(feed.ID, entry.GUIDHash); storedURL != "" {
+		return storedURL
+	}
+
+	destination, err := urlcleaner.ResolveRedirect(entryURL, feed.EffectiveUserAgent())
+	if err != nil {
+		logger.Error(`[Filter] Unable to resolve the redirection: %q => %v`, entryURL, err)
+		return entryURL
+	}
+
+	return cleaner.CleanURL(destination)
+}
+
+// contentExtractors returns the extractors defined for the feed, or for its category.
+//
+// By default, the scraper rules are used when available, otherwise the content is extracted with readability.
+func contentExtractors(feed *model.Feed) extractor.Chain {
+	values := []string{feed.ContentExtractors}
+	if feed.Category != nil {
+		values = append(values, feed.Category.ContentExtractors)
+	}
+
+	for _, value := range values {
+		chain, err := extractor.ParseChain(value)
+		if err != nil {
+			logger.Error(`[Processor] Invalid content extractors for feed #%d: %v`, feed.ID, err)
+			continue
+		}
+
+		if len(chain) > 0 {
+			return chain
 		}
 	}
 
-	return nil
+	if feed.UseMercury {
+		return extractor.Chain{{Name: extractor.Mercury}}
+	}
+
+	return extractor.Chain{{Name: extractor.Scraper}, {Name: extractor.Readability}}
+}
+
+func userMercuryAPIURL(store *storage.Storage, chain extractor.Chain, userID int64) string {
+	if !chain.Contains(extractor.Mercury) {
+		return ""
+	}
+
+	user, err := store.UserByID(userID)
+	if err != nil || user == nil {
+		logger.Error(`[Processor] Unable to fetch user #%d: %v`, userID, err)
+		return ""
+	}
+
+	return user.MercuryAPIURL
+}
+
+// updateEntryFromArticle replaces the entry content and fills the metadata missing from the feed.
+func updateEntryFromArticle(entry *model.Entry, article *readability.Article) {
+	if article.Content != "" {
+		entry.Content = article.Content
+	} else if entry.Content == "" && article.Excerpt != "" {
+		entry.Content = "<p>" + html.EscapeString(article.Excerpt) + "</p>"
+	}
+
+	if article.Title != "" && (entry.Title == "" || entry.Title == entry.URL) {
+		entry.Title = article.Title
+	}
+
+	if entry.Author == "" {
+		entry.Author = article.Byline
+	}
+
+	// The parsers use the current time when the feed doesn't give the date.
+	if entry.DateUnknown && !article.PublishedAt.IsZero() {
+		entry.SetDate(article.PublishedAt)
+	}
+
+	if article.ImageURL != "" && entry.Content != "" && !strings.Contains(entry.Content, "<img") {
+		entry.Content = `<p><img src="` + html.EscapeString(article.ImageURL) + `" alt=""></p>` + entry.Content
+	}
 }
@@ -0,0 +1,117 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package processor // import "miniflux.app/reader/processor"
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+	"miniflux.app/reader/identity"
+	"miniflux.app/reader/parser"
+	"miniflux.app/reader/readability"
+	"miniflux.app/reader/urlcleaner"
+)
+
+type fakeEntryURLFinder map[string]string
+
+func (f fakeEntryURLFinder) EntryURLByGUIDHash(feedID int64, guidHash string) string {
+	return f[guidHash]
+}
+
+func TestCleanEntryURLWithURLIdentity(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("RESOLVE_REDIRECT_URLS", "1")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	feed := &model.Feed{ID: 1, EntryIdentity: model.EntryIdentityURL}
+	entry := &model.Entry{GUIDHash: "guid", URL: "https://feeds.feedburner.com/~r/example/~3/abc/"}
+	entry.Hash = identity.EntryHash(feed.EntryIdentity, entry)
+
+	store := fakeEntryURLFinder{"guid": "https://example.org/story"}
+	cleaner := urlcleaner.New(config.Opts.TrackingParameters())
+	if result := cleanEntryURL(store, feed, cleaner, entry); result != "https://example.org/story" {
+		t.Errorf(`The URL saved for the hash of the parser should be used, got %q`, result)
+	}
+}
+
+func TestProcessFeedEntriesComputesIdentityBeforeRewriting(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	content := `<p>Some content</p><script>track()</script>`
+	feed := &model.Feed{
+		ID:            1,
+		EntryIdentity: model.EntryIdentityContent,
+		Entries:       model.Entries{{Hash: "guid", URL: "https://example.org/story", Content: content}},
+	}
+
+	ProcessFeedEntries(nil, feed)
+
+	entry := feed.Entries[0]
+	expected := identity.EntryHash(model.EntryIdentityContent, &model.Entry{Hash: "guid", Content: content})
+	if entry.Hash != expected {
+		t.Errorf(`The identity should be computed from the content of the feed, got %q instead of %q`, entry.Hash, expected)
+	}
+
+	if entry.GUIDHash != "guid" {
+		t.Errorf(`The hash of the parser should be kept, got %q`, entry.GUIDHash)
+	}
+
+	if entry.Content == content {
+		t.Error(`The content should be sanitized`)
+	}
+}
+
+func TestUpdateEntryFromArticleWithUnknownDate(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<item>
+				<title>Story</title>
+				<link>https://example.org/story</link>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, parseErr := parser.ParseFeed("https://example.org/", data)
+	if parseErr != nil {
+		t.Fatal(parseErr)
+	}
+
+	publishedAt := time.Date(2020, time.May, 2, 10, 0, 0, 0, time.UTC)
+	entry := feed.Entries[0]
+	updateEntryFromArticle(entry, &readability.Article{Content: "<p>Story</p>", PublishedAt: publishedAt})
+
+	if !entry.Date.Equal(publishedAt) || entry.DateUnknown {
+		t.Errorf(`The date of the article should be used, got %v`, entry.Date)
+	}
+}
+
+func TestUpdateEntryFromArticleKeepsKnownDate(t *testing.T) {
+	date := time.Date(2020, time.May, 1, 8, 0, 0, 0, time.UTC)
+	entry := &model.Entry{}
+	entry.SetDate(date)
+
+	updateEntryFromArticle(entry, &readability.Article{PublishedAt: date.Add(time.Hour)})
+
+	if !entry.Date.Equal(date) {
+		t.Errorf(`The date of the feed should be kept, got %v`, entry.Date)
+	}
+}
@@ -66,7 +66,7 @@ func (r *rdfItem) Transform() *model.Entry {
 	entry.URL = r.entryURL()
 	entry.Content = r.entryContent()
 	entry.Hash = r.entryHash()
-	entry.Date = r.entryDate()
+	entry.SetDate(r.entryDate())
 	return entry
 }
 
@@ -96,13 +96,13 @@ func (r *rdfItem) entryDate() time.Time {
 		result, err := date.Parse(r.DublinCoreDate)
 		if err != nil {
 			logger.Error("rdf: %v", err)
-			return time.Now()
+			return time.Time{}
 		}
 
 		return result
 	}
 
-	return time.Now()
+	return time.Time{}
 }
 
 func (r *rdfItem) entryHash() string {
@@ -0,0 +1,397 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package readability // import "miniflux.app/reader/readability"
+
+import (
+	"bytes"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	videosRegexp        = regexp.MustCompile(`(?i)//(www\.)?((dailymotion|youtube|youtube-nocookie|player\.vimeo|v\.qq)\.com|(archive|upload\.wikimedia)\.org|player\.twitch\.tv)`)
+	shareElementsRegexp = regexp.MustCompile(`(?i)(\b|_)(share|sharedaddy)(\b|_)`)
+)
+
+// Elements containing less text than this threshold are removed when they look like share buttons.
+const shareElementThreshold = 500
+
+// prepDocument removes the scripts and the styles, and replaces the consecutive line breaks and the font tags.
+func prepDocument(document *html.Node) {
+	for _, n := range getElementsByTagName(document, "script", "noscript", "style") {
+		removeNode(n)
+	}
+
+	replaceBrs(document)
+
+	for _, n := range getElementsByTagName(document, "font") {
+		setNodeTag(n, "span")
+	}
+}
+
+// replaceBrs replaces two or more successive <br> with a paragraph containing the following phrasing content.
+func replaceBrs(document *html.Node) {
+	for _, br := range getElementsByTagName(document, "br") {
+		if br.Parent == nil {
+			continue
+		}
+
+		replaced := false
+		next := nextNonWhitespaceNode(br.NextSibling)
+		for next != nil && tagName(next) == "br" {
+			replaced = true
+			sibling := next.NextSibling
+			removeNode(next)
+			next = nextNonWhitespaceNode(sibling)
+		}
+
+		if !replaced {
+			continue
+		}
+
+		paragraph := &html.Node{Type: html.ElementNode}
+		setNodeTag(paragraph, "p")
+		replaceNode(br, paragraph)
+
+		next = paragraph.NextSibling
+		for next != nil {
+			// Stop at the next <br><br>.
+			if tagName(next) == "br" {
+				if following := nextNonWhitespaceNode(next.NextSibling); following != nil && tagName(following) == "br" {
+					break
+				}
+			}
+
+			if !isPhrasingContent(next) {
+				break
+			}
+
+			sibling := next.NextSibling
+			removeNode(next)
+			paragraph.AppendChild(next)
+			next = sibling
+		}
+
+		trimTrailingWhitespace(paragraph)
+
+		if tagName(paragraph.Parent) == "p" {
+			setNodeTag(paragraph.Parent, "div")
+		}
+	}
+}
+
+// prepArticle removes the elements that are not part of the article content.
+func (p *parser) prepArticle(articleContent *html.Node) {
+	p.markDataTables(articleContent)
+
+	p.cleanConditionally(articleContent, "form")
+	p.cleanConditionally(articleContent, "fieldset")
+	clean(articleContent, "object")
+	clean(articleContent, "embed")
+	clean(articleContent, "footer")
+	clean(articleContent, "link")
+	clean(articleContent, "aside")
+
+	for _, child := range elementChildren(articleContent) {
+		cleanMatchedNodes(child, func(n *html.Node, matchString string) bool {
+			return shareElementsRegexp.MatchString(matchString) && textLength(textContent(n)) < shareElementThreshold
+		})
+	}
+
+	clean(articleContent, "iframe")
+	clean(articleContent, "input")
+	clean(articleContent, "textarea")
+	clean(articleContent, "select")
+	clean(articleContent, "button")
+	p.cleanHeaders(articleContent)
+
+	p.cleanConditionally(articleContent, "table")
+	p.cleanConditionally(articleContent, "ul")
+	p.cleanConditionally(articleContent, "div")
+
+	// The title of the article is displayed separately.
+	for _, h1 := range getElementsByTagName(articleContent, "h1") {
+		setNodeTag(h1, "h2")
+	}
+
+	for _, paragraph := range getElementsByTagName(articleContent, "p") {
+		if len(getElementsByTagName(paragraph, "img", "embed", "object", "iframe")) == 0 && strings.TrimSpace(textContent(paragraph)) == "" {
+			removeNode(paragraph)
+		}
+	}
+
+	for _, br := range getElementsByTagName(articleContent, "br") {
+		if next := nextNonWhitespaceNode(br.NextSibling); next != nil && tagName(next) == "p" {
+			removeNode(br)
+		}
+	}
+
+	// Tables with a single cell are used for the layout.
+	for _, table := range getElementsByTagName(articleContent, "table") {
+		if table.Parent == nil {
+			continue
+		}
+
+		tbody := table
+		if hasSingleTagInsideElement(table, "tbody") {
+			tbody = firstElementChild(table)
+		}
+
+		if !hasSingleTagInsideElement(tbody, "tr") {
+			continue
+		}
+
+		row := firstElementChild(tbody)
+		if !hasSingleTagInsideElement(row, "td") {
+			continue
+		}
+
+		cell := firstElementChild(row)
+		tag := "div"
+		if allChildren(cell, isPhrasingContent) {
+			tag = "p"
+		}
+
+		setNodeTag(cell, tag)
+		replaceNode(table, cell)
+	}
+}
+
+// clean removes all the elements with the given tag, the embedded videos are kept.
+func clean(n *html.Node, tag string) {
+	isEmbed := tag == "object" || tag == "embed" || tag == "iframe"
+
+	for _, element := range getElementsByTagName(n, tag) {
+		if isEmbed && isVideo(element) {
+			continue
+		}
+
+		removeNode(element)
+	}
+}
+
+func isVideo(n *html.Node) bool {
+	for _, attr := range n.Attr {
+		if videosRegexp.MatchString(attr.Val) {
+			return true
+		}
+	}
+
+	if tagName(n) == "object" {
+		var buffer bytes.Buffer
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			html.Render(&buffer, child)
+		}
+		return videosRegexp.Match(buffer.Bytes())
+	}
+
+	return false
+}
+
+// cleanMatchedNodes removes the descendants matching the filter.
+func cleanMatchedNodes(n *html.Node, filter func(*html.Node, string) bool) {
+	endOfSearchMarker := getNextNode(n, true)
+	next := getNextNode(n, false)
+
+	for next != nil && next != endOfSearchMarker {
+		if filter(next, getAttribute(next, "class")+" "+getAttribute(next, "id")) {
+			next = removeAndGetNext(next)
+		} else {
+			next = getNextNode(next, false)
+		}
+	}
+}
+
+// cleanHeaders removes the headers that don't look like content.
+func (p *parser) cleanHeaders(n *html.Node) {
+	for _, header := range getElementsByTagName(n, "h1", "h2") {
+		if p.getClassWeight(header) < 0 {
+			removeNode(header)
+		}
+	}
+}
+
+// cleanConditionally removes the elements with the given tag when they look fishy:
+// too many images, too many links, not enough text, etc.
+func (p *parser) cleanConditionally(n *html.Node, tag string) {
+	if p.flags&flagCleanConditionally == 0 {
+		return
+	}
+
+	elements := getElementsByTagName(n, tag)
+	for i := len(elements) - 1; i >= 0; i-- {
+		if p.shouldRemove(elements[i], tag) {
+			removeNode(elements[i])
+		}
+	}
+}
+
+func (p *parser) shouldRemove(n *html.Node, tag string) bool {
+	if n.Parent == nil {
+		return false
+	}
+
+	text := innerText(n)
+	length := textLength(text)
+
+	isList := tag == "ul" || tag == "ol"
+	if !isList && length > 0 {
+		listLength := 0
+		for _, list := range getElementsByTagName(n, "ul", "ol") {
+			listLength += textLength(innerText(list))
+		}
+		isList = float64(listLength)/float64(length) > 0.9
+	}
+
+	if tag == "table" && p.dataTables[n] {
+		return false
+	}
+
+	if hasAncestorTag(n, "table", -1, func(table *html.Node) bool { return p.dataTables[table] }) {
+		return false
+	}
+
+	if hasAncestorTag(n, "code", 3, nil) {
+		return false
+	}
+
+	weight := p.getClassWeight(n)
+	if weight < 0 {
+		return true
+	}
+
+	if strings.Count(text, ",") >= 10 {
+		return false
+	}
+
+	paragraphs := len(getElementsByTagName(n, "p"))
+	images := len(getElementsByTagName(n, "img"))
+	listItems := len(getElementsByTagName(n, "li")) - 100
+	inputs := len(getElementsByTagName(n, "input"))
+	headingDensity := getTextDensity(n, "h1", "h2", "h3", "h4", "h5", "h6")
+
+	embeds := 0
+	for _, embed := range getElementsByTagName(n, "object", "embed", "iframe") {
+		if isVideo(embed) {
+			return false
+		}
+		embeds++
+	}
+
+	linkDensity := getLinkDensity(n)
+	inFigure := hasAncestorTag(n, "figure", 3, nil)
+
+	haveToRemove := (images > 1 && float64(paragraphs)/float64(images) < 0.5 && !inFigure) ||
+		(!isList && listItems > paragraphs) ||
+		(float64(inputs) > math.Floor(float64(paragraphs)/3)) ||
+		(!isList && headingDensity < 0.9 && length < 25 && (images == 0 || images > 2) && !inFigure) ||
+		(!isList && weight < 25 && linkDensity > 0.2) ||
+		(weight >= 25 && linkDensity > 0.5) ||
+		((embeds == 1 && length < 75) || embeds > 1)
+
+	// Simple lists of images are kept.
+	if isList && haveToRemove {
+		for _, child := range elementChildren(n) {
+			if len(elementChildren(child)) > 1 {
+				return haveToRemove
+			}
+		}
+
+		if images == len(getElementsByTagName(n, "li")) {
+			return false
+		}
+	}
+
+	return haveToRemove
+}
+
+func getTextDensity(n *html.Node, tags ...string) float64 {
+	length := textLength(innerText(n))
+	if length == 0 {
+		return 0
+	}
+
+	childrenLength := 0
+	for _, child := range getElementsByTagName(n, tags...) {
+		childrenLength += textLength(innerText(child))
+	}
+
+	return float64(childrenLength) / float64(length)
+}
+
+// markDataTables finds the tables containing data rather than used for the layout.
+func (p *parser) markDataTables(n *html.Node) {
+	for _, table := range getElementsByTagName(n, "table") {
+		p.dataTables[table] = isDataTable(table)
+	}
+}
+
+func isDataTable(table *html.Node) bool {
+	if getAttribute(table, "role") == "presentation" || getAttribute(table, "datatable") == "0" {
+		return false
+	}
+
+	if getAttribute(table, "summary") != "" {
+		return true
+	}
+
+	for _, caption := range getElementsByTagName(table, "caption") {
+		if caption.FirstChild != nil {
+			return true
+		}
+	}
+
+	if len(getElementsByTagName(table, "col", "colgroup", "tfoot", "thead", "th")) > 0 {
+		return true
+	}
+
+	if len(getElementsByTagName(table, "table")) > 0 {
+		return false
+	}
+
+	rows, columns := getRowAndColumnCount(table)
+	return rows >= 10 || columns > 4 || rows*columns > 10
+}
+
+func getRowAndColumnCount(table *html.Node) (int, int) {
+	rows := 0
+	columns := 0
+
+	for _, tr := range getElementsByTagName(table, "tr") {
+		rowSpan, err := strconv.Atoi(getAttribute(tr, "rowspan"))
+		if err != nil || rowSpan < 1 {
+			rowSpan = 1
+		}
+		rows += rowSpan
+
+		columnsInRow := 0
+		for _, cell := range getElementsByTagName(tr, "td") {
+			colSpan, err := strconv.Atoi(getAttribute(cell, "colspan"))
+			if err != nil || colSpan < 1 {
+				colSpan = 1
+			}
+			columnsInRow += colSpan
+		}
+
+		if columnsInRow > columns {
+			columns = columnsInRow
+		}
+	}
+
+	return rows, columns
+}
+
+func allChildren(n *html.Node, predicate func(*html.Node) bool) bool {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if !predicate(child) {
+			return false
+		}
+	}
+	return true
+}
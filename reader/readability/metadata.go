@@ -0,0 +1,305 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package readability // import "miniflux.app/reader/readability"
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"miniflux.app/logger"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	propertyRegexp     = regexp.MustCompile(`(?i)\s*(article|dc|dcterm|og|twitter)\s*:\s*(author|creator|description|published_time|title|site_name|image)\s*`)
+	nameRegexp         = regexp.MustCompile(`(?i)^\s*(?:(dc|dcterm|og|twitter|weibo:(article|webpage))\s*[\.:]\s*)?(author|creator|description|title|site_name|image)\s*$`)
+	articleTypesRegexp = regexp.MustCompile(`^(Article|AdvertiserContentArticle|NewsArticle|AnalysisNewsArticle|AskPublicNewsArticle|BackgroundNewsArticle|OpinionNewsArticle|ReportageNewsArticle|ReviewNewsArticle|Report|SatiricalArticle|ScholarlyArticle|MedicalScholarlyArticle|SocialMediaPosting|BlogPosting|LiveBlogPosting|DiscussionForumPosting|TechArticle|APIReference)$`)
+	schemaOrgRegexp    = regexp.MustCompile(`^https?://schema\.org/?$`)
+
+	titleSeparatorsRegexp             = regexp.MustCompile(` [\|\-\\/>»] `)
+	titleHierarchicalSeparatorsRegexp = regexp.MustCompile(` [\\/>»] `)
+	titleLastPartRegexp               = regexp.MustCompile(`(.*)[\|\-\\/>»] .*`)
+	titleFirstPartRegexp              = regexp.MustCompile(`[^\|\-\\/>»]*[\|\-\\/>»](.*)`)
+	titleAllSeparatorsRegexp          = regexp.MustCompile(`[\|\-\\/>»]+`)
+)
+
+type metadata struct {
+	title         string
+	byline        string
+	excerpt       string
+	siteName      string
+	imageURL      string
+	publishedTime string
+}
+
+// getMetadata reads the JSON-LD data, the meta tags and the title of the document.
+func getMetadata(document *html.Node) *metadata {
+	jsonld := getJSONLD(document)
+	values := make(map[string]string)
+
+	for _, meta := range getElementsByTagName(document, "meta") {
+		content := strings.TrimSpace(getAttribute(meta, "content"))
+		if content == "" {
+			continue
+		}
+
+		matched := false
+		if property := getAttribute(meta, "property"); property != "" {
+			for _, match := range propertyRegexp.FindAllString(property, -1) {
+				values[strings.ToLower(strings.Join(strings.Fields(match), ""))] = content
+				matched = true
+			}
+		}
+
+		if name := getAttribute(meta, "name"); !matched && nameRegexp.MatchString(name) {
+			key := strings.ToLower(strings.Join(strings.Fields(name), ""))
+			values[strings.Replace(key, ".", ":", -1)] = content
+		}
+
+		if itemprop := getAttribute(meta, "itemprop"); itemprop == "datePublished" {
+			values["itemprop:datepublished"] = content
+		}
+	}
+
+	first := func(keys ...string) string {
+		for _, key := range keys {
+			if value := values[key]; value != "" {
+				return value
+			}
+		}
+		return ""
+	}
+
+	m := &metadata{
+		title:         jsonld.title,
+		byline:        jsonld.byline,
+		excerpt:       jsonld.excerpt,
+		siteName:      jsonld.siteName,
+		imageURL:      jsonld.imageURL,
+		publishedTime: jsonld.publishedTime,
+	}
+
+	if m.title == "" {
+		m.title = first("dc:title", "dcterm:title", "og:title", "weibo:article:title", "weibo:webpage:title", "title", "twitter:title")
+	}
+
+	if m.title == "" {
+		m.title = getArticleTitle(document)
+	}
+
+	if m.byline == "" {
+		m.byline = first("dc:creator", "dcterm:creator", "author")
+	}
+
+	if m.excerpt == "" {
+		m.excerpt = first("dc:description", "dcterm:description", "og:description", "weibo:article:description", "weibo:webpage:description", "description", "twitter:description")
+	}
+
+	if m.siteName == "" {
+		m.siteName = first("og:site_name")
+	}
+
+	if m.publishedTime == "" {
+		m.publishedTime = first("article:published_time", "itemprop:datepublished")
+	}
+
+	if m.imageURL == "" {
+		m.imageURL = first("og:image", "twitter:image")
+	}
+
+	if m.imageURL == "" {
+		for _, link := range getElementsByTagName(document, "link") {
+			if getAttribute(link, "rel") == "image_src" {
+				m.imageURL = strings.TrimSpace(getAttribute(link, "href"))
+				break
+			}
+		}
+	}
+
+	m.title = normalizeSpaces(m.title)
+	m.byline = normalizeSpaces(m.byline)
+	m.excerpt = normalizeSpaces(m.excerpt)
+	m.siteName = normalizeSpaces(m.siteName)
+	m.publishedTime = strings.TrimSpace(m.publishedTime)
+	m.imageURL = strings.TrimSpace(m.imageURL)
+
+	return m
+}
+
+// getJSONLD returns the metadata of the first schema.org article found in the JSON-LD scripts.
+func getJSONLD(document *html.Node) *metadata {
+	for _, script := range getElementsByTagName(document, "script") {
+		if getAttribute(script, "type") != "application/ld+json" {
+			continue
+		}
+
+		content := strings.TrimSpace(textContent(script))
+		content = strings.TrimSuffix(strings.TrimPrefix(content, "<![CDATA["), "]]>")
+
+		var data interface{}
+		if err := json.Unmarshal([]byte(content), &data); err != nil {
+			logger.Debug("[Readability] Unable to parse JSON-LD: %v", err)
+			continue
+		}
+
+		if article := findJSONLDArticle(data); article != nil {
+			return parseJSONLDArticle(article)
+		}
+	}
+
+	return &metadata{}
+}
+
+func findJSONLDArticle(data interface{}) map[string]interface{} {
+	switch value := data.(type) {
+	case []interface{}:
+		for _, item := range value {
+			if article := findJSONLDArticle(item); article != nil {
+				return article
+			}
+		}
+	case map[string]interface{}:
+		if context, ok := value["@context"].(string); ok && !schemaOrgRegexp.MatchString(context) {
+			return nil
+		}
+
+		if articleTypesRegexp.MatchString(jsonString(value["@type"])) {
+			return value
+		}
+
+		if graph, ok := value["@graph"].([]interface{}); ok {
+			return findJSONLDArticle(graph)
+		}
+	}
+
+	return nil
+}
+
+func parseJSONLDArticle(article map[string]interface{}) *metadata {
+	m := &metadata{
+		title:         jsonString(article["headline"]),
+		excerpt:       jsonString(article["description"]),
+		publishedTime: jsonString(article["datePublished"]),
+	}
+
+	if m.title == "" {
+		m.title = jsonString(article["name"])
+	}
+
+	switch author := article["author"].(type) {
+	case map[string]interface{}:
+		m.byline = jsonString(author["name"])
+	case []interface{}:
+		var names []string
+		for _, item := range author {
+			if object, ok := item.(map[string]interface{}); ok {
+				if name := jsonString(object["name"]); name != "" {
+					names = append(names, name)
+				}
+			}
+		}
+		m.byline = strings.Join(names, ", ")
+	case string:
+		m.byline = author
+	}
+
+	if publisher, ok := article["publisher"].(map[string]interface{}); ok {
+		m.siteName = jsonString(publisher["name"])
+	}
+
+	m.imageURL = jsonImage(article["image"])
+	return m
+}
+
+func jsonImage(value interface{}) string {
+	switch image := value.(type) {
+	case string:
+		return image
+	case map[string]interface{}:
+		return jsonString(image["url"])
+	case []interface{}:
+		if len(image) > 0 {
+			return jsonImage(image[0])
+		}
+	}
+	return ""
+}
+
+func jsonString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case []interface{}:
+		if len(v) > 0 {
+			return jsonString(v[0])
+		}
+	}
+	return ""
+}
+
+// getArticleTitle returns the title of the document without the name of the website.
+func getArticleTitle(document *html.Node) string {
+	titles := getElementsByTagName(document, "title")
+	if len(titles) == 0 {
+		return ""
+	}
+
+	originalTitle := normalizeSpaces(textContent(titles[0]))
+	currentTitle := originalTitle
+	hadHierarchicalSeparators := false
+
+	switch {
+	case titleSeparatorsRegexp.MatchString(currentTitle):
+		hadHierarchicalSeparators = titleHierarchicalSeparatorsRegexp.MatchString(currentTitle)
+		currentTitle = titleLastPartRegexp.ReplaceAllString(originalTitle, "$1")
+
+		// The website name could be at the beginning of the title.
+		if wordCount(currentTitle) < 3 {
+			currentTitle = titleFirstPartRegexp.ReplaceAllString(originalTitle, "$1")
+		}
+	case strings.Contains(currentTitle, ": "):
+		matchesHeading := false
+		for _, heading := range getElementsByTagName(document, "h1", "h2") {
+			if normalizeSpaces(textContent(heading)) == currentTitle {
+				matchesHeading = true
+				break
+			}
+		}
+
+		if !matchesHeading {
+			currentTitle = originalTitle[strings.LastIndex(originalTitle, ":")+1:]
+
+			if wordCount(currentTitle) < 3 {
+				currentTitle = originalTitle[strings.Index(originalTitle, ":")+1:]
+			} else if wordCount(originalTitle[:strings.Index(originalTitle, ":")]) > 5 {
+				currentTitle = originalTitle
+			}
+		}
+	case textLength(currentTitle) > 150 || textLength(currentTitle) < 15:
+		if headings := getElementsByTagName(document, "h1"); len(headings) == 1 {
+			currentTitle = textContent(headings[0])
+		}
+	}
+
+	currentTitle = normalizeSpaces(currentTitle)
+
+	// Short titles are probably wrong, except when only the website name was removed.
+	count := wordCount(currentTitle)
+	if count <= 4 && (!hadHierarchicalSeparators || count != wordCount(titleAllSeparatorsRegexp.ReplaceAllString(originalTitle, ""))-1) {
+		currentTitle = originalTitle
+	}
+
+	return currentTitle
+}
+
+func wordCount(text string) int {
+	return len(strings.Fields(text))
+}
+
+func normalizeSpaces(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
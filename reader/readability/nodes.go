@@ -0,0 +1,298 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package readability // import "miniflux.app/reader/readability"
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	divToPElements = map[string]bool{
+		"blockquote": true,
+		"dl":         true,
+		"div":        true,
+		"img":        true,
+		"ol":         true,
+		"p":          true,
+		"pre":        true,
+		"table":      true,
+		"ul":         true,
+	}
+
+	phrasingElements = map[string]bool{
+		"abbr": true, "audio": true, "b": true, "bdo": true, "br": true, "button": true, "cite": true,
+		"code": true, "data": true, "datalist": true, "dfn": true, "em": true, "embed": true, "i": true,
+		"img": true, "input": true, "kbd": true, "label": true, "mark": true, "math": true, "meter": true,
+		"noscript": true, "object": true, "output": true, "progress": true, "q": true, "ruby": true,
+		"samp": true, "script": true, "select": true, "small": true, "span": true, "strong": true,
+		"sub": true, "sup": true, "textarea": true, "time": true, "var": true, "wbr": true,
+	}
+)
+
+func tagName(n *html.Node) string {
+	if n == nil || n.Type != html.ElementNode {
+		return ""
+	}
+	return n.Data
+}
+
+func getAttribute(n *html.Node, key string) string {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func hasAttribute(n *html.Node, key string) bool {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func setNodeTag(n *html.Node, tag string) {
+	n.Data = tag
+	n.DataAtom = atom.Lookup([]byte(tag))
+}
+
+func removeNode(n *html.Node) {
+	if n.Parent != nil {
+		n.Parent.RemoveChild(n)
+	}
+}
+
+func replaceNode(oldNode, newNode *html.Node) {
+	if newNode.Parent != nil {
+		newNode.Parent.RemoveChild(newNode)
+	}
+	oldNode.Parent.InsertBefore(newNode, oldNode)
+	oldNode.Parent.RemoveChild(oldNode)
+}
+
+func elementChildren(n *html.Node) []*html.Node {
+	var children []*html.Node
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+func firstElementChild(n *html.Node) *html.Node {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode {
+			return child
+		}
+	}
+	return nil
+}
+
+func nextElementSibling(n *html.Node) *html.Node {
+	for sibling := n.NextSibling; sibling != nil; sibling = sibling.NextSibling {
+		if sibling.Type == html.ElementNode {
+			return sibling
+		}
+	}
+	return nil
+}
+
+// getNextNode traverses the elements in document order, the children are skipped when ignoreSelfAndKids is true.
+func getNextNode(n *html.Node, ignoreSelfAndKids bool) *html.Node {
+	if !ignoreSelfAndKids {
+		if child := firstElementChild(n); child != nil {
+			return child
+		}
+	}
+
+	if sibling := nextElementSibling(n); sibling != nil {
+		return sibling
+	}
+
+	for n = n.Parent; n != nil; n = n.Parent {
+		if sibling := nextElementSibling(n); sibling != nil {
+			return sibling
+		}
+	}
+
+	return nil
+}
+
+func removeAndGetNext(n *html.Node) *html.Node {
+	next := getNextNode(n, true)
+	removeNode(n)
+	return next
+}
+
+// getElementsByTagName returns the descendants with one of the given tags, in document order.
+func getElementsByTagName(n *html.Node, tags ...string) []*html.Node {
+	var elements []*html.Node
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type != html.ElementNode {
+				continue
+			}
+
+			for _, tag := range tags {
+				if child.Data == tag || tag == "*" {
+					elements = append(elements, child)
+					break
+				}
+			}
+
+			walk(child)
+		}
+	}
+	walk(n)
+	return elements
+}
+
+// hasAncestorTag checks the ancestors up to maxDepth levels, a negative depth checks all the ancestors.
+func hasAncestorTag(n *html.Node, tag string, maxDepth int, filter func(*html.Node) bool) bool {
+	depth := 0
+	for n = n.Parent; n != nil; n = n.Parent {
+		if maxDepth >= 0 && depth >= maxDepth {
+			return false
+		}
+
+		if tagName(n) == tag && (filter == nil || filter(n)) {
+			return true
+		}
+		depth++
+	}
+	return false
+}
+
+func getNodeAncestors(n *html.Node, maxDepth int) []*html.Node {
+	var ancestors []*html.Node
+	for n = n.Parent; n != nil; n = n.Parent {
+		ancestors = append(ancestors, n)
+		if maxDepth > 0 && len(ancestors) == maxDepth {
+			break
+		}
+	}
+	return ancestors
+}
+
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+
+	var builder strings.Builder
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			switch child.Type {
+			case html.TextNode:
+				builder.WriteString(child.Data)
+			case html.ElementNode:
+				walk(child)
+			}
+		}
+	}
+	walk(n)
+	return builder.String()
+}
+
+// innerText returns the text content with normalized spaces.
+func innerText(n *html.Node) string {
+	return strings.Join(strings.Fields(textContent(n)), " ")
+}
+
+func textLength(text string) int {
+	return utf8.RuneCountInString(text)
+}
+
+func isWhitespace(n *html.Node) bool {
+	return (n.Type == html.TextNode && strings.TrimSpace(n.Data) == "") || tagName(n) == "br"
+}
+
+func isPhrasingContent(n *html.Node) bool {
+	if n.Type == html.TextNode {
+		return true
+	}
+
+	tag := tagName(n)
+	if phrasingElements[tag] {
+		return true
+	}
+
+	if tag == "a" || tag == "del" || tag == "ins" {
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			if !isPhrasingContent(child) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// hasSingleTagInsideElement returns true if the element has a single child with the given tag and no text.
+func hasSingleTagInsideElement(n *html.Node, tag string) bool {
+	children := elementChildren(n)
+	if len(children) != 1 || tagName(children[0]) != tag {
+		return false
+	}
+
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.TextNode && strings.TrimSpace(child.Data) != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasChildBlockElement(n *html.Node) bool {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode && (divToPElements[child.Data] || hasChildBlockElement(child)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isElementWithoutContent(n *html.Node) bool {
+	if n.Type != html.ElementNode || strings.TrimSpace(textContent(n)) != "" {
+		return false
+	}
+
+	children := elementChildren(n)
+	return len(children) == 0 || len(children) == len(getElementsByTagName(n, "br", "hr"))
+}
+
+// isProbablyVisible ignores the elements hidden with inline styles or attributes.
+func isProbablyVisible(n *html.Node) bool {
+	style := strings.ToLower(strings.Replace(getAttribute(n, "style"), " ", "", -1))
+	if strings.Contains(style, "display:none") || strings.Contains(style, "visibility:hidden") {
+		return false
+	}
+
+	if hasAttribute(n, "hidden") {
+		return false
+	}
+
+	return getAttribute(n, "aria-hidden") != "true" || strings.Contains(getAttribute(n, "class"), "fallback-image")
+}
+
+// nextNonWhitespaceNode skips the whitespace text nodes.
+func nextNonWhitespaceNode(n *html.Node) *html.Node {
+	for n != nil && n.Type != html.ElementNode && strings.TrimSpace(textContent(n)) == "" {
+		n = n.NextSibling
+	}
+	return n
+}
@@ -6,301 +6,686 @@ package readability // import "miniflux.app/reader/readability"
 
 import (
 	"bytes"
-	"fmt"
 	"io"
+	"io/ioutil"
 	"math"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"miniflux.app/logger"
+	"miniflux.app/reader/date"
+	"miniflux.app/url"
 
-	"github.com/PuerkitoBio/goquery"
 	"golang.org/x/net/html"
 )
 
 const (
-	defaultTagsToScore = "section,h2,h3,h4,h5,h6,p,td,pre,div"
+	flagStripUnlikelys = 1 << iota
+	flagWeightClasses
+	flagCleanConditionally
+)
+
+const (
+	// Number of top candidates to compare when looking for the article container.
+	nbTopCandidates = 5
+
+	// Minimum number of characters an article must have to stop the retries.
+	charThreshold = 500
 )
 
 var (
-	divToPElementsRegexp = regexp.MustCompile(`(?i)<(a|blockquote|dl|div|img|ol|p|pre|table|ul)`)
-	sentenceRegexp       = regexp.MustCompile(`\.( |$)`)
+	defaultTagsToScore   = map[string]bool{"section": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "p": true, "td": true, "pre": true}
+	alterToDivExceptions = map[string]bool{"div": true, "article": true, "section": true, "p": true}
+	unlikelyRoles        = map[string]bool{"menu": true, "menubar": true, "complementary": true, "navigation": true, "alert": true, "alertdialog": true, "dialog": true}
 
-	blacklistCandidatesRegexp  = regexp.MustCompile(`(?i)popupbody|-ad|g-plus`)
-	okMaybeItsACandidateRegexp = regexp.MustCompile(`(?i)and|article|body|column|main|shadow`)
-	unlikelyCandidatesRegexp   = regexp.MustCompile(`(?i)banner|breadcrumbs|combx|comment|community|cover-wrap|disqus|extra|foot|header|legends|menu|modal|related|remark|replies|rss|shoutbox|sidebar|skyscraper|social|sponsor|supplemental|ad-break|agegate|pagination|pager|popup|yom-remote`)
+	unlikelyCandidatesRegexp   = regexp.MustCompile(`(?i)-ad-|ai2html|banner|breadcrumbs|combx|comment|community|cover-wrap|disqus|extra|footer|gdpr|header|legends|menu|related|remark|replies|rss|shoutbox|sidebar|skyscraper|social|sponsor|supplemental|ad-break|agegate|pagination|pager|popup|yom-remote`)
+	okMaybeItsACandidateRegexp = regexp.MustCompile(`(?i)and|article|body|column|content|main|shadow`)
 
-	negativeRegexp = regexp.MustCompile(`(?i)hidden|^hid$|hid$|hid|^hid |banner|combx|comment|com-|contact|foot|footer|footnote|masthead|media|meta|modal|outbrain|promo|related|scroll|share|shoutbox|sidebar|skyscraper|sponsor|shopping|tags|tool|widget|byline|author|dateline|writtenby|p-author`)
 	positiveRegexp = regexp.MustCompile(`(?i)article|body|content|entry|hentry|h-entry|main|page|pagination|post|text|blog|story`)
+	negativeRegexp = regexp.MustCompile(`(?i)-ad-|hidden|^hid$| hid$| hid |^hid |banner|combx|comment|com-|contact|foot|footer|footnote|gdpr|masthead|media|meta|outbrain|promo|related|scroll|share|shoutbox|sidebar|skyscraper|sponsor|shopping|tags|tool|widget`)
+	bylineRegexp   = regexp.MustCompile(`(?i)byline|author|dateline|writtenby|p-author`)
+	sentenceRegexp = regexp.MustCompile(`\.( |$)`)
+	hashURLRegexp  = regexp.MustCompile(`^#.+`)
 )
 
-type candidate struct {
-	selection *goquery.Selection
-	score     float32
+// Article contains the relevant content and the metadata of a web page.
+type Article struct {
+	Title       string
+	Byline      string
+	Excerpt     string
+	SiteName    string
+	ImageURL    string
+	PublishedAt time.Time
+	Content     string
 }
 
-func (c *candidate) Node() *html.Node {
-	return c.selection.Get(0)
+type parser struct {
+	flags      int
+	title      string
+	byline     string
+	scores     map[*html.Node]float64
+	dataTables map[*html.Node]bool
 }
 
-func (c *candidate) String() string {
-	id, _ := c.selection.Attr("id")
-	class, _ := c.selection.Attr("class")
+type attempt struct {
+	content    *html.Node
+	byline     string
+	textLength int
+}
 
-	if id != "" && class != "" {
-		return fmt.Sprintf("%s#%s.%s => %f", c.Node().DataAtom, id, class, c.score)
-	} else if id != "" {
-		return fmt.Sprintf("%s#%s => %f", c.Node().DataAtom, id, c.score)
-	} else if class != "" {
-		return fmt.Sprintf("%s.%s => %f", c.Node().DataAtom, class, c.score)
+// ExtractContent returns relevant content.
+func ExtractContent(page io.Reader) (string, error) {
+	article, err := Extract("", page)
+	if err != nil {
+		return "", err
 	}
 
-	return fmt.Sprintf("%s => %f", c.Node().DataAtom, c.score)
+	return article.Content, nil
 }
 
-type candidateList map[*html.Node]*candidate
+// Extract returns the relevant content of the page along with its title, byline, excerpt and lead image.
+func Extract(pageURL string, page io.Reader) (*Article, error) {
+	buffer, err := ioutil.ReadAll(page)
+	if err != nil {
+		return nil, err
+	}
+
+	article, err := ExtractMetadata(pageURL, bytes.NewReader(buffer))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{
+		flags: flagStripUnlikelys | flagWeightClasses | flagCleanConditionally,
+		title: article.Title,
+	}
 
-func (c candidateList) String() string {
-	var output []string
-	for _, candidate := range c {
-		output = append(output, candidate.String())
+	content, err := p.grabArticle(buffer)
+	if err != nil {
+		return nil, err
 	}
 
-	return strings.Join(output, ", ")
+	if article.Byline == "" {
+		article.Byline = p.byline
+	}
+
+	if content == nil {
+		return article, nil
+	}
+
+	if article.Excerpt == "" {
+		if paragraphs := getElementsByTagName(content, "p"); len(paragraphs) > 0 {
+			article.Excerpt = innerText(paragraphs[0])
+		}
+	}
+
+	if article.ImageURL == "" {
+		for _, img := range getElementsByTagName(content, "img") {
+			if src := strings.TrimSpace(getAttribute(img, "src")); src != "" && !strings.HasPrefix(src, "data:") {
+				article.ImageURL = absoluteURL(pageURL, src)
+				break
+			}
+		}
+	}
+
+	var output bytes.Buffer
+	if err := html.Render(&output, content); err != nil {
+		return nil, err
+	}
+
+	article.Content = output.String()
+	return article, nil
 }
 
-// ExtractContent returns relevant content.
-func ExtractContent(page io.Reader) (string, error) {
-	document, err := goquery.NewDocumentFromReader(page)
+// ExtractMetadata returns the title, byline, excerpt, site name, publication date and lead image of the page.
+//
+// The JSON-LD data are used first, then the meta tags and the title of the document.
+func ExtractMetadata(pageURL string, page io.Reader) (*Article, error) {
+	document, err := html.Parse(page)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	document.Find("script,style,noscript").Each(func(i int, s *goquery.Selection) {
-		removeNodes(s)
-	})
+	metadata := getMetadata(document)
 
-	transformMisusedDivsIntoParagraphs(document)
-	removeUnlikelyCandidates(document)
+	article := &Article{
+		Title:    metadata.title,
+		Byline:   metadata.byline,
+		Excerpt:  metadata.excerpt,
+		SiteName: metadata.siteName,
+	}
 
-	candidates := getCandidates(document)
-	logger.Debug("[Readability] Candidates: %v", candidates)
+	if metadata.imageURL != "" {
+		article.ImageURL = absoluteURL(pageURL, metadata.imageURL)
+	}
 
-	topCandidate := getTopCandidate(document, candidates)
-	logger.Debug("[Readability] TopCandidate: %v", topCandidate)
+	if metadata.publishedTime != "" {
+		if publishedAt, err := date.Parse(metadata.publishedTime); err == nil {
+			article.PublishedAt = publishedAt
+		} else {
+			logger.Debug("[Readability] Unable to parse publication date %q: %v", metadata.publishedTime, err)
+		}
+	}
 
-	output := getArticle(topCandidate, candidates)
-	return output, nil
+	return article, nil
 }
 
-// Now that we have the top candidate, look through its siblings for content that might also be related.
-// Things like preambles, content split by ads that we removed, etc.
-func getArticle(topCandidate *candidate, candidates candidateList) string {
-	output := bytes.NewBufferString("<div>")
-	siblingScoreThreshold := float32(math.Max(10, float64(topCandidate.score*.2)))
+// grabArticle finds the article container and its related siblings.
+// The page is parsed again with fewer heuristics until enough text is found.
+func (p *parser) grabArticle(page []byte) (*html.Node, error) {
+	var attempts []attempt
 
-	topCandidate.selection.Siblings().Union(topCandidate.selection).Each(func(i int, s *goquery.Selection) {
-		append := false
-		node := s.Get(0)
+	for {
+		document, err := html.Parse(bytes.NewReader(page))
+		if err != nil {
+			return nil, err
+		}
+
+		p.scores = make(map[*html.Node]float64)
+		p.dataTables = make(map[*html.Node]bool)
+		p.byline = ""
+
+		prepDocument(document)
 
-		if node == topCandidate.Node() {
-			append = true
-		} else if c, ok := candidates[node]; ok && c.score >= siblingScoreThreshold {
-			append = true
+		body := getElementsByTagName(document, "body")
+		if len(body) == 0 {
+			return nil, nil
 		}
 
-		if s.Is("p") {
-			linkDensity := getLinkDensity(s)
-			content := s.Text()
-			contentLength := len(content)
+		articleContent := p.extractArticle(body[0])
+		length := textLength(innerText(articleContent))
+		if length >= charThreshold {
+			return articleContent, nil
+		}
 
-			if contentLength >= 80 && linkDensity < .25 {
-				append = true
-			} else if contentLength < 80 && linkDensity == 0 && sentenceRegexp.MatchString(content) {
-				append = true
+		attempts = append(attempts, attempt{content: articleContent, byline: p.byline, textLength: length})
+
+		switch {
+		case p.flags&flagStripUnlikelys != 0:
+			p.flags ^= flagStripUnlikelys
+		case p.flags&flagWeightClasses != 0:
+			p.flags ^= flagWeightClasses
+		case p.flags&flagCleanConditionally != 0:
+			p.flags ^= flagCleanConditionally
+		default:
+			sort.SliceStable(attempts, func(i, j int) bool {
+				return attempts[i].textLength > attempts[j].textLength
+			})
+
+			if attempts[0].textLength == 0 {
+				return nil, nil
 			}
+
+			p.byline = attempts[0].byline
+			return attempts[0].content, nil
 		}
 
-		if append {
-			tag := "div"
-			if s.Is("p") {
-				tag = node.Data
+		logger.Debug("[Readability] Not enough content found (%d characters), retrying with flags %d", length, p.flags)
+	}
+}
+
+func (p *parser) extractArticle(body *html.Node) *html.Node {
+	elementsToScore := p.prepareNodes(body)
+	candidates := p.scoreElements(elementsToScore)
+
+	topCandidate := p.findTopCandidate(body, candidates)
+	articleContent := p.joinSiblings(topCandidate)
+	p.prepArticle(articleContent)
+
+	return articleContent
+}
+
+// prepareNodes removes the unlikely candidates and returns the nodes to score.
+func (p *parser) prepareNodes(body *html.Node) []*html.Node {
+	var elementsToScore []*html.Node
+	shouldRemoveTitleHeader := true
+
+	node := body
+	for node != nil {
+		tag := tagName(node)
+		matchString := getAttribute(node, "class") + " " + getAttribute(node, "id")
+
+		if !isProbablyVisible(node) {
+			node = removeAndGetNext(node)
+			continue
+		}
+
+		if getAttribute(node, "aria-modal") == "true" && getAttribute(node, "role") == "dialog" {
+			node = removeAndGetNext(node)
+			continue
+		}
+
+		if p.byline == "" && isByline(node, matchString) {
+			p.byline = innerText(node)
+			node = removeAndGetNext(node)
+			continue
+		}
+
+		if shouldRemoveTitleHeader && p.headerDuplicatesTitle(node) {
+			shouldRemoveTitleHeader = false
+			node = removeAndGetNext(node)
+			continue
+		}
+
+		if p.flags&flagStripUnlikelys != 0 && tag != "body" && tag != "a" {
+			if unlikelyCandidatesRegexp.MatchString(matchString) &&
+				!okMaybeItsACandidateRegexp.MatchString(matchString) &&
+				!hasAncestorTag(node, "table", 3, nil) &&
+				!hasAncestorTag(node, "code", 3, nil) {
+				logger.Debug("[Readability] Removing unlikely candidate %s", matchString)
+				node = removeAndGetNext(node)
+				continue
 			}
 
-			html, _ := s.Html()
-			fmt.Fprintf(output, "<%s>%s</%s>", tag, html, tag)
+			if unlikelyRoles[getAttribute(node, "role")] {
+				node = removeAndGetNext(node)
+				continue
+			}
 		}
-	})
 
-	output.Write([]byte("</div>"))
-	return output.String()
-}
+		switch tag {
+		case "div", "section", "header", "h1", "h2", "h3", "h4", "h5", "h6":
+			if isElementWithoutContent(node) {
+				node = removeAndGetNext(node)
+				continue
+			}
+		}
 
-func removeUnlikelyCandidates(document *goquery.Document) {
-	document.Find("*").Not("html,body").Each(func(i int, s *goquery.Selection) {
-		class, _ := s.Attr("class")
-		id, _ := s.Attr("id")
-		str := class + id
+		if defaultTagsToScore[tag] {
+			elementsToScore = append(elementsToScore, node)
+		}
 
-		if blacklistCandidatesRegexp.MatchString(str) || (unlikelyCandidatesRegexp.MatchString(str) && !okMaybeItsACandidateRegexp.MatchString(str)) {
-			removeNodes(s)
+		if tag == "div" {
+			wrapPhrasingContent(node)
+
+			// Sites like Medium put each paragraph in its own div.
+			if hasSingleTagInsideElement(node, "p") && getLinkDensity(node) < 0.25 {
+				child := firstElementChild(node)
+				replaceNode(node, child)
+				node = child
+				elementsToScore = append(elementsToScore, node)
+			} else if !hasChildBlockElement(node) {
+				setNodeTag(node, "p")
+				elementsToScore = append(elementsToScore, node)
+			}
 		}
-	})
-}
 
-func getTopCandidate(document *goquery.Document, candidates candidateList) *candidate {
-	var best *candidate
+		node = getNextNode(node, false)
+	}
 
-	for _, c := range candidates {
-		if best == nil {
-			best = c
-		} else if best.score < c.score {
-			best = c
+	return elementsToScore
+}
+
+// wrapPhrasingContent puts the phrasing content of a div into paragraphs.
+func wrapPhrasingContent(div *html.Node) {
+	var paragraph *html.Node
+
+	child := div.FirstChild
+	for child != nil {
+		next := child.NextSibling
+
+		if isPhrasingContent(child) {
+			if paragraph != nil {
+				div.RemoveChild(child)
+				paragraph.AppendChild(child)
+			} else if !isWhitespace(child) {
+				paragraph = &html.Node{Type: html.ElementNode}
+				setNodeTag(paragraph, "p")
+				div.InsertBefore(paragraph, child)
+				div.RemoveChild(child)
+				paragraph.AppendChild(child)
+			}
+		} else if paragraph != nil {
+			trimTrailingWhitespace(paragraph)
+			paragraph = nil
 		}
+
+		child = next
 	}
 
-	if best == nil {
-		best = &candidate{document.Find("body"), 0}
+	if paragraph != nil {
+		trimTrailingWhitespace(paragraph)
 	}
+}
 
-	return best
+func trimTrailingWhitespace(n *html.Node) {
+	for n.LastChild != nil && isWhitespace(n.LastChild) {
+		n.RemoveChild(n.LastChild)
+	}
 }
 
-// Loop through all paragraphs, and assign a score to them based on how content-y they look.
-// Then add their score to their parent node.
-// A score is determined by things like number of commas, class names, etc.
-// Maybe eventually link density.
-func getCandidates(document *goquery.Document) candidateList {
-	candidates := make(candidateList)
+// scoreElements assigns a score to the paragraphs based on how content-y they look,
+// the score is propagated to their ancestors which are returned as candidates.
+func (p *parser) scoreElements(elementsToScore []*html.Node) []*html.Node {
+	var candidates []*html.Node
+
+	for _, element := range elementsToScore {
+		if element.Parent == nil || element.Parent.Type != html.ElementNode {
+			continue
+		}
+
+		text := innerText(element)
+		length := textLength(text)
+		if length < 25 {
+			continue
+		}
+
+		ancestors := getNodeAncestors(element, 5)
+		if len(ancestors) == 0 {
+			continue
+		}
+
+		// Add a point for the paragraph itself, for any comma and for every 100 characters (up to 3 points).
+		contentScore := 1.0
+		contentScore += float64(strings.Count(text, ",") + 1)
+		contentScore += math.Min(math.Floor(float64(length)/100), 3)
+
+		for level, ancestor := range ancestors {
+			if ancestor.Type != html.ElementNode || ancestor.Parent == nil || ancestor.Parent.Type != html.ElementNode {
+				continue
+			}
+
+			if _, found := p.scores[ancestor]; !found {
+				p.initializeNode(ancestor)
+				candidates = append(candidates, ancestor)
+			}
 
-	document.Find(defaultTagsToScore).Each(func(i int, s *goquery.Selection) {
-		text := s.Text()
+			// The parent gets the full score, the grandparent half and the other ancestors a third of their level.
+			divider := 1.0
+			switch level {
+			case 0:
+			case 1:
+				divider = 2
+			default:
+				divider = float64(level * 3)
+			}
 
-		// If this paragraph is less than 25 characters, don't even count it.
-		if len(text) < 25 {
-			return
+			p.scores[ancestor] += contentScore / divider
 		}
+	}
+
+	// Scale the final scores based on link density, good content should have a small link density.
+	for _, candidate := range candidates {
+		p.scores[candidate] *= 1 - getLinkDensity(candidate)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return p.scores[candidates[i]] > p.scores[candidates[j]]
+	})
+
+	if len(candidates) > nbTopCandidates {
+		candidates = candidates[:nbTopCandidates]
+	}
 
-		parent := s.Parent()
-		parentNode := parent.Get(0)
+	return candidates
+}
 
-		grandParent := parent.Parent()
-		var grandParentNode *html.Node
-		if grandParent.Length() > 0 {
-			grandParentNode = grandParent.Get(0)
+func (p *parser) findTopCandidate(body *html.Node, topCandidates []*html.Node) *html.Node {
+	if len(topCandidates) == 0 || tagName(topCandidates[0]) == "body" {
+		// Move everything into a div when no candidate was found.
+		topCandidate := &html.Node{Type: html.ElementNode}
+		setNodeTag(topCandidate, "div")
+		for body.FirstChild != nil {
+			child := body.FirstChild
+			body.RemoveChild(child)
+			topCandidate.AppendChild(child)
 		}
 
-		if _, found := candidates[parentNode]; !found {
-			candidates[parentNode] = scoreNode(parent)
+		body.AppendChild(topCandidate)
+		p.initializeNode(topCandidate)
+		return topCandidate
+	}
+
+	topCandidate := topCandidates[0]
+
+	// Find a better top candidate when it contains only part of the article:
+	// the common ancestor of the candidates with a similar score is used.
+	var alternativeCandidateAncestors [][]*html.Node
+	for _, candidate := range topCandidates[1:] {
+		if p.scores[candidate]/p.scores[topCandidate] >= 0.75 {
+			alternativeCandidateAncestors = append(alternativeCandidateAncestors, getNodeAncestors(candidate, 0))
 		}
+	}
 
-		if grandParentNode != nil {
-			if _, found := candidates[grandParentNode]; !found {
-				candidates[grandParentNode] = scoreNode(grandParent)
+	const minimumTopCandidates = 3
+	if len(alternativeCandidateAncestors) >= minimumTopCandidates {
+		for parent := topCandidate.Parent; parent != nil && tagName(parent) != "body"; parent = parent.Parent {
+			listsContainingThisAncestor := 0
+			for _, ancestors := range alternativeCandidateAncestors {
+				if containsNode(ancestors, parent) {
+					listsContainingThisAncestor++
+				}
+			}
+
+			if listsContainingThisAncestor >= minimumTopCandidates {
+				topCandidate = parent
+				break
 			}
 		}
+	}
 
-		// Add a point for the paragraph itself as a base.
-		contentScore := float32(1.0)
+	if _, found := p.scores[topCandidate]; !found {
+		p.initializeNode(topCandidate)
+	}
 
-		// Add points for any commas within this paragraph.
-		contentScore += float32(strings.Count(text, ",") + 1)
+	// A parent with a better score contains more content, the score is expected to decrease when climbing up.
+	lastScore := p.scores[topCandidate]
+	scoreThreshold := lastScore / 3
+	for parent := topCandidate.Parent; parent != nil && tagName(parent) != "body"; parent = parent.Parent {
+		parentScore, found := p.scores[parent]
+		if !found {
+			continue
+		}
 
-		// For every 100 characters in this paragraph, add another point. Up to 3 points.
-		contentScore += float32(math.Min(float64(int(len(text)/100.0)), 3))
+		if parentScore < scoreThreshold {
+			break
+		}
 
-		candidates[parentNode].score += contentScore
-		if grandParentNode != nil {
-			candidates[grandParentNode].score += contentScore / 2.0
+		if parentScore > lastScore {
+			topCandidate = parent
+			break
 		}
-	})
 
-	// Scale the final candidates score based on link density. Good content
-	// should have a relatively small link density (5% or less) and be mostly
-	// unaffected by this operation
-	for _, candidate := range candidates {
-		candidate.score = candidate.score * (1 - getLinkDensity(candidate.selection))
+		lastScore = parentScore
 	}
 
-	return candidates
+	// Climb up while the candidate is the only child of its parent to join the related siblings.
+	for parent := topCandidate.Parent; parent != nil && tagName(parent) != "body" && len(elementChildren(parent)) == 1; parent = topCandidate.Parent {
+		topCandidate = parent
+	}
+
+	if _, found := p.scores[topCandidate]; !found {
+		p.initializeNode(topCandidate)
+	}
+
+	return topCandidate
 }
 
-func scoreNode(s *goquery.Selection) *candidate {
-	c := &candidate{selection: s, score: 0}
+// joinSiblings looks through the siblings of the top candidate for related content,
+// like preambles or content split by ads that were removed.
+func (p *parser) joinSiblings(topCandidate *html.Node) *html.Node {
+	articleContent := &html.Node{Type: html.ElementNode}
+	setNodeTag(articleContent, "div")
+
+	topScore := p.scores[topCandidate]
+	siblingScoreThreshold := math.Max(10, topScore*0.2)
+	topClass := getAttribute(topCandidate, "class")
+
+	siblings := []*html.Node{topCandidate}
+	if topCandidate.Parent != nil {
+		siblings = elementChildren(topCandidate.Parent)
+	}
 
-	switch s.Get(0).DataAtom.String() {
+	for _, sibling := range siblings {
+		shouldAppend := sibling == topCandidate
+
+		if !shouldAppend {
+			contentBonus := 0.0
+			if topClass != "" && getAttribute(sibling, "class") == topClass {
+				contentBonus += topScore * 0.2
+			}
+
+			if score, found := p.scores[sibling]; found && score+contentBonus >= siblingScoreThreshold {
+				shouldAppend = true
+			} else if tagName(sibling) == "p" {
+				linkDensity := getLinkDensity(sibling)
+				content := innerText(sibling)
+				length := textLength(content)
+
+				if length > 80 && linkDensity < 0.25 {
+					shouldAppend = true
+				} else if length < 80 && length > 0 && linkDensity == 0 && sentenceRegexp.MatchString(content) {
+					shouldAppend = true
+				}
+			}
+		}
+
+		if shouldAppend {
+			if !alterToDivExceptions[tagName(sibling)] {
+				setNodeTag(sibling, "div")
+			}
+
+			removeNode(sibling)
+			articleContent.AppendChild(sibling)
+		}
+	}
+
+	return articleContent
+}
+
+// initializeNode gives a base score to the node based on its tag and its class name.
+func (p *parser) initializeNode(n *html.Node) {
+	score := 0.0
+
+	switch tagName(n) {
 	case "div":
-		c.score += 5
-	case "pre", "td", "blockquote", "img":
-		c.score += 3
+		score += 5
+	case "pre", "td", "blockquote":
+		score += 3
 	case "address", "ol", "ul", "dl", "dd", "dt", "li", "form":
-		c.score -= 3
+		score -= 3
 	case "h1", "h2", "h3", "h4", "h5", "h6", "th":
-		c.score -= 5
+		score -= 5
 	}
 
-	c.score += getClassWeight(s)
-	return c
+	p.scores[n] = score + p.getClassWeight(n)
 }
 
-// Get the density of links as a percentage of the content
-// This is the amount of text that is inside a link divided by the total text in the node.
-func getLinkDensity(s *goquery.Selection) float32 {
-	linkLength := len(s.Find("a").Text())
-	textLength := len(s.Text())
-
-	if textLength == 0 {
+// getClassWeight uses the class and the id of the element to tell if it looks good or bad.
+func (p *parser) getClassWeight(n *html.Node) float64 {
+	if p.flags&flagWeightClasses == 0 {
 		return 0
 	}
 
-	return float32(linkLength) / float32(textLength)
-}
-
-// Get an elements class/id weight. Uses regular expressions to tell if this
-// element looks good or bad.
-func getClassWeight(s *goquery.Selection) float32 {
-	weight := 0
-	class, _ := s.Attr("class")
-	id, _ := s.Attr("id")
+	weight := 0.0
+	for _, value := range []string{getAttribute(n, "class"), getAttribute(n, "id")} {
+		if value == "" {
+			continue
+		}
 
-	if class != "" {
-		if negativeRegexp.MatchString(class) {
+		if negativeRegexp.MatchString(value) {
 			weight -= 25
 		}
 
-		if positiveRegexp.MatchString(class) {
+		if positiveRegexp.MatchString(value) {
 			weight += 25
 		}
 	}
 
-	if id != "" {
-		if negativeRegexp.MatchString(id) {
-			weight -= 25
-		}
+	return weight
+}
 
-		if positiveRegexp.MatchString(id) {
-			weight += 25
+func (p *parser) headerDuplicatesTitle(n *html.Node) bool {
+	tag := tagName(n)
+	if (tag != "h1" && tag != "h2") || p.title == "" {
+		return false
+	}
+
+	return textSimilarity(p.title, innerText(n)) > 0.75
+}
+
+func isByline(n *html.Node, matchString string) bool {
+	if getAttribute(n, "rel") != "author" &&
+		!strings.Contains(getAttribute(n, "itemprop"), "author") &&
+		!bylineRegexp.MatchString(matchString) {
+		return false
+	}
+
+	length := textLength(strings.TrimSpace(textContent(n)))
+	return length > 0 && length < 100
+}
+
+// getLinkDensity returns the amount of text inside links divided by the total text of the node.
+func getLinkDensity(n *html.Node) float64 {
+	length := textLength(innerText(n))
+	if length == 0 {
+		return 0
+	}
+
+	linkLength := 0.0
+	for _, link := range getElementsByTagName(n, "a") {
+		// Links to sections of the page are most likely part of the content.
+		coefficient := 1.0
+		if hashURLRegexp.MatchString(getAttribute(link, "href")) {
+			coefficient = 0.3
 		}
+
+		linkLength += float64(textLength(innerText(link))) * coefficient
 	}
 
-	return float32(weight)
+	return linkLength / float64(length)
 }
 
-func transformMisusedDivsIntoParagraphs(document *goquery.Document) {
-	document.Find("div").Each(func(i int, s *goquery.Selection) {
-		html, _ := s.Html()
-		if !divToPElementsRegexp.MatchString(html) {
-			node := s.Get(0)
-			node.Data = "p"
+// textSimilarity compares the words of two texts, 1 means that all the words of textB are in textA.
+func textSimilarity(textA, textB string) float64 {
+	tokensA := tokenize(textA)
+	tokensB := tokenize(textB)
+	if len(tokensA) == 0 || len(tokensB) == 0 {
+		return 0
+	}
+
+	words := make(map[string]bool)
+	for _, token := range tokensA {
+		words[token] = true
+	}
+
+	var uniqueTokensB []string
+	for _, token := range tokensB {
+		if !words[token] {
+			uniqueTokensB = append(uniqueTokensB, token)
 		}
+	}
+
+	distance := float64(len(strings.Join(uniqueTokensB, " "))) / float64(len(strings.Join(tokensB, " ")))
+	return 1 - distance
+}
+
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !(r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r > 127)
 	})
 }
 
-func removeNodes(s *goquery.Selection) {
-	s.Each(func(i int, s *goquery.Selection) {
-		parent := s.Parent()
-		if parent.Length() > 0 {
-			parent.Get(0).RemoveChild(s.Get(0))
+func containsNode(nodes []*html.Node, n *html.Node) bool {
+	for _, node := range nodes {
+		if node == n {
+			return true
 		}
-	})
+	}
+	return false
+}
+
+func absoluteURL(pageURL, link string) string {
+	if pageURL == "" || url.IsAbsoluteURL(link) {
+		return link
+	}
+
+	absolute, err := url.AbsoluteURL(pageURL, link)
+	if err != nil {
+		return link
+	}
+
+	return absolute
 }
@@ -0,0 +1,238 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package readability // import "miniflux.app/reader/readability"
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const paragraph = `Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore et dolore magna aliqua. Ut enim ad minim veniam, quis nostrud exercitation ullamco laboris nisi ut aliquip ex ea commodo consequat.`
+
+func TestExtractArticle(t *testing.T) {
+	page := `<html>
+		<head>
+			<title>The title of the article | Example Website</title>
+			<meta property="og:site_name" content="Example Website">
+			<meta property="article:published_time" content="2020-05-02T10:00:00Z">
+		</head>
+		<body>
+			<div class="header"><a href="/">Home</a> <a href="/about">About</a></div>
+			<div class="sidebar"><ul><li><a href="/1">Link 1</a></li><li><a href="/2">Link 2</a></li></ul></div>
+			<article>
+				<h1>The title of the article</h1>
+				<p class="byline">By Jane Doe</p>
+				<img src="/images/lead.jpg">
+				<p>` + paragraph + `</p>
+				<p>` + paragraph + `</p>
+				<p>` + paragraph + `</p>
+				<div class="share"><a href="https://facebook.com/">Share on Facebook</a> <a href="https://twitter.com/">Share on Twitter</a></div>
+			</article>
+			<div class="footer">Copyright</div>
+		</body>
+	</html>`
+
+	article, err := Extract("https://example.org/articles/1", strings.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if article.Title != "The title of the article" {
+		t.Errorf(`Unexpected title: %q`, article.Title)
+	}
+
+	if article.Byline != "By Jane Doe" {
+		t.Errorf(`Unexpected byline: %q`, article.Byline)
+	}
+
+	if article.SiteName != "Example Website" {
+		t.Errorf(`Unexpected site name: %q`, article.SiteName)
+	}
+
+	if !article.PublishedAt.Equal(time.Date(2020, time.May, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected publication date: %v`, article.PublishedAt)
+	}
+
+	if article.ImageURL != "https://example.org/images/lead.jpg" {
+		t.Errorf(`Unexpected image URL: %q`, article.ImageURL)
+	}
+
+	if !strings.HasPrefix(article.Excerpt, "Lorem ipsum") {
+		t.Errorf(`Unexpected excerpt: %q`, article.Excerpt)
+	}
+
+	if strings.Count(article.Content, "Lorem ipsum") != 3 {
+		t.Errorf(`The paragraphs should be kept: %q`, article.Content)
+	}
+
+	for _, unexpected := range []string{"Home", "Link 1", "Copyright", "Share on", "By Jane Doe", "<h1>"} {
+		if strings.Contains(article.Content, unexpected) {
+			t.Errorf(`The content should not contain %q: %q`, unexpected, article.Content)
+		}
+	}
+}
+
+func TestExtractMetadataFromJSONLD(t *testing.T) {
+	page := `<html>
+		<head>
+			<title>Ignored title</title>
+			<meta property="og:title" content="Ignored OpenGraph title">
+			<script type="application/ld+json">{
+				"@context": "https://schema.org",
+				"@graph": [
+					{"@type": "WebSite", "name": "Website"},
+					{
+						"@type": "NewsArticle",
+						"headline": "JSON-LD headline",
+						"description": "JSON-LD description",
+						"datePublished": "2020-04-01T08:30:00+02:00",
+						"author": [{"@type": "Person", "name": "Jane Doe"}, {"@type": "Person", "name": "John Doe"}],
+						"publisher": {"@type": "Organization", "name": "Example News"},
+						"image": {"@type": "ImageObject", "url": "/image.png"}
+					}
+				]
+			}</script>
+		</head>
+		<body></body>
+	</html>`
+
+	article, err := ExtractMetadata("https://example.org/news/", strings.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if article.Title != "JSON-LD headline" {
+		t.Errorf(`Unexpected title: %q`, article.Title)
+	}
+
+	if article.Byline != "Jane Doe, John Doe" {
+		t.Errorf(`Unexpected byline: %q`, article.Byline)
+	}
+
+	if article.Excerpt != "JSON-LD description" {
+		t.Errorf(`Unexpected excerpt: %q`, article.Excerpt)
+	}
+
+	if article.SiteName != "Example News" {
+		t.Errorf(`Unexpected site name: %q`, article.SiteName)
+	}
+
+	if article.ImageURL != "https://example.org/image.png" {
+		t.Errorf(`Unexpected image URL: %q`, article.ImageURL)
+	}
+
+	if !article.PublishedAt.Equal(time.Date(2020, time.April, 1, 6, 30, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected publication date: %v`, article.PublishedAt)
+	}
+}
+
+func TestExtractMetadataFromMetaTags(t *testing.T) {
+	page := `<html>
+		<head>
+			<meta name="author" content="Jane Doe">
+			<meta property="og:title" content="OpenGraph title">
+			<meta name="twitter:description" content="Twitter description">
+			<meta name="description" content="Meta description">
+			<meta property="og:image" content="https://cdn.example.org/image.jpg">
+		</head>
+		<body></body>
+	</html>`
+
+	article, err := ExtractMetadata("https://example.org/", strings.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if article.Title != "OpenGraph title" || article.Byline != "Jane Doe" || article.Excerpt != "Meta description" {
+		t.Errorf(`Unexpected metadata: %+v`, article)
+	}
+
+	if article.ImageURL != "https://cdn.example.org/image.jpg" {
+		t.Errorf(`Unexpected image URL: %q`, article.ImageURL)
+	}
+}
+
+func TestGetArticleTitle(t *testing.T) {
+	scenarios := map[string]string{
+		`<title>A very interesting article title - Website</title>`:           `A very interesting article title`,
+		`<title>Short title - Website</title>`:                                `Short title - Website`,
+		`<title>Website » An interesting article title</title>`:               `An interesting article title`,
+		`<title>Website | Short title</title>`:                                `Website | Short title`,
+		`<title>Website: A very interesting article title</title>`:            `A very interesting article title`,
+		`<title>Title: with colon</title><h1>Title: with colon</h1>`:          `Title: with colon`,
+		`<title>Short</title><h1>The only heading of this page</h1>`:          `The only heading of this page`,
+		`<title>  Spaces   everywhere   in this    title  </title>`:           `Spaces everywhere in this title`,
+		`<title>Some very long category name with words: Short title</title>`: `Some very long category name with words: Short title`,
+	}
+
+	for input, expected := range scenarios {
+		article, err := ExtractMetadata("", strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if article.Title != expected {
+			t.Errorf(`Unexpected title for %q: got %q instead of %q`, input, article.Title, expected)
+		}
+	}
+}
+
+func TestExtractWithBrParagraphs(t *testing.T) {
+	page := `<html><body><div id="content">` + paragraph + `<br><br>` + paragraph + `<br><br>` + paragraph + `</div></body></html>`
+
+	article, err := Extract("", strings.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Count(article.Content, "<p>") != 3 || strings.Contains(article.Content, "<br") {
+		t.Errorf(`Successive line breaks should be replaced by paragraphs: %q`, article.Content)
+	}
+}
+
+func TestExtractKeepsVideos(t *testing.T) {
+	page := `<html><body><article>
+		<p>` + paragraph + `</p>
+		<iframe src="https://www.youtube.com/embed/video"></iframe>
+		<iframe src="https://ads.example.org/banner"></iframe>
+		<p>` + paragraph + `</p>
+		<p>` + paragraph + `</p>
+	</article></body></html>`
+
+	article, err := Extract("", strings.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(article.Content, "youtube.com/embed/video") {
+		t.Errorf(`Videos should be kept: %q`, article.Content)
+	}
+
+	if strings.Contains(article.Content, "ads.example.org") {
+		t.Errorf(`Other iframes should be removed: %q`, article.Content)
+	}
+}
+
+func TestExtractEmptyPage(t *testing.T) {
+	article, err := Extract("", strings.NewReader(`<html><head><title>Empty page title</title></head><body></body></html>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if article.Content != "" {
+		t.Errorf(`Unexpected content: %q`, article.Content)
+	}
+}
+
+func TestTextSimilarity(t *testing.T) {
+	if similarity := textSimilarity("The article title", "the article title"); similarity != 1 {
+		t.Errorf(`Identical texts should be similar: %f`, similarity)
+	}
+
+	if similarity := textSimilarity("The article title", "Something else"); similarity != 0 {
+		t.Errorf(`Different texts should not be similar: %f`, similarity)
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rewrite // import "miniflux.app/reader/rewrite"
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Width of the preferred image candidate in a srcset attribute.
+const preferredImageWidth = 1200
+
+// Attributes used by lazy-loading scripts, ordered most preferred to least preferred.
+var (
+	lazySrcAttributes = []string{
+		"data-lazy-src",
+		"data-src",
+		"data-original",
+		"data-orig",
+		"data-url",
+		"data-orig-file",
+		"data-large-file",
+		"data-medium-file",
+		"data-hi-res-src",
+		"data-lazyload",
+		"data-echo",
+	}
+
+	lazySrcsetAttributes = []string{
+		"data-lazy-srcset",
+		"data-srcset",
+	}
+)
+
+// NormalizeImages resolves lazy-loaded images, responsive images and noscript fallbacks,
+// so the images are still displayed once the content is sanitized.
+func NormalizeImages(entryContent string) string {
+	if !strings.Contains(entryContent, "<img") && !strings.Contains(entryContent, "<picture") {
+		return entryContent
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return entryContent
+	}
+
+	changed := unwrapNoscriptImages(doc)
+
+	doc.Find("img, picture > source").Each(func(i int, s *goquery.Selection) {
+		if promoteLazyAttributes(s) {
+			changed = true
+		}
+	})
+
+	doc.Find("img").Each(func(i int, img *goquery.Selection) {
+		if hasUsableSrc(img) {
+			return
+		}
+
+		srcset := img.AttrOr("srcset", "")
+		if srcset == "" {
+			img.ParentFiltered("picture").Find("source[srcset]").EachWithBreak(func(i int, source *goquery.Selection) bool {
+				srcset = source.AttrOr("srcset", "")
+				return srcset == ""
+			})
+		}
+
+		if src := selectSrcsetCandidate(srcset); src != "" {
+			img.SetAttr("src", src)
+			changed = true
+		}
+	})
+
+	doc.Find("picture").Each(func(i int, picture *goquery.Selection) {
+		if img := picture.Find("img").First(); img.Length() > 0 {
+			picture.ReplaceWithSelection(img)
+			changed = true
+		}
+	})
+
+	if !changed {
+		return entryContent
+	}
+
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
+
+// unwrapNoscriptImages replaces noscript elements by the images they contain,
+// the placeholder image displayed when scripts are enabled is removed.
+func unwrapNoscriptImages(doc *goquery.Document) bool {
+	changed := false
+
+	doc.Find("noscript").Each(func(i int, noscript *goquery.Selection) {
+		fragment, err := goquery.NewDocumentFromReader(strings.NewReader(noscript.Text()))
+		if err != nil {
+			return
+		}
+
+		images := fragment.Find("img")
+		if images.Length() == 0 {
+			return
+		}
+
+		if previous := noscript.Prev(); previous.Is("img") && isPlaceholder(previous) {
+			previous.Remove()
+		}
+
+		html, _ := fragment.Find("body").First().Html()
+		noscript.ReplaceWithHtml(html)
+		changed = true
+	})
+
+	return changed
+}
+
+// promoteLazyAttributes copies the lazy-loading attributes to src and srcset.
+func promoteLazyAttributes(s *goquery.Selection) bool {
+	changed := false
+
+	if s.Is("img") {
+		for _, attribute := range lazySrcAttributes {
+			if value := strings.TrimSpace(s.AttrOr(attribute, "")); value != "" && !strings.HasPrefix(value, "data:") {
+				s.SetAttr("src", value)
+				changed = true
+				break
+			}
+		}
+	}
+
+	for _, attribute := range lazySrcsetAttributes {
+		if value := strings.TrimSpace(s.AttrOr(attribute, "")); value != "" {
+			s.SetAttr("srcset", value)
+			changed = true
+			break
+		}
+	}
+
+	return changed
+}
+
+// isPlaceholder returns true if the image is only displayed until the real one is loaded by a script.
+func isPlaceholder(img *goquery.Selection) bool {
+	if !hasUsableSrc(img) {
+		return true
+	}
+
+	for _, attribute := range append(lazySrcAttributes, lazySrcsetAttributes...) {
+		if _, found := img.Attr(attribute); found {
+			return true
+		}
+	}
+
+	return strings.Contains(img.AttrOr("class", ""), "lazy")
+}
+
+func hasUsableSrc(img *goquery.Selection) bool {
+	src := strings.TrimSpace(img.AttrOr("src", ""))
+	return src != "" && !strings.HasPrefix(src, "data:")
+}
+
+// selectSrcsetCandidate returns the largest image up to the preferred width, or the smallest one if they are all larger.
+// With pixel density descriptors, the largest density up to 2x is preferred.
+func selectSrcsetCandidate(srcset string) string {
+	var selected, smallest string
+	var selectedSize, smallestSize float64
+
+	for _, candidate := range parseSrcset(srcset) {
+		size, limit := 1.0, 2.0
+		if descriptor := candidate.descriptor; descriptor != "" {
+			value, err := strconv.ParseFloat(descriptor[:len(descriptor)-1], 64)
+			if err != nil || value <= 0 {
+				continue
+			}
+
+			size = value
+			if strings.HasSuffix(descriptor, "w") {
+				limit = preferredImageWidth
+			}
+		}
+
+		if size <= limit && size > selectedSize {
+			selected, selectedSize = candidate.url, size
+		}
+
+		if smallest == "" || size < smallestSize {
+			smallest, smallestSize = candidate.url, size
+		}
+	}
+
+	if selected == "" {
+		return smallest
+	}
+
+	return selected
+}
+
+type srcsetCandidate struct {
+	url        string
+	descriptor string
+}
+
+// parseSrcset splits a srcset attribute, the URLs can contain commas but not whitespaces.
+func parseSrcset(srcset string) []srcsetCandidate {
+	var candidates []srcsetCandidate
+
+	for srcset != "" {
+		srcset = strings.TrimLeft(srcset, " \t\n\r,")
+		if srcset == "" {
+			break
+		}
+
+		end := strings.IndexAny(srcset, " \t\n\r")
+		if end == -1 {
+			end = len(srcset)
+		}
+
+		candidate := srcsetCandidate{url: srcset[:end]}
+		srcset = srcset[end:]
+
+		if strings.HasSuffix(candidate.url, ",") {
+			candidate.url = strings.TrimRight(candidate.url, ",")
+		} else {
+			end = strings.IndexByte(srcset, ',')
+			if end == -1 {
+				end = len(srcset)
+			}
+
+			candidate.descriptor = strings.ToLower(strings.TrimSpace(srcset[:end]))
+			srcset = srcset[end:]
+		}
+
+		if candidate.descriptor == "" || strings.HasSuffix(candidate.descriptor, "w") || strings.HasSuffix(candidate.descriptor, "x") {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return candidates
+}
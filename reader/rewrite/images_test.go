@@ -0,0 +1,61 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rewrite // import "miniflux.app/reader/rewrite"
+
+import "testing"
+
+func TestNormalizeImages(t *testing.T) {
+	scenarios := map[string]string{
+		// Content without images is left untouched.
+		`<p>Text</p>`: `<p>Text</p>`,
+
+		// Regular images are left untouched.
+		`<img src="https://example.org/a.jpg"/>`: `<img src="https://example.org/a.jpg"/>`,
+
+		// Lazy attributes are promoted.
+		`<img src="data:image/gif;base64,R0lGOD" data-src="https://example.org/a.jpg">`:     `<img src="https://example.org/a.jpg" data-src="https://example.org/a.jpg"/>`,
+		`<img data-lazy-src="/a.jpg" data-src="/b.jpg">`:                                    `<img data-lazy-src="/a.jpg" data-src="/b.jpg" src="/a.jpg"/>`,
+		`<img data-srcset="/a-640.jpg 640w, /a-1024.jpg 1024w, /a-2048.jpg 2048w" alt="A">`: `<img data-srcset="/a-640.jpg 640w, /a-1024.jpg 1024w, /a-2048.jpg 2048w" alt="A" srcset="/a-640.jpg 640w, /a-1024.jpg 1024w, /a-2048.jpg 2048w" src="/a-1024.jpg"/>`,
+
+		// The candidate is picked from srcset.
+		`<img srcset="/a.jpg 1x, /a@2x.jpg 2x, /a@3x.jpg 3x">`: `<img srcset="/a.jpg 1x, /a@2x.jpg 2x, /a@3x.jpg 3x" src="/a@2x.jpg"/>`,
+		`<img srcset="/a-2000.jpg 2000w,/a-1600.jpg 1600w">`:   `<img srcset="/a-2000.jpg 2000w,/a-1600.jpg 1600w" src="/a-1600.jpg"/>`,
+		`<img srcset="/a,b.jpg">`:                              `<img srcset="/a,b.jpg" src="/a,b.jpg"/>`,
+
+		// Pictures are replaced by their image.
+		`<picture><source type="image/webp" srcset="/a.webp 800w"><img alt="A"></picture>`:         `<img alt="A" src="/a.webp"/>`,
+		`<picture><source data-srcset="/a.jpg"><img src="data:image/gif;base64,R0lGOD"></picture>`: `<img src="/a.jpg"/>`,
+
+		// The noscript fallbacks replace the placeholders.
+		`<img class="lazyload" src="/placeholder.gif"><noscript><img src="/a.jpg" alt="A"></noscript>`: `<img src="/a.jpg" alt="A"/>`,
+		`<img src="/b.jpg"><noscript><img src="/a.jpg"></noscript>`:                                    `<img src="/b.jpg"/><img src="/a.jpg"/>`,
+		`<img src="/b.jpg"><noscript><p>Enable JavaScript</p></noscript>`:                              `<img src="/b.jpg"><noscript><p>Enable JavaScript</p></noscript>`,
+	}
+
+	for input, expected := range scenarios {
+		if output := NormalizeImages(input); output != expected {
+			t.Errorf(`Unexpected output for %q, got %q instead of %q`, input, output, expected)
+		}
+	}
+}
+
+func TestSelectSrcsetCandidate(t *testing.T) {
+	scenarios := map[string]string{
+		``:                                   ``,
+		`/a.jpg`:                             `/a.jpg`,
+		`/a.jpg 2x, /b.jpg`:                  `/a.jpg`,
+		`/a.jpg 480w, /b.jpg 960w`:           `/b.jpg`,
+		`/a.jpg 4000w, /b.jpg 3000w`:         `/b.jpg`,
+		`/a.jpg 1.5x, /b.jpg invalid, /c 3x`: `/a.jpg`,
+		` /a.jpg 100w , , /b.jpg 200w `:      `/b.jpg`,
+		`https://example.org/a.jpg?w=1,2 1x`: `https://example.org/a.jpg?w=1,2`,
+	}
+
+	for input, expected := range scenarios {
+		if output := selectSrcsetCandidate(input); output != expected {
+			t.Errorf(`Unexpected candidate for %q, got %q instead of %q`, input, output, expected)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rewrite // import "miniflux.app/reader/rewrite"
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// rule is a rewrite function call, for example replace("regex", "replacement").
+type rule struct {
+	name string
+	args []string
+}
+
+// Number of arguments expected by each rewrite function.
+var ruleArguments = map[string]int{
+	"add_image_title":                          0,
+	"add_mailto_subject":                       0,
+	"add_dynamic_image":                        0,
+	"add_youtube_video":                        0,
+	"add_invidious_video":                      0,
+	"add_youtube_video_using_invidious_player": 0,
+	"add_pdf_download_link":                    0,
+	"nl2br":                                    0,
+	"convert_text_link":                        0,
+	"convert_text_links":                       0,
+	"replace":                                  2,
+	"remove":                                   1,
+	"replace_title":                            2,
+	"add_class":                                2,
+}
+
+// ValidateRules makes sure the rewrite rules are well formed and use existing functions.
+func ValidateRules(value string) error {
+	rules, err := parseRules(value)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		expected, found := ruleArguments[rule.name]
+		if !found {
+			return fmt.Errorf("rewrite: unknown rule %q", rule.name)
+		}
+
+		if len(rule.args) != expected {
+			return fmt.Errorf("rewrite: the rule %q expects %d argument(s), got %d", rule.name, expected, len(rule.args))
+		}
+
+		switch rule.name {
+		case "replace", "replace_title":
+			if _, err := regexp.Compile(rule.args[0]); err != nil {
+				return fmt.Errorf("rewrite: invalid regular expression %q: %v", rule.args[0], err)
+			}
+		case "remove", "add_class":
+			if strings.TrimSpace(rule.args[0]) == "" {
+				return fmt.Errorf("rewrite: the rule %q expects a CSS selector", rule.name)
+			}
+		}
+
+		if rule.name == "add_class" && !classNameRegex.MatchString(rule.args[1]) {
+			return fmt.Errorf("rewrite: invalid class name %q", rule.args[1])
+		}
+	}
+
+	return nil
+}
+
+// parseRules splits a comma separated list of rules.
+//
+// The arguments are quoted with double or single quotes, a backslash escapes the quote character
+// and is kept as is otherwise, so regular expressions can be written without double escaping.
+// The rules parsed before a syntax error are returned along with the error.
+func parseRules(value string) ([]rule, error) {
+	var rules []rule
+	p := &ruleParser{input: value}
+
+	for {
+		p.skipSpaces()
+		if p.done() {
+			return rules, nil
+		}
+
+		if p.peek() == ',' {
+			p.pos++
+			continue
+		}
+
+		current, err := p.parseRule()
+		if err != nil {
+			return rules, err
+		}
+		rules = append(rules, current)
+
+		p.skipSpaces()
+		if !p.done() && p.peek() != ',' {
+			return rules, fmt.Errorf("rewrite: unexpected character %q at position %d", p.peek(), p.pos)
+		}
+	}
+}
+
+type ruleParser struct {
+	input string
+	pos   int
+}
+
+func (p *ruleParser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *ruleParser) peek() byte {
+	return p.input[p.pos]
+}
+
+func (p *ruleParser) skipSpaces() {
+	for !p.done() && strings.IndexByte(" \t\r\n", p.peek()) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *ruleParser) parseRule() (rule, error) {
+	start := p.pos
+	for !p.done() && isNameCharacter(p.peek()) {
+		p.pos++
+	}
+
+	current := rule{name: strings.ToLower(p.input[start:p.pos])}
+	if current.name == "" {
+		return current, fmt.Errorf("rewrite: rule name expected at position %d", p.pos)
+	}
+
+	p.skipSpaces()
+	if p.done() || p.peek() != '(' {
+		return current, nil
+	}
+	p.pos++
+
+	for {
+		p.skipSpaces()
+		if p.done() {
+			return current, fmt.Errorf("rewrite: missing closing parenthesis for %q", current.name)
+		}
+
+		if p.peek() == ')' && len(current.args) == 0 {
+			p.pos++
+			return current, nil
+		}
+
+		arg, err := p.parseString()
+		if err != nil {
+			return current, err
+		}
+		current.args = append(current.args, arg)
+
+		p.skipSpaces()
+		if p.done() {
+			return current, fmt.Errorf("rewrite: missing closing parenthesis for %q", current.name)
+		}
+
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return current, nil
+		default:
+			return current, fmt.Errorf("rewrite: unexpected character %q at position %d", p.peek(), p.pos)
+		}
+	}
+}
+
+func (p *ruleParser) parseString() (string, error) {
+	quote := p.peek()
+	if quote != '"' && quote != '\'' {
+		return "", fmt.Errorf("rewrite: quoted argument expected at position %d", p.pos)
+	}
+	p.pos++
+
+	var value strings.Builder
+	for !p.done() {
+		c := p.peek()
+		p.pos++
+
+		switch {
+		case c == quote:
+			return value.String(), nil
+		case c == '\\' && !p.done() && p.peek() == quote:
+			value.WriteByte(quote)
+			p.pos++
+		default:
+			value.WriteByte(c)
+		}
+	}
+
+	return "", fmt.Errorf("rewrite: unterminated string")
+}
+
+func isNameCharacter(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
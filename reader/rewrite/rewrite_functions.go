@@ -11,14 +11,17 @@ import (
 	"regexp"
 	"strings"
 
+	"miniflux.app/reader/sanitizer"
+
 	"github.com/PuerkitoBio/goquery"
 )
 
 var (
-	youtubeRegex  = regexp.MustCompile(`youtube\.com/watch\?v=(.*)`)
-	invidioRegex  = regexp.MustCompile(`invidio\.us\/watch\?v=(.*)`)
-	imgRegex      = regexp.MustCompile(`<img [^>]+>`)
-	textLinkRegex = regexp.MustCompile(`(?mi)(\bhttps?:\/\/[-A-Z0-9+&@#\/%?=~_|!:,.;]*[-A-Z0-9+&@#\/%=~_|])`)
+	youtubeRegex   = regexp.MustCompile(`youtube\.com/watch\?v=(.*)`)
+	invidioRegex   = regexp.MustCompile(`invidio\.us\/watch\?v=(.*)`)
+	imgRegex       = regexp.MustCompile(`<img [^>]+>`)
+	textLinkRegex  = regexp.MustCompile(`(?mi)(\bhttps?:\/\/[-A-Z0-9+&@#\/%?=~_|!:,.;]*[-A-Z0-9+&@#\/%=~_|])`)
+	classNameRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
 )
 
 func addImageTitle(entryURL, entryContent string) string {
@@ -183,3 +186,76 @@ func replaceTextLinks(input string) string {
 func replaceLineFeeds(input string) string {
 	return strings.Replace(input, "\n", "<br>", -1)
 }
+
+func replaceWithRegex(input, expression, replacement string) string {
+	re, err := regexp.Compile(expression)
+	if err != nil {
+		return input
+	}
+
+	return re.ReplaceAllString(input, replacement)
+}
+
+func removeElements(entryContent, selector string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return entryContent
+	}
+
+	matches := doc.Find(selector)
+	if matches.Length() == 0 {
+		return entryContent
+	}
+
+	matches.Remove()
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
+
+func addClass(entryContent, selector, className string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return entryContent
+	}
+
+	matches := doc.Find(selector)
+	if matches.Length() == 0 {
+		return entryContent
+	}
+
+	if !strings.HasPrefix(className, sanitizer.AllowedClassPrefix) {
+		className = sanitizer.AllowedClassPrefix + className
+	}
+
+	matches.AddClass(className)
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
+
+// removeRewriteClasses removes the class names reserved to the rewrite rules from the content.
+func removeRewriteClasses(entryContent string) string {
+	if !strings.Contains(entryContent, sanitizer.AllowedClassPrefix) {
+		return entryContent
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return entryContent
+	}
+
+	matches := doc.Find("[class*='" + sanitizer.AllowedClassPrefix + "']")
+	if matches.Length() == 0 {
+		return entryContent
+	}
+
+	matches.Each(func(i int, s *goquery.Selection) {
+		for _, className := range strings.Fields(s.AttrOr("class", "")) {
+			if strings.HasPrefix(className, sanitizer.AllowedClassPrefix) {
+				s.RemoveClass(className)
+			}
+		}
+	})
+
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
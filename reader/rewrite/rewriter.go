@@ -18,13 +18,19 @@ func Rewriter(entryURL, entryContent, customRewriteRules string) string {
 		rulesList = customRewriteRules
 	}
 
-	rules := strings.Split(rulesList, ",")
-	rules = append(rules, "add_pdf_download_link")
+	rules, err := parseRules(rulesList)
+	if err != nil {
+		logger.Debug(`[Rewrite] Invalid rules %q for %q: %v`, rulesList, entryURL, err)
+	}
+	rules = append(rules, rule{name: "add_pdf_download_link"})
+
+	// Only the classes added by the rules are kept by the sanitizer, the ones of the feed are removed first.
+	entryContent = removeRewriteClasses(entryContent)
 
 	logger.Debug(`[Rewrite] Applying rules %v for %q`, rules, entryURL)
 
 	for _, rule := range rules {
-		switch strings.TrimSpace(rule) {
+		switch rule.name {
 		case "add_image_title":
 			entryContent = addImageTitle(entryURL, entryContent)
 		case "add_mailto_subject":
@@ -43,12 +49,37 @@ func Rewriter(entryURL, entryContent, customRewriteRules string) string {
 			entryContent = replaceLineFeeds(entryContent)
 		case "convert_text_link", "convert_text_links":
 			entryContent = replaceTextLinks(entryContent)
+		case "replace":
+			if len(rule.args) == 2 {
+				entryContent = replaceWithRegex(entryContent, rule.args[0], rule.args[1])
+			}
+		case "remove":
+			if len(rule.args) == 1 {
+				entryContent = removeElements(entryContent, rule.args[0])
+			}
+		case "add_class":
+			if len(rule.args) == 2 {
+				entryContent = addClass(entryContent, rule.args[0], rule.args[1])
+			}
 		}
 	}
 
 	return entryContent
 }
 
+// TitleRewriter modify item titles with the replace_title rules.
+func TitleRewriter(entryTitle, customRewriteRules string) string {
+	rules, _ := parseRules(customRewriteRules)
+
+	for _, rule := range rules {
+		if rule.name == "replace_title" && len(rule.args) == 2 {
+			entryTitle = strings.TrimSpace(replaceWithRegex(entryTitle, rule.args[0], rule.args[1]))
+		}
+	}
+
+	return entryTitle
+}
+
 func getPredefinedRewriteRules(entryURL string) string {
 	urlDomain := url.Domain(entryURL)
 	for domain, rules := range predefinedRules {
@@ -4,7 +4,10 @@
 
 package rewrite // import "miniflux.app/reader/rewrite"
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestReplaceTextLinks(t *testing.T) {
 	scenarios := map[string]string{
@@ -176,3 +179,115 @@ func TestConvertTextLinkRewriteRule(t *testing.T) {
 		t.Errorf(`Not expected output: got %q instead of %q`, output, expected)
 	}
 }
+
+func TestParseRules(t *testing.T) {
+	rules, err := parseRules(` nl2br, replace("\d+ (?:comments|replies)", ''), remove('div[class="ads, promo"]'),replace_title("^\[Sponsored\] ", "")`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []rule{
+		{name: "nl2br"},
+		{name: "replace", args: []string{`\d+ (?:comments|replies)`, ``}},
+		{name: "remove", args: []string{`div[class="ads, promo"]`}},
+		{name: "replace_title", args: []string{`^\[Sponsored\] `, ``}},
+	}
+
+	if len(rules) != len(expected) {
+		t.Fatalf(`Unexpected rules: %v`, rules)
+	}
+
+	for i := range expected {
+		if rules[i].name != expected[i].name || strings.Join(rules[i].args, "|") != strings.Join(expected[i].args, "|") {
+			t.Errorf(`Unexpected rule #%d: %v instead of %v`, i, rules[i], expected[i])
+		}
+	}
+}
+
+func TestParseRulesWithEscapedQuote(t *testing.T) {
+	rules, err := parseRules(`replace("say \"hello\"", 'it\'s')`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules) != 1 || rules[0].args[0] != `say "hello"` || rules[0].args[1] != `it's` {
+		t.Errorf(`Unexpected rules: %v`, rules)
+	}
+}
+
+func TestValidateRules(t *testing.T) {
+	valid := []string{
+		``,
+		`add_image_title, nl2br`,
+		`replace("(\w+)@example\.org", "$1"), add_class("img", "wide")`,
+		`remove("script, .ads")`,
+	}
+
+	for _, value := range valid {
+		if err := ValidateRules(value); err != nil {
+			t.Errorf(`The rules %q should be valid: %v`, value, err)
+		}
+	}
+
+	invalid := []string{
+		`some rule`,
+		`unknown_rule`,
+		`replace("[a-z", "")`,
+		`replace("a")`,
+		`nl2br("a")`,
+		`remove("")`,
+		`remove("p"`,
+		`remove(p)`,
+		`replace("a, "b")`,
+		`add_class("img", "wide image")`,
+	}
+
+	for _, value := range invalid {
+		if err := ValidateRules(value); err == nil {
+			t.Errorf(`The rules %q should be invalid`, value)
+		}
+	}
+}
+
+func TestRewriteWithCustomRules(t *testing.T) {
+	content := `<p>Article</p><div class="ads">Buy now</div><p>42 comments</p><img src="https://example.org/image.png">`
+	rules := `replace("\d+ comments", "Comments"), remove(".ads"), add_class("img", "wide")`
+
+	output := Rewriter("https://example.org/article", content, rules)
+	expected := `<p>Article</p><p>Comments</p><img src="https://example.org/image.png" class="rewrite-wide"/>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteRemovesReservedClassesOfTheFeed(t *testing.T) {
+	content := `<p class="intro rewrite-wide">Article</p><img src="https://example.org/image.png">`
+
+	output := Rewriter("https://example.org/article", content, `add_class("img", "wide")`)
+	expected := `<p class="intro">Article</p><img src="https://example.org/image.png" class="rewrite-wide"/>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteRulesOrder(t *testing.T) {
+	output := Rewriter("https://example.org/article", `a`, `replace("a", "b"), replace("b", "c")`)
+	if output != `c` {
+		t.Errorf(`The rules should be applied in order, got %q`, output)
+	}
+}
+
+func TestTitleRewriter(t *testing.T) {
+	rules := `remove(".ads"), replace_title("^\[Sponsored\]", ""), replace_title("(?i)episode (\d+)", "#$1")`
+
+	output := TitleRewriter("[Sponsored] Podcast Episode 12", rules)
+	if output != "Podcast #12" {
+		t.Errorf(`Unexpected title: %q`, output)
+	}
+
+	if output := TitleRewriter("Title", ""); output != "Title" {
+		t.Errorf(`The title should not change without rules, got %q`, output)
+	}
+}
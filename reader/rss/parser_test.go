@@ -615,6 +615,33 @@ func TestParseEntryWithDublinCoreDate(t *testing.T) {
 	}
 }
 
+func TestParseEntryWithoutDate(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+				<rss version="2.0">
+				<channel>
+					<title>Example</title>
+					<link>http://example.org/</link>
+					<item>
+						<title>Item 1</title>
+						<link>http://example.org/item1</link>
+					</item>
+				</channel>
+			</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Entries[0].Date.IsZero() {
+		t.Errorf("The current time should be used as unknown date, got: %v", feed.Entries[0].Date)
+	}
+
+	if !feed.Entries[0].DateUnknown {
+		t.Error("The date should be unknown")
+	}
+}
+
 func TestParseEntryWithContentEncoded(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 		<rss version="2.0" xmlns:content="http://purl.org/rss/1.0/modules/content/">
@@ -1209,3 +1236,30 @@ func TestEntryDescriptionFromGooglePlayDescription(t *testing.T) {
 		t.Errorf(`Unexpected podcast content, got %q instead of %q`, result, expected)
 	}
 }
+
+func TestParseEntryWithCategories(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<item>
+				<title>Test</title>
+				<link>https://example.org/item</link>
+				<category>Go</category>
+				<category domain="https://example.org/tags">Open Source</category>
+				<category>go</category>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := feed.Entries[0].Tags
+	if len(tags) != 2 || tags[0] != "Go" || tags[1] != "Open Source" {
+		t.Errorf("Incorrect entry tags, got: %v", tags)
+	}
+}
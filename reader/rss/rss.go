@@ -145,6 +145,7 @@ type rssItem struct {
 	Authors        []rssAuthor      `xml:"author"`
 	CommentLinks   []rssCommentLink `xml:"comments"`
 	EnclosureLinks []rssEnclosure   `xml:"enclosure"`
+	Categories     []string         `xml:"category"`
 	DublinCoreElement
 	FeedBurnerElement
 	PodcastEntryElement
@@ -155,12 +156,13 @@ func (r *rssItem) Transform() *model.Entry {
 	entry := new(model.Entry)
 	entry.URL = r.entryURL()
 	entry.CommentsURL = r.entryCommentsURL()
-	entry.Date = r.entryDate()
+	entry.SetDate(r.entryDate())
 	entry.Author = r.entryAuthor()
 	entry.Hash = r.entryHash()
 	entry.Content = r.entryContent()
 	entry.Title = r.entryTitle()
 	entry.Enclosures = r.entryEnclosures()
+	entry.AddTags(r.Categories...)
 	return entry
 }
 
@@ -174,13 +176,13 @@ func (r *rssItem) entryDate() time.Time {
 		result, err := date.Parse(value)
 		if err != nil {
 			logger.Error("rss: %v", err)
-			return time.Now()
+			return time.Time{}
 		}
 
 		return result
 	}
 
-	return time.Now()
+	return time.Time{}
 }
 
 func (r *rssItem) entryAuthor() string {
@@ -0,0 +1,65 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sanitizer // import "miniflux.app/reader/sanitizer"
+
+import (
+	"net/url"
+	"strings"
+
+	"miniflux.app/config"
+)
+
+// policy contains the tags, attributes and iframe sources allowed by the sanitizer.
+type policy struct {
+	tags        map[string][]string
+	iframeHosts []string
+}
+
+func newPolicy(extension *config.SanitizerPolicy) *policy {
+	p := &policy{tags: getTagWhitelist()}
+
+	if extension != nil {
+		for tag, attributes := range extension.Tags {
+			p.tags[tag] = append(p.tags[tag], attributes...)
+		}
+
+		p.iframeHosts = extension.IframeHosts
+	}
+
+	return p
+}
+
+func (p *policy) isValidTag(tagName string) bool {
+	_, found := p.tags[tagName]
+	return found
+}
+
+func (p *policy) isValidAttribute(tagName, attributeName string) bool {
+	return inList(attributeName, p.tags[tagName])
+}
+
+func (p *policy) isValidIframeSource(src string) bool {
+	if isValidIframeSource(src) {
+		return true
+	}
+
+	if len(p.iframeHosts) == 0 {
+		return false
+	}
+
+	u, err := url.Parse(src)
+	if err != nil || u.Scheme != "https" {
+		return false
+	}
+
+	host := strings.ToLower(u.Host)
+	for _, allowedHost := range p.iframeHosts {
+		if host == allowedHost || (strings.HasPrefix(allowedHost, "*.") && strings.HasSuffix(host, allowedHost[1:])) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"miniflux.app/config"
 	"miniflux.app/url"
 
 	"golang.org/x/net/html"
@@ -20,8 +21,22 @@ var (
 	youtubeEmbedRegex = regexp.MustCompile(`//www\.youtube\.com/embed/(.*)`)
 )
 
-// Sanitize returns safe HTML.
+// AllowedClassPrefix is the prefix of the class names kept by the sanitizer, they are added by the rewrite rules.
+const AllowedClassPrefix = "rewrite-"
+
+// Sanitize returns safe HTML, the built-in rules are extended by the sanitizer policy of the configuration.
 func Sanitize(baseURL, input string) string {
+	var extension *config.SanitizerPolicy
+	if config.Opts != nil {
+		extension = config.Opts.SanitizerPolicy()
+	}
+
+	return SanitizeWithPolicy(baseURL, input, extension)
+}
+
+// SanitizeWithPolicy returns safe HTML, the policy extends the built-in rules when defined.
+func SanitizeWithPolicy(baseURL, input string, extension *config.SanitizerPolicy) string {
+	policy := newPolicy(extension)
 	tokenizer := html.NewTokenizer(bytes.NewBufferString(input))
 	var buffer bytes.Buffer
 	var tagStack []string
@@ -46,10 +61,10 @@ func Sanitize(baseURL, input string) string {
 
 			buffer.WriteString(html.EscapeString(token.Data))
 		case html.StartTagToken:
-			tagName := token.DataAtom.String()
+			tagName := token.Data
 
-			if !isPixelTracker(tagName, token.Attr) && isValidTag(tagName) {
-				attrNames, htmlAttributes := sanitizeAttributes(baseURL, tagName, token.Attr)
+			if !isPixelTracker(tagName, token.Attr) && policy.isValidTag(tagName) {
+				attrNames, htmlAttributes := sanitizeAttributes(policy, baseURL, tagName, token.Attr)
 
 				if hasRequiredAttributes(tagName, attrNames) {
 					if len(attrNames) > 0 {
@@ -64,16 +79,16 @@ func Sanitize(baseURL, input string) string {
 				blacklistedTagDepth++
 			}
 		case html.EndTagToken:
-			tagName := token.DataAtom.String()
-			if isValidTag(tagName) && inList(tagName, tagStack) {
+			tagName := token.Data
+			if policy.isValidTag(tagName) && inList(tagName, tagStack) {
 				buffer.WriteString(fmt.Sprintf("</%s>", tagName))
 			} else if isBlacklistedTag(tagName) {
 				blacklistedTagDepth--
 			}
 		case html.SelfClosingTagToken:
-			tagName := token.DataAtom.String()
-			if !isPixelTracker(tagName, token.Attr) && isValidTag(tagName) {
-				attrNames, htmlAttributes := sanitizeAttributes(baseURL, tagName, token.Attr)
+			tagName := token.Data
+			if !isPixelTracker(tagName, token.Attr) && policy.isValidTag(tagName) {
+				attrNames, htmlAttributes := sanitizeAttributes(policy, baseURL, tagName, token.Attr)
 
 				if hasRequiredAttributes(tagName, attrNames) {
 					if len(attrNames) > 0 {
@@ -87,20 +102,28 @@ func Sanitize(baseURL, input string) string {
 	}
 }
 
-func sanitizeAttributes(baseURL, tagName string, attributes []html.Attribute) ([]string, string) {
+func sanitizeAttributes(policy *policy, baseURL, tagName string, attributes []html.Attribute) ([]string, string) {
 	var htmlAttrs, attrNames []string
 	var err error
 
 	for _, attribute := range attributes {
 		value := attribute.Val
 
-		if !isValidAttribute(tagName, attribute.Key) {
+		if attribute.Key == "class" {
+			if value = filterClassNames(value); value != "" {
+				attrNames = append(attrNames, attribute.Key)
+				htmlAttrs = append(htmlAttrs, fmt.Sprintf(`%s="%s"`, attribute.Key, html.EscapeString(value)))
+			}
+			continue
+		}
+
+		if !policy.isValidAttribute(tagName, attribute.Key) {
 			continue
 		}
 
 		if isExternalResourceAttribute(attribute.Key) {
 			if tagName == "iframe" {
-				if isValidIframeSource(attribute.Val) {
+				if policy.isValidIframeSource(attribute.Val) {
 					value = rewriteIframeURL(attribute.Val)
 				} else {
 					continue
@@ -145,31 +168,21 @@ func getExtraAttributes(tagName string) ([]string, []string) {
 	}
 }
 
-func isValidTag(tagName string) bool {
-	for element := range getTagWhitelist() {
-		if tagName == element {
-			return true
-		}
-	}
-
-	return false
-}
-
-func isValidAttribute(tagName, attributeName string) bool {
-	for element, attributes := range getTagWhitelist() {
-		if tagName == element {
-			if inList(attributeName, attributes) {
-				return true
-			}
+// filterClassNames keeps only the class names added by the rewrite rules.
+func filterClassNames(value string) string {
+	var classNames []string
+	for _, className := range strings.Fields(value) {
+		if strings.HasPrefix(className, AllowedClassPrefix) {
+			classNames = append(classNames, className)
 		}
 	}
 
-	return false
+	return strings.Join(classNames, " ")
 }
 
 func isExternalResourceAttribute(attribute string) bool {
 	switch attribute {
-	case "src", "href", "poster", "cite":
+	case "src", "href", "xlink:href", "poster", "cite":
 		return true
 	default:
 		return false
@@ -233,6 +246,7 @@ func hasValidURIScheme(src string) bool {
 		"facetime://",
 		"feed:",
 		"ftp://",
+		"gemini://",
 		"geo:",
 		"gopher://",
 		"git://",
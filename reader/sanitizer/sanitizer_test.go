@@ -351,6 +351,16 @@ func TestXMPPURIScheme(t *testing.T) {
 	}
 }
 
+func TestGeminiLink(t *testing.T) {
+	input := `<p><a href="gemini://example.org/post.gmi">Gemini link</a></p>`
+	expected := `<p><a href="gemini://example.org/post.gmi" rel="noopener noreferrer" target="_blank" referrerpolicy="no-referrer">Gemini link</a></p>`
+	output := Sanitize("gemini://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
 func TestBlacklistedLink(t *testing.T) {
 	input := `<p>This image is not valid <img src="https://stats.wordpress.com/some-tracker"></p>`
 	expected := `<p>This image is not valid </p>`
@@ -480,3 +490,13 @@ func TestReplaceStyle(t *testing.T) {
 		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
 	}
 }
+
+func TestClassNames(t *testing.T) {
+	input := `<p class="intro rewrite-lead">Text</p><div class="ads">Ads</div><img src="http://example.org/image.png" class="rewrite-wide">`
+	expected := `<p class="rewrite-lead">Text</p>Ads<img src="http://example.org/image.png" class="rewrite-wide" loading="lazy">`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
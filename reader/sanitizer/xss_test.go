@@ -0,0 +1,143 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sanitizer // import "miniflux.app/reader/sanitizer"
+
+import (
+	"strings"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+var xssVectors = []string{
+	`<script>alert(1)</script>`,
+	`<SCRIPT SRC=http://example.com/xss.js></SCRIPT>`,
+	`<scr<script>ipt>alert(1)</script>`,
+	`<<script>script>alert(1)<</script>/script>`,
+	`<img src=x onerror=alert(1)>`,
+	`<img src="http://example.org/a.png" onload="alert(1)">`,
+	`<img src="javascript:alert(1)">`,
+	`<img src="data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==">`,
+	`<img src="http://example.org/a.png" srcset="javascript:alert(1)">`,
+	`<img src="http://example.org/a.png" alt="" onerror="alert(1)">`,
+	`<a href="javascript:alert(1)">link</a>`,
+	`<a href="JaVaScRiPt:alert(1)">link</a>`,
+	`<a href="&#106;avascript:alert(1)">link</a>`,
+	`<a href="&#x6A;&#x61;&#x76;&#x61;&#x73;&#x63;&#x72;&#x69;&#x70;&#x74;&#x3A;alert(1)">link</a>`,
+	`<a href="vbscript:msgbox(1)">link</a>`,
+	`<a href="data:text/html,<script>alert(1)</script>">link</a>`,
+	`<a href="http://example.org/" onclick="alert(1)">link</a>`,
+	`<a href="http://example.org/" style="position:fixed;top:0">link</a>`,
+	`<iframe src="javascript:alert(1)"></iframe>`,
+	`<iframe srcdoc="<script>alert(1)</script>"></iframe>`,
+	`<iframe src="https://www.youtube.com/embed/1" onload="alert(1)"></iframe>`,
+	`<iframe src="https://evil.example.com/"></iframe>`,
+	`<svg onload=alert(1)>`,
+	`<svg><script>alert(1)</script></svg>`,
+	`<math><mi xlink:href="javascript:alert(1)">X</mi></math>`,
+	`<object data="javascript:alert(1)"></object>`,
+	`<embed src="javascript:alert(1)">`,
+	`<form action="javascript:alert(1)"><input type="submit"></form>`,
+	`<button formaction="javascript:alert(1)">X</button>`,
+	`<style>@import 'http://example.com/xss.css';</style>`,
+	`<div style="background:url(javascript:alert(1))">X</div>`,
+	`<base href="javascript:alert(1)//">`,
+	`<meta http-equiv="refresh" content="0;url=javascript:alert(1)">`,
+	`<link rel="stylesheet" href="http://example.com/xss.css">`,
+	`<video poster="javascript:alert(1)" src="http://example.org/a.mp4"></video>`,
+	`<audio src="x" onerror="alert(1)"></audio>`,
+	`<q cite="javascript:alert(1)">X</q>`,
+	`<noscript><p title="</noscript><img src=x onerror=alert(1)>"></noscript>`,
+	`<p class="rewrite-x&quot; onclick=&quot;alert(1)">X</p>`,
+	`<details open ontoggle="alert(1)"><summary>X</summary></details>`,
+	`<table background="javascript:alert(1)"><tr><td>X</td></tr></table>`,
+	`<body onload="alert(1)">`,
+	`<img src="http://example.org/a.png" width="1" height="1">`,
+}
+
+var xssMarkers = []string{
+	"<script", "javascript:", "vbscript:", "data:text/html", "onerror=", "onload=", "onclick=", "ontoggle=",
+	"<style", "style=", "srcdoc", "srcset", "<object", "<embed", "<form", "<input", "<button", "formaction",
+	"<base", "<meta", "<link", "<svg", "<math", "background=", "<body", "width=\"1\"",
+}
+
+func TestXSSVectors(t *testing.T) {
+	policy := config.NewSanitizerPolicy()
+	policy.AllowTags("details, summary")
+	policy.AllowAttributes("details:open")
+	policy.AllowIframeHosts("video.example.org")
+
+	for _, vector := range xssVectors {
+		for _, extension := range []*config.SanitizerPolicy{nil, policy} {
+			output := strings.ToLower(SanitizeWithPolicy("http://example.org/", vector, extension))
+			for _, marker := range xssMarkers {
+				if strings.Contains(output, marker) {
+					t.Errorf(`Unsafe output for %q: %q contains %q`, vector, output, marker)
+				}
+			}
+		}
+	}
+}
+
+func TestEscapedAttributeValues(t *testing.T) {
+	input := `<img src="http://example.org/a.png" alt="&quot; onerror=&quot;alert(1)">`
+	expected := `<img src="http://example.org/a.png" alt="&#34; onerror=&#34;alert(1)" loading="lazy">`
+
+	if output := Sanitize("http://example.org/", input); output != expected {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestSanitizeIsIdempotent(t *testing.T) {
+	input := `<p class="rewrite-lead">A <a href="/a">link</a>, <img src="a.png" alt="A"> and a video:</p><iframe src="https://www.youtube.com/embed/1"></iframe><video src="a.mp4" poster="a.png"></video>`
+
+	output := Sanitize("http://example.org/", input)
+	if again := Sanitize("http://example.org/", output); again != output {
+		t.Errorf(`The sanitized content should not change, got %q instead of %q`, again, output)
+	}
+}
+
+func TestSanitizerPolicyTags(t *testing.T) {
+	policy := config.NewSanitizerPolicy()
+	policy.AllowTags("details, summary, mark")
+	policy.AllowAttributes("details:open")
+
+	input := `<details open><summary>Title</summary><p><mark title="x">Text</mark></p></details>`
+
+	expected := `<details open=""><summary>Title</summary><p><mark>Text</mark></p></details>`
+	if output := SanitizeWithPolicy("http://example.org/", input, policy); output != expected {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+
+	expected = `Title<p>Text</p>`
+	if output := SanitizeWithPolicy("http://example.org/", input, nil); output != expected {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestSanitizerPolicyIframeHosts(t *testing.T) {
+	policy := config.NewSanitizerPolicy()
+	policy.AllowIframeHosts("video.example.org, *.example.net")
+
+	scenarios := map[string]bool{
+		"https://video.example.org/embed/1":          true,
+		"https://a.example.net/embed/1":              true,
+		"http://video.example.org/embed/1":           false,
+		"https://video.example.org.evil.com/embed/1": false,
+		"https://evilexample.net/embed/1":            false,
+		"https://example.org/embed/1":                false,
+	}
+
+	for src, allowed := range scenarios {
+		output := SanitizeWithPolicy("http://example.org/", `<iframe src="`+src+`"></iframe>`, policy)
+		if strings.Contains(output, "<iframe") != allowed {
+			t.Errorf(`Unexpected output for %q: %q`, src, output)
+		}
+	}
+
+	if output := SanitizeWithPolicy("http://example.org/", `<iframe src="https://video.example.org/embed/1"></iframe>`, nil); output != "" {
+		t.Errorf(`The iframe should be removed without policy, got %q`, output)
+	}
+}
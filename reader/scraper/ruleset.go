@@ -0,0 +1,147 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package scraper // import "miniflux.app/reader/scraper"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"miniflux.app/url"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const (
+	// Number of pages downloaded by default when the pagination is followed.
+	defaultMaxPages = 10
+
+	// Safe limit of pages downloaded for one article.
+	maxPages = 20
+
+	relNextSelector = `link[rel~="next"], a[rel~="next"]`
+)
+
+// Rules contains the CSS selectors used to extract the content of a web page.
+//
+// The rules are a list of clauses separated by semicolons:
+//
+//	[include:] <selector>   keep the matching elements (the default)
+//	exclude: <selector>     remove the matching elements
+//	next[: <selector>]      follow the pagination link (rel="next" when no selector is given)
+//	pages: <number>         maximum number of pages to download
+//
+// Without include selectors, the content is extracted with readability once the excluded elements are removed.
+type Rules struct {
+	Include  string
+	Exclude  string
+	Next     string
+	MaxPages int
+}
+
+// ParseRules parses the scraper rules of a feed.
+func ParseRules(value string) (*Rules, error) {
+	rules := &Rules{MaxPages: 1}
+	var includes, excludes []string
+
+	for _, clause := range strings.Split(value, ";") {
+		clause = strings.TrimSpace(clause)
+		if clause == "" {
+			continue
+		}
+
+		name, argument := splitClause(clause)
+		switch name {
+		case "include":
+			includes = append(includes, argument)
+		case "exclude":
+			excludes = append(excludes, argument)
+		case "next":
+			rules.Next = argument
+			if rules.Next == "" {
+				rules.Next = relNextSelector
+			}
+			if rules.MaxPages == 1 {
+				rules.MaxPages = defaultMaxPages
+			}
+		case "pages":
+			pages, err := strconv.Atoi(argument)
+			if err != nil || pages < 1 || pages > maxPages {
+				return nil, fmt.Errorf("scraper: the number of pages must be between 1 and %d", maxPages)
+			}
+			rules.MaxPages = pages
+		default:
+			includes = append(includes, clause)
+		}
+
+		if (name == "include" || name == "exclude") && argument == "" {
+			return nil, fmt.Errorf("scraper: missing selector for %q", name)
+		}
+	}
+
+	rules.Include = strings.Join(includes, ", ")
+	rules.Exclude = strings.Join(excludes, ", ")
+
+	if rules.Next == "" {
+		rules.MaxPages = 1
+	}
+
+	return rules, nil
+}
+
+// splitClause returns the directive name and its argument, the name is empty for plain selectors.
+func splitClause(clause string) (string, string) {
+	if strings.ToLower(clause) == "next" {
+		return "next", ""
+	}
+
+	parts := strings.SplitN(clause, ":", 2)
+	if len(parts) == 2 {
+		name := strings.ToLower(strings.TrimSpace(parts[0]))
+		switch name {
+		case "include", "exclude", "next", "pages":
+			return name, strings.TrimSpace(parts[1])
+		}
+	}
+
+	return "", clause
+}
+
+// nextPageURL returns the absolute URL of the pagination link.
+func (r *Rules) nextPageURL(document *goquery.Document, pageURL string) string {
+	if r.Next == "" {
+		return ""
+	}
+
+	href, found := document.Find(r.Next).First().Attr("href")
+	href = strings.TrimSpace(href)
+	if !found || href == "" || strings.HasPrefix(href, "#") {
+		return ""
+	}
+
+	nextURL, err := url.AbsoluteURL(pageURL, href)
+	if err != nil {
+		return ""
+	}
+
+	return nextURL
+}
+
+// scrap removes the excluded elements and returns the HTML of the included ones.
+func (r *Rules) scrap(document *goquery.Document) string {
+	if r.Exclude != "" {
+		document.Find(r.Exclude).Remove()
+	}
+
+	contents := ""
+	document.Find(r.Include).Each(func(i int, s *goquery.Selection) {
+		var content string
+
+		content, _ = goquery.OuterHtml(s)
+		contents += content
+	})
+
+	return contents
+}
@@ -0,0 +1,130 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package scraper // import "miniflux.app/reader/scraper"
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules("article p; include: article img ; exclude: .ads; Exclude: .share; next")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rules.Include != "article p, article img" {
+		t.Errorf(`Unexpected include selector: %q`, rules.Include)
+	}
+
+	if rules.Exclude != ".ads, .share" {
+		t.Errorf(`Unexpected exclude selector: %q`, rules.Exclude)
+	}
+
+	if rules.Next != relNextSelector || rules.MaxPages != defaultMaxPages {
+		t.Errorf(`Unexpected pagination: %q, %d`, rules.Next, rules.MaxPages)
+	}
+}
+
+func TestParseRulesPagination(t *testing.T) {
+	rules, err := ParseRules("pages: 3; next: a.next-page; div:not(.comments)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rules.Next != "a.next-page" || rules.MaxPages != 3 {
+		t.Errorf(`Unexpected pagination: %q, %d`, rules.Next, rules.MaxPages)
+	}
+
+	if rules.Include != "div:not(.comments)" {
+		t.Errorf(`Unexpected include selector: %q`, rules.Include)
+	}
+
+	rules, err = ParseRules("pages: 5; article")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rules.MaxPages != 1 {
+		t.Errorf(`The page limit should be ignored without pagination, got %d`, rules.MaxPages)
+	}
+}
+
+func TestParseInvalidRules(t *testing.T) {
+	for _, value := range []string{"pages: abc", "next; pages: 0", "next; pages: 100", "exclude:", "include: ; article"} {
+		if _, err := ParseRules(value); err == nil {
+			t.Errorf(`The rules %q should be invalid`, value)
+		}
+	}
+}
+
+func TestExcludeRules(t *testing.T) {
+	html := `<article><p>Content</p><p class="ads">Advertisement</p><div class="share"><p>Share</p></div></article>`
+
+	content, err := scrapContent(strings.NewReader(html), "article p; exclude: .ads, .share")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if content != "<p>Content</p>" {
+		t.Errorf(`Unexpected content: %q`, content)
+	}
+}
+
+func TestMultiPageArticle(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		switch r.URL.Path {
+		case "/article/2":
+			fmt.Fprint(w, `<html><head><link rel="next" href="/article/3"></head><body><article><p>Page 2</p><img src="image.png"></article></body></html>`)
+		case "/article/3":
+			fmt.Fprint(w, `<html><head><link rel="next" href="/article/1"></head><body><article><p>Page 3</p></article></body></html>`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	page := `<html><head><link rel="next" href="/article/2"></head><body><article><p>Page 1</p><p class="ads">Ads</p></article></body></html>`
+
+	article, err := ExtractWithRules(server.URL+"/article/1", []byte(page), "article > *; exclude: .ads; next", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<p>Page 1</p><p>Page 2</p><img src="` + server.URL + `/article/image.png"/><p>Page 3</p>`
+	if article.Content != expected {
+		t.Errorf(`Unexpected content: %q`, article.Content)
+	}
+
+	if requests != 2 {
+		t.Errorf(`The pages already visited should not be downloaded again, got %d requests`, requests)
+	}
+
+	article, err = ExtractWithRules(server.URL+"/article/1", []byte(page), "article > *; next; pages: 2", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasSuffix(article.Content, "<p>Page 2</p><img src=\""+server.URL+"/article/image.png\"/>") {
+		t.Errorf(`The page limit should be respected: %q`, article.Content)
+	}
+}
@@ -5,9 +5,11 @@
 package scraper // import "miniflux.app/reader/scraper"
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"strings"
 
 	"miniflux.app/http/client"
@@ -18,8 +20,11 @@ import (
 	"github.com/PuerkitoBio/goquery"
 )
 
-// Fetch downloads a web page and returns relevant contents.
-func Fetch(websiteURL, rules, userAgent string) (string, error) {
+// ErrNoRules is returned when no scraper rules are defined for a website.
+var ErrNoRules = errors.New("scraper: no scraper rules defined for this website")
+
+// Download fetches a HTML document and returns the effective URL and the page converted to UTF-8.
+func Download(websiteURL, userAgent string) (string, []byte, error) {
 	clt := client.New(websiteURL)
 	if userAgent != "" {
 		clt.WithUserAgent(userAgent)
@@ -27,59 +32,140 @@ func Fetch(websiteURL, rules, userAgent string) (string, error) {
 
 	response, err := clt.Get()
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	if response.HasServerFailure() {
-		return "", errors.New("scraper: unable to download web page")
+		return "", nil, errors.New("scraper: unable to download web page")
 	}
 
 	if !isWhitelistedContentType(response.ContentType) {
-		return "", fmt.Errorf("scraper: this resource is not a HTML document (%s)", response.ContentType)
+		return "", nil, fmt.Errorf("scraper: this resource is not a HTML document (%s)", response.ContentType)
 	}
 
 	if err = response.EnsureUnicodeBody(); err != nil {
-		return "", err
+		return "", nil, err
+	}
+
+	page, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", nil, err
 	}
 
 	// The entry URL could redirect somewhere else.
-	websiteURL = response.EffectiveURL
+	return response.EffectiveURL, page, nil
+}
 
+// ExtractWithRules returns the elements of the page matching the scraper rules along with the article metadata.
+//
+// The predefined rules of the website are used when no rules are given, ErrNoRules is returned if there is none.
+// When the rules follow the pagination, the next pages are downloaded and appended to the content.
+func ExtractWithRules(websiteURL string, page []byte, rules, userAgent string) (*readability.Article, error) {
 	if rules == "" {
 		rules = getPredefinedScraperRules(websiteURL)
 	}
 
-	var content string
-	if rules != "" {
-		logger.Debug(`[Scraper] Using rules %q for %q`, rules, websiteURL)
-		content, err = scrapContent(response.Body, rules)
-	} else {
-		logger.Debug(`[Scraper] Using readability for %q`, websiteURL)
-		content, err = readability.ExtractContent(response.Body)
+	if rules == "" {
+		return nil, ErrNoRules
 	}
 
+	parsedRules, err := ParseRules(rules)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	logger.Debug(`[Scraper] Using rules %q for %q`, rules, websiteURL)
+	article, err := readability.ExtractMetadata(websiteURL, bytes.NewReader(page))
+	if err != nil {
+		return nil, err
 	}
 
-	return content, nil
+	content, nextURL, err := extractPage(websiteURL, page, parsedRules)
+	if err != nil {
+		return nil, err
+	}
+
+	visited := map[string]bool{websiteURL: true}
+	for pages := 1; nextURL != "" && pages < parsedRules.MaxPages; pages++ {
+		if visited[nextURL] || url.Domain(nextURL) != url.Domain(websiteURL) {
+			break
+		}
+		visited[nextURL] = true
+
+		logger.Debug(`[Scraper] Fetching page %d of %q: %q`, pages+1, websiteURL, nextURL)
+		pageURL, nextPage, err := Download(nextURL, userAgent)
+		if err != nil {
+			logger.Error(`[Scraper] Unable to fetch the next page %q: %v`, nextURL, err)
+			break
+		}
+
+		var pageContent string
+		pageContent, nextURL, err = extractPage(pageURL, nextPage, parsedRules)
+		if err != nil {
+			logger.Error(`[Scraper] Unable to extract the content of %q: %v`, pageURL, err)
+			break
+		}
+
+		content += pageContent
+	}
+
+	article.Content = content
+	return article, nil
 }
 
-func scrapContent(page io.Reader, rules string) (string, error) {
-	document, err := goquery.NewDocumentFromReader(page)
+// extractPage returns the content of a single page and the URL of the next one.
+func extractPage(pageURL string, page []byte, rules *Rules) (string, string, error) {
+	document, err := goquery.NewDocumentFromReader(bytes.NewReader(page))
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
-	contents := ""
-	document.Find(rules).Each(func(i int, s *goquery.Selection) {
-		var content string
+	nextURL := rules.nextPageURL(document, pageURL)
 
-		content, _ = goquery.OuterHtml(s)
-		contents += content
+	// The pages are stitched together, relative links must be resolved against their own page.
+	document.Find("[src], [href]").Each(func(i int, s *goquery.Selection) {
+		for _, attribute := range []string{"src", "href"} {
+			if value, found := s.Attr(attribute); found && !strings.HasPrefix(value, "#") {
+				if absoluteURL, err := url.AbsoluteURL(pageURL, value); err == nil {
+					s.SetAttr(attribute, absoluteURL)
+				}
+			}
+		}
 	})
 
-	return contents, nil
+	if rules.Include != "" {
+		return rules.scrap(document), nextURL, nil
+	}
+
+	if rules.Exclude != "" {
+		document.Find(rules.Exclude).Remove()
+	}
+
+	remainingHTML, err := document.Html()
+	if err != nil {
+		return "", "", err
+	}
+
+	article, err := readability.Extract(pageURL, strings.NewReader(remainingHTML))
+	if err != nil {
+		return "", "", err
+	}
+
+	return article.Content, nextURL, nil
+}
+
+func scrapContent(page io.Reader, rules string) (string, error) {
+	parsedRules, err := ParseRules(rules)
+	if err != nil {
+		return "", err
+	}
+
+	document, err := goquery.NewDocumentFromReader(page)
+	if err != nil {
+		return "", err
+	}
+
+	return parsedRules.scrap(document), nil
 }
 
 func getPredefinedScraperRules(websiteURL string) string {
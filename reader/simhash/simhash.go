@@ -0,0 +1,125 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package simhash // import "miniflux.app/reader/simhash"
+
+import (
+	"hash/fnv"
+	"strings"
+	"unicode"
+
+	"miniflux.app/reader/sanitizer"
+)
+
+// Two fingerprints separated by at most this number of bits are considered near-duplicates.
+const MaxDistance = 3
+
+// Texts with fewer tokens don't get a fingerprint, they are too short to be compared reliably.
+const minTokens = 8
+
+// The words of the title weigh more than the words of the content.
+const titleWeight = 3
+
+// Fingerprint returns the SimHash of the normalized title and content, or 0 when the text is too short.
+func Fingerprint(title, content string) uint64 {
+	weights := make(map[string]int)
+	for _, token := range tokenize(title) {
+		weights[token] += titleWeight
+	}
+
+	for _, token := range tokenize(sanitizer.StripTags(content)) {
+		weights[token]++
+	}
+
+	if len(weights) < minTokens {
+		return 0
+	}
+
+	var vector [64]int
+	for token, weight := range weights {
+		hash := hashToken(token)
+		for bit := uint(0); bit < 64; bit++ {
+			if hash&(1<<bit) != 0 {
+				vector[bit] += weight
+			} else {
+				vector[bit] -= weight
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for bit := uint(0); bit < 64; bit++ {
+		if vector[bit] > 0 {
+			fingerprint |= 1 << bit
+		}
+	}
+
+	return fingerprint
+}
+
+// Distance returns the number of different bits between two fingerprints.
+func Distance(a, b uint64) int {
+	distance := 0
+	for x := a ^ b; x != 0; x &= x - 1 {
+		distance++
+	}
+
+	return distance
+}
+
+// IsNearDuplicate returns true when both fingerprints are set and close enough.
+func IsNearDuplicate(a, b uint64) bool {
+	return a != 0 && b != 0 && Distance(a, b) <= MaxDistance
+}
+
+func hashToken(token string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(token))
+	return h.Sum64()
+}
+
+// tokenize returns the lowercase words of the text.
+// Chinese and Japanese texts don't separate words, pairs of characters are used instead.
+func tokenize(text string) []string {
+	var tokens []string
+	var word []rune
+	var ideographs []rune
+
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+
+	flushIdeographs := func() {
+		if len(ideographs) == 1 {
+			tokens = append(tokens, string(ideographs))
+		}
+
+		for i := 0; i+1 < len(ideographs); i++ {
+			tokens = append(tokens, string(ideographs[i:i+2]))
+		}
+
+		ideographs = ideographs[:0]
+	}
+
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana):
+			flushWord()
+			ideographs = append(ideographs, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushIdeographs()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushIdeographs()
+		}
+	}
+
+	flushWord()
+	flushIdeographs()
+	return tokens
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package simhash // import "miniflux.app/reader/simhash"
+
+import (
+	"reflect"
+	"testing"
+)
+
+const story = `<p>The central bank raised its main interest rate by half a point on Tuesday,
+the largest increase in two decades, as officials try to bring inflation under control
+without pushing the economy into a recession.</p>`
+
+func TestFingerprintOfNearDuplicates(t *testing.T) {
+	a := Fingerprint("Central bank raises interest rate by half a point", story)
+	b := Fingerprint("Central Bank raises interest rate by half a point!", story+"<p>Read more on our website.</p>")
+
+	if !IsNearDuplicate(a, b) {
+		t.Errorf(`The entries should be near-duplicates, distance is %d`, Distance(a, b))
+	}
+}
+
+func TestFingerprintOfDifferentStories(t *testing.T) {
+	a := Fingerprint("Central bank raises interest rate by half a point", story)
+	b := Fingerprint("Local team wins the championship", `<p>The home team won the final game of the season
+on Sunday evening after a dramatic overtime, giving the city its first title in thirty years.</p>`)
+
+	if IsNearDuplicate(a, b) {
+		t.Errorf(`The entries should not be near-duplicates, distance is %d`, Distance(a, b))
+	}
+}
+
+func TestFingerprintIgnoresMarkupAndCase(t *testing.T) {
+	a := Fingerprint("Central bank raises interest rate", story)
+	b := Fingerprint("CENTRAL BANK RAISES INTEREST RATE", "<div><strong>"+story+"</strong></div>")
+
+	if a != b {
+		t.Errorf(`The fingerprints should be identical, distance is %d`, Distance(a, b))
+	}
+}
+
+func TestFingerprintOfShortText(t *testing.T) {
+	if fingerprint := Fingerprint("Hello", "<p>World</p>"); fingerprint != 0 {
+		t.Errorf(`Short texts should not have a fingerprint, got %d`, fingerprint)
+	}
+
+	if IsNearDuplicate(0, 0) {
+		t.Error(`Entries without fingerprint are never near-duplicates`)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	scenarios := []struct {
+		a, b     uint64
+		distance int
+	}{
+		{0, 0, 0},
+		{0xFF, 0x0F, 4},
+		{0, 1 << 63, 1},
+		{^uint64(0), 0, 64},
+	}
+
+	for _, scenario := range scenarios {
+		if distance := Distance(scenario.a, scenario.b); distance != scenario.distance {
+			t.Errorf(`Wrong distance between %x and %x, got %d instead of %d`, scenario.a, scenario.b, distance, scenario.distance)
+		}
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	scenarios := map[string][]string{
+		"Hello, World 2020!": {"hello", "world", "2020"},
+		"中文新闻 today":         {"中文", "文新", "新闻", "today"},
+		"日":                  {"日"},
+		"":                   nil,
+	}
+
+	for input, expected := range scenarios {
+		if tokens := tokenize(input); !reflect.DeepEqual(tokens, expected) {
+			t.Errorf(`Wrong tokens for %q, got %q instead of %q`, input, tokens, expected)
+		}
+	}
+}
@@ -7,9 +7,11 @@ package subscription // import "miniflux.app/reader/subscription"
 import (
 	"io"
 	"strings"
+	"sync"
 
 	"miniflux.app/errors"
 	"miniflux.app/http/client"
+	"miniflux.app/logger"
 	"miniflux.app/reader/browser"
 	"miniflux.app/reader/parser"
 	"miniflux.app/url"
@@ -23,6 +25,10 @@ var (
 
 // FindSubscriptions downloads and try to find one or more subscriptions from an URL.
 func FindSubscriptions(websiteURL, userAgent, username, password string) (Subscriptions, *errors.LocalizedError) {
+	if subscriptions := findSiteSubscriptions(websiteURL); len(subscriptions) > 0 {
+		return subscriptions, nil
+	}
+
 	request := client.New(websiteURL)
 	request.WithCredentials(username, password)
 	request.WithUserAgent(userAgent)
@@ -32,7 +38,8 @@ func FindSubscriptions(websiteURL, userAgent, username, password string) (Subscr
 	}
 
 	body := response.BodyAsString()
-	if format := parser.DetectFeedFormat(body); format != parser.FormatUnknown {
+	format := parser.DetectFeedFormat(body)
+	if format != parser.FormatUnknown && format != parser.FormatHFeed {
 		var subscriptions Subscriptions
 		subscriptions = append(subscriptions, &Subscription{
 			Title: response.EffectiveURL,
@@ -43,7 +50,64 @@ func FindSubscriptions(websiteURL, userAgent, username, password string) (Subscr
 		return subscriptions, nil
 	}
 
-	return parseDocument(response.EffectiveURL, strings.NewReader(body))
+	subscriptions, parseErr := parseDocument(response.EffectiveURL, strings.NewReader(body))
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	if len(subscriptions) == 0 {
+		subscriptions = probeSubscriptions(candidateURLs(response.EffectiveURL), userAgent, username, password)
+	}
+
+	// Pages with h-feed markup can be subscribed directly, in addition to the feeds they advertise.
+	if format == parser.FormatHFeed {
+		subscriptions = append(subscriptions, &Subscription{
+			Title: response.EffectiveURL,
+			URL:   response.EffectiveURL,
+			Type:  format,
+		})
+	}
+
+	return subscriptions, nil
+}
+
+// probeSubscriptions downloads the candidate URLs concurrently and keeps the valid feeds, in the order of the candidates.
+func probeSubscriptions(candidates []string, userAgent, username, password string) Subscriptions {
+	results := make([]*Subscription, len(candidates))
+
+	var wg sync.WaitGroup
+	for i, candidate := range candidates {
+		wg.Add(1)
+		go func(i int, candidate string) {
+			defer wg.Done()
+
+			request := client.New(candidate)
+			request.WithCredentials(username, password)
+			request.WithUserAgent(userAgent)
+			response, err := browser.Exec(request)
+			if err != nil {
+				logger.Debug("[Subscription:probe] %s: %v", candidate, err)
+				return
+			}
+
+			format := parser.DetectFeedFormat(response.BodyAsString())
+			if format != parser.FormatUnknown && format != parser.FormatHFeed {
+				results[i] = &Subscription{Title: response.EffectiveURL, URL: response.EffectiveURL, Type: format}
+			}
+		}(i, candidate)
+	}
+	wg.Wait()
+
+	var subscriptions Subscriptions
+	seen := make(map[string]bool)
+	for _, subscription := range results {
+		if subscription != nil && !seen[subscription.URL] {
+			seen[subscription.URL] = true
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+
+	return subscriptions
 }
 
 func parseDocument(websiteURL string, data io.Reader) (Subscriptions, *errors.LocalizedError) {
@@ -0,0 +1,71 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package subscription // import "miniflux.app/reader/subscription"
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+const atomFeed = `<?xml version="1.0" encoding="utf-8"?><feed xmlns="http://www.w3.org/2005/Atom"><title>Blog</title></feed>`
+
+func startWebsite(t *testing.T, pages map[string]string) *httptest.Server {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, found := pages[r.URL.Path]
+		if !found {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Write([]byte(page))
+	}))
+}
+
+func TestFindSubscriptionsWithLinkTag(t *testing.T) {
+	server := startWebsite(t, map[string]string{
+		"/":     `<html><head><link rel="alternate" type="application/atom+xml" title="Blog" href="/posts.atom"></head></html>`,
+		"/feed": atomFeed,
+	})
+	defer server.Close()
+
+	subscriptions, err := FindSubscriptions(server.URL+"/", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subscriptions) != 1 || subscriptions[0].URL != server.URL+"/posts.atom" {
+		t.Errorf(`The advertised feeds should be returned without probing: %v`, subscriptions)
+	}
+}
+
+func TestFindSubscriptionsWithWellKnownPaths(t *testing.T) {
+	server := startWebsite(t, map[string]string{
+		"/blog/":     `<html><head><title>Blog</title></head></html>`,
+		"/atom.xml":  atomFeed,
+		"/index.xml": `<html><body>Not a feed</body></html>`,
+	})
+	defer server.Close()
+
+	subscriptions, err := FindSubscriptions(server.URL+"/blog/", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subscriptions) != 1 || subscriptions[0].URL != server.URL+"/atom.xml" || subscriptions[0].Type != "atom" {
+		t.Errorf(`Unexpected subscriptions: %v`, subscriptions)
+	}
+}
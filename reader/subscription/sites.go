@@ -0,0 +1,123 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package subscription // import "miniflux.app/reader/subscription"
+
+import (
+	url_parser "net/url"
+	"regexp"
+	"strings"
+
+	"miniflux.app/reader/parser"
+)
+
+var (
+	youtubeChannelRegex   = regexp.MustCompile(`^/channel/([\w-]+)`)
+	youtubeUserRegex      = regexp.MustCompile(`^/user/([\w-]+)`)
+	redditSubredditRegex  = regexp.MustCompile(`^/r/(\w+)`)
+	redditUserRegex       = regexp.MustCompile(`^/(?:u|user)/([\w-]+)`)
+	githubRepositoryRegex = regexp.MustCompile(`^/([\w.-]+)/([\w.-]+)`)
+	mastodonProfileRegex  = regexp.MustCompile(`^/@(\w+)/?$`)
+)
+
+// GitHub paths that are not user or organization names.
+var githubReservedNames = map[string]bool{
+	"about":         true,
+	"explore":       true,
+	"features":      true,
+	"marketplace":   true,
+	"notifications": true,
+	"orgs":          true,
+	"pricing":       true,
+	"settings":      true,
+	"topics":        true,
+	"trending":      true,
+}
+
+// findSiteSubscriptions translates the URL of well-known websites to their feed URLs without downloading the page.
+func findSiteSubscriptions(websiteURL string) Subscriptions {
+	u, err := url_parser.Parse(websiteURL)
+	if err != nil {
+		return nil
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	switch host {
+	case "youtube.com", "m.youtube.com":
+		return youtubeSubscriptions(u)
+	case "reddit.com", "old.reddit.com":
+		return redditSubscriptions(u)
+	case "github.com":
+		return githubSubscriptions(u)
+	}
+
+	return nil
+}
+
+func youtubeSubscriptions(u *url_parser.URL) Subscriptions {
+	const feedURL = "https://www.youtube.com/feeds/videos.xml?"
+
+	if playlistID := u.Query().Get("list"); playlistID != "" {
+		return Subscriptions{{Title: "YouTube playlist", URL: feedURL + "playlist_id=" + url_parser.QueryEscape(playlistID), Type: parser.FormatAtom}}
+	}
+
+	if matches := youtubeChannelRegex.FindStringSubmatch(u.Path); matches != nil {
+		return Subscriptions{{Title: "YouTube channel", URL: feedURL + "channel_id=" + matches[1], Type: parser.FormatAtom}}
+	}
+
+	if matches := youtubeUserRegex.FindStringSubmatch(u.Path); matches != nil {
+		return Subscriptions{{Title: "YouTube channel", URL: feedURL + "user=" + matches[1], Type: parser.FormatAtom}}
+	}
+
+	return nil
+}
+
+func redditSubscriptions(u *url_parser.URL) Subscriptions {
+	if matches := redditSubredditRegex.FindStringSubmatch(u.Path); matches != nil {
+		return Subscriptions{{Title: "r/" + matches[1], URL: "https://www.reddit.com/r/" + matches[1] + "/.rss", Type: parser.FormatAtom}}
+	}
+
+	if matches := redditUserRegex.FindStringSubmatch(u.Path); matches != nil {
+		return Subscriptions{{Title: "u/" + matches[1], URL: "https://www.reddit.com/user/" + matches[1] + "/.rss", Type: parser.FormatAtom}}
+	}
+
+	return nil
+}
+
+func githubSubscriptions(u *url_parser.URL) Subscriptions {
+	matches := githubRepositoryRegex.FindStringSubmatch(u.Path)
+	if matches == nil || githubReservedNames[strings.ToLower(matches[1])] {
+		return nil
+	}
+
+	repository := matches[1] + "/" + strings.TrimSuffix(matches[2], ".git")
+	baseURL := "https://github.com/" + repository
+	return Subscriptions{
+		{Title: repository + " releases", URL: baseURL + "/releases.atom", Type: parser.FormatAtom},
+		{Title: repository + " commits", URL: baseURL + "/commits.atom", Type: parser.FormatAtom},
+		{Title: repository + " tags", URL: baseURL + "/tags.atom", Type: parser.FormatAtom},
+	}
+}
+
+// candidateURLs returns the feed URLs to probe when the page doesn't advertise any feed.
+func candidateURLs(websiteURL string) []string {
+	u, err := url_parser.Parse(websiteURL)
+	if err != nil || u.Host == "" {
+		return nil
+	}
+
+	var candidates []string
+	root := u.Scheme + "://" + u.Host
+
+	// Mastodon profiles are on any domain, the feed is only probed when the path looks like a profile.
+	if matches := mastodonProfileRegex.FindStringSubmatch(u.Path); matches != nil {
+		candidates = append(candidates, root+"/@"+matches[1]+".rss")
+	}
+
+	for _, path := range []string{"/feed", "/rss.xml", "/atom.xml", "/index.xml", "/feed.json"} {
+		candidates = append(candidates, root+path)
+	}
+
+	return candidates
+}
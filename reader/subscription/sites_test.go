@@ -0,0 +1,57 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package subscription // import "miniflux.app/reader/subscription"
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindSiteSubscriptions(t *testing.T) {
+	scenarios := map[string][]string{
+		"https://www.youtube.com/channel/UCxyz-123/videos": {"https://www.youtube.com/feeds/videos.xml?channel_id=UCxyz-123"},
+		"https://www.youtube.com/playlist?list=PLabc":      {"https://www.youtube.com/feeds/videos.xml?playlist_id=PLabc"},
+		"https://m.youtube.com/watch?v=123&list=PLabc":     {"https://www.youtube.com/feeds/videos.xml?playlist_id=PLabc"},
+		"https://www.youtube.com/user/miniflux":            {"https://www.youtube.com/feeds/videos.xml?user=miniflux"},
+		"https://www.reddit.com/r/golang/":                 {"https://www.reddit.com/r/golang/.rss"},
+		"https://old.reddit.com/u/someone":                 {"https://www.reddit.com/user/someone/.rss"},
+		"https://github.com/miniflux/miniflux.git":         {"https://github.com/miniflux/miniflux/releases.atom", "https://github.com/miniflux/miniflux/commits.atom", "https://github.com/miniflux/miniflux/tags.atom"},
+		"https://github.com/miniflux/miniflux/issues":      {"https://github.com/miniflux/miniflux/releases.atom", "https://github.com/miniflux/miniflux/commits.atom", "https://github.com/miniflux/miniflux/tags.atom"},
+		"https://github.com/explore/repositories":          nil,
+		"https://github.com/miniflux":                      nil,
+		"https://www.youtube.com/":                         nil,
+		"https://example.org/r/golang":                     nil,
+	}
+
+	for websiteURL, expected := range scenarios {
+		var urls []string
+		for _, subscription := range findSiteSubscriptions(websiteURL) {
+			urls = append(urls, subscription.URL)
+		}
+
+		if !reflect.DeepEqual(urls, expected) {
+			t.Errorf(`Unexpected subscriptions for %q: got %v instead of %v`, websiteURL, urls, expected)
+		}
+	}
+}
+
+func TestCandidateURLs(t *testing.T) {
+	expected := []string{
+		"https://mastodon.social/@miniflux.rss",
+		"https://mastodon.social/feed",
+		"https://mastodon.social/rss.xml",
+		"https://mastodon.social/atom.xml",
+		"https://mastodon.social/index.xml",
+		"https://mastodon.social/feed.json",
+	}
+
+	if urls := candidateURLs("https://mastodon.social/@miniflux"); !reflect.DeepEqual(urls, expected) {
+		t.Errorf(`Unexpected candidates: %v`, urls)
+	}
+
+	if urls := candidateURLs("https://example.org/blog/post.html"); len(urls) != 5 || urls[0] != "https://example.org/feed" {
+		t.Errorf(`Unexpected candidates: %v`, urls)
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package urlcleaner // import "miniflux.app/reader/urlcleaner"
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	"miniflux.app/http/client"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Wrappers containing the destination URL in a query parameter, they are removed without network request.
+var queryWrappers = []struct {
+	host      string
+	path      string
+	parameter string
+}{
+	{"www.google.com", "/url", "q"},
+	{"www.google.com", "/url", "url"},
+	{"news.google.com", "/news/url", "url"},
+	{"l.facebook.com", "/l.php", "u"},
+	{"lm.facebook.com", "/l.php", "u"},
+	{"l.instagram.com", "/", "u"},
+	{"out.reddit.com", "/", "url"},
+	{"t.umblr.com", "/redirect", "z"},
+	{"www.youtube.com", "/redirect", "q"},
+	{"steamcommunity.com", "/linkfilter/", "url"},
+	{"slack-redir.net", "/link", "url"},
+}
+
+// Hosts only redirecting to another URL, the destination is known after following the redirection.
+var redirectWrappers = []string{
+	"feedproxy.google.com",
+	"feeds.feedburner.com/~r/",
+	"rss.feedsportal.com",
+	"t.co",
+	"bit.ly",
+	"buff.ly",
+	"dlvr.it",
+	"ift.tt",
+	"ow.ly",
+	"trib.al",
+	"lnkd.in",
+}
+
+// Cleaner removes the tracking parameters and the redirect wrappers from URLs.
+type Cleaner struct {
+	parameters []string
+}
+
+// New returns a Cleaner removing the given tracking parameters,
+// a trailing wildcard matches all the parameters starting with the prefix.
+func New(parameters []string) *Cleaner {
+	c := &Cleaner{}
+	for _, parameter := range parameters {
+		if parameter = strings.ToLower(strings.TrimSpace(parameter)); parameter != "" {
+			c.parameters = append(c.parameters, parameter)
+		}
+	}
+
+	return c
+}
+
+// CleanURL unwraps the URL if it's a known wrapper and removes the tracking parameters.
+//
+// The URL is returned unchanged if it does not contain tracking parameters, so the encoding is preserved.
+func (c *Cleaner) CleanURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "" && u.Scheme != "http" && u.Scheme != "https") {
+		return rawURL
+	}
+
+	if destination := unwrapQueryWrapper(u); destination != "" {
+		return c.CleanURL(destination)
+	}
+
+	changed := false
+	if u.RawQuery != "" {
+		var parts []string
+		for _, part := range strings.Split(u.RawQuery, "&") {
+			if c.isTrackingParameter(part) {
+				changed = true
+			} else if part != "" {
+				parts = append(parts, part)
+			}
+		}
+		u.RawQuery = strings.Join(parts, "&")
+		u.ForceQuery = false
+	}
+
+	// Some websites add the tracking parameters to the fragment, for example "#xtor=RSS-1".
+	if fragment := u.Fragment; fragment != "" && strings.Contains(fragment, "=") {
+		isTracking := true
+		for _, part := range strings.Split(fragment, "&") {
+			isTracking = isTracking && c.isTrackingParameter(part)
+		}
+
+		if isTracking {
+			u.Fragment = ""
+			changed = true
+		}
+	}
+
+	if !changed {
+		return rawURL
+	}
+
+	return u.String()
+}
+
+// CleanContent cleans the links of a HTML document.
+func (c *Cleaner) CleanContent(content string) string {
+	if !strings.Contains(content, "<a") {
+		return content
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return content
+	}
+
+	changed := false
+	doc.Find("a[href]").Each(func(i int, a *goquery.Selection) {
+		href, _ := a.Attr("href")
+		if cleanURL := c.CleanURL(href); cleanURL != href {
+			a.SetAttr("href", cleanURL)
+			changed = true
+		}
+	})
+
+	if !changed {
+		return content
+	}
+
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
+
+func (c *Cleaner) isTrackingParameter(part string) bool {
+	name := part
+	if index := strings.IndexByte(part, '='); index >= 0 {
+		name = part[:index]
+	}
+
+	if unescapedName, err := url.QueryUnescape(name); err == nil {
+		name = unescapedName
+	}
+	name = strings.ToLower(name)
+
+	for _, parameter := range c.parameters {
+		if strings.HasSuffix(parameter, "*") {
+			if strings.HasPrefix(name, parameter[:len(parameter)-1]) {
+				return true
+			}
+		} else if name == parameter {
+			return true
+		}
+	}
+
+	return false
+}
+
+func unwrapQueryWrapper(u *url.URL) string {
+	host := strings.ToLower(u.Hostname())
+	for _, wrapper := range queryWrappers {
+		if host == wrapper.host && strings.HasPrefix(u.Path, wrapper.path) {
+			destination := u.Query().Get(wrapper.parameter)
+			if strings.HasPrefix(destination, "http://") || strings.HasPrefix(destination, "https://") {
+				return destination
+			}
+		}
+	}
+
+	return ""
+}
+
+// IsRedirectWrapper returns true if the URL belongs to a service only redirecting to another URL.
+func IsRedirectWrapper(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	hostAndPath := strings.ToLower(u.Host) + u.Path
+	for _, wrapper := range redirectWrappers {
+		if strings.HasSuffix(wrapper, "/") {
+			if strings.HasPrefix(hostAndPath, wrapper) {
+				return true
+			}
+		} else if strings.ToLower(u.Host) == wrapper {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ResolveRedirect follows the redirections of the URL and returns the final destination.
+func ResolveRedirect(rawURL, userAgent string) (string, error) {
+	clt := client.New(rawURL)
+	if userAgent != "" {
+		clt.WithUserAgent(userAgent)
+	}
+
+	response, err := clt.Get()
+	if err != nil {
+		return "", err
+	}
+
+	if response.HasServerFailure() {
+		return "", errors.New("urlcleaner: unable to follow the redirection")
+	}
+
+	return response.EffectiveURL, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package urlcleaner // import "miniflux.app/reader/urlcleaner"
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+var testParameters = []string{"utm_*", "fbclid", "xtor"}
+
+func TestCleanURL(t *testing.T) {
+	scenarios := map[string]string{
+		"https://example.org/article":                                            "https://example.org/article",
+		"https://example.org/article?id=1&utm_source=rss&utm_medium=feed":        "https://example.org/article?id=1",
+		"https://example.org/article?UTM_Source=rss":                             "https://example.org/article",
+		"https://example.org/article?fbclid=abc&b=2&a=1":                         "https://example.org/article?b=2&a=1",
+		"https://example.org/article?q=a%20b&utm_campaign=x":                     "https://example.org/article?q=a%20b",
+		"https://example.org/article?q=a+b&c=%2F":                                "https://example.org/article?q=a+b&c=%2F",
+		"https://example.org/article?fbclid=abc#comments":                        "https://example.org/article#comments",
+		"https://example.org/article#xtor=RSS-1":                                 "https://example.org/article",
+		"https://example.org/article#section=2":                                  "https://example.org/article#section=2",
+		"/article?utm_source=rss":                                                "/article",
+		"mailto:someone@example.org?utm_source=rss":                              "mailto:someone@example.org?utm_source=rss",
+		"https://www.google.com/url?q=https://example.org/a?utm_source=x&sa=D":   "https://example.org/a",
+		"https://l.facebook.com/l.php?u=https%3A%2F%2Fexample.org%2Fa&h=AT0":     "https://example.org/a",
+		"https://out.reddit.com/t3_abc?url=https%3A%2F%2Fexample.org%2F&token=x": "https://example.org/",
+		"https://www.google.com/url?q=javascript:alert(1)":                       "https://www.google.com/url?q=javascript:alert(1)",
+	}
+
+	cleaner := New(testParameters)
+	for input, expected := range scenarios {
+		if output := cleaner.CleanURL(input); output != expected {
+			t.Errorf(`Unexpected URL for %q, got %q instead of %q`, input, output, expected)
+		}
+	}
+}
+
+func TestCleanContent(t *testing.T) {
+	cleaner := New(testParameters)
+
+	input := `<p><a href="https://example.org/?utm_source=rss&amp;id=1">Link</a> <a href="#top">Top</a></p>`
+	expected := `<p><a href="https://example.org/?id=1">Link</a> <a href="#top">Top</a></p>`
+	if output := cleaner.CleanContent(input); output != expected {
+		t.Errorf(`Unexpected content, got %q instead of %q`, output, expected)
+	}
+
+	input = `<p><a href="https://example.org/?id=1">Link</a></p>`
+	if output := cleaner.CleanContent(input); output != input {
+		t.Errorf(`The content should not change, got %q`, output)
+	}
+}
+
+func TestIsRedirectWrapper(t *testing.T) {
+	scenarios := map[string]bool{
+		"http://feedproxy.google.com/~r/example/~3/abc/":  true,
+		"https://feeds.feedburner.com/~r/example/~3/abc/": true,
+		"https://feeds.feedburner.com/example":            false,
+		"https://t.co/abc":                                true,
+		"https://example.org/t.co":                        false,
+		"https://bit.ly.example.org/abc":                  false,
+	}
+
+	for input, expected := range scenarios {
+		if output := IsRedirectWrapper(input); output != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, input, output, expected)
+		}
+	}
+}
+
+func TestResolveRedirect(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wrapper" {
+			http.Redirect(w, r, "/article?utm_source=feedburner", http.StatusMovedPermanently)
+			return
+		}
+
+		w.Write([]byte("Article"))
+	}))
+	defer server.Close()
+
+	destination, err := ResolveRedirect(server.URL+"/wrapper", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if destination != server.URL+"/article?utm_source=feedburner" {
+		t.Errorf(`Unexpected destination: %q`, destination)
+	}
+
+	if _, err := ResolveRedirect(server.URL+"/missing", ""); err != nil {
+		t.Errorf(`The pages without redirection should be returned as is: %v`, err)
+	}
+}
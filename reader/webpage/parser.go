@@ -0,0 +1,169 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package webpage // import "miniflux.app/reader/webpage"
+
+import (
+	"io"
+	url_parser "net/url"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/errors"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/date"
+	"miniflux.app/url"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Parse returns a normalized feed struct from a web page by applying the given CSS selectors.
+func Parse(websiteURL string, data io.Reader, rules *model.WebPageRules) (*model.Feed, *errors.LocalizedError) {
+	if err := rules.Validate(); err != nil {
+		return nil, errors.NewLocalizedError("Invalid web page rules: %v", err)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(data)
+	if err != nil {
+		return nil, errors.NewLocalizedError("Unable to parse web page: %q", err)
+	}
+
+	feed := new(model.Feed)
+	feed.FeedURL = websiteURL
+	feed.SiteURL = websiteURL
+	feed.Title = strings.TrimSpace(doc.Find("title").First().Text())
+	if feed.Title == "" {
+		feed.Title = websiteURL
+	}
+
+	doc.Find(rules.ItemSelector).Each(func(i int, item *goquery.Selection) {
+		if entry := transformItem(websiteURL, item, rules); entry != nil {
+			feed.Entries = append(feed.Entries, entry)
+		}
+	})
+
+	return feed, nil
+}
+
+func transformItem(websiteURL string, item *goquery.Selection, rules *model.WebPageRules) *model.Entry {
+	entry := new(model.Entry)
+	entry.URL = getLink(websiteURL, item, rules)
+	entry.Title = getTitle(item, rules)
+	entry.Content = getSummary(item, rules)
+	entry.SetDate(getDate(item, rules))
+
+	if entry.Title == "" {
+		entry.Title = entry.URL
+	}
+
+	if entry.Title == "" {
+		return nil
+	}
+
+	// Items without their own link share the page URL, the hash must be based on something else.
+	if entry.URL != "" && entry.URL != websiteURL {
+		entry.Hash = crypto.Hash(entry.URL)
+	} else {
+		entry.URL = websiteURL
+		entry.Hash = crypto.Hash(entry.Title + entry.Content)
+	}
+
+	return entry
+}
+
+func getTitle(item *goquery.Selection, rules *model.WebPageRules) string {
+	selector := rules.TitleSelector
+	if selector == "" {
+		selector = rules.LinkSelector
+	}
+
+	return normalizeSpaces(findFirst(item, selector).Text())
+}
+
+func getLink(websiteURL string, item *goquery.Selection, rules *model.WebPageRules) string {
+	var element *goquery.Selection
+	if rules.LinkSelector != "" {
+		element = findFirst(item, rules.LinkSelector)
+	} else {
+		element = findFirst(item, rules.TitleSelector)
+	}
+
+	href, found := element.Attr("href")
+	if !found {
+		href, found = element.Find("a[href]").First().Attr("href")
+	}
+
+	if !found && rules.LinkSelector == "" {
+		href, found = item.Find("a[href]").First().Attr("href")
+	}
+
+	href = strings.TrimSpace(href)
+	if !found || href == "" {
+		return ""
+	}
+
+	absoluteURL, err := url.AbsoluteURL(websiteURL, href)
+	if err != nil {
+		return ""
+	}
+
+	// The links are scraped from arbitrary pages, javascript: and other schemes are ignored.
+	if u, err := url_parser.Parse(absoluteURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+
+	return absoluteURL
+}
+
+func getDate(item *goquery.Selection, rules *model.WebPageRules) time.Time {
+	if rules.DateSelector == "" {
+		return time.Time{}
+	}
+
+	element := findFirst(item, rules.DateSelector)
+	value := element.AttrOr("datetime", element.AttrOr("content", ""))
+	if value == "" {
+		value = normalizeSpaces(element.Text())
+	}
+
+	if value == "" {
+		return time.Time{}
+	}
+
+	result, err := date.Parse(value)
+	if err != nil {
+		logger.Debug("webpage: %v", err)
+		return time.Time{}
+	}
+
+	return result
+}
+
+func getSummary(item *goquery.Selection, rules *model.WebPageRules) string {
+	if rules.SummarySelector == "" {
+		return ""
+	}
+
+	content, _ := findFirst(item, rules.SummarySelector).Html()
+	return strings.TrimSpace(content)
+}
+
+// findFirst returns the first element matching the selector, the item itself included.
+func findFirst(item *goquery.Selection, selector string) *goquery.Selection {
+	if selector == "" {
+		return item.Slice(0, 0)
+	}
+
+	if item.Is(selector) {
+		return item
+	}
+
+	return item.Find(selector).First()
+}
+
+func normalizeSpaces(input string) string {
+	return strings.Join(strings.Fields(input), " ")
+}
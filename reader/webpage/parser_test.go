@@ -0,0 +1,172 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package webpage // import "miniflux.app/reader/webpage"
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+const page = `<!DOCTYPE html>
+<html>
+<head><title> Example Blog </title></head>
+<body>
+	<ul class="posts">
+		<li class="post">
+			<h2><a href="/posts/first">First   post</a></h2>
+			<time datetime="2020-04-01T10:00:00Z">April 1st</time>
+			<p class="excerpt">Hello <b>World</b></p>
+		</li>
+		<li class="post">
+			<h2><a href="https://example.org/posts/second">Second post</a></h2>
+			<time>Thu, 02 Apr 2020 10:00:00 GMT</time>
+			<p class="excerpt">Second excerpt</p>
+		</li>
+		<li class="post">
+			<h2>Announcement without link</h2>
+		</li>
+	</ul>
+</body>
+</html>`
+
+func TestParseWebPage(t *testing.T) {
+	rules := &model.WebPageRules{
+		ItemSelector:    "li.post",
+		TitleSelector:   "h2",
+		DateSelector:    "time",
+		SummarySelector: "p.excerpt",
+	}
+
+	feed, err := Parse("https://example.org/blog", strings.NewReader(page), rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "Example Blog" {
+		t.Errorf(`Incorrect feed title, got: %q`, feed.Title)
+	}
+
+	if feed.SiteURL != "https://example.org/blog" || feed.FeedURL != "https://example.org/blog" {
+		t.Errorf(`Incorrect feed URLs, got: %q and %q`, feed.SiteURL, feed.FeedURL)
+	}
+
+	if len(feed.Entries) != 3 {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+
+	first := feed.Entries[0]
+	if first.Title != "First post" {
+		t.Errorf(`Incorrect entry title, got: %q`, first.Title)
+	}
+
+	if first.URL != "https://example.org/posts/first" {
+		t.Errorf(`Incorrect entry URL, got: %q`, first.URL)
+	}
+
+	if first.Content != "Hello <b>World</b>" {
+		t.Errorf(`Incorrect entry content, got: %q`, first.Content)
+	}
+
+	expectedDate := time.Date(2020, time.April, 1, 10, 0, 0, 0, time.UTC)
+	if !first.Date.Equal(expectedDate) {
+		t.Errorf(`Incorrect entry date, got: %v`, first.Date)
+	}
+
+	second := feed.Entries[1]
+	if second.URL != "https://example.org/posts/second" {
+		t.Errorf(`Incorrect entry URL, got: %q`, second.URL)
+	}
+
+	if second.Date.Day() != 2 {
+		t.Errorf(`Incorrect entry date, got: %v`, second.Date)
+	}
+
+	third := feed.Entries[2]
+	if third.URL != "https://example.org/blog" {
+		t.Errorf(`Entries without link should use the page URL, got: %q`, third.URL)
+	}
+
+	if third.Hash == "" || third.Hash == first.Hash {
+		t.Errorf(`Incorrect entry hash, got: %q`, third.Hash)
+	}
+}
+
+func TestParseWebPageWithLinkSelector(t *testing.T) {
+	rules := &model.WebPageRules{
+		ItemSelector: "li.post h2 a",
+		LinkSelector: "a",
+	}
+
+	feed, err := Parse("https://example.org/blog", strings.NewReader(page), rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+
+	if feed.Entries[1].Title != "Second post" {
+		t.Errorf(`The link text should be used as title, got: %q`, feed.Entries[1].Title)
+	}
+
+	if feed.Entries[0].URL != "https://example.org/posts/first" {
+		t.Errorf(`Incorrect entry URL, got: %q`, feed.Entries[0].URL)
+	}
+}
+
+func TestParseWebPageWithInvalidRules(t *testing.T) {
+	if _, err := Parse("https://example.org/", strings.NewReader(page), &model.WebPageRules{TitleSelector: "h2"}); err == nil {
+		t.Error(`Rules without item selector should be rejected`)
+	}
+
+	if _, err := Parse("https://example.org/", strings.NewReader(page), nil); err == nil {
+		t.Error(`Missing rules should be rejected`)
+	}
+}
+
+func TestParseWebPageWithoutMatches(t *testing.T) {
+	rules := &model.WebPageRules{ItemSelector: "article", TitleSelector: "h1"}
+
+	feed, err := Parse("https://example.org/", strings.NewReader(page), rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feed.Entries) != 0 {
+		t.Errorf(`No entries should be returned, got: %d`, len(feed.Entries))
+	}
+}
+
+func TestParseWebPageIgnoresUnsafeLinks(t *testing.T) {
+	data := `<ul>
+		<li><a href="javascript:alert(document.cookie)">Script</a></li>
+		<li><a href="data:text/html;base64,PHNjcmlwdD4=">Data</a></li>
+		<li><a href="/safe">Safe</a></li>
+	</ul>`
+	rules := &model.WebPageRules{ItemSelector: "li", TitleSelector: "a"}
+
+	feed, err := Parse("https://example.org/", strings.NewReader(data), rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feed.Entries) != 3 {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+
+	for _, entry := range feed.Entries[:2] {
+		if entry.URL != "https://example.org/" {
+			t.Errorf(`Unsafe links should be replaced by the page URL, got: %q`, entry.URL)
+		}
+	}
+
+	if feed.Entries[2].URL != "https://example.org/safe" {
+		t.Errorf(`Incorrect entry URL, got: %q`, feed.Entries[2].URL)
+	}
+}
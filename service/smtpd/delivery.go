@@ -0,0 +1,161 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package smtpd // import "miniflux.app/service/smtpd"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"miniflux.app/config"
+	"miniflux.app/crypto"
+	"miniflux.app/integration"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/newsletter"
+	"miniflux.app/reader/processor"
+)
+
+// accept returns true if the recipient is the newsletter address of a user.
+func (s *Server) accept(recipient string) bool {
+	if !strings.HasSuffix(strings.ToLower(recipient), "@"+strings.ToLower(config.Opts.MailDomain())) {
+		return false
+	}
+
+	token, _, err := model.ParseNewsletterAddress(recipient)
+	if err != nil {
+		return false
+	}
+
+	user, err := s.store.UserByNewsletterToken(token)
+	if err != nil {
+		logger.Error("[SMTP] %v", err)
+	}
+
+	return user != nil
+}
+
+// deliver creates an entry from the message in the feed of the sender or in the feed given by the address.
+func (s *Server) deliver(recipient string, data []byte) error {
+	token, feedID, err := model.ParseNewsletterAddress(recipient)
+	if err != nil {
+		return permanentError{err}
+	}
+
+	user, err := s.store.UserByNewsletterToken(token)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return permanentError{fmt.Errorf("unknown recipient %q", recipient)}
+	}
+
+	message, err := newsletter.Parse(bytes.NewReader(data))
+	if err != nil {
+		return permanentError{err}
+	}
+
+	var feed *model.Feed
+	if feedID > 0 {
+		feed, err = s.store.FeedByID(user.ID, feedID)
+		if err != nil {
+			return err
+		}
+
+		if feed == nil || !feed.IsNewsletter() {
+			return permanentError{fmt.Errorf("the feed #%d doesn't receive newsletters", feedID)}
+		}
+	} else {
+		feed, err = s.senderFeed(user.ID, message)
+		if err != nil {
+			return err
+		}
+	}
+
+	images := make(map[*newsletter.Image]string)
+	entry := message.Entry(func(image *newsletter.Image) string {
+		images[image] = crypto.GenerateRandomStringHex(20)
+		return config.Opts.BaseURL() + "/newsletter/image/" + images[image]
+	})
+
+	feed.Entries = model.Entries{entry}
+	processor.ProcessFeedEntries(s.store, feed)
+
+	entriesToSend, err := s.store.UpdateEntries(user.ID, feed.ID, feed.Entries, false)
+	if err != nil {
+		return err
+	}
+
+	if len(entriesToSend) > 0 {
+		settings, err := s.store.Integration(user.ID)
+		if err != nil {
+			logger.Error("[SMTP] %v", err)
+		} else {
+			integration.SendEntries(entriesToSend, settings)
+		}
+	}
+
+	// The entry ID is only defined when the entry has been created, a message delivered twice keeps its images.
+	if entry.ID == 0 {
+		return nil
+	}
+
+	for image, token := range images {
+		err := s.store.CreateNewsletterImage(&model.NewsletterImage{
+			UserID:   user.ID,
+			EntryID:  entry.ID,
+			Token:    token,
+			MimeType: image.MimeType,
+			Content:  image.Data,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	logger.Debug("[SMTP] Message %q delivered to feed #%d of user #%d", message.MessageID, feed.ID, user.ID)
+	return nil
+}
+
+// senderFeed returns the virtual feed of the sender, the feed is created for the first message.
+func (s *Server) senderFeed(userID int64, message *newsletter.Message) (*model.Feed, error) {
+	feed, err := s.store.NewsletterFeed(userID, message.From.Address)
+	if err != nil || feed != nil {
+		return feed, err
+	}
+
+	category, err := s.store.FirstCategory(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if category == nil {
+		return nil, errors.New("no category found")
+	}
+
+	feed = &model.Feed{
+		UserID:     userID,
+		Category:   category,
+		FeedURL:    "mailto:" + message.From.Address,
+		SiteURL:    "mailto:" + message.From.Address,
+		Title:      message.From.Name,
+		SourceType: model.FeedSourceTypeNewsletter,
+		SourceSettings: model.SourceSettings{
+			Newsletter: &model.NewsletterSettings{Sender: message.From.Address},
+		},
+	}
+
+	if feed.Title == "" {
+		feed.Title = message.From.Address
+	}
+
+	if err := s.store.CreateFeed(feed); err != nil {
+		return nil, err
+	}
+
+	return feed, nil
+}
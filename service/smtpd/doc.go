@@ -0,0 +1,12 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package smtpd implements the mail service receiving newsletters over SMTP or LMTP.
+
+The service only accepts messages for the local newsletter addresses, it never relays messages.
+
+*/
+package smtpd // import "miniflux.app/service/smtpd"
@@ -0,0 +1,303 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package smtpd // import "miniflux.app/service/smtpd"
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/logger"
+)
+
+const (
+	commandTimeout = 5 * time.Minute
+	maxLineLength  = 1024
+	maxRecipients  = 100
+)
+
+// permanentError is returned when a message must not be delivered again.
+type permanentError struct {
+	error
+}
+
+type acceptFunc func(recipient string) bool
+type deliverFunc func(recipient string, data []byte) error
+
+type session struct {
+	conn       net.Conn
+	reader     *bufio.Reader
+	writer     *bufio.Writer
+	lmtp       bool
+	domain     string
+	maxSize    int64
+	accept     acceptFunc
+	deliver    deliverFunc
+	greeted    bool
+	hasSender  bool
+	recipients []string
+}
+
+func newSession(conn net.Conn, accept acceptFunc, deliver deliverFunc) *session {
+	return &session{
+		conn:    conn,
+		reader:  bufio.NewReaderSize(conn, maxLineLength),
+		writer:  bufio.NewWriter(conn),
+		lmtp:    config.Opts.MailProtocol() == "lmtp",
+		domain:  config.Opts.MailDomain(),
+		maxSize: config.Opts.MailMaxMessageSize(),
+		accept:  accept,
+		deliver: deliver,
+	}
+}
+
+func (s *session) serve() {
+	defer s.conn.Close()
+
+	s.reply(220, s.domain+" Miniflux ready")
+	for {
+		s.conn.SetDeadline(time.Now().Add(commandTimeout))
+
+		line, err := s.reader.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			s.reply(500, "Line too long")
+			return
+		}
+
+		if err != nil {
+			return
+		}
+
+		verb, argument := parseCommand(string(line))
+		if !s.handle(verb, argument) {
+			return
+		}
+	}
+}
+
+// handle executes a command and returns false when the connection must be closed.
+func (s *session) handle(verb, argument string) bool {
+	switch verb {
+	case "HELO", "EHLO", "LHLO":
+		if s.lmtp != (verb == "LHLO") {
+			s.reply(500, "Unsupported greeting, use "+s.greeting())
+			return true
+		}
+
+		s.greeted = true
+		s.reset()
+		if verb == "HELO" {
+			s.reply(250, s.domain)
+		} else {
+			s.reply(250, s.domain, "PIPELINING", "8BITMIME", fmt.Sprintf("SIZE %d", s.maxSize))
+		}
+	case "MAIL":
+		s.handleMail(argument)
+	case "RCPT":
+		s.handleRecipient(argument)
+	case "DATA":
+		return s.handleData()
+	case "RSET":
+		s.reset()
+		s.reply(250, "OK")
+	case "NOOP":
+		s.reply(250, "OK")
+	case "VRFY":
+		s.reply(252, "Cannot verify user")
+	case "QUIT":
+		s.reply(221, "Bye")
+		return false
+	default:
+		s.reply(502, "Command not implemented")
+	}
+
+	return true
+}
+
+func (s *session) handleMail(argument string) {
+	if !s.greeted {
+		s.reply(503, "Send "+s.greeting()+" first")
+		return
+	}
+
+	_, parameters, ok := parsePath(argument, "FROM:")
+	if !ok {
+		s.reply(501, "Syntax: MAIL FROM:<address>")
+		return
+	}
+
+	for _, parameter := range parameters {
+		if strings.HasPrefix(strings.ToUpper(parameter), "SIZE=") {
+			size, err := strconv.ParseInt(parameter[5:], 10, 64)
+			if err == nil && size > s.maxSize {
+				s.reply(552, "Message size exceeds fixed maximum message size")
+				return
+			}
+		}
+	}
+
+	s.reset()
+	s.hasSender = true
+	s.reply(250, "OK")
+}
+
+func (s *session) handleRecipient(argument string) {
+	if !s.hasSender {
+		s.reply(503, "Need MAIL command first")
+		return
+	}
+
+	address, _, ok := parsePath(argument, "TO:")
+	if !ok || address == "" {
+		s.reply(501, "Syntax: RCPT TO:<address>")
+		return
+	}
+
+	if len(s.recipients) >= maxRecipients {
+		s.reply(452, "Too many recipients")
+		return
+	}
+
+	if !s.accept(address) {
+		s.reply(550, "No such user here")
+		return
+	}
+
+	s.recipients = append(s.recipients, address)
+	s.reply(250, "OK")
+}
+
+func (s *session) handleData() bool {
+	if len(s.recipients) == 0 {
+		s.reply(503, "Need RCPT command first")
+		return true
+	}
+
+	s.reply(354, "End data with <CR><LF>.<CR><LF>")
+	s.conn.SetDeadline(time.Now().Add(commandTimeout))
+
+	reader := textproto.NewReader(s.reader).DotReader()
+	data, err := ioutil.ReadAll(io.LimitReader(reader, s.maxSize+1))
+	if err != nil {
+		return false
+	}
+
+	if int64(len(data)) > s.maxSize {
+		if _, err := io.Copy(ioutil.Discard, reader); err != nil {
+			return false
+		}
+
+		s.replyForEachRecipient(552, "Message size exceeds fixed maximum message size")
+		s.reset()
+		return true
+	}
+
+	if s.lmtp {
+		for _, recipient := range s.recipients {
+			code, message := s.deliverTo(recipient, data)
+			s.reply(code, message)
+		}
+	} else {
+		// SMTP has a single reply, the message is accepted if at least one recipient received it.
+		code, message := 0, ""
+		for _, recipient := range s.recipients {
+			recipientCode, recipientMessage := s.deliverTo(recipient, data)
+			if code == 0 || recipientCode < code {
+				code, message = recipientCode, recipientMessage
+			}
+		}
+		s.reply(code, message)
+	}
+
+	s.reset()
+	return true
+}
+
+func (s *session) deliverTo(recipient string, data []byte) (int, string) {
+	err := s.deliver(recipient, data)
+	switch err.(type) {
+	case nil:
+		return 250, "OK"
+	case permanentError:
+		logger.Error("[SMTP] Message for %q rejected: %v", recipient, err)
+		return 554, "Message rejected"
+	default:
+		logger.Error("[SMTP] Unable to deliver message for %q: %v", recipient, err)
+		return 451, "Unable to deliver message, try again later"
+	}
+}
+
+func (s *session) replyForEachRecipient(code int, message string) {
+	if !s.lmtp {
+		s.reply(code, message)
+		return
+	}
+
+	for range s.recipients {
+		s.reply(code, message)
+	}
+}
+
+func (s *session) reset() {
+	s.hasSender = false
+	s.recipients = nil
+}
+
+func (s *session) greeting() string {
+	if s.lmtp {
+		return "LHLO"
+	}
+
+	return "EHLO"
+}
+
+// reply sends a single or a multi-line reply.
+func (s *session) reply(code int, lines ...string) {
+	for i, line := range lines {
+		separator := " "
+		if i < len(lines)-1 {
+			separator = "-"
+		}
+
+		fmt.Fprintf(s.writer, "%d%s%s\r\n", code, separator, line)
+	}
+
+	s.writer.Flush()
+}
+
+func parseCommand(line string) (string, string) {
+	line = strings.TrimRight(line, "\r\n")
+	if index := strings.Index(line, " "); index >= 0 {
+		return strings.ToUpper(line[:index]), strings.TrimSpace(line[index+1:])
+	}
+
+	return strings.ToUpper(line), ""
+}
+
+// parsePath returns the address and the parameters of the arguments of the MAIL and RCPT commands.
+func parsePath(argument, prefix string) (string, []string, bool) {
+	if len(argument) < len(prefix) || !strings.EqualFold(argument[:len(prefix)], prefix) {
+		return "", nil, false
+	}
+
+	argument = strings.TrimSpace(argument[len(prefix):])
+	if !strings.HasPrefix(argument, "<") {
+		return "", nil, false
+	}
+
+	end := strings.Index(argument, ">")
+	if end < 0 {
+		return "", nil, false
+	}
+
+	return argument[1:end], strings.Fields(argument[end+1:]), true
+}
@@ -0,0 +1,173 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package smtpd // import "miniflux.app/service/smtpd"
+
+import (
+	"errors"
+	"net"
+	"net/textproto"
+	"os"
+	"strings"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+type testMailbox struct {
+	messages map[string]string
+}
+
+func (m *testMailbox) accept(recipient string) bool {
+	return strings.HasSuffix(recipient, "@example.org")
+}
+
+func (m *testMailbox) deliver(recipient string, data []byte) error {
+	if strings.HasPrefix(recipient, "invalid") {
+		return permanentError{errors.New("invalid message")}
+	}
+
+	m.messages[recipient] = string(data)
+	return nil
+}
+
+func startSession(t *testing.T, protocol string) (*textproto.Conn, *testMailbox) {
+	os.Clearenv()
+	os.Setenv("MAIL_LISTEN_ADDR", "127.0.0.1:2525")
+	os.Setenv("MAIL_PROTOCOL", protocol)
+	os.Setenv("MAIL_DOMAIN", "example.org")
+	os.Setenv("MAIL_MAX_MESSAGE_SIZE", "1")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	server, client := net.Pipe()
+	mailbox := &testMailbox{messages: make(map[string]string)}
+	go newSession(server, mailbox.accept, mailbox.deliver).serve()
+
+	conn := textproto.NewConn(client)
+	expectResponse(t, conn, 220)
+	return conn, mailbox
+}
+
+func expectResponse(t *testing.T, conn *textproto.Conn, code int) string {
+	t.Helper()
+
+	_, message, err := conn.ReadResponse(code)
+	if err != nil {
+		t.Fatalf(`Unexpected response: %v`, err)
+	}
+
+	return message
+}
+
+func sendCommand(t *testing.T, conn *textproto.Conn, code int, format string, args ...interface{}) string {
+	t.Helper()
+
+	if err := conn.PrintfLine(format, args...); err != nil {
+		t.Fatal(err)
+	}
+
+	return expectResponse(t, conn, code)
+}
+
+func sendData(t *testing.T, conn *textproto.Conn, data string) {
+	t.Helper()
+
+	writer := conn.DotWriter()
+	writer.Write([]byte(data))
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSMTPSession(t *testing.T) {
+	conn, mailbox := startSession(t, "smtp")
+	defer conn.Close()
+
+	sendCommand(t, conn, 503, "MAIL FROM:<news@example.com>")
+	sendCommand(t, conn, 500, "LHLO client.example.com")
+
+	extensions := sendCommand(t, conn, 250, "EHLO client.example.com")
+	if !strings.Contains(extensions, "PIPELINING") || !strings.Contains(extensions, "SIZE 1048576") {
+		t.Errorf(`Unexpected extensions: %q`, extensions)
+	}
+
+	sendCommand(t, conn, 503, "RCPT TO:<abc@example.org>")
+	sendCommand(t, conn, 552, "MAIL FROM:<news@example.com> SIZE=2000000")
+	sendCommand(t, conn, 250, "MAIL FROM:<news@example.com> SIZE=2000 BODY=8BITMIME")
+	sendCommand(t, conn, 550, "RCPT TO:<someone@example.com>")
+	sendCommand(t, conn, 501, "RCPT TO:abc@example.org")
+	sendCommand(t, conn, 250, "RCPT TO:<abc@example.org>")
+	sendCommand(t, conn, 250, "RCPT TO:<invalid@example.org>")
+	sendCommand(t, conn, 354, "DATA")
+	sendData(t, conn, "Subject: Hello\r\n\r\n.Dotted line\r\n")
+	expectResponse(t, conn, 250)
+
+	if message := mailbox.messages["abc@example.org"]; message != "Subject: Hello\n\n.Dotted line\n" {
+		t.Errorf(`Unexpected message: %q`, message)
+	}
+
+	sendCommand(t, conn, 503, "DATA")
+	sendCommand(t, conn, 502, "STARTTLS")
+	sendCommand(t, conn, 221, "QUIT")
+}
+
+func TestLMTPSession(t *testing.T) {
+	conn, mailbox := startSession(t, "lmtp")
+	defer conn.Close()
+
+	sendCommand(t, conn, 500, "EHLO client.example.com")
+	sendCommand(t, conn, 250, "LHLO client.example.com")
+	sendCommand(t, conn, 250, "MAIL FROM:<>")
+	sendCommand(t, conn, 250, "RCPT TO:<abc@example.org>")
+	sendCommand(t, conn, 250, "RCPT TO:<invalid@example.org>")
+	sendCommand(t, conn, 354, "DATA")
+	sendData(t, conn, "Subject: Hello\r\n\r\nText\r\n")
+
+	// LMTP returns a reply for each recipient.
+	expectResponse(t, conn, 250)
+	expectResponse(t, conn, 554)
+
+	if len(mailbox.messages) != 1 {
+		t.Errorf(`Unexpected messages: %v`, mailbox.messages)
+	}
+
+	sendCommand(t, conn, 221, "QUIT")
+}
+
+func TestMessageTooLarge(t *testing.T) {
+	conn, mailbox := startSession(t, "smtp")
+	defer conn.Close()
+
+	sendCommand(t, conn, 250, "HELO client.example.com")
+	sendCommand(t, conn, 250, "MAIL FROM:<news@example.com>")
+	sendCommand(t, conn, 250, "RCPT TO:<abc@example.org>")
+	sendCommand(t, conn, 354, "DATA")
+	sendData(t, conn, "Subject: Hello\r\n\r\n"+strings.Repeat("0123456789\r\n", 100000))
+	expectResponse(t, conn, 552)
+
+	if len(mailbox.messages) != 0 {
+		t.Error(`Large messages should be rejected`)
+	}
+
+	sendCommand(t, conn, 250, "NOOP")
+	sendCommand(t, conn, 221, "QUIT")
+}
+
+func TestParsePath(t *testing.T) {
+	address, parameters, ok := parsePath("from: <news@example.com> SIZE=42", "FROM:")
+	if !ok || address != "news@example.com" || len(parameters) != 1 || parameters[0] != "SIZE=42" {
+		t.Errorf(`Unexpected result: %q, %v, %v`, address, parameters, ok)
+	}
+
+	for _, argument := range []string{"", "TO:<a@b>", "FROM:a@b", "FROM:<a@b"} {
+		if _, _, ok := parsePath(argument, "FROM:"); ok {
+			t.Errorf(`The argument %q should be rejected`, argument)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package smtpd // import "miniflux.app/service/smtpd"
+
+import (
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"miniflux.app/config"
+	"miniflux.app/logger"
+	"miniflux.app/storage"
+)
+
+// Server receives newsletters and delivers them to the feeds of the recipients.
+type Server struct {
+	store    *storage.Storage
+	listener net.Listener
+	wg       sync.WaitGroup
+}
+
+// Serve starts a new SMTP or LMTP server.
+func Serve(store *storage.Storage) *Server {
+	listenAddr := config.Opts.MailListenAddr()
+	network := "tcp"
+	if strings.HasPrefix(listenAddr, "/") {
+		network = "unix"
+		os.Remove(listenAddr)
+	}
+
+	listener, err := net.Listen(network, listenAddr)
+	if err != nil {
+		logger.Fatal(`Mail server failed to start: %v`, err)
+	}
+
+	if network == "unix" {
+		if err := os.Chmod(listenAddr, 0666); err != nil {
+			logger.Fatal(`Unable to change socket permission: %v`, err)
+		}
+	}
+
+	server := &Server{store: store, listener: listener}
+	logger.Info(`Receiving newsletters over %s on %q for the domain %q`, strings.ToUpper(config.Opts.MailProtocol()), listenAddr, config.Opts.MailDomain())
+
+	go server.serve()
+	return server
+}
+
+// Shutdown stops accepting connections and waits for the current sessions.
+func (s *Server) Shutdown() {
+	s.listener.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			return
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			newSession(conn, s.accept, s.deliver).serve()
+		}()
+	}
+}
@@ -32,8 +32,24 @@ func (s *Storage) CategoryExists(userID, categoryID int64) bool {
 func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 AND id=$2`
-	err := s.db.QueryRow(query, userID, categoryID).Scan(&category.ID, &category.UserID, &category.Title)
+	query := `
+		SELECT
+			id, user_id, title, content_extractors, filter_mode, crawler, user_agent, scraper_rules, rewrite_rules
+		FROM categories
+		WHERE
+			user_id=$1 AND id=$2
+	`
+	err := s.db.QueryRow(query, userID, categoryID).Scan(
+		&category.ID,
+		&category.UserID,
+		&category.Title,
+		&category.ContentExtractors,
+		&category.FilterMode,
+		&category.Crawler,
+		&category.UserAgent,
+		&category.ScraperRules,
+		&category.RewriteRules,
+	)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -81,7 +97,14 @@ func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category,
 
 // Categories returns all categories that belongs to the given user.
 func (s *Storage) Categories(userID int64) (model.Categories, error) {
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 ORDER BY title ASC`
+	query := `
+		SELECT
+			id, user_id, title, content_extractors, filter_mode, crawler, user_agent, scraper_rules, rewrite_rules
+		FROM categories
+		WHERE
+			user_id=$1
+		ORDER BY title ASC
+	`
 	rows, err := s.db.Query(query, userID)
 	if err != nil {
 		return nil, fmt.Errorf(`store: unable to fetch categories: %v`, err)
@@ -91,7 +114,17 @@ func (s *Storage) Categories(userID int64) (model.Categories, error) {
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title); err != nil {
+		if err := rows.Scan(
+			&category.ID,
+			&category.UserID,
+			&category.Title,
+			&category.ContentExtractors,
+			&category.FilterMode,
+			&category.Crawler,
+			&category.UserAgent,
+			&category.ScraperRules,
+			&category.RewriteRules,
+		); err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch category row: %v`, err)
 		}
 
@@ -108,6 +141,12 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 			c.id,
 			c.user_id,
 			c.title,
+			c.content_extractors,
+			c.filter_mode,
+			c.crawler,
+			c.user_agent,
+			c.scraper_rules,
+			c.rewrite_rules,
 			(SELECT count(*) FROM feeds WHERE feeds.category_id=c.id) AS count
 		FROM categories c
 		WHERE
@@ -124,7 +163,14 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.FeedCount); err != nil {
+		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.ContentExtractors,
+			&category.FilterMode,
+			&category.Crawler,
+			&category.UserAgent,
+			&category.ScraperRules,
+			&category.RewriteRules,
+			&category.FeedCount,
+		); err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch category row: %v`, err)
 		}
 
@@ -138,9 +184,9 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 func (s *Storage) CreateCategory(category *model.Category) error {
 	query := `
 		INSERT INTO categories
-			(user_id, title)
+			(user_id, title, content_extractors, filter_mode, crawler, user_agent, scraper_rules, rewrite_rules)
 		VALUES
-			($1, $2)
+			($1, $2, $3, $4, $5, $6, $7, $8)
 		RETURNING
 			id
 	`
@@ -148,6 +194,12 @@ func (s *Storage) CreateCategory(category *model.Category) error {
 		query,
 		category.UserID,
 		category.Title,
+		category.ContentExtractors,
+		category.FilterMode,
+		category.Crawler,
+		category.UserAgent,
+		category.ScraperRules,
+		category.RewriteRules,
 	).Scan(&category.ID)
 
 	if err != nil {
@@ -159,10 +211,29 @@ func (s *Storage) CreateCategory(category *model.Category) error {
 
 // UpdateCategory updates an existing category.
 func (s *Storage) UpdateCategory(category *model.Category) error {
-	query := `UPDATE categories SET title=$1 WHERE id=$2 AND user_id=$3`
+	query := `
+		UPDATE
+			categories
+		SET
+			title=$1,
+			content_extractors=$2,
+			filter_mode=$3,
+			crawler=$4,
+			user_agent=$5,
+			scraper_rules=$6,
+			rewrite_rules=$7
+		WHERE
+			id=$8 AND user_id=$9
+	`
 	_, err := s.db.Exec(
 		query,
 		category.Title,
+		category.ContentExtractors,
+		category.FilterMode,
+		category.Crawler,
+		category.UserAgent,
+		category.ScraperRules,
+		category.RewriteRules,
 		category.ID,
 		category.UserID,
 	)
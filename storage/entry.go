@@ -12,14 +12,17 @@ import (
 	"miniflux.app/crypto"
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/reader/filter"
+	"miniflux.app/reader/simhash"
 
 	"github.com/lib/pq"
 )
 
-// CountUnreadEntries returns the number of unread entries.
+// CountUnreadEntries returns the number of unread entries, the near-duplicate entries are counted once like in the unread list.
 func (s *Storage) CountUnreadEntries(userID int64) int {
 	builder := s.NewEntryQueryBuilder(userID)
 	builder.WithStatus(model.EntryStatusUnread)
+	builder.WithCollapsedClusters()
 
 	n, err := builder.CountEntries()
 	if err != nil {
@@ -35,7 +38,7 @@ func (s *Storage) NewEntryQueryBuilder(userID int64) *EntryQueryBuilder {
 	return NewEntryQueryBuilder(s, userID)
 }
 
-// UpdateEntryContent updates entry content.
+// UpdateEntryContent updates entry content, title and author.
 func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
 	tx, err := s.db.Begin()
 	if err != nil {
@@ -46,11 +49,11 @@ func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
 		UPDATE
 			entries
 		SET
-			content=$1
+			content=$1, title=$2, author=$3
 		WHERE
-			id=$2 AND user_id=$3
+			id=$4 AND user_id=$5
 	`
-	_, err = tx.Exec(query, entry.Content, entry.ID, entry.UserID)
+	_, err = tx.Exec(query, entry.Content, entry.Title, entry.Author, entry.ID, entry.UserID)
 	if err != nil {
 		tx.Rollback()
 		return fmt.Errorf(`store: unable to update content of entry #%d: %v`, entry.ID, err)
@@ -74,14 +77,18 @@ func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
 }
 
 // createEntry add a new entry.
-func (s *Storage) createEntry(entry *model.Entry) error {
+func (s *Storage) createEntry(entry *model.Entry, fingerprint uint64) error {
+	if entry.Status == "" {
+		entry.Status = model.EntryStatusUnread
+	}
+
 	query := `
 		INSERT INTO entries
-			(title, hash, url, comments_url, published_at, content, author, user_id, feed_id, changed_at, document_vectors)
+			(title, hash, url, comments_url, published_at, content, author, user_id, feed_id, status, starred, tags, fingerprint, cluster_id, guid_hash, identity_hashes, changed_at, document_vectors)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, now(), setweight(to_tsvector('chinese', substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector('chinese', substring(coalesce($6, '') for 1000000)), 'B'))
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, coalesce($12::text[], '{}'), $13, nullif($14, 0), coalesce(nullif($15, ''), $2), $16, now(), setweight(to_tsvector('chinese', substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector('chinese', substring(coalesce($6, '') for 1000000)), 'B'))
 		RETURNING
-			id, status
+			id
 	`
 	err := s.db.QueryRow(
 		query,
@@ -94,12 +101,25 @@ func (s *Storage) createEntry(entry *model.Entry) error {
 		entry.Author,
 		entry.UserID,
 		entry.FeedID,
-	).Scan(&entry.ID, &entry.Status)
+		entry.Status,
+		entry.Starred,
+		pq.Array(entry.Tags),
+		int64(fingerprint),
+		entry.ClusterID,
+		entry.GUIDHash,
+		entry.IdentityHashes,
+	).Scan(&entry.ID)
 
 	if err != nil {
 		return fmt.Errorf(`store: unable to create entry %q (feed #%d): %v`, entry.URL, entry.FeedID, err)
 	}
 
+	if entry.ClusterID != 0 {
+		if err := s.joinCluster(entry.ClusterID); err != nil {
+			return err
+		}
+	}
+
 	for i := 0; i < len(entry.Enclosures); i++ {
 		entry.Enclosures[i].EntryID = entry.ID
 		entry.Enclosures[i].UserID = entry.UserID
@@ -115,22 +135,35 @@ func (s *Storage) createEntry(entry *model.Entry) error {
 // updateEntry updates an entry when a feed is refreshed.
 // Note: we do not update the published date because some feeds do not contains any date,
 // it default to time.Now() which could change the order of items on the history page.
-func (s *Storage) updateEntry(entry *model.Entry) error {
+func (s *Storage) updateEntry(entry *model.Entry, revisionSettings *entryRevisionSettings) error {
 	query := `
+		WITH previous AS (
+			SELECT
+				id, title, content
+			FROM
+				entries
+			WHERE
+				user_id=$6 AND feed_id=$7 AND hash=$8
+			FOR UPDATE
+		)
 		UPDATE
-			entries
+			entries e
 		SET
 			title=$1,
 			url=$2,
 			comments_url=$3,
 			content=$4,
 			author=$5,
+			identity_hashes=$9,
 			document_vectors = setweight(to_tsvector('chinese',substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector('chinese', substring(coalesce($4, '') for 1000000)), 'B')
+		FROM
+			previous
 		WHERE
-			user_id=$6 AND feed_id=$7 AND hash=$8
+			e.id=previous.id
 		RETURNING
-			id
+			e.id, previous.title, coalesce(previous.content, '')
 	`
+	var previousTitle, previousContent string
 	err := s.db.QueryRow(
 		query,
 		entry.Title,
@@ -141,12 +174,17 @@ func (s *Storage) updateEntry(entry *model.Entry) error {
 		entry.UserID,
 		entry.FeedID,
 		entry.Hash,
-	).Scan(&entry.ID)
+		entry.IdentityHashes,
+	).Scan(&entry.ID, &previousTitle, &previousContent)
 
 	if err != nil {
 		return fmt.Errorf(`store: unable to update entry %q: %v`, entry.URL, err)
 	}
 
+	if err := s.recordEntryRevision(revisionSettings, entry, previousTitle, previousContent); err != nil {
+		return err
+	}
+
 	for _, enclosure := range entry.Enclosures {
 		enclosure.UserID = entry.UserID
 		enclosure.EntryID = entry.ID
@@ -155,6 +193,14 @@ func (s *Storage) updateEntry(entry *model.Entry) error {
 	return s.UpdateEnclosures(entry.Enclosures)
 }
 
+// EntryExists checks if the given entry belongs to the user.
+func (s *Storage) EntryExists(userID, entryID int64) bool {
+	var result bool
+	query := `SELECT true FROM entries WHERE user_id=$1 AND id=$2`
+	s.db.QueryRow(query, userID, entryID).Scan(&result)
+	return result
+}
+
 // entryExists checks if an entry already exists based on its hash when refreshing a feed.
 func (s *Storage) entryExists(entry *model.Entry) bool {
 	var result int
@@ -180,28 +226,35 @@ func (s *Storage) cleanupEntries(feedID int64, entryHashes []string) error {
 	return nil
 }
 
-// FilterByTitle remove Entries match by title filter
-func (s *Storage) FilterByTitle(userID, feedID int64, regex string) error {
-	_, err := s.db.Exec(`UPDATE entries SET status=$1  WHERE feed_id=$2 AND user_id=$3 AND title ~* $4;`,
-		model.EntryStatusRemoved, feedID, userID, regex)
+// UpdateEntries updates a list of entries while refreshing a feed.
+//
+// The filter rules of the feed are applied to the new entries before their creation.
+// New entries similar to a recent entry of another feed join its cluster.
+// It returns the new entries that the filter rules send to the integrations.
+func (s *Storage) UpdateEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool) (entriesToSend model.Entries, err error) {
+	rules, err := s.FeedFilterRules(userID, feedID)
 	if err != nil {
-		return fmt.Errorf("unable to remove entries #%d filter: %s: %v", feedID, regex, err)
+		return nil, err
 	}
-	return nil
-}
 
-// FilterByContent remove Entries match by content filter
-func (s *Storage) FilterByContent(userID, feedID int64, regex string) error {
-	_, err := s.db.Exec(`UPDATE entries SET status=$1  WHERE feed_id=$2 AND user_id=$3 AND content ~* $4;`,
-		model.EntryStatusRemoved, feedID, userID, regex)
+	filterMode, err := s.feedFilterMode(userID, feedID)
 	if err != nil {
-		return fmt.Errorf("unable to remove entries #%d filter: %s: %v", feedID, regex, err)
+		return nil, err
+	}
+
+	entryFilter := filter.New(rules, filterMode)
+	hits := make(map[int64]int64)
+	var suppressedCount int64
+	clusters := &entryClusters{store: s, userID: userID, feedID: feedID}
+
+	var revisionSettings *entryRevisionSettings
+	if updateExistingEntries {
+		revisionSettings, err = s.entryRevisionSettings(userID)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return nil
-}
 
-// UpdateEntries updates a list of entries while refreshing a feed.
-func (s *Storage) UpdateEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool, titleFilter, contentFilter string) (err error) {
 	var entryHashes []string
 	for _, entry := range entries {
 		entry.UserID = userID
@@ -209,14 +262,35 @@ func (s *Storage) UpdateEntries(userID, feedID int64, entries model.Entries, upd
 
 		if s.entryExists(entry) {
 			if updateExistingEntries {
-				err = s.updateEntry(entry)
+				err = s.updateEntry(entry, revisionSettings)
 			}
 		} else {
-			err = s.createEntry(entry)
+			result := entryFilter.Apply(entry)
+			for _, ruleID := range result.MatchedRules {
+				hits[ruleID]++
+			}
+
+			if result.Suppressed {
+				suppressedCount++
+			}
+
+			var fingerprint uint64
+			if entry.Status != model.EntryStatusRemoved {
+				fingerprint = simhash.Fingerprint(entry.Title, entry.Content)
+				entry.ClusterID, err = clusters.find(fingerprint)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			err = s.createEntry(entry, fingerprint)
+			if err == nil && result.SendToIntegrations {
+				entriesToSend = append(entriesToSend, entry)
+			}
 		}
 
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		entryHashes = append(entryHashes, entry.Hash)
@@ -226,22 +300,18 @@ func (s *Storage) UpdateEntries(userID, feedID int64, entries model.Entries, upd
 		logger.Error(`store: feed #%d: %v`, feedID, err)
 	}
 
-	if titleFilter != "" {
-		if err := s.FilterByTitle(userID, feedID, titleFilter); err != nil {
-			logger.Error("[Storage:FilterByTitle]  feed #%d: %v", feedID, err)
-		}
+	if err := s.incrementFilterRuleHits(hits); err != nil {
+		logger.Error(`store: feed #%d: %v`, feedID, err)
 	}
 
-	if contentFilter != "" {
-		if err := s.FilterByContent(userID, feedID, contentFilter); err != nil {
-			logger.Error("[Storage:FilterByContent]  feed #%d: %v", feedID, err)
-		}
+	if err := s.incrementSuppressedCount(feedID, suppressedCount); err != nil {
+		logger.Error(`store: feed #%d: %v`, feedID, err)
 	}
 
-	return nil
+	return entriesToSend, nil
 }
 
-// ArchiveEntries changes the status of read items to "removed" after specified days.
+// ArchiveEntries changes the status of read items to "removed" after specified days, except the starred and highlighted ones.
 func (s *Storage) ArchiveEntries(days int) error {
 	if days < 0 {
 		return nil
@@ -253,7 +323,7 @@ func (s *Storage) ArchiveEntries(days int) error {
 		SET
 			status='removed'
 		WHERE
-			id=ANY(SELECT id FROM entries WHERE status='read' AND starred is false AND published_at < now () - '%d days'::interval LIMIT 5000)
+			id=ANY(SELECT id FROM entries WHERE status='read' AND starred is false AND published_at < now () - '%d days'::interval AND NOT EXISTS (SELECT 1 FROM highlights h WHERE h.entry_id=entries.id) LIMIT 5000)
 	`
 	if _, err := s.db.Exec(fmt.Sprintf(query, days)); err != nil {
 		return fmt.Errorf(`store: unable to archive read entries: %v`, err)
@@ -393,6 +463,17 @@ func (s *Storage) EntryURLExists(feedID int64, entryURL string) bool {
 	return result
 }
 
+// EntryURLByGUIDHash returns the URL of the entry having this hash computed by the parser,
+// or an empty string if the entry does not exist.
+//
+// Entries created before the identity strategies only have the hash of the parser.
+func (s *Storage) EntryURLByGUIDHash(feedID int64, guidHash string) string {
+	var result string
+	query := `SELECT url FROM entries WHERE feed_id=$1 AND (guid_hash=$2 OR (guid_hash='' AND hash=$2))`
+	s.db.QueryRow(query, feedID, guidHash).Scan(&result)
+	return result
+}
+
 // EntryShareCode returns the share code of the provided entry.
 // It generates a new one if not already defined.
 func (s *Storage) EntryShareCode(userID int64, entryID int64) (shareCode string, err error) {
@@ -0,0 +1,154 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+	"miniflux.app/reader/simhash"
+
+	"github.com/lib/pq"
+)
+
+// Only the entries published during this period are compared to the new ones.
+const clusterPeriodDays = 3
+
+type clusterCandidate struct {
+	entryID     int64
+	clusterID   int64
+	fingerprint uint64
+}
+
+// entryClusters finds the cluster of the new entries of a feed among the recent entries of the other feeds.
+// The recent entries are loaded only when a new entry has to be compared.
+type entryClusters struct {
+	store      *Storage
+	userID     int64
+	feedID     int64
+	loaded     bool
+	candidates []clusterCandidate
+}
+
+func (c *entryClusters) find(fingerprint uint64) (int64, error) {
+	if fingerprint == 0 {
+		return 0, nil
+	}
+
+	if !c.loaded {
+		if err := c.load(); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, candidate := range c.candidates {
+		if simhash.IsNearDuplicate(fingerprint, candidate.fingerprint) {
+			if candidate.clusterID != 0 {
+				return candidate.clusterID, nil
+			}
+			return candidate.entryID, nil
+		}
+	}
+
+	return 0, nil
+}
+
+func (c *entryClusters) load() error {
+	query := fmt.Sprintf(`
+		SELECT
+			id, coalesce(cluster_id, 0), fingerprint
+		FROM
+			entries
+		WHERE
+			user_id=$1 AND feed_id<>$2 AND fingerprint<>0 AND status<>$3 AND published_at > now() - '%d days'::interval
+		ORDER BY
+			published_at ASC, id ASC
+	`, clusterPeriodDays)
+
+	rows, err := c.store.db.Query(query, c.userID, c.feedID, model.EntryStatusRemoved)
+	if err != nil {
+		return fmt.Errorf(`store: unable to fetch cluster candidates: %v`, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var candidate clusterCandidate
+		var fingerprint int64
+		if err := rows.Scan(&candidate.entryID, &candidate.clusterID, &fingerprint); err != nil {
+			return fmt.Errorf(`store: unable to fetch cluster candidate row: %v`, err)
+		}
+
+		candidate.fingerprint = uint64(fingerprint)
+		c.candidates = append(c.candidates, candidate)
+	}
+
+	c.loaded = true
+	return nil
+}
+
+// joinCluster makes sure the first entry of the cluster belongs to it.
+func (s *Storage) joinCluster(clusterID int64) error {
+	query := `UPDATE entries SET cluster_id=$1 WHERE id=$1 AND cluster_id IS NULL`
+	if _, err := s.db.Exec(query, clusterID); err != nil {
+		return fmt.Errorf(`store: unable to create cluster #%d: %v`, clusterID, err)
+	}
+
+	return nil
+}
+
+// EntryClusters returns the other entries of the cluster of each given entry, indexed by entry ID.
+func (s *Storage) EntryClusters(userID int64, entries model.Entries) (map[int64]model.Entries, error) {
+	var clusterIDs []int64
+	for _, entry := range entries {
+		if entry.ClusterID != 0 {
+			clusterIDs = append(clusterIDs, entry.ClusterID)
+		}
+	}
+
+	clusters := make(map[int64]model.Entries)
+	if len(clusterIDs) == 0 {
+		return clusters, nil
+	}
+
+	builder := s.NewEntryQueryBuilder(userID)
+	builder.WithClusterIDs(clusterIDs)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithOrder(model.DefaultSortingOrder)
+	builder.WithDirection("asc")
+	members, err := builder.GetEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		for _, member := range members {
+			if member.ClusterID == entry.ClusterID && member.ID != entry.ID {
+				clusters[entry.ID] = append(clusters[entry.ID], member)
+			}
+		}
+	}
+
+	return clusters, nil
+}
+
+// SetClustersStatus updates the status of the given entries and of the other entries of their clusters.
+func (s *Storage) SetClustersStatus(userID int64, entryIDs []int64, status string) error {
+	query := `
+		UPDATE
+			entries
+		SET
+			status=$1, changed_at=now()
+		WHERE
+			user_id=$2 AND status<>$4 AND (
+				id=ANY($3) OR
+				cluster_id IN (SELECT cluster_id FROM entries WHERE user_id=$2 AND id=ANY($3) AND cluster_id IS NOT NULL)
+			)
+	`
+	if _, err := s.db.Exec(query, status, userID, pq.Array(entryIDs), model.EntryStatusRemoved); err != nil {
+		return fmt.Errorf(`store: unable to update the clusters of entries %v: %v`, entryIDs, err)
+	}
+
+	return nil
+}
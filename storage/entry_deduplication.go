@@ -0,0 +1,204 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+	"miniflux.app/reader/identity"
+
+	"github.com/lib/pq"
+)
+
+// DeduplicateEntries uses the hashes computed when the entries were received to find the hash of each entry
+// with the identity strategy of the feed, and merges the entries having the same hash.
+// It returns the number of removed duplicates.
+//
+// The oldest entry of each group is kept: it stays removed when it was removed, otherwise it is read when
+// one of the duplicates was read, starred when one of them was starred, and it receives the tags, the labels and the highlights of all of them.
+func (s *Storage) DeduplicateEntries(userID, feedID int64) (int, error) {
+	var strategy string
+	err := s.db.QueryRow(`SELECT entry_identity FROM feeds WHERE user_id=$1 AND id=$2`, userID, feedID).Scan(&strategy)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to fetch the entry identity of feed #%d: %v`, feedID, err)
+	}
+
+	entries, err := s.entriesToDeduplicate(userID, feedID)
+	if err != nil {
+		return 0, err
+	}
+
+	var hashes []string
+	groups := make(map[string]model.Entries)
+	for _, entry := range entries {
+		hash := identity.StoredEntryHash(strategy, entry)
+		if _, found := groups[hash]; !found {
+			hashes = append(hashes, hash)
+		}
+		groups[hash] = append(groups[hash], entry)
+	}
+
+	var keepers model.Entries
+	var duplicateIDs, keeperIDs []int64
+	for _, hash := range hashes {
+		keeper := groups[hash][0]
+		for _, duplicate := range groups[hash][1:] {
+			mergeDuplicateEntry(keeper, duplicate)
+			duplicateIDs = append(duplicateIDs, duplicate.ID)
+			keeperIDs = append(keeperIDs, keeper.ID)
+		}
+
+		keeper.Hash = hash
+		keepers = append(keepers, keeper)
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	if err := deduplicateEntries(tx, userID, feedID, keepers, duplicateIDs, keeperIDs); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf(`store: unable to commit the deduplication of feed #%d: %v`, feedID, err)
+	}
+
+	return len(duplicateIDs), nil
+}
+
+func (s *Storage) entriesToDeduplicate(userID, feedID int64) (model.Entries, error) {
+	query := `
+		SELECT
+			id, hash, guid_hash, identity_hashes, url, status, starred, tags
+		FROM
+			entries
+		WHERE
+			user_id=$1 AND feed_id=$2
+		ORDER BY
+			id ASC
+	`
+	rows, err := s.db.Query(query, userID, feedID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch the entries of feed #%d: %v`, feedID, err)
+	}
+	defer rows.Close()
+
+	var entries model.Entries
+	for rows.Next() {
+		var entry model.Entry
+		err := rows.Scan(
+			&entry.ID,
+			&entry.Hash,
+			&entry.GUIDHash,
+			&entry.IdentityHashes,
+			&entry.URL,
+			&entry.Status,
+			&entry.Starred,
+			pq.Array(&entry.Tags),
+		)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry row: %v`, err)
+		}
+
+		// Entries created before the identity strategies only have the hash of the parser.
+		if entry.GUIDHash == "" {
+			entry.GUIDHash = entry.Hash
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
+
+// mergeDuplicateEntry keeps the state of the duplicate in the entry that remains.
+func mergeDuplicateEntry(keeper, duplicate *model.Entry) {
+	switch {
+	case keeper.Status == model.EntryStatusRemoved:
+		// The entry removed by the user must not come back.
+	case keeper.Status == model.EntryStatusRead || duplicate.Status == model.EntryStatusRead:
+		keeper.Status = model.EntryStatusRead
+	case keeper.Status == model.EntryStatusUnread || duplicate.Status == model.EntryStatusUnread:
+		keeper.Status = model.EntryStatusUnread
+	}
+
+	keeper.Starred = keeper.Starred || duplicate.Starred
+	keeper.AddTags(duplicate.Tags...)
+}
+
+// deduplicateEntries removes the duplicates, keeperIDs contains the entry kept for each duplicate.
+func deduplicateEntries(tx *sql.Tx, userID, feedID int64, keepers model.Entries, duplicateIDs, keeperIDs []int64) error {
+	if len(duplicateIDs) > 0 {
+		query := `
+			INSERT INTO entry_labels
+				(user_id, entry_id, label_id)
+			SELECT
+				el.user_id, d.keeper_id, el.label_id
+			FROM
+				entry_labels el
+			JOIN
+				unnest($2::bigint[], $3::bigint[]) AS d(duplicate_id, keeper_id) ON d.duplicate_id=el.entry_id
+			WHERE
+				el.user_id=$1
+			ON CONFLICT DO NOTHING
+		`
+		if _, err := tx.Exec(query, userID, pq.Array(duplicateIDs), pq.Array(keeperIDs)); err != nil {
+			return fmt.Errorf(`store: unable to move the labels of the duplicates of feed #%d: %v`, feedID, err)
+		}
+
+		query = `
+			UPDATE
+				highlights h
+			SET
+				entry_id=d.keeper_id
+			FROM
+				unnest($2::bigint[], $3::bigint[]) AS d(duplicate_id, keeper_id)
+			WHERE
+				h.user_id=$1 AND h.entry_id=d.duplicate_id
+		`
+		if _, err := tx.Exec(query, userID, pq.Array(duplicateIDs), pq.Array(keeperIDs)); err != nil {
+			return fmt.Errorf(`store: unable to move the highlights of the duplicates of feed #%d: %v`, feedID, err)
+		}
+
+		if _, err := tx.Exec(`DELETE FROM entries WHERE user_id=$1 AND id=ANY($2)`, userID, pq.Array(duplicateIDs)); err != nil {
+			return fmt.Errorf(`store: unable to remove the duplicates of feed #%d: %v`, feedID, err)
+		}
+	}
+
+	// The hashes are unique per feed, they are replaced by temporary values before receiving the new ones.
+	_, err := tx.Exec(
+		`UPDATE entries SET guid_hash=CASE WHEN guid_hash='' THEN hash ELSE guid_hash END, hash='deduplication:' || id WHERE user_id=$1 AND feed_id=$2`,
+		userID,
+		feedID,
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to reset the hashes of feed #%d: %v`, feedID, err)
+	}
+
+	query := `
+		UPDATE
+			entries
+		SET
+			hash=$1,
+			status=$2,
+			starred=$3,
+			tags=coalesce($4::text[], '{}'),
+			changed_at=CASE WHEN status<>$2 OR starred<>$3 THEN now() ELSE changed_at END
+		WHERE
+			id=$5
+	`
+	for _, keeper := range keepers {
+		if _, err := tx.Exec(query, keeper.Hash, keeper.Status, keeper.Starred, pq.Array(keeper.Tags), keeper.ID); err != nil {
+			return fmt.Errorf(`store: unable to update entry #%d: %v`, keeper.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -52,6 +52,19 @@ func (e *EntryPaginationBuilder) WithCategoryID(categoryID int64) {
 	}
 }
 
+// WithLabelID adds a label to the condition.
+func (e *EntryPaginationBuilder) WithLabelID(labelID int64) {
+	if labelID != 0 {
+		e.conditions = append(e.conditions, fmt.Sprintf("EXISTS (SELECT 1 FROM entry_labels el WHERE el.entry_id=e.id AND el.label_id = $%d)", len(e.args)+1))
+		e.args = append(e.args, labelID)
+	}
+}
+
+// WithSmartFolder adds the criteria of the smart folder to the condition.
+func (e *EntryPaginationBuilder) WithSmartFolder(folder *model.SmartFolder) {
+	e.conditions, e.args = smartFolderConditions(folder, e.conditions, e.args)
+}
+
 // WithStatus adds status to the condition.
 func (e *EntryPaginationBuilder) WithStatus(status string) {
 	if status != "" {
@@ -24,6 +24,10 @@ type EntryQueryBuilder struct {
 	direction  string
 	limit      int
 	offset     int
+
+	userID           int64
+	categoryID       int64
+	collapseClusters bool
 }
 
 // WithSearchQuery adds full-text search query to the condition.
@@ -83,6 +87,20 @@ func (e *EntryQueryBuilder) WithEntryIDs(entryIDs []int64) *EntryQueryBuilder {
 	return e
 }
 
+// WithClusterIDs adds a condition to fetch only the entries of the given clusters.
+func (e *EntryQueryBuilder) WithClusterIDs(clusterIDs []int64) *EntryQueryBuilder {
+	e.conditions = append(e.conditions, fmt.Sprintf("e.cluster_id = ANY($%d)", len(e.args)+1))
+	e.args = append(e.args, pq.Array(clusterIDs))
+	return e
+}
+
+// WithCollapsedClusters keeps only the first published entry of each cluster among the entries with the same status.
+// When a category is given, only the entries of the category are compared.
+func (e *EntryQueryBuilder) WithCollapsedClusters() *EntryQueryBuilder {
+	e.collapseClusters = true
+	return e
+}
+
 // WithEntryID set the entryID.
 func (e *EntryQueryBuilder) WithEntryID(entryID int64) *EntryQueryBuilder {
 	if entryID != 0 {
@@ -106,10 +124,26 @@ func (e *EntryQueryBuilder) WithCategoryID(categoryID int64) *EntryQueryBuilder
 	if categoryID > 0 {
 		e.conditions = append(e.conditions, fmt.Sprintf("f.category_id = $%d", len(e.args)+1))
 		e.args = append(e.args, categoryID)
+		e.categoryID = categoryID
 	}
 	return e
 }
 
+// WithLabelID adds a condition to fetch only the entries with the given label.
+func (e *EntryQueryBuilder) WithLabelID(labelID int64) *EntryQueryBuilder {
+	if labelID > 0 {
+		e.conditions = append(e.conditions, fmt.Sprintf("EXISTS (SELECT 1 FROM entry_labels el WHERE el.entry_id=e.id AND el.label_id = $%d)", len(e.args)+1))
+		e.args = append(e.args, labelID)
+	}
+	return e
+}
+
+// WithSmartFolder adds the criteria of the smart folder to the condition.
+func (e *EntryQueryBuilder) WithSmartFolder(folder *model.SmartFolder) *EntryQueryBuilder {
+	e.conditions, e.args = smartFolderConditions(folder, e.conditions, e.args)
+	return e
+}
+
 // WithStatus set the entry status.
 func (e *EntryQueryBuilder) WithStatus(status string) *EntryQueryBuilder {
 	if status != "" {
@@ -215,6 +249,9 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			e.content,
 			e.status,
 			e.starred,
+			e.tags,
+			coalesce(e.cluster_id, 0),
+			e.revision_count,
 			f.title as feed_title,
 			f.feed_url,
 			f.site_url,
@@ -223,9 +260,16 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			f.scraper_rules,
 			f.rewrite_rules,
 			f.crawler,
+			f.crawler_override,
 			f.user_agent,
 			fi.icon_id,
 			f.use_mercury,
+			f.content_extractors,
+			c.content_extractors as category_content_extractors,
+			c.crawler as category_crawler,
+			c.user_agent as category_user_agent,
+			c.scraper_rules as category_scraper_rules,
+			c.rewrite_rules as category_rewrite_rules,
 			u.timezone
 		FROM
 			entries e
@@ -274,6 +318,9 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.Content,
 			&entry.Status,
 			&entry.Starred,
+			pq.Array(&entry.Tags),
+			&entry.ClusterID,
+			&entry.RevisionCount,
 			&entry.Feed.Title,
 			&entry.Feed.FeedURL,
 			&entry.Feed.SiteURL,
@@ -283,9 +330,16 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.Feed.ScraperRules,
 			&entry.Feed.RewriteRules,
 			&entry.Feed.Crawler,
+			&entry.Feed.CrawlerOverride,
 			&entry.Feed.UserAgent,
 			&iconID,
 			&entry.Feed.UseMercury,
+			&entry.Feed.ContentExtractors,
+			&entry.Feed.Category.ContentExtractors,
+			&entry.Feed.Category.Crawler,
+			&entry.Feed.Category.UserAgent,
+			&entry.Feed.Category.ScraperRules,
+			&entry.Feed.Category.RewriteRules,
 			&tz,
 		)
 
@@ -310,6 +364,36 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 		entries = append(entries, &entry)
 	}
 
+	// Labels and highlights are private, they are not loaded for anonymous users.
+	if e.userID > 0 && len(entries) > 0 {
+		entryIDs := make([]int64, 0, len(entries))
+		for _, entry := range entries {
+			entryIDs = append(entryIDs, entry.ID)
+		}
+
+		labels, err := e.store.entriesLabels(e.userID, entryIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		highlights, err := e.store.entriesHighlights(e.userID, entryIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			entry.Labels = labels[entry.ID]
+			if entry.Labels == nil {
+				entry.Labels = make(model.Labels, 0)
+			}
+
+			entry.Highlights = highlights[entry.ID]
+			if entry.Highlights == nil {
+				entry.Highlights = make(model.Highlights, 0)
+			}
+		}
+	}
+
 	return entries, nil
 }
 
@@ -342,7 +426,20 @@ func (e *EntryQueryBuilder) GetEntryIDs() ([]int64, error) {
 }
 
 func (e *EntryQueryBuilder) buildCondition() string {
-	return strings.Join(e.conditions, " AND ")
+	conditions := e.conditions
+	if e.collapseClusters {
+		scope := ""
+		if e.categoryID > 0 {
+			scope = "AND ce.feed_id IN (SELECT id FROM feeds WHERE category_id=f.category_id)"
+		}
+
+		conditions = append(conditions[:len(conditions):len(conditions)], fmt.Sprintf(`(e.cluster_id IS NULL OR NOT EXISTS (
+			SELECT 1 FROM entries ce
+			WHERE ce.user_id=e.user_id AND ce.cluster_id=e.cluster_id AND ce.status=e.status AND (ce.published_at, ce.id) < (e.published_at, e.id) %s
+		))`, scope))
+	}
+
+	return strings.Join(conditions, " AND ")
 }
 
 func (e *EntryQueryBuilder) buildSorting() string {
@@ -371,6 +468,7 @@ func (e *EntryQueryBuilder) buildSorting() string {
 func NewEntryQueryBuilder(store *Storage, userID int64) *EntryQueryBuilder {
 	return &EntryQueryBuilder{
 		store:      store,
+		userID:     userID,
 		args:       []interface{}{userID},
 		conditions: []string{"e.user_id = $1"},
 	}
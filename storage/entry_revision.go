@@ -0,0 +1,139 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+	"miniflux.app/reader/diff"
+	"miniflux.app/reader/sanitizer"
+)
+
+// Above this percentage of changed words, an updated entry is marked as unread again.
+const substantialChangeRatio = 20
+
+// entryRevisionSettings holds the user preferences applied when existing entries are updated.
+type entryRevisionSettings struct {
+	limit          int
+	unreadOnUpdate bool
+}
+
+func (s *Storage) entryRevisionSettings(userID int64) (*entryRevisionSettings, error) {
+	var settings entryRevisionSettings
+	query := `SELECT entry_revisions_limit, unread_on_update FROM users WHERE id=$1`
+	err := s.db.QueryRow(query, userID).Scan(&settings.limit, &settings.unreadOnUpdate)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch revision settings for user #%d: %v`, userID, err)
+	}
+
+	return &settings, nil
+}
+
+// recordEntryRevision keeps the previous title and content of an updated entry
+// and marks the entry as unread when its content has changed substantially.
+func (s *Storage) recordEntryRevision(settings *entryRevisionSettings, entry *model.Entry, previousTitle, previousContent string) error {
+	if previousTitle == entry.Title && previousContent == entry.Content {
+		return nil
+	}
+
+	if settings.limit > 0 {
+		if err := s.createEntryRevision(settings.limit, entry, previousTitle, previousContent); err != nil {
+			return err
+		}
+	}
+
+	if settings.unreadOnUpdate && isSubstantialChange(previousContent, entry.Content) {
+		query := `UPDATE entries SET status=$1, changed_at=now() WHERE id=$2 AND user_id=$3 AND status=$4`
+		_, err := s.db.Exec(query, model.EntryStatusUnread, entry.ID, entry.UserID, model.EntryStatusRead)
+		if err != nil {
+			return fmt.Errorf(`store: unable to mark updated entry #%d as unread: %v`, entry.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) createEntryRevision(limit int, entry *model.Entry, previousTitle, previousContent string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	query := `INSERT INTO entry_revisions (user_id, entry_id, title, content) VALUES ($1, $2, $3, $4)`
+	if _, err := tx.Exec(query, entry.UserID, entry.ID, previousTitle, previousContent); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to create revision of entry #%d: %v`, entry.ID, err)
+	}
+
+	query = `
+		DELETE FROM
+			entry_revisions
+		WHERE
+			entry_id=$1 AND id NOT IN (
+				SELECT id FROM entry_revisions WHERE entry_id=$1 ORDER BY id DESC LIMIT $2
+			)
+	`
+	if _, err := tx.Exec(query, entry.ID, limit); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to remove old revisions of entry #%d: %v`, entry.ID, err)
+	}
+
+	query = `UPDATE entries SET revision_count=(SELECT count(*) FROM entry_revisions WHERE entry_id=$1) WHERE id=$1`
+	if _, err := tx.Exec(query, entry.ID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to count revisions of entry #%d: %v`, entry.ID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit revision of entry #%d: %v`, entry.ID, err)
+	}
+
+	return nil
+}
+
+// EntryRevisions returns the previous versions of an entry, the most recent first.
+func (s *Storage) EntryRevisions(userID, entryID int64) (model.EntryRevisions, error) {
+	query := `
+		SELECT
+			id, user_id, entry_id, title, content, created_at
+		FROM
+			entry_revisions
+		WHERE
+			user_id=$1 AND entry_id=$2
+		ORDER BY
+			id DESC
+	`
+	rows, err := s.db.Query(query, userID, entryID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch revisions of entry #%d: %v`, entryID, err)
+	}
+	defer rows.Close()
+
+	revisions := make(model.EntryRevisions, 0)
+	for rows.Next() {
+		var revision model.EntryRevision
+		if err := rows.Scan(
+			&revision.ID,
+			&revision.UserID,
+			&revision.EntryID,
+			&revision.Title,
+			&revision.Content,
+			&revision.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch revision row: %v`, err)
+		}
+
+		revisions = append(revisions, &revision)
+	}
+
+	return revisions, nil
+}
+
+// isSubstantialChange returns true if enough words of the text content have been added or removed.
+func isSubstantialChange(previousContent, content string) bool {
+	changes := diff.Words(sanitizer.StripTags(previousContent), sanitizer.StripTags(content))
+	return changes.Ratio() >= substantialChangeRatio
+}
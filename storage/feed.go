@@ -70,12 +70,26 @@ func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
 			f.scraper_rules,
 			f.rewrite_rules,
 			f.crawler,
+			f.crawler_override,
 			f.user_agent,
 			f.username,
 			f.password,
+			f.use_mercury,
+			f.content_extractors,
+			f.filter_mode,
+			f.entry_identity,
+			f.suppressed_count,
 			f.disabled,
+			f.source_type,
+			f.source_settings,
 			f.category_id,
 			c.title as category_title,
+			c.content_extractors as category_content_extractors,
+			c.filter_mode as category_filter_mode,
+			c.crawler as category_crawler,
+			c.user_agent as category_user_agent,
+			c.scraper_rules as category_scraper_rules,
+			c.rewrite_rules as category_rewrite_rules,
 			fi.icon_id,
 			u.timezone
 		FROM feeds f
@@ -112,12 +126,26 @@ func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
 			&feed.ScraperRules,
 			&feed.RewriteRules,
 			&feed.Crawler,
+			&feed.CrawlerOverride,
 			&feed.UserAgent,
 			&feed.Username,
 			&feed.Password,
+			&feed.UseMercury,
+			&feed.ContentExtractors,
+			&feed.FilterMode,
+			&feed.EntryIdentity,
+			&feed.SuppressedCount,
 			&feed.Disabled,
+			&feed.SourceType,
+			&feed.SourceSettings,
 			&feed.Category.ID,
 			&feed.Category.Title,
+			&feed.Category.ContentExtractors,
+			&feed.Category.FilterMode,
+			&feed.Category.Crawler,
+			&feed.Category.UserAgent,
+			&feed.Category.ScraperRules,
+			&feed.Category.RewriteRules,
 			&iconID,
 			&tz,
 		)
@@ -152,6 +180,7 @@ func (s *Storage) FeedsWithCounters(userID int64) (model.Feeds, error) {
 			f.parsing_error_count, f.parsing_error_msg,
 			f.scraper_rules, f.rewrite_rules, f.crawler, f.user_agent,
 			f.username, f.password, f.disabled,
+			f.source_type, f.source_settings, f.suppressed_count,
 			f.category_id, c.title as category_title,
 			fi.icon_id,
 			u.timezone,
@@ -183,6 +212,7 @@ func (s *Storage) FeedsByCategoryWithCounters(userID, categoryID int64) (model.F
 			f.parsing_error_count, f.parsing_error_msg,
 			f.scraper_rules, f.rewrite_rules, f.crawler, f.user_agent,
 			f.username, f.password, f.disabled,
+			f.source_type, f.source_settings, f.suppressed_count,
 			f.category_id, c.title as category_title,
 			fi.icon_id,
 			u.timezone,
@@ -231,6 +261,9 @@ func (s *Storage) fetchFeedsWithCounters(query string, args ...interface{}) (mod
 			&feed.Username,
 			&feed.Password,
 			&feed.Disabled,
+			&feed.SourceType,
+			&feed.SourceSettings,
+			&feed.SuppressedCount,
 			&feed.Category.ID,
 			&feed.Category.Title,
 			&iconID,
@@ -275,16 +308,27 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 			f.parsing_error_msg,
 			f.scraper_rules,
 			f.rewrite_rules,
-			f.title_filter,
-			f.content_filter, 
 			f.crawler,
+			f.crawler_override,
 			f.user_agent,
 			f.username,
 			f.password,
 			f.use_mercury,
+			f.content_extractors,
+			f.filter_mode,
+			f.entry_identity,
+			f.suppressed_count,
 			f.disabled,
+			f.source_type,
+			f.source_settings,
 			f.category_id,
 			c.title as category_title,
+			c.content_extractors as category_content_extractors,
+			c.filter_mode as category_filter_mode,
+			c.crawler as category_crawler,
+			c.user_agent as category_user_agent,
+			c.scraper_rules as category_scraper_rules,
+			c.rewrite_rules as category_rewrite_rules,
 			fi.icon_id,
 			u.timezone
 		FROM feeds f
@@ -308,16 +352,27 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 		&feed.ParsingErrorMsg,
 		&feed.ScraperRules,
 		&feed.RewriteRules,
-		&feed.TitleFilter,
-		&feed.ContentFilter,
 		&feed.Crawler,
+		&feed.CrawlerOverride,
 		&feed.UserAgent,
 		&feed.Username,
 		&feed.Password,
 		&feed.UseMercury,
+		&feed.ContentExtractors,
+		&feed.FilterMode,
+		&feed.EntryIdentity,
+		&feed.SuppressedCount,
 		&feed.Disabled,
+		&feed.SourceType,
+		&feed.SourceSettings,
 		&feed.Category.ID,
 		&feed.Category.Title,
+		&feed.Category.ContentExtractors,
+		&feed.Category.FilterMode,
+		&feed.Category.Crawler,
+		&feed.Category.UserAgent,
+		&feed.Category.ScraperRules,
+		&feed.Category.RewriteRules,
 		&iconID,
 		&tz,
 	)
@@ -339,6 +394,10 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 
 // CreateFeed creates a new feed.
 func (s *Storage) CreateFeed(feed *model.Feed) error {
+	if feed.SourceType == "" {
+		feed.SourceType = model.FeedSourceTypeFeed
+	}
+
 	sql := `
 		INSERT INTO feeds (
 			feed_url,
@@ -355,10 +414,15 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 			use_mercury,
 			disabled,
 			scraper_rules,
-			rewrite_rules
+			rewrite_rules,
+			source_type,
+			source_settings,
+			filter_mode,
+			entry_identity,
+			crawler_override
 		)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
 		RETURNING
 			id
 	`
@@ -379,23 +443,16 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.Disabled,
 		feed.ScraperRules,
 		feed.RewriteRules,
+		feed.SourceType,
+		feed.SourceSettings,
+		feed.FilterMode,
+		feed.EntryIdentity,
+		feed.CrawlerOverride,
 	).Scan(&feed.ID)
 	if err != nil {
 		return fmt.Errorf(`store: unable to create feed %q: %v`, feed.FeedURL, err)
 	}
 
-	for i := 0; i < len(feed.Entries); i++ {
-		feed.Entries[i].FeedID = feed.ID
-		feed.Entries[i].UserID = feed.UserID
-
-		if !s.entryExists(feed.Entries[i]) {
-			err := s.createEntry(feed.Entries[i])
-			if err != nil {
-				return err
-			}
-		}
-	}
-
 	return nil
 }
 
@@ -416,16 +473,20 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 			parsing_error_count=$9,
 			scraper_rules=$10,
 			rewrite_rules=$11,
-			title_filter=$12,
-			content_filter=$13,
-			crawler=$14,
-			user_agent=$15,
-			username=$16,
-			password=$17,
-			use_mercury=$18,
-			disabled=$19
+			crawler=$12,
+			user_agent=$13,
+			username=$14,
+			password=$15,
+			use_mercury=$16,
+			disabled=$17,
+			source_type=$18,
+			source_settings=$19,
+			content_extractors=$20,
+			filter_mode=$21,
+			entry_identity=$22,
+			crawler_override=$23
 		WHERE
-			id=$20 AND user_id=$21
+			id=$24 AND user_id=$25
 	`
 
 	_, err = s.db.Exec(query,
@@ -440,14 +501,18 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		feed.ParsingErrorCount,
 		feed.ScraperRules,
 		feed.RewriteRules,
-		feed.TitleFilter,
-		feed.ContentFilter,
 		feed.Crawler,
 		feed.UserAgent,
 		feed.Username,
 		feed.Password,
 		feed.UseMercury,
 		feed.Disabled,
+		feed.SourceType,
+		feed.SourceSettings,
+		feed.ContentExtractors,
+		feed.FilterMode,
+		feed.EntryIdentity,
+		feed.CrawlerOverride,
 		feed.ID,
 		feed.UserID,
 	)
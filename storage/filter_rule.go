@@ -0,0 +1,231 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+const filterRuleColumns = `
+	id, user_id, coalesce(category_id, 0), coalesce(feed_id, 0), field, operator, value,
+	action, action_value, error_msg, hit_count, last_hit_at, created_at
+`
+
+// FilterRuleExists checks if a filter rule exists.
+func (s *Storage) FilterRuleExists(userID, ruleID int64) bool {
+	var result bool
+	query := `SELECT true FROM filter_rules WHERE user_id=$1 AND id=$2`
+	s.db.QueryRow(query, userID, ruleID).Scan(&result)
+	return result
+}
+
+// FilterRule returns a filter rule.
+func (s *Storage) FilterRule(userID, ruleID int64) (*model.FilterRule, error) {
+	query := `SELECT ` + filterRuleColumns + ` FROM filter_rules WHERE user_id=$1 AND id=$2`
+	rule, err := scanFilterRule(s.db.QueryRow(query, userID, ruleID))
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch filter rule #%d: %v`, ruleID, err)
+	}
+
+	return rule, nil
+}
+
+// FilterRules returns all the filter rules of the user.
+func (s *Storage) FilterRules(userID int64) (model.FilterRules, error) {
+	query := `SELECT ` + filterRuleColumns + ` FROM filter_rules WHERE user_id=$1 ORDER BY id ASC`
+	return s.fetchFilterRules(query, userID)
+}
+
+// FeedFilterRules returns the rules applied to a feed: the rules of the user, of the feed category and of the feed.
+func (s *Storage) FeedFilterRules(userID, feedID int64) (model.FilterRules, error) {
+	query := `
+		SELECT ` + filterRuleColumns + `
+		FROM
+			filter_rules
+		WHERE
+			user_id=$1 AND (
+				(category_id IS NULL AND feed_id IS NULL) OR
+				feed_id=$2 OR
+				category_id=(SELECT category_id FROM feeds WHERE id=$2 AND user_id=$1)
+			)
+		ORDER BY id ASC
+	`
+	return s.fetchFilterRules(query, userID, feedID)
+}
+
+func (s *Storage) fetchFilterRules(query string, args ...interface{}) (model.FilterRules, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch filter rules: %v`, err)
+	}
+	defer rows.Close()
+
+	rules := make(model.FilterRules, 0)
+	for rows.Next() {
+		rule, err := scanFilterRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch filter rule row: %v`, err)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanFilterRule(row rowScanner) (*model.FilterRule, error) {
+	var rule model.FilterRule
+	err := row.Scan(
+		&rule.ID,
+		&rule.UserID,
+		&rule.CategoryID,
+		&rule.FeedID,
+		&rule.Field,
+		&rule.Operator,
+		&rule.Value,
+		&rule.Action,
+		&rule.ActionValue,
+		&rule.ErrorMsg,
+		&rule.HitCount,
+		&rule.LastHitAt,
+		&rule.CreatedAt,
+	)
+	return &rule, err
+}
+
+// CreateFilterRule inserts a new filter rule.
+func (s *Storage) CreateFilterRule(rule *model.FilterRule) error {
+	query := `
+		INSERT INTO filter_rules
+			(user_id, category_id, feed_id, field, operator, value, action, action_value)
+		VALUES
+			($1, NULLIF($2, 0), NULLIF($3, 0), $4, $5, $6, $7, $8)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		rule.UserID,
+		rule.CategoryID,
+		rule.FeedID,
+		rule.Field,
+		rule.Operator,
+		rule.Value,
+		rule.Action,
+		rule.ActionValue,
+	).Scan(
+		&rule.ID,
+		&rule.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create filter rule: %v`, err)
+	}
+
+	return nil
+}
+
+// UpdateFilterRule updates a filter rule, the hit count is preserved.
+// The rule is validated before being saved, its previous error is cleared.
+func (s *Storage) UpdateFilterRule(rule *model.FilterRule) error {
+	query := `
+		UPDATE
+			filter_rules
+		SET
+			category_id=NULLIF($1, 0),
+			feed_id=NULLIF($2, 0),
+			field=$3,
+			operator=$4,
+			value=$5,
+			action=$6,
+			action_value=$7,
+			error_msg=''
+		WHERE
+			id=$8 AND user_id=$9
+	`
+	_, err := s.db.Exec(
+		query,
+		rule.CategoryID,
+		rule.FeedID,
+		rule.Field,
+		rule.Operator,
+		rule.Value,
+		rule.Action,
+		rule.ActionValue,
+		rule.ID,
+		rule.UserID,
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update filter rule #%d: %v`, rule.ID, err)
+	}
+
+	return nil
+}
+
+// RemoveFilterRule deletes a filter rule.
+func (s *Storage) RemoveFilterRule(userID, ruleID int64) error {
+	query := `DELETE FROM filter_rules WHERE id=$1 AND user_id=$2`
+	_, err := s.db.Exec(query, ruleID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove filter rule #%d: %v`, ruleID, err)
+	}
+
+	return nil
+}
+
+// incrementFilterRuleHits records the number of entries matched by each rule.
+func (s *Storage) incrementFilterRuleHits(hits map[int64]int64) error {
+	query := `UPDATE filter_rules SET hit_count=hit_count+$1, last_hit_at=now() WHERE id=$2`
+	for ruleID, count := range hits {
+		if _, err := s.db.Exec(query, count, ruleID); err != nil {
+			return fmt.Errorf(`store: unable to update the hit count of filter rule #%d: %v`, ruleID, err)
+		}
+	}
+
+	return nil
+}
+
+// feedFilterMode returns the filter mode of a feed, the mode of the category is used when the feed has none.
+func (s *Storage) feedFilterMode(userID, feedID int64) (string, error) {
+	var mode string
+	query := `
+		SELECT
+			coalesce(nullif(f.filter_mode, ''), nullif(c.filter_mode, ''), $3)
+		FROM feeds f
+		LEFT JOIN categories c ON c.id=f.category_id
+		WHERE
+			f.user_id=$1 AND f.id=$2
+	`
+	err := s.db.QueryRow(query, userID, feedID, model.FilterModeBlock).Scan(&mode)
+	if err != nil {
+		return "", fmt.Errorf(`store: unable to fetch the filter mode of feed #%d: %v`, feedID, err)
+	}
+
+	return mode, nil
+}
+
+// incrementSuppressedCount records the number of entries suppressed by the allowlist of a feed.
+func (s *Storage) incrementSuppressedCount(feedID, count int64) error {
+	if count == 0 {
+		return nil
+	}
+
+	query := `UPDATE feeds SET suppressed_count=suppressed_count+$1 WHERE id=$2`
+	if _, err := s.db.Exec(query, count, feedID); err != nil {
+		return fmt.Errorf(`store: unable to update the suppressed count of feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
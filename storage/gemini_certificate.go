@@ -0,0 +1,50 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// GeminiCertificate returns the certificate pinned for a Gemini host.
+func (s *Storage) GeminiCertificate(host string) (*model.GeminiCertificate, error) {
+	var certificate model.GeminiCertificate
+	query := `SELECT host, fingerprint, expires_at, created_at FROM gemini_certificates WHERE host=$1`
+	err := s.db.QueryRow(query, host).Scan(
+		&certificate.Host,
+		&certificate.Fingerprint,
+		&certificate.ExpiresAt,
+		&certificate.CreatedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch Gemini certificate for %q: %v`, host, err)
+	}
+
+	return &certificate, nil
+}
+
+// UpdateGeminiCertificate pins the certificate of a Gemini host.
+func (s *Storage) UpdateGeminiCertificate(certificate *model.GeminiCertificate) error {
+	query := `
+		INSERT INTO gemini_certificates
+			(host, fingerprint, expires_at, created_at)
+		VALUES
+			($1, $2, $3, now())
+		ON CONFLICT (host) DO UPDATE
+			SET fingerprint=EXCLUDED.fingerprint, expires_at=EXCLUDED.expires_at, created_at=EXCLUDED.created_at
+	`
+	if _, err := s.db.Exec(query, certificate.Host, certificate.Fingerprint, certificate.ExpiresAt); err != nil {
+		return fmt.Errorf(`store: unable to update Gemini certificate for %q: %v`, certificate.Host, err)
+	}
+
+	return nil
+}
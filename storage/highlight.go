@@ -0,0 +1,221 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// Highlight returns a highlight from the database.
+func (s *Storage) Highlight(userID, highlightID int64) (*model.Highlight, error) {
+	var highlight model.Highlight
+
+	query := `
+		SELECT
+			id, user_id, entry_id, quote, prefix, suffix, note, created_at
+		FROM
+			highlights
+		WHERE
+			user_id=$1 AND id=$2
+	`
+	err := s.db.QueryRow(query, userID, highlightID).Scan(
+		&highlight.ID,
+		&highlight.UserID,
+		&highlight.EntryID,
+		&highlight.Quote,
+		&highlight.Prefix,
+		&highlight.Suffix,
+		&highlight.Note,
+		&highlight.CreatedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch highlight: %v`, err)
+	default:
+		return &highlight, nil
+	}
+}
+
+// Highlights returns the highlights of the given user with the title of their entry, the most recent first.
+// A limit of 0 returns all the highlights after the offset.
+func (s *Storage) Highlights(userID int64, offset, limit int) (model.Highlights, error) {
+	query := `
+		SELECT
+			h.id, h.user_id, h.entry_id, h.quote, h.prefix, h.suffix, h.note, h.created_at,
+			e.title, e.url, e.feed_id, f.title
+		FROM
+			highlights h
+		JOIN
+			entries e ON e.id=h.entry_id
+		JOIN
+			feeds f ON f.id=e.feed_id
+		WHERE
+			h.user_id=$1
+		ORDER BY
+			h.created_at DESC, h.id DESC
+		LIMIT nullif($2, 0) OFFSET $3
+	`
+	rows, err := s.db.Query(query, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch highlights: %v`, err)
+	}
+	defer rows.Close()
+
+	highlights := make(model.Highlights, 0)
+	for rows.Next() {
+		var highlight model.Highlight
+		err := rows.Scan(
+			&highlight.ID,
+			&highlight.UserID,
+			&highlight.EntryID,
+			&highlight.Quote,
+			&highlight.Prefix,
+			&highlight.Suffix,
+			&highlight.Note,
+			&highlight.CreatedAt,
+			&highlight.EntryTitle,
+			&highlight.EntryURL,
+			&highlight.FeedID,
+			&highlight.FeedTitle,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch highlight row: %v`, err)
+		}
+
+		highlights = append(highlights, &highlight)
+	}
+
+	return highlights, nil
+}
+
+// CountHighlights returns the number of highlights of the given user.
+func (s *Storage) CountHighlights(userID int64) (int, error) {
+	var count int
+	if err := s.db.QueryRow(`SELECT count(*) FROM highlights WHERE user_id=$1`, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf(`store: unable to count highlights: %v`, err)
+	}
+
+	return count, nil
+}
+
+// EntryHighlights returns the highlights of an entry in the order they were created.
+func (s *Storage) EntryHighlights(userID, entryID int64) (model.Highlights, error) {
+	highlights, err := s.entriesHighlights(userID, []int64{entryID})
+	if err != nil {
+		return nil, err
+	}
+
+	if highlights[entryID] == nil {
+		return make(model.Highlights, 0), nil
+	}
+
+	return highlights[entryID], nil
+}
+
+// entriesHighlights returns the highlights of the given entries, indexed by entry ID.
+func (s *Storage) entriesHighlights(userID int64, entryIDs []int64) (map[int64]model.Highlights, error) {
+	query := `
+		SELECT
+			id, user_id, entry_id, quote, prefix, suffix, note, created_at
+		FROM
+			highlights
+		WHERE
+			user_id=$1 AND entry_id = ANY($2)
+		ORDER BY
+			created_at ASC, id ASC
+	`
+	rows, err := s.db.Query(query, userID, pq.Array(entryIDs))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entry highlights: %v`, err)
+	}
+	defer rows.Close()
+
+	highlights := make(map[int64]model.Highlights)
+	for rows.Next() {
+		var highlight model.Highlight
+		err := rows.Scan(
+			&highlight.ID,
+			&highlight.UserID,
+			&highlight.EntryID,
+			&highlight.Quote,
+			&highlight.Prefix,
+			&highlight.Suffix,
+			&highlight.Note,
+			&highlight.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry highlight row: %v`, err)
+		}
+
+		highlights[highlight.EntryID] = append(highlights[highlight.EntryID], &highlight)
+	}
+
+	return highlights, nil
+}
+
+// CreateHighlight creates a new highlight.
+func (s *Storage) CreateHighlight(highlight *model.Highlight) error {
+	query := `
+		INSERT INTO highlights
+			(user_id, entry_id, quote, prefix, suffix, note)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		highlight.UserID,
+		highlight.EntryID,
+		highlight.Quote,
+		highlight.Prefix,
+		highlight.Suffix,
+		highlight.Note,
+	).Scan(&highlight.ID, &highlight.CreatedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create highlight: %v`, err)
+	}
+
+	return nil
+}
+
+// UpdateHighlight updates the note of a highlight.
+func (s *Storage) UpdateHighlight(highlight *model.Highlight) error {
+	query := `UPDATE highlights SET note=$1 WHERE id=$2 AND user_id=$3`
+	if _, err := s.db.Exec(query, highlight.Note, highlight.ID, highlight.UserID); err != nil {
+		return fmt.Errorf(`store: unable to update highlight: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveHighlight deletes a highlight.
+func (s *Storage) RemoveHighlight(userID, highlightID int64) error {
+	query := `DELETE FROM highlights WHERE id = $1 AND user_id = $2`
+	result, err := s.db.Exec(query, highlightID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this highlight: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this highlight: %v`, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no highlight has been removed`)
+	}
+
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 	"strings"
 
 	"miniflux.app/model"
+
+	"github.com/lib/pq"
 )
 
 // HasIcon checks if the given feed has an icon.
@@ -94,8 +96,31 @@ func (s *Storage) CreateIcon(icon *model.Icon) error {
 	return nil
 }
 
-// CreateFeedIcon creates an icon and associate the icon to the given feed.
-func (s *Storage) CreateFeedIcon(feedID int64, icon *model.Icon) error {
+// IconCheckNeeded returns true if the feed has no icon or if the icon has not been checked for the given number of days.
+func (s *Storage) IconCheckNeeded(feedID int64, days int) bool {
+	var result bool
+	query := `
+		SELECT
+			NOT EXISTS(SELECT 1 FROM feed_icons WHERE feed_id=$1)
+			OR ($2 > 0 AND (icon_checked_at IS NULL OR icon_checked_at < now() - $2 * interval '1 day'))
+		FROM feeds
+		WHERE id=$1
+	`
+	s.db.QueryRow(query, feedID, days).Scan(&result)
+	return result
+}
+
+// UpdateIconCheckedAt records that the icon of the given feed has been checked.
+func (s *Storage) UpdateIconCheckedAt(feedID int64) error {
+	if _, err := s.db.Exec(`UPDATE feeds SET icon_checked_at=now() WHERE id=$1`, feedID); err != nil {
+		return fmt.Errorf(`store: unable to update icon check date: %v`, err)
+	}
+
+	return nil
+}
+
+// UpdateFeedIcon creates the icon if necessary and associates the icon to the given feed, the previous icon is removed when not used anymore.
+func (s *Storage) UpdateFeedIcon(feedID int64, icon *model.Icon) error {
 	err := s.IconByHash(icon)
 	if err != nil {
 		return err
@@ -108,11 +133,49 @@ func (s *Storage) CreateFeedIcon(feedID int64, icon *model.Icon) error {
 		}
 	}
 
-	_, err = s.db.Exec(`INSERT INTO feed_icons (feed_id, icon_id) VALUES ($1, $2)`, feedID, icon.ID)
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	var previousIconIDs []int64
+	rows, err := tx.Query(`DELETE FROM feed_icons WHERE feed_id=$1 RETURNING icon_id`, feedID)
 	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to remove feed icon: %v`, err)
+	}
+
+	for rows.Next() {
+		var iconID int64
+		if err := rows.Scan(&iconID); err != nil {
+			rows.Close()
+			tx.Rollback()
+			return fmt.Errorf(`store: unable to remove feed icon: %v`, err)
+		}
+		previousIconIDs = append(previousIconIDs, iconID)
+	}
+	rows.Close()
+
+	if _, err := tx.Exec(`INSERT INTO feed_icons (feed_id, icon_id) VALUES ($1, $2)`, feedID, icon.ID); err != nil {
+		tx.Rollback()
 		return fmt.Errorf(`store: unable to create feed icon: %v`, err)
 	}
 
+	query := `DELETE FROM icons WHERE id=ANY($1) AND NOT EXISTS(SELECT 1 FROM feed_icons WHERE icon_id=icons.id)`
+	if _, err := tx.Exec(query, pq.Array(previousIconIDs)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to remove unused icons: %v`, err)
+	}
+
+	if _, err := tx.Exec(`UPDATE feeds SET icon_checked_at=now() WHERE id=$1`, feedID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to update icon check date: %v`, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
 	return nil
 }
 
@@ -21,10 +21,10 @@ func (s *Storage) NewBatch(batchSize int) (jobs model.JobList, err error) {
 		FROM
 			feeds
 		WHERE
-			parsing_error_count < $1 AND disabled is false
+			parsing_error_count < $1 AND disabled is false AND source_type <> $2
 		ORDER BY checked_at ASC LIMIT %d
 	`
-	return s.fetchBatchRows(fmt.Sprintf(query, batchSize), maxParsingError)
+	return s.fetchBatchRows(fmt.Sprintf(query, batchSize), maxParsingError, model.FeedSourceTypeNewsletter)
 }
 
 // NewUserBatch returns a serie of jobs but only for a given user.
@@ -38,10 +38,10 @@ func (s *Storage) NewUserBatch(userID int64, batchSize int) (jobs model.JobList,
 		FROM
 			feeds
 		WHERE
-			user_id=$1 AND disabled is false
+			user_id=$1 AND disabled is false AND source_type <> $2
 		ORDER BY checked_at ASC LIMIT %d
 	`
-	return s.fetchBatchRows(fmt.Sprintf(query, batchSize), userID)
+	return s.fetchBatchRows(fmt.Sprintf(query, batchSize), userID, model.FeedSourceTypeNewsletter)
 }
 
 func (s *Storage) fetchBatchRows(query string, args ...interface{}) (jobs model.JobList, err error) {
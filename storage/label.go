@@ -0,0 +1,202 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// AnotherLabelExists checks if another label exists with the same title.
+func (s *Storage) AnotherLabelExists(userID, labelID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM labels WHERE user_id=$1 AND id != $2 AND title=$3`
+	s.db.QueryRow(query, userID, labelID, title).Scan(&result)
+	return result
+}
+
+// Label returns a label from the database.
+func (s *Storage) Label(userID, labelID int64) (*model.Label, error) {
+	var label model.Label
+
+	query := `SELECT id, user_id, title FROM labels WHERE user_id=$1 AND id=$2`
+	err := s.db.QueryRow(query, userID, labelID).Scan(&label.ID, &label.UserID, &label.Title)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch label: %v`, err)
+	default:
+		return &label, nil
+	}
+}
+
+// Labels returns all labels of the given user with the number of labeled entries.
+func (s *Storage) Labels(userID int64) (model.Labels, error) {
+	query := `
+		SELECT
+			l.id,
+			l.user_id,
+			l.title,
+			(SELECT count(*) FROM entry_labels WHERE entry_labels.label_id=l.id) AS count
+		FROM labels l
+		WHERE
+			user_id=$1
+		ORDER BY l.title ASC
+	`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch labels: %v`, err)
+	}
+	defer rows.Close()
+
+	labels := make(model.Labels, 0)
+	for rows.Next() {
+		var label model.Label
+		if err := rows.Scan(&label.ID, &label.UserID, &label.Title, &label.EntryCount); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch label row: %v`, err)
+		}
+
+		labels = append(labels, &label)
+	}
+
+	return labels, nil
+}
+
+// CreateLabel creates a new label.
+func (s *Storage) CreateLabel(label *model.Label) error {
+	query := `INSERT INTO labels (user_id, title) VALUES ($1, $2) RETURNING id`
+	if err := s.db.QueryRow(query, label.UserID, label.Title).Scan(&label.ID); err != nil {
+		return fmt.Errorf(`store: unable to create label: %v`, err)
+	}
+
+	return nil
+}
+
+// UpdateLabel updates an existing label.
+func (s *Storage) UpdateLabel(label *model.Label) error {
+	query := `UPDATE labels SET title=$1 WHERE id=$2 AND user_id=$3`
+	if _, err := s.db.Exec(query, label.Title, label.ID, label.UserID); err != nil {
+		return fmt.Errorf(`store: unable to update label: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveLabel deletes a label, the entries are kept.
+func (s *Storage) RemoveLabel(userID, labelID int64) error {
+	query := `DELETE FROM labels WHERE id = $1 AND user_id = $2`
+	result, err := s.db.Exec(query, labelID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this label: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this label: %v`, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no label has been removed`)
+	}
+
+	return nil
+}
+
+// EntryLabels returns the labels attached to an entry.
+func (s *Storage) EntryLabels(userID, entryID int64) (model.Labels, error) {
+	labels, err := s.entriesLabels(userID, []int64{entryID})
+	if err != nil {
+		return nil, err
+	}
+
+	if labels[entryID] == nil {
+		return make(model.Labels, 0), nil
+	}
+
+	return labels[entryID], nil
+}
+
+// entriesLabels returns the labels of the given entries, indexed by entry ID.
+func (s *Storage) entriesLabels(userID int64, entryIDs []int64) (map[int64]model.Labels, error) {
+	query := `
+		SELECT
+			el.entry_id, l.id, l.user_id, l.title
+		FROM
+			entry_labels el
+		JOIN
+			labels l ON l.id=el.label_id
+		WHERE
+			el.user_id=$1 AND el.entry_id = ANY($2)
+		ORDER BY
+			l.title ASC
+	`
+	rows, err := s.db.Query(query, userID, pq.Array(entryIDs))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entry labels: %v`, err)
+	}
+	defer rows.Close()
+
+	labels := make(map[int64]model.Labels)
+	for rows.Next() {
+		var entryID int64
+		var label model.Label
+		if err := rows.Scan(&entryID, &label.ID, &label.UserID, &label.Title); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry label row: %v`, err)
+		}
+
+		labels[entryID] = append(labels[entryID], &label)
+	}
+
+	return labels, nil
+}
+
+// SetEntryLabels replaces the labels of an entry, the labels that don't belong to the user are ignored.
+func (s *Storage) SetEntryLabels(userID, entryID int64, labelIDs []int64) error {
+	if labelIDs == nil {
+		labelIDs = []int64{}
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	query := `DELETE FROM entry_labels WHERE user_id=$1 AND entry_id=$2 AND label_id != ALL($3)`
+	if _, err := tx.Exec(query, userID, entryID, pq.Array(labelIDs)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to remove labels of entry #%d: %v`, entryID, err)
+	}
+
+	query = `
+		INSERT INTO entry_labels
+			(user_id, entry_id, label_id)
+		SELECT
+			$1, e.id, l.id
+		FROM
+			entries e
+		JOIN
+			labels l ON l.user_id=e.user_id
+		WHERE
+			e.user_id=$1 AND e.id=$2 AND l.id = ANY($3)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := tx.Exec(query, userID, entryID, pq.Array(labelIDs)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to add labels to entry #%d: %v`, entryID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit labels of entry #%d: %v`, entryID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+)
+
+// NewsletterToken returns the token of the newsletter address of the given user, the token is generated on first use.
+func (s *Storage) NewsletterToken(userID int64) (string, error) {
+	var token string
+	err := s.db.QueryRow(`SELECT token FROM newsletter_tokens WHERE user_id=$1`, userID).Scan(&token)
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return "", fmt.Errorf(`store: unable to fetch newsletter token: %v`, err)
+	default:
+		return token, nil
+	}
+
+	query := `
+		INSERT INTO newsletter_tokens
+			(user_id, token)
+		VALUES
+			($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+			SET user_id=EXCLUDED.user_id
+		RETURNING
+			token
+	`
+	if err := s.db.QueryRow(query, userID, crypto.GenerateRandomStringHex(12)).Scan(&token); err != nil {
+		return "", fmt.Errorf(`store: unable to create newsletter token: %v`, err)
+	}
+
+	return token, nil
+}
+
+// UserByNewsletterToken returns the user owning the given newsletter token.
+func (s *Storage) UserByNewsletterToken(token string) (*model.User, error) {
+	var userID int64
+	err := s.db.QueryRow(`SELECT user_id FROM newsletter_tokens WHERE token=$1`, token).Scan(&userID)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch user by newsletter token: %v`, err)
+	}
+
+	return s.UserByID(userID)
+}
+
+// NewsletterFeed returns the feed receiving the newsletters of the given sender.
+func (s *Storage) NewsletterFeed(userID int64, sender string) (*model.Feed, error) {
+	var feedID int64
+	query := `SELECT id FROM feeds WHERE user_id=$1 AND source_type=$2 AND source_settings->'newsletter'->>'sender'=$3 ORDER BY id LIMIT 1`
+	err := s.db.QueryRow(query, userID, model.FeedSourceTypeNewsletter, sender).Scan(&feedID)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch newsletter feed for %q: %v`, sender, err)
+	}
+
+	return s.FeedByID(userID, feedID)
+}
+
+// CreateNewsletterImage saves an inline image of a newsletter.
+func (s *Storage) CreateNewsletterImage(image *model.NewsletterImage) error {
+	query := `
+		INSERT INTO newsletter_images
+			(user_id, entry_id, token, mime_type, content)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		image.UserID,
+		image.EntryID,
+		image.Token,
+		image.MimeType,
+		image.Content,
+	).Scan(&image.ID, &image.CreatedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create newsletter image: %v`, err)
+	}
+
+	return nil
+}
+
+// NewsletterImage returns the newsletter image identified by the given token.
+func (s *Storage) NewsletterImage(token string) (*model.NewsletterImage, error) {
+	var image model.NewsletterImage
+	query := `SELECT id, user_id, entry_id, token, mime_type, content, created_at FROM newsletter_images WHERE token=$1`
+	err := s.db.QueryRow(query, token).Scan(
+		&image.ID,
+		&image.UserID,
+		&image.EntryID,
+		&image.Token,
+		&image.MimeType,
+		&image.Content,
+		&image.CreatedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch newsletter image: %v`, err)
+	}
+
+	return &image, nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// PageSnapshot returns the last snapshot of a monitored page.
+func (s *Storage) PageSnapshot(feedID int64) (*model.PageSnapshot, error) {
+	var snapshot model.PageSnapshot
+	query := `SELECT feed_id, content, created_at FROM page_snapshots WHERE feed_id=$1`
+	err := s.db.QueryRow(query, feedID).Scan(&snapshot.FeedID, &snapshot.Content, &snapshot.CreatedAt)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch page snapshot for feed #%d: %v`, feedID, err)
+	}
+
+	return &snapshot, nil
+}
+
+// UpdatePageSnapshot replaces the snapshot of a monitored page.
+func (s *Storage) UpdatePageSnapshot(feedID int64, content string) error {
+	query := `
+		INSERT INTO page_snapshots
+			(feed_id, content, created_at)
+		VALUES
+			($1, $2, now())
+		ON CONFLICT (feed_id) DO UPDATE
+			SET content=EXCLUDED.content, created_at=EXCLUDED.created_at
+	`
+	if _, err := s.db.Exec(query, feedID, content); err != nil {
+		return fmt.Errorf(`store: unable to update page snapshot for feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,257 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// AnotherSmartFolderExists checks if another smart folder exists with the same title.
+func (s *Storage) AnotherSmartFolderExists(userID, folderID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM smart_folders WHERE user_id=$1 AND id != $2 AND title=$3`
+	s.db.QueryRow(query, userID, folderID, title).Scan(&result)
+	return result
+}
+
+// SmartFolder returns a smart folder from the database with its number of unread entries.
+func (s *Storage) SmartFolder(userID, folderID int64) (*model.SmartFolder, error) {
+	query := `
+		SELECT
+			id, user_id, title, search_query, feed_ids, category_ids, label_ids, status, published_after, published_before
+		FROM
+			smart_folders
+		WHERE
+			user_id=$1 AND id=$2
+	`
+	folder, err := scanSmartFolder(s.db.QueryRow(query, userID, folderID))
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch smart folder: %v`, err)
+	}
+
+	folder.UnreadCount, err = s.countSmartFolderUnreadEntries(folder)
+	if err != nil {
+		return nil, err
+	}
+
+	return folder, nil
+}
+
+// SmartFolders returns all smart folders of the given user with their number of unread entries.
+func (s *Storage) SmartFolders(userID int64) (model.SmartFolders, error) {
+	query := `
+		SELECT
+			id, user_id, title, search_query, feed_ids, category_ids, label_ids, status, published_after, published_before
+		FROM
+			smart_folders
+		WHERE
+			user_id=$1
+		ORDER BY
+			title ASC
+	`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch smart folders: %v`, err)
+	}
+	defer rows.Close()
+
+	folders := make(model.SmartFolders, 0)
+	for rows.Next() {
+		folder, err := scanSmartFolder(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch smart folder row: %v`, err)
+		}
+
+		folders = append(folders, folder)
+	}
+
+	for _, folder := range folders {
+		folder.UnreadCount, err = s.countSmartFolderUnreadEntries(folder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return folders, nil
+}
+
+func (s *Storage) countSmartFolderUnreadEntries(folder *model.SmartFolder) (int, error) {
+	builder := NewEntryQueryBuilder(s, folder.UserID)
+	builder.WithSmartFolder(folder)
+	builder.WithStatus(model.EntryStatusUnread)
+	return builder.CountEntries()
+}
+
+// CreateSmartFolder creates a new smart folder.
+func (s *Storage) CreateSmartFolder(folder *model.SmartFolder) error {
+	query := `
+		INSERT INTO smart_folders
+			(user_id, title, search_query, feed_ids, category_ids, label_ids, status, published_after, published_before)
+		VALUES
+			($1, $2, $3, coalesce($4::bigint[], '{}'), coalesce($5::bigint[], '{}'), coalesce($6::bigint[], '{}'), $7, $8, $9)
+		RETURNING
+			id
+	`
+	err := s.db.QueryRow(
+		query,
+		folder.UserID,
+		folder.Title,
+		folder.SearchQuery,
+		pq.Array(folder.FeedIDs),
+		pq.Array(folder.CategoryIDs),
+		pq.Array(folder.LabelIDs),
+		folder.Status,
+		folder.PublishedAfter,
+		folder.PublishedBefore,
+	).Scan(&folder.ID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create smart folder: %v`, err)
+	}
+
+	return nil
+}
+
+// UpdateSmartFolder updates an existing smart folder.
+func (s *Storage) UpdateSmartFolder(folder *model.SmartFolder) error {
+	query := `
+		UPDATE
+			smart_folders
+		SET
+			title=$1,
+			search_query=$2,
+			feed_ids=coalesce($3::bigint[], '{}'),
+			category_ids=coalesce($4::bigint[], '{}'),
+			label_ids=coalesce($5::bigint[], '{}'),
+			status=$6,
+			published_after=$7,
+			published_before=$8
+		WHERE
+			id=$9 AND user_id=$10
+	`
+	_, err := s.db.Exec(
+		query,
+		folder.Title,
+		folder.SearchQuery,
+		pq.Array(folder.FeedIDs),
+		pq.Array(folder.CategoryIDs),
+		pq.Array(folder.LabelIDs),
+		folder.Status,
+		folder.PublishedAfter,
+		folder.PublishedBefore,
+		folder.ID,
+		folder.UserID,
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update smart folder: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveSmartFolder deletes a smart folder, the entries are kept.
+func (s *Storage) RemoveSmartFolder(userID, folderID int64) error {
+	query := `DELETE FROM smart_folders WHERE id = $1 AND user_id = $2`
+	result, err := s.db.Exec(query, folderID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this smart folder: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this smart folder: %v`, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no smart folder has been removed`)
+	}
+
+	return nil
+}
+
+type smartFolderScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSmartFolder(row smartFolderScanner) (*model.SmartFolder, error) {
+	var folder model.SmartFolder
+	var publishedAfter, publishedBefore pq.NullTime
+
+	err := row.Scan(
+		&folder.ID,
+		&folder.UserID,
+		&folder.Title,
+		&folder.SearchQuery,
+		pq.Array(&folder.FeedIDs),
+		pq.Array(&folder.CategoryIDs),
+		pq.Array(&folder.LabelIDs),
+		&folder.Status,
+		&publishedAfter,
+		&publishedBefore,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if publishedAfter.Valid {
+		folder.PublishedAfter = &publishedAfter.Time
+	}
+
+	if publishedBefore.Valid {
+		folder.PublishedBefore = &publishedBefore.Time
+	}
+
+	return &folder, nil
+}
+
+// smartFolderConditions appends the criteria of the smart folder to the conditions of an entry query,
+// the entries table is aliased "e" and the feeds table "f".
+func smartFolderConditions(folder *model.SmartFolder, conditions []string, args []interface{}) ([]string, []interface{}) {
+	if folder.SearchQuery != "" {
+		conditions = append(conditions, fmt.Sprintf("e.document_vectors @@ plainto_tsquery('chinese', $%d)", len(args)+1))
+		args = append(args, folder.SearchQuery)
+	}
+
+	if len(folder.FeedIDs) > 0 {
+		conditions = append(conditions, fmt.Sprintf("e.feed_id = ANY($%d)", len(args)+1))
+		args = append(args, pq.Array(folder.FeedIDs))
+	}
+
+	if len(folder.CategoryIDs) > 0 {
+		conditions = append(conditions, fmt.Sprintf("f.category_id = ANY($%d)", len(args)+1))
+		args = append(args, pq.Array(folder.CategoryIDs))
+	}
+
+	if len(folder.LabelIDs) > 0 {
+		conditions = append(conditions, fmt.Sprintf("EXISTS (SELECT 1 FROM entry_labels el WHERE el.entry_id=e.id AND el.label_id = ANY($%d))", len(args)+1))
+		args = append(args, pq.Array(folder.LabelIDs))
+	}
+
+	if folder.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("e.status = $%d", len(args)+1))
+		args = append(args, folder.Status)
+	}
+
+	if folder.PublishedAfter != nil {
+		conditions = append(conditions, fmt.Sprintf("e.published_at >= $%d", len(args)+1))
+		args = append(args, *folder.PublishedAfter)
+	}
+
+	if folder.PublishedBefore != nil {
+		conditions = append(conditions, fmt.Sprintf("e.published_at < $%d", len(args)+1))
+		args = append(args, *folder.PublishedBefore)
+	}
+
+	return conditions, args
+}
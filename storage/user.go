@@ -124,9 +124,11 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				timezone=$6,
 				entry_direction=$7,
 				keyboard_shortcuts=$8,
-				mercury_parser_api_url=$9
+				mercury_parser_api_url=$9,
+				entry_revisions_limit=$10,
+				unread_on_update=$11
 			WHERE
-				id=$10
+				id=$12
 		`
 
 		_, err = s.db.Exec(
@@ -140,6 +142,8 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.EntryDirection,
 			user.KeyboardShortcuts,
 			user.MercuryAPIURL,
+			user.EntryRevisionsLimit,
+			user.UnreadOnUpdate,
 			user.ID,
 		)
 		if err != nil {
@@ -155,9 +159,11 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				timezone=$5,
 				entry_direction=$6,
 				keyboard_shortcuts=$7,
-				mercury_parser_api_url=$8
+				mercury_parser_api_url=$8,
+				entry_revisions_limit=$9,
+				unread_on_update=$10
 			WHERE
-				id=$9
+				id=$11
 		`
 
 		_, err := s.db.Exec(
@@ -170,6 +176,8 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.EntryDirection,
 			user.KeyboardShortcuts,
 			user.MercuryAPIURL,
+			user.EntryRevisionsLimit,
+			user.UnreadOnUpdate,
 			user.ID,
 		)
 
@@ -208,6 +216,8 @@ func (s *Storage) UserByID(userID int64) (*model.User, error) {
 			entry_direction,
 			keyboard_shortcuts,
 			mercury_parser_api_url,
+			entry_revisions_limit,
+			unread_on_update,
 			last_login_at,
 			extra
 		FROM
@@ -232,6 +242,8 @@ func (s *Storage) UserByUsername(username string) (*model.User, error) {
 			entry_direction,
 			keyboard_shortcuts,
 			mercury_parser_api_url,
+			entry_revisions_limit,
+			unread_on_update,
 			last_login_at,
 			extra
 		FROM
@@ -255,6 +267,8 @@ func (s *Storage) UserByExtraField(field, value string) (*model.User, error) {
 			entry_direction,
 			keyboard_shortcuts,
 			mercury_parser_api_url,
+			entry_revisions_limit,
+			unread_on_update,
 			last_login_at,
 			extra
 		FROM
@@ -278,6 +292,8 @@ func (s *Storage) UserByAPIKey(token string) (*model.User, error) {
 			u.entry_direction,
 			u.keyboard_shortcuts,
 			u.mercury_parser_api_url,
+			u.entry_revisions_limit,
+			u.unread_on_update,
 			u.last_login_at,
 			u.extra
 		FROM
@@ -304,6 +320,8 @@ func (s *Storage) fetchUser(query string, args ...interface{}) (*model.User, err
 		&user.EntryDirection,
 		&user.KeyboardShortcuts,
 		&user.MercuryAPIURL,
+		&user.EntryRevisionsLimit,
+		&user.UnreadOnUpdate,
 		&user.LastLoginAt,
 		&extra,
 	)
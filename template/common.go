@@ -50,6 +50,11 @@ var templateCommonMap = map[string]string{
                     <li>
                         {{ t "page.feeds.last_check" }} <time datetime="{{ isodate .CheckedAt }}" title="{{ isodate .CheckedAt }}">{{ elapsed $.user.Timezone .CheckedAt }}</time>
                     </li>
+                    {{ if .SuppressedCount }}
+                    <li>
+                        {{ t "page.feeds.suppressed_count" .SuppressedCount }}
+                    </li>
+                    {{ end }}
                 </ul>
                 <ul class="item-meta-info">
                     <li>
@@ -98,6 +103,58 @@ var templateCommonMap = map[string]string{
     </li>
 </ul>
 {{ end }}`,
+	"filter_rule_fields": `{{ define "filter_rule_fields" }}
+<label for="form-scope">{{ t "form.filter_rule.label.scope" }}</label>
+<select id="form-scope" name="scope">
+    <option value="">{{ t "form.filter_rule.scope.all" }}</option>
+    {{ if .categories }}
+    <optgroup label="{{ t "form.filter_rule.scope.categories" }}">
+        {{ range .categories }}
+            {{ $scope := printf "category:%d" .ID }}
+            <option value="{{ $scope }}" {{ if eq $scope $.form.Scope }}selected="selected"{{ end }}>{{ .Title }}</option>
+        {{ end }}
+    </optgroup>
+    {{ end }}
+    {{ if .feeds }}
+    <optgroup label="{{ t "form.filter_rule.scope.feeds" }}">
+        {{ range .feeds }}
+            {{ $scope := printf "feed:%d" .ID }}
+            <option value="{{ $scope }}" {{ if eq $scope $.form.Scope }}selected="selected"{{ end }}>{{ .Title }}</option>
+        {{ end }}
+    </optgroup>
+    {{ end }}
+</select>
+
+<label for="form-field">{{ t "form.filter_rule.label.field" }}</label>
+<select id="form-field" name="field">
+    {{ range $field := .filterFields }}
+        <option value="{{ $field }}" {{ if eq $field $.form.Field }}selected="selected"{{ end }}>{{ t (printf "filter_rule.field.%s" $field) }}</option>
+    {{ end }}
+</select>
+
+<label for="form-operator">{{ t "form.filter_rule.label.operator" }}</label>
+<select id="form-operator" name="operator">
+    {{ range $operator := .filterOperators }}
+        <option value="{{ $operator }}" {{ if eq $operator $.form.Operator }}selected="selected"{{ end }}>{{ t (printf "filter_rule.operator.%s" $operator) }}</option>
+    {{ end }}
+</select>
+
+<label for="form-value">{{ t "form.filter_rule.label.value" }}</label>
+<input type="text" name="value" id="form-value" value="{{ .form.Value }}" spellcheck="false">
+<p>{{ t "form.filter_rule.help.value" }}</p>
+
+<label for="form-action">{{ t "form.filter_rule.label.action" }}</label>
+<select id="form-action" name="action">
+    {{ range $action := .filterActions }}
+        <option value="{{ $action }}" {{ if eq $action $.form.Action }}selected="selected"{{ end }}>{{ t (printf "filter_rule.action.%s" $action) }}</option>
+    {{ end }}
+</select>
+
+<label for="form-action-value">{{ t "form.filter_rule.label.action_value" }}</label>
+<input type="text" name="action_value" id="form-action-value" value="{{ .form.ActionValue }}">
+<p>{{ t "form.filter_rule.help.action_value" }}</p>
+{{ end }}
+`,
 	"icons": `<!--
 
 MIT License
@@ -186,6 +243,15 @@ SOFTWARE.
     <polyline points="15 4 20 4 20 9" />
 </svg>
 {{ end }}
+{{ define "icon_highlight" }}
+<svg xmlns="http://www.w3.org/2000/svg" class="icon icon-tabler icon-tabler-highlight" width="24" height="24" viewBox="0 0 24 24" stroke-width="2" stroke="currentColor" fill="none" stroke-linecap="round" stroke-linejoin="round">
+    <path stroke="none" d="M0 0h24v24H0z"/>
+    <path d="M3 19h4l10.5 -10.5a2.828 2.828 0 1 0 -4 -4l-10.5 10.5v4" />
+    <line x1="12.5" y1="5.5" x2="16.5" y2="9.5" />
+    <line x1="4.5" y1="13.5" x2="8.5" y2="17.5" />
+    <path d="M21 15v4h-8l4 -4z" />
+</svg>
+{{ end }}
 {{ define "icon_delete" }}
 <svg xmlns="http://www.w3.org/2000/svg" class="icon icon-tabler icon-tabler-trash" width="24" height="24" viewBox="0 0 24 24" stroke-width="2" stroke="currentColor" fill="none" stroke-linecap="round" stroke-linejoin="round">
     <path stroke="none" d="M0 0h24v24H0z"/>
@@ -196,6 +262,19 @@ SOFTWARE.
     <path d="M9 7v-3a1 1 0 0 1 1 -1h4a1 1 0 0 1 1 1v3" />
 </svg>
 {{ end }}`,
+	"item_cluster": `{{ define "item_cluster" }}
+<div class="item-cluster">
+    <span>{{ t "entry.cluster.also_covered_by" }}</span>
+    <ul>
+        {{ range . }}
+        <li>
+            <a href="{{ route "feedEntry" "feedID" .FeedID "entryID" .ID }}" title="{{ .Title }}">{{ .Feed.Title }}</a>
+        </li>
+        {{ end }}
+    </ul>
+</div>
+{{ end }}
+`,
 	"item_meta": `{{ define "item_meta" }}
 <div class="item-meta">
     <ul class="item-meta-info">
@@ -205,6 +284,20 @@ SOFTWARE.
         <li>
             <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ elapsed .user.Timezone .entry.Date }}</time>
         </li>
+        {{ if .entry.Labels }}
+        <li>
+            <ul class="entry-labels">
+            {{ range .entry.Labels }}
+                <li><a href="{{ route "labelEntries" "labelID" .ID }}">{{ .Title }}</a></li>
+            {{ end }}
+            </ul>
+        </li>
+        {{ end }}
+        {{ if .entry.RevisionCount }}
+        <li>
+            <a href="{{ route "entryRevisions" "entryID" .entry.ID }}" class="entry-updated">{{ plural "entry.revisions.updated" .entry.RevisionCount .entry.RevisionCount }}</a>
+        </li>
+        {{ end }}
     </ul>
     <ul class="item-meta-icons">
         {{ if .entry.ShareCode }}
@@ -345,6 +438,15 @@ SOFTWARE.
                 <li {{ if eq .menu "categories" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g c" }}">
                     <a href="{{ route "categories" }}" data-page="categories">{{ t "menu.categories" }}</a>
                 </li>
+                <li {{ if eq .menu "labels" }}class="active"{{ end }}>
+                    <a href="{{ route "labels" }}" data-page="labels">{{ t "menu.labels" }}</a>
+                </li>
+                <li {{ if eq .menu "folders" }}class="active"{{ end }}>
+                    <a href="{{ route "smartFolders" }}" data-page="folders">{{ t "menu.smart_folders" }}</a>
+                </li>
+                <li {{ if eq .menu "highlights" }}class="active"{{ end }}>
+                    <a href="{{ route "highlights" }}" data-page="highlights">{{ t "menu.highlights" }}</a>
+                </li>
                 <li {{ if eq .menu "settings" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g s" }}">
                     <a href="{{ route "settings" }}" data-page="settings">{{ t "menu.settings" }}</a>
                 </li>
@@ -424,6 +526,14 @@ SOFTWARE.
 </body>
 </html>
 {{ end }}
+`,
+	"page_monitor_fields": `{{ define "page_monitor_fields" }}
+<label for="form-monitor-selector">{{ t "form.feed.label.monitor_selector" }}</label>
+<input type="text" name="monitor_selector" id="form-monitor-selector" placeholder="#content" value="{{ .MonitorSelector }}">
+
+<label for="form-monitor-threshold">{{ t "form.feed.label.monitor_threshold" }}</label>
+<input type="number" name="monitor_threshold" id="form-monitor-threshold" min="0" max="100" value="{{ .MonitorThreshold }}">
+{{ end }}
 `,
 	"pagination": `{{ define "pagination" }}
 <div class="pagination">
@@ -453,6 +563,9 @@ SOFTWARE.
     <li>
         <a href="{{ route "integrations" }}">{{ t "menu.integrations" }}</a>
     </li>
+    <li>
+        <a href="{{ route "filterRules" }}">{{ t "menu.filter_rules" }}</a>
+    </li>
     <li>
         <a href="{{ route "apiKeys" }}">{{ t "menu.api_keys" }}</a>
     </li>
@@ -469,15 +582,86 @@ SOFTWARE.
     </li>
 </ul>
 {{ end }}`,
+	"smart_folder_fields": `{{ define "smart_folder_fields" }}
+<label for="form-title">{{ t "form.smart_folder.label.title" }}</label>
+<input type="text" name="title" id="form-title" value="{{ .form.Title }}" required autofocus>
+
+<label for="form-search-query">{{ t "form.smart_folder.label.search_query" }}</label>
+<input type="text" name="search_query" id="form-search-query" value="{{ .form.SearchQuery }}">
+
+{{ if .feeds }}
+<label for="form-feeds">{{ t "form.smart_folder.label.feeds" }}</label>
+<select id="form-feeds" name="feed_id" multiple>
+    {{ range .feeds }}
+        <option value="{{ .ID }}" {{ if $.form.HasFeed .ID }}selected="selected"{{ end }}>{{ .Title }}</option>
+    {{ end }}
+</select>
+{{ end }}
+
+{{ if .categories }}
+<label for="form-categories">{{ t "form.smart_folder.label.categories" }}</label>
+<select id="form-categories" name="category_id" multiple>
+    {{ range .categories }}
+        <option value="{{ .ID }}" {{ if $.form.HasCategory .ID }}selected="selected"{{ end }}>{{ .Title }}</option>
+    {{ end }}
+</select>
+{{ end }}
+
+{{ if .labels }}
+<label for="form-labels">{{ t "form.smart_folder.label.labels" }}</label>
+<select id="form-labels" name="label_id" multiple>
+    {{ range .labels }}
+        <option value="{{ .ID }}" {{ if $.form.HasLabel .ID }}selected="selected"{{ end }}>{{ .Title }}</option>
+    {{ end }}
+</select>
+{{ end }}
+<p>{{ t "form.smart_folder.help.selection" }}</p>
+
+<label for="form-status">{{ t "form.smart_folder.label.status" }}</label>
+<select id="form-status" name="status">
+    <option value="">{{ t "form.smart_folder.status.all" }}</option>
+    <option value="unread" {{ if eq .form.Status "unread" }}selected="selected"{{ end }}>{{ t "form.smart_folder.status.unread" }}</option>
+    <option value="read" {{ if eq .form.Status "read" }}selected="selected"{{ end }}>{{ t "form.smart_folder.status.read" }}</option>
+</select>
+
+<label for="form-published-after">{{ t "form.smart_folder.label.published_after" }}</label>
+<input type="date" name="published_after" id="form-published-after" value="{{ .form.PublishedAfter }}" placeholder="YYYY-MM-DD">
+
+<label for="form-published-before">{{ t "form.smart_folder.label.published_before" }}</label>
+<input type="date" name="published_before" id="form-published-before" value="{{ .form.PublishedBefore }}" placeholder="YYYY-MM-DD">
+{{ end }}
+`,
+	"web_page_rules": `{{ define "web_page_rules_fields" }}
+<label for="form-item-selector">{{ t "form.feed.label.item_selector" }}</label>
+<input type="text" name="item_selector" id="form-item-selector" placeholder="article.post" value="{{ .ItemSelector }}">
+
+<label for="form-title-selector">{{ t "form.feed.label.title_selector" }}</label>
+<input type="text" name="title_selector" id="form-title-selector" placeholder="h2" value="{{ .TitleSelector }}">
+
+<label for="form-link-selector">{{ t "form.feed.label.link_selector" }}</label>
+<input type="text" name="link_selector" id="form-link-selector" placeholder="h2 a" value="{{ .LinkSelector }}">
+
+<label for="form-date-selector">{{ t "form.feed.label.date_selector" }}</label>
+<input type="text" name="date_selector" id="form-date-selector" placeholder="time" value="{{ .DateSelector }}">
+
+<label for="form-summary-selector">{{ t "form.feed.label.summary_selector" }}</label>
+<input type="text" name="summary_selector" id="form-summary-selector" placeholder="p.summary" value="{{ .SummarySelector }}">
+{{ end }}
+`,
 }
 
 var templateCommonMapChecksums = map[string]string{
 	"entry_pagination": "cdca9cf12586e41e5355190b06d9168f57f77b85924d1e63b13524bc15abcbf6",
-	"feed_list":        "46cbfc441404dc55c56a9fd7ddc43d98216762f2562f2a17e35eb508dc84246f",
-	"feed_menu":        "318d8662dda5ca9dfc75b909c8461e79c86fb5082df1428f67aaf856f19f4b50",
-	"icons":            "f0d94c2cfa6655b44adaf97f0b95c52a9cff5c31f3a8829ad438e4db7114af7e",
-	"item_meta":        "a5b07cc6597e5c8f3ca849ee486acb3f16f062d8a1eaa47d2fb402ae6825b7ef",
-	"layout":           "a4ed0b69bf16342166358ca9c3cf23c27d61443eca2e5da9fa46ff7474afe55b",
-	"pagination":       "7b61288e86283c4cf0dc83bcbf8bf1c00c7cb29e60201c8c0b633b2450d2911f",
-	"settings_menu":    "e2b777630c0efdbc529800303c01d6744ed3af80ec505ac5a5b3f99c9b989156",
+	"feed_list": "7746dec68a5dcbe4248ece06d22680dfe5521c5f2a6c4cbd7e908a4269a70ff1",
+	"feed_menu": "318d8662dda5ca9dfc75b909c8461e79c86fb5082df1428f67aaf856f19f4b50",
+	"filter_rule_fields": "77b9b90de0a0e8e98874111c06b0e50afa49f583c9751220e01cc1d955e20936",
+	"icons": "0a3ff02227d2f7877e4f35e15145bb7dd5f3ad392ca20d4c02daddf98039683c",
+	"item_cluster": "7089ea561e9690e83891e8eff0f160a29283da1ff329259ea046beb10138ee33",
+	"item_meta": "97799a51a06cd29cb0c969b33cd75fd05649850093536290d902320afcc04ad2",
+	"layout": "2ba6134df65da3636d0d33b7a8622d32f6625ce2dbf448913ac452eb3ee947e0",
+	"page_monitor_fields": "b213769b6f6c3c91ea879a4ad634a135188e6ccac823f1128df094b6ffd2cb3f",
+	"pagination": "7b61288e86283c4cf0dc83bcbf8bf1c00c7cb29e60201c8c0b633b2450d2911f",
+	"settings_menu": "406d697ed354894ed320ff1566b7810d42c7639ba1869d0dd076ef0f7fbaa4c3",
+	"smart_folder_fields": "63b3451e8a6173de2f90c043ac4d220f3590693093a16bf72fa877ec7cfbff72",
+	"web_page_rules": "3f6814380ddc38793f838c5aeba75edff836f3d80beb8c5d11f60217208894b3",
 }
@@ -57,6 +57,31 @@ var templateViewsMap = map[string]string{
             {{ end }}
         </select>
 
+        <label for="form-source-type">{{ t "form.feed.label.source_type" }}</label>
+        <select id="form-source-type" name="source_type">
+            <option value="feed">{{ t "form.feed.source_type.feed" }}</option>
+            <option value="web_page" {{ if eq .form.SourceType "web_page" }}selected="selected"{{ end }}>{{ t "form.feed.source_type.web_page" }}</option>
+            <option value="page_monitor" {{ if eq .form.SourceType "page_monitor" }}selected="selected"{{ end }}>{{ t "form.feed.source_type.page_monitor" }}</option>
+            {{ if and .user.IsAdmin hasLocalSources }}
+            <option value="file" {{ if eq .form.SourceType "file" }}selected="selected"{{ end }}>{{ t "form.feed.source_type.file" }}</option>
+            <option value="command" {{ if eq .form.SourceType "command" }}selected="selected"{{ end }}>{{ t "form.feed.source_type.command" }}</option>
+            {{ end }}
+        </select>
+
+        <details {{ if .form.IsWebPage }}open{{ end }}>
+            <summary>{{ t "page.add_feed.legend.web_page" }}</summary>
+            <div class="details-content">
+                {{ template "web_page_rules_fields" .form }}
+            </div>
+        </details>
+
+        <details {{ if .form.IsPageMonitor }}open{{ end }}>
+            <summary>{{ t "page.add_feed.legend.page_monitor" }}</summary>
+            <div class="details-content">
+                {{ template "page_monitor_fields" .form }}
+            </div>
+        </details>
+
         <details>
             <summary>{{ t "page.add_feed.legend.advanced_options" }}</summary>
             <div class="details-content">
@@ -79,17 +104,39 @@ var templateViewsMap = map[string]string{
                 <input type="text" name="feed_password" id="form-feed-password" value="{{ .form.Password }}">
 
                 <label for="form-scraper-rules">{{ t "form.feed.label.scraper_rules" }}</label>
-                <input type="text" name="scraper_rules" id="form-scraper-rules" value="{{ .form.ScraperRules }}">
+                <input type="text" name="scraper_rules" id="form-scraper-rules" value="{{ .form.ScraperRules }}" spellcheck="false">
+                <p>{{ t "form.feed.help.scraper_rules" }}</p>
 
                 <label for="form-rewrite-rules">{{ t "form.feed.label.rewrite_rules" }}</label>
-                <input type="text" name="rewrite_rules" id="form-rewrite-rules" value="{{ .form.RewriteRules }}">
+                <input type="text" name="rewrite_rules" id="form-rewrite-rules" value="{{ .form.RewriteRules }}" spellcheck="false">
+                <p>{{ t "form.feed.help.rewrite_rules" }}</p>
             </div>
         </details>
 
         <div class="buttons">
             <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.loading" }}">{{ t "page.add_feed.submit" }}</button>
+            <button type="submit" class="button" name="preview" value="1">{{ t "page.add_feed.preview" }}</button>
         </div>
     </form>
+
+    {{ if .previewEnabled }}
+    <div class="panel">
+        <h3>{{ t "page.add_feed.preview_title" }}</h3>
+        {{ if .previewEntries }}
+            <ul>
+            {{ range .previewEntries }}
+                <li>
+                    <strong><a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .Title }}</a></strong>
+                    <small><time datetime="{{ isodate .Date }}">{{ isodate .Date }}</time></small>
+                    {{ if .Content }}<p>{{ truncate .Content 200 }}</p>{{ end }}
+                </li>
+            {{ end }}
+            </ul>
+        {{ else }}
+            <p>{{ t "page.add_feed.preview_empty" }}</p>
+        {{ end }}
+    </div>
+    {{ end }}
 {{ end }}
 
 {{ end }}
@@ -285,7 +332,7 @@ var templateViewsMap = map[string]string{
 {{ if not .entries }}
     <p class="alert">{{ t "alert.no_category_entry" }}</p>
 {{ else }}
-    <div class="items">
+    <div class="items"{{ if .showOnlyUnreadEntries }} data-collapsed-clusters="true"{{ end }}>
         {{ range .entries }}
         <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
             <div class="item-header">
@@ -298,6 +345,11 @@ var templateViewsMap = map[string]string{
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
             {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry  }}
+            {{ if $.clusters }}
+                {{ with index $.clusters .ID }}
+                    {{ template "item_cluster" . }}
+                {{ end }}
+            {{ end }}
         </article>
         {{ end }}
     </div>
@@ -442,6 +494,56 @@ var templateViewsMap = map[string]string{
     </div>
 </form>
 {{ end }}
+`,
+	"create_filter_rule": `{{ define "title"}}{{ t "page.new_filter_rule.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.new_filter_rule.title" }}</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+<form action="{{ route "saveFilterRule" }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    {{ template "filter_rule_fields" . }}
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "filterRules" }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
+`,
+	"create_smart_folder": `{{ define "title"}}{{ t "page.new_smart_folder.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.new_smart_folder.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "smartFolders" }}">{{ t "menu.smart_folders" }}</a>
+        </li>
+    </ul>
+</section>
+
+<form action="{{ route "saveSmartFolder" }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    {{ template "smart_folder_fields" . }}
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "smartFolders" }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
 `,
 	"create_user": `{{ define "title"}}{{ t "page.new_user.title" }}{{ end }}
 
@@ -490,6 +592,9 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "createCategory" }}">{{ t "menu.create_category" }}</a>
         </li>
+        <li>
+            <a href="{{ route "createFilterRule" }}?category_id={{ .category.ID }}">{{ t "menu.create_filter_rule" }}</a>
+        </li>
     </ul>
 </section>
 
@@ -503,6 +608,32 @@ var templateViewsMap = map[string]string{
     <label for="form-title">{{ t "form.category.label.title" }}</label>
     <input type="text" name="title" id="form-title" value="{{ .form.Title }}" required autofocus>
 
+    <p>{{ t "form.category.help.default_settings" }}</p>
+
+    <label><input type="checkbox" name="crawler" value="1" {{ if .form.Crawler }}checked{{ end }}> {{ t "form.feed.label.crawler" }}</label>
+
+    <label for="form-user-agent">{{ t "form.feed.label.user_agent" }}</label>
+    <input type="text" name="user_agent" id="form-user-agent" placeholder="{{ .defaultUserAgent }}" value="{{ .form.UserAgent }}">
+
+    <label for="form-scraper-rules">{{ t "form.feed.label.scraper_rules" }}</label>
+    <input type="text" name="scraper_rules" id="form-scraper-rules" value="{{ .form.ScraperRules }}" spellcheck="false">
+    <p>{{ t "form.feed.help.scraper_rules" }}</p>
+
+    <label for="form-rewrite-rules">{{ t "form.feed.label.rewrite_rules" }}</label>
+    <input type="text" name="rewrite_rules" id="form-rewrite-rules" value="{{ .form.RewriteRules }}" spellcheck="false">
+    <p>{{ t "form.feed.help.rewrite_rules" }}</p>
+
+    <label for="form-content-extractors">{{ t "form.feed.label.content_extractors" }}</label>
+    <input type="text" name="content_extractors" id="form-content-extractors" value="{{ .form.ContentExtractors }}" spellcheck="false">
+    <p>{{ t "form.category.help.content_extractors" .contentExtractors }}</p>
+
+    <label for="form-filter-mode">{{ t "form.category.label.filter_mode" }}</label>
+    <select id="form-filter-mode" name="filter_mode">
+        <option value="block" {{ if ne .form.FilterMode "allowlist" }}selected="selected"{{ end }}>{{ t "form.filter_mode.block" }}</option>
+        <option value="allowlist" {{ if eq .form.FilterMode "allowlist" }}selected="selected"{{ end }}>{{ t "form.filter_mode.allowlist" }}</option>
+    </select>
+    <p>{{ t "form.filter_mode.help" }}</p>
+
     <div class="buttons">
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
     </div>
@@ -524,6 +655,9 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "refreshFeed" "feedID" .feed.ID }}">{{ t "menu.refresh_feed" }}</a>
         </li>
+        <li>
+            <a href="{{ route "createFilterRule" }}?feed_id={{ .feed.ID }}">{{ t "menu.create_filter_rule" }}</a>
+        </li>
     </ul>
 </section>
 
@@ -537,6 +671,12 @@ var templateViewsMap = map[string]string{
     </div>
     {{ end }}
 
+    {{ if .newsletterAddress }}
+    <div class="panel">
+        <p>{{ t "page.edit_feed.newsletter_address" }} <strong>{{ .newsletterAddress }}</strong></p>
+    </div>
+    {{ end }}
+
     <form action="{{ route "updateFeed" "feedID" .feed.ID }}" method="post" autocomplete="off">
         <input type="hidden" name="csrf" value="{{ .csrf }}">
 
@@ -567,19 +707,25 @@ var templateViewsMap = map[string]string{
         <input type="text" name="feed_password" id="form-feed-password" value="{{ .form.Password }}">
 
 	    <label for="form-user-agent">{{ t "form.feed.label.user_agent" }}</label>
-	    <input type="text" name="user_agent" id="form-user-agent" placeholder="{{ .defaultUserAgent }}" value="{{ .form.UserAgent }}">
+	    <input type="text" name="user_agent" id="form-user-agent" placeholder="{{ if .feed.Category.UserAgent }}{{ .feed.Category.UserAgent }}{{ else }}{{ .defaultUserAgent }}{{ end }}" value="{{ .form.UserAgent }}">
 
         <label for="form-scraper-rules">{{ t "form.feed.label.scraper_rules" }}</label>
-        <input type="text" name="scraper_rules" id="form-scraper-rules" value="{{ .form.ScraperRules }}">
+        <input type="text" name="scraper_rules" id="form-scraper-rules" placeholder="{{ .feed.Category.ScraperRules }}" value="{{ .form.ScraperRules }}" spellcheck="false">
+        <p>{{ t "form.feed.help.scraper_rules" }}</p>
 
         <label for="form-rewrite-rules">{{ t "form.feed.label.rewrite_rules" }}</label>
-        <input type="text" name="rewrite_rules" id="form-rewrite-rules" value="{{ .form.RewriteRules }}">
+        <input type="text" name="rewrite_rules" id="form-rewrite-rules" placeholder="{{ .feed.Category.RewriteRules }}" value="{{ .form.RewriteRules }}" spellcheck="false">
+        <p>{{ t "form.feed.help.rewrite_rules" }}</p>
 
-        <label for="form-title-filter">{{ t "form.feed.label.title_filter" }}</label>
-        <input type="text" name="title_filter" id="form-title-filter" value="{{ .form.TitleFilter }}">
+        {{ if .form.IsWebPage }}
+            <input type="hidden" name="source_type" value="{{ .form.SourceType }}">
+            {{ template "web_page_rules_fields" .form }}
+        {{ end }}
 
-        <label for="form-content-filter">{{ t "form.feed.label.content_filter" }}</label>
-        <input type="text" name="content_filter" id="form-content-filter" value="{{ .form.ContentFilter }}">
+        {{ if .form.IsPageMonitor }}
+            <input type="hidden" name="source_type" value="{{ .form.SourceType }}">
+            {{ template "page_monitor_fields" .form }}
+        {{ end }}
 
         <label for="form-category">{{ t "form.feed.label.category" }}</label>
         <select id="form-category" name="category_id">
@@ -589,23 +735,169 @@ var templateViewsMap = map[string]string{
         </select>
 
         <label><input type="checkbox" name="crawler" value="1" {{ if .form.Crawler }}checked{{ end }}> {{ t "form.feed.label.crawler" }}</label>
+        <label><input type="checkbox" name="crawler_override" value="1" {{ if .form.CrawlerOverride }}checked{{ end }}> {{ t "form.feed.label.crawler_override" }}</label>
         <label><input type="checkbox" name="disabled" value="1" {{ if .form.Disabled }}checked{{ end }}> {{ t "form.feed.label.disabled" }}</label>
 
         <label>
             <input type="checkbox" name="use_mercury" id="form-use_mercury" value="1" {{ if .form.UseMercury }}checked{{ end }}> {{ t "form.feed.label.use_mercury" }}
         </label>
 
+        <label for="form-content-extractors">{{ t "form.feed.label.content_extractors" }}</label>
+        <input type="text" name="content_extractors" id="form-content-extractors" placeholder="{{ .feed.Category.ContentExtractors }}" value="{{ .form.ContentExtractors }}" spellcheck="false">
+        <p>{{ t "form.feed.help.content_extractors" .contentExtractors }}</p>
+
+        <label for="form-filter-mode">{{ t "form.feed.label.filter_mode" }}</label>
+        <select id="form-filter-mode" name="filter_mode">
+            <option value="" {{ if eq .form.FilterMode "" }}selected="selected"{{ end }}>{{ t "form.feed.filter_mode.inherit" }}</option>
+            <option value="block" {{ if eq .form.FilterMode "block" }}selected="selected"{{ end }}>{{ t "form.filter_mode.block" }}</option>
+            <option value="allowlist" {{ if eq .form.FilterMode "allowlist" }}selected="selected"{{ end }}>{{ t "form.filter_mode.allowlist" }}</option>
+        </select>
+        <p>{{ t "form.filter_mode.help" }}</p>
+
+        <label for="form-entry-identity">{{ t "form.feed.label.entry_identity" }}</label>
+        <select id="form-entry-identity" name="entry_identity">
+            <option value="" {{ if eq .form.EntryIdentity "" }}selected="selected"{{ end }}>{{ t "form.feed.entry_identity.default" }}</option>
+            <option value="guid" {{ if eq .form.EntryIdentity "guid" }}selected="selected"{{ end }}>{{ t "form.feed.entry_identity.guid" }}</option>
+            <option value="url" {{ if eq .form.EntryIdentity "url" }}selected="selected"{{ end }}>{{ t "form.feed.entry_identity.url" }}</option>
+            <option value="canonical_url" {{ if eq .form.EntryIdentity "canonical_url" }}selected="selected"{{ end }}>{{ t "form.feed.entry_identity.canonical_url" }}</option>
+            <option value="title_date" {{ if eq .form.EntryIdentity "title_date" }}selected="selected"{{ end }}>{{ t "form.feed.entry_identity.title_date" }}</option>
+            <option value="content" {{ if eq .form.EntryIdentity "content" }}selected="selected"{{ end }}>{{ t "form.feed.entry_identity.content" }}</option>
+        </select>
+        <p>{{ t "form.feed.help.entry_identity" }}</p>
+
+        <details {{ if .previews }}open{{ end }}>
+            <summary>{{ t "page.edit_feed.legend.preview" }}</summary>
+            <div class="details-content">
+                <p>{{ t "form.feed.help.preview" }}</p>
+                <label><input type="checkbox" name="preview_refetch" value="1" {{ if .form.PreviewRefetch }}checked{{ end }}> {{ t "form.feed.label.preview_refetch" }}</label>
+                <label><input type="checkbox" name="preview_crawl" value="1" {{ if .form.PreviewCrawl }}checked{{ end }}> {{ t "form.feed.label.preview_crawl" }}</label>
+            </div>
+        </details>
+
         <div class="buttons">
-            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button> {{ t "action.or" }} <a href="{{ route "feeds" }}">{{ t "action.cancel" }}</a>
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+            <button type="submit" class="button" formaction="{{ route "previewFeed" "feedID" .feed.ID }}">{{ t "action.preview" }}</button>
+            {{ t "action.or" }} <a href="{{ route "feeds" }}">{{ t "action.cancel" }}</a>
         </div>
     </form>
 
+    {{ if .previews }}
+    <section class="feed-preview">
+        <h2>{{ t "page.edit_feed.preview.title" }}</h2>
+        <p>{{ t "page.edit_feed.preview.summary" .previewRemovedCount (len .previews) }}</p>
+        <div class="items">
+        {{ range .previews }}
+            <article class="item feed-preview-entry {{ if .IsRemoved }}feed-preview-removed{{ end }}">
+                <div class="item-header">
+                    <span class="item-title">
+                        {{ if .TitleChanged }}<del>{{ .OriginalTitle }}</del> <ins>{{ .Title }}</ins>{{ else }}{{ .Title }}{{ end }}
+                    </span>
+                </div>
+                <div class="item-meta">
+                    <ul class="item-meta-info">
+                        <li>
+                            {{ if .Suppressed }}{{ t "page.edit_feed.preview.suppressed" }}
+                            {{ else if .IsRemoved }}{{ t "page.edit_feed.preview.removed" }}
+                            {{ else if eq .Status "read" }}{{ t "page.edit_feed.preview.read" }}
+                            {{ else }}{{ t "page.edit_feed.preview.kept" }}{{ end }}
+                        </li>
+                        {{ if .Starred }}<li>{{ t "page.edit_feed.preview.starred" }}</li>{{ end }}
+                        {{ if .MatchedRules }}<li>{{ t "page.edit_feed.preview.matched_rules" (len .MatchedRules) }}</li>{{ end }}
+                        {{ if .Tags }}
+                        <li>
+                            <ul class="entry-tags">
+                            {{ range .Tags }}
+                                <li>{{ . }}</li>
+                            {{ end }}
+                            </ul>
+                        </li>
+                        {{ end }}
+                        <li>
+                            <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ domain .URL }}</a>
+                        </li>
+                    </ul>
+                </div>
+                {{ if .Error }}
+                <div class="parsing-error">
+                    <small class="parsing-error-message">{{ .Error }}</small>
+                </div>
+                {{ end }}
+                {{ if .ContentDiff }}
+                <details>
+                    <summary>{{ t "page.edit_feed.preview.content_changed" }}</summary>
+                    <div class="details-content feed-preview-diff">{{ noescape .ContentDiff }}</div>
+                </details>
+                {{ else }}
+                <p class="feed-preview-unchanged">{{ t "page.edit_feed.preview.content_unchanged" }}</p>
+                {{ end }}
+            </article>
+        {{ end }}
+        </div>
+    </section>
+    {{ end }}
+
+    <div class="panel">
+        <h3>{{ t "page.edit_feed.effective_settings" }}</h3>
+        <p>{{ t "page.edit_feed.effective_settings.help" .feed.Category.Title }}</p>
+        <ul>
+            <li>
+                <strong>{{ t "form.feed.label.crawler" }}: </strong>
+                {{ if .feed.EffectiveCrawler }}{{ t "page.edit_feed.enabled" }}{{ else }}{{ t "page.edit_feed.disabled" }}{{ end }}
+                {{ if and (not .feed.CrawlerOverride) (not .feed.Crawler) .feed.Category.Crawler }}<em>({{ t "page.edit_feed.inherited" }})</em>{{ end }}
+            </li>
+            <li>
+                <strong>{{ t "form.feed.label.user_agent" }}: </strong>
+                {{ if .feed.EffectiveUserAgent }}<code>{{ .feed.EffectiveUserAgent }}</code>{{ else }}{{ .defaultUserAgent }}{{ end }}
+                {{ if and (not .feed.UserAgent) .feed.Category.UserAgent }}<em>({{ t "page.edit_feed.inherited" }})</em>{{ end }}
+            </li>
+            <li>
+                <strong>{{ t "form.feed.label.scraper_rules" }}: </strong>
+                {{ if .feed.EffectiveScraperRules }}<code>{{ .feed.EffectiveScraperRules }}</code>{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}
+                {{ if and (not .feed.ScraperRules) .feed.Category.ScraperRules }}<em>({{ t "page.edit_feed.inherited" }})</em>{{ end }}
+            </li>
+            <li>
+                <strong>{{ t "form.feed.label.rewrite_rules" }}: </strong>
+                {{ if .feed.EffectiveRewriteRules }}<code>{{ .feed.EffectiveRewriteRules }}</code>{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}
+                {{ if and (not .feed.RewriteRules) .feed.Category.RewriteRules }}<em>({{ t "page.edit_feed.inherited" }})</em>{{ end }}
+            </li>
+            <li>
+                <strong>{{ t "form.feed.label.content_extractors" }}: </strong>
+                {{ if .feed.EffectiveContentExtractors }}<code>{{ .feed.EffectiveContentExtractors }}</code>{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}
+                {{ if and (not .feed.ContentExtractors) .feed.Category.ContentExtractors }}<em>({{ t "page.edit_feed.inherited" }})</em>{{ end }}
+            </li>
+            <li>
+                <strong>{{ t "form.feed.label.filter_mode" }}: </strong>
+                {{ t (printf "form.filter_mode.%s" .feed.EffectiveFilterMode) }}
+                {{ if and (not .feed.FilterMode) .feed.Category.FilterMode }}<em>({{ t "page.edit_feed.inherited" }})</em>{{ end }}
+            </li>
+        </ul>
+    </div>
+
     <div class="panel">
         <ul>
             <li><strong>{{ t "page.edit_feed.last_check" }} </strong><time datetime="{{ isodate .feed.CheckedAt }}" title="{{ isodate .feed.CheckedAt }}">{{ elapsed $.user.Timezone .feed.CheckedAt }}</time></li>
             <li><strong>{{ t "page.edit_feed.etag_header" }} </strong>{{ if .feed.EtagHeader }}{{ .feed.EtagHeader }}{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}</li>
             <li><strong>{{ t "page.edit_feed.last_modified_header" }} </strong>{{ if .feed.LastModifiedHeader }}{{ .feed.LastModifiedHeader }}{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}</li>
+            <li><strong>{{ t "page.edit_feed.suppressed_count" }} </strong>{{ .feed.SuppressedCount }}</li>
+            <li><strong>{{ t "page.edit_feed.icon" }} </strong>{{ if .feed.Icon }}<img src="{{ route "icon" "iconID" .feed.Icon.IconID }}" width="16" height="16" alt="{{ .feed.Title }}">{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}</li>
         </ul>
+
+        <form action="{{ route "refreshFeedIcon" "feedID" .feed.ID }}" method="post">
+            <input type="hidden" name="csrf" value="{{ .csrf }}">
+            <div class="buttons">
+                <button type="submit" class="button" data-label-loading="{{ t "form.submit.loading" }}">{{ t "action.refresh_icon" }}</button>
+            </div>
+        </form>
+    </div>
+
+    <div class="panel">
+        <p>{{ t "page.edit_feed.deduplicate.help" }}</p>
+        <form action="{{ route "deduplicateFeed" "feedID" .feed.ID }}" method="post">
+            <input type="hidden" name="csrf" value="{{ .csrf }}">
+            <div class="buttons">
+                <button type="submit" class="button" data-label-loading="{{ t "form.submit.loading" }}">{{ t "action.deduplicate" }}</button>
+            </div>
+        </form>
     </div>
 
     <div class="alert alert-error">
@@ -621,6 +913,120 @@ var templateViewsMap = map[string]string{
     </div>
 {{ end }}
 
+{{ end }}
+`,
+	"edit_filter_rule": `{{ define "title"}}{{ t "page.edit_filter_rule.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.edit_filter_rule.title" }}</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+<form action="{{ route "updateFilterRule" "ruleID" .rule.ID }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    {{ template "filter_rule_fields" . }}
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button> {{ t "action.or" }} <a href="{{ route "filterRules" }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
+`,
+	"edit_highlight": `{{ define "title"}}{{ t "page.edit_highlight.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.edit_highlight.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "highlights" }}">{{ t "menu.highlights" }}</a>
+        </li>
+    </ul>
+</section>
+
+<blockquote class="entry-highlight-quote">{{ .highlight.Quote }}</blockquote>
+
+<form action="{{ route "updateHighlight" "highlightID" .highlight.ID }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <label for="form-note">{{ t "form.highlight.label.note" }}</label>
+    <textarea name="note" id="form-note" autofocus>{{ .form.Note }}</textarea>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button> {{ t "action.or" }} <a href="{{ route "highlights" }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
+`,
+	"edit_label": `{{ define "title"}}{{ t "page.edit_label.title" .label.Title }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.edit_label.title" .label.Title }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "labels" }}">{{ t "menu.labels" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "labelEntries" "labelID" .label.ID }}">{{ t "menu.label_entries" }}</a>
+        </li>
+    </ul>
+</section>
+
+<form action="{{ route "updateLabel" "labelID" .label.ID }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <label for="form-title">{{ t "form.label.label.title" }}</label>
+    <input type="text" name="title" id="form-title" value="{{ .form.Title }}" required autofocus>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button> {{ t "action.or" }} <a href="{{ route "labels" }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
+`,
+	"edit_smart_folder": `{{ define "title"}}{{ t "page.edit_smart_folder.title" .folder.Title }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.edit_smart_folder.title" .folder.Title }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "smartFolders" }}">{{ t "menu.smart_folders" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "smartFolderEntries" "folderID" .folder.ID }}">{{ t "menu.smart_folder_entries" }}</a>
+        </li>
+    </ul>
+</section>
+
+<form action="{{ route "updateSmartFolder" "folderID" .folder.ID }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    {{ template "smart_folder_fields" . }}
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button> {{ t "action.or" }} <a href="{{ route "smartFolders" }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
 {{ end }}
 `,
 	"edit_user": `{{ define "title"}}{{ t "page.edit_user.title" .selected_user.Username }}{{ end }}
@@ -701,6 +1107,15 @@ var templateViewsMap = map[string]string{
                             >{{ template "icon_save" }}<span class="icon-label">{{ t "entry.save.label" }}</span></a>
                     </li>
                 {{ end }}
+                <li>
+                    <a href="#"
+                        title="{{ t "entry.highlight.title" }}"
+                        data-highlight-entry="true"
+                        data-highlight-url="{{ route "createHighlight" "entryID" .entry.ID }}"
+                        data-label-note="{{ t "entry.highlight.note" }}"
+                        data-toast-empty="{{ t "entry.highlight.toast.empty" }}"
+                        >{{ template "icon_highlight" }}<span class="icon-label">{{ t "entry.highlight.label" }}</span></a>
+                </li>
                 <li>
                     {{ if .entry.ShareCode }}
                         <a href="{{ route "sharedEntry" "shareCode" .entry.ShareCode }}"
@@ -759,10 +1174,28 @@ var templateViewsMap = map[string]string{
                     <a href="{{ route "categoryEntries" "categoryID" .entry.Feed.Category.ID }}">{{ .entry.Feed.Category.Title }}</a>
                 </span>
             {{ end }}
+            {{ if .entry.Tags }}
+                <ul class="entry-tags">
+                {{ range .entry.Tags }}
+                    <li>{{ . }}</li>
+                {{ end }}
+                </ul>
+            {{ end }}
+            {{ if .user }}
+                <ul class="entry-labels">
+                {{ range .entry.Labels }}
+                    <li><a href="{{ route "labelEntries" "labelID" .ID }}">{{ .Title }}</a></li>
+                {{ end }}
+                    <li class="entry-labels-edit"><a href="{{ route "entryLabels" "entryID" .entry.ID }}">{{ t "entry.labels.edit" }}</a></li>
+                </ul>
+            {{ end }}
         </div>
         <div class="entry-date">
             {{ if .user }}
                 <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ elapsed $.user.Timezone .entry.Date }}</time>
+                {{ if .entry.RevisionCount }}
+                    – <a href="{{ route "entryRevisions" "entryID" .entry.ID }}" class="entry-updated">{{ plural "entry.revisions.updated" .entry.RevisionCount .entry.RevisionCount }}</a>
+                {{ end }}
             {{ else }}
                 <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ elapsed "UTC" .entry.Date }}</time>
             {{ end }}
@@ -782,6 +1215,33 @@ var templateViewsMap = map[string]string{
             {{ noescape .entry.Content }}
         {{ end }}
     </article>
+    {{ if and .user .entry.Highlights }}
+    <section class="entry-highlights">
+        <h2>{{ t "page.entry.highlights" }} ({{ len .entry.Highlights }})</h2>
+        <ul>
+        {{ range .entry.Highlights }}
+            <li class="entry-highlight" data-quote="{{ .Quote }}" data-prefix="{{ .Prefix }}">
+                <blockquote class="entry-highlight-quote">{{ .Quote }}</blockquote>
+                {{ if .Note }}
+                    <p class="entry-highlight-note">{{ .Note }}</p>
+                {{ end }}
+                <ul class="entry-highlight-actions">
+                    <li><a href="{{ route "editHighlight" "highlightID" .ID }}">{{ t "menu.edit_highlight" }}</a></li>
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "removeHighlight" "highlightID" .ID }}">{{ t "action.remove" }}</a>
+                    </li>
+                </ul>
+            </li>
+        {{ end }}
+        </ul>
+    </section>
+    {{ end }}
     {{ if .entry.Enclosures }}
     <details class="entry-enclosures">
         <summary>{{ t "page.entry.attachments" }} ({{ len .entry.Enclosures }})</summary>
@@ -828,30 +1288,108 @@ var templateViewsMap = map[string]string{
 {{ end }}
 {{ end }}
 `,
-	"feed_entries": `{{ define "title"}}{{ .feed.Title }} ({{ .total }}){{ end }}
+	"entry_labels": `{{ define "title"}}{{ t "page.entry_labels.title" }} - {{ .entry.Title }}{{ end }}
 
 {{ define "content"}}
 <section class="page-header">
-    <h1>{{ .feed.Title }} ({{ .total }})</h1>
+    <h1>{{ .entry.Title }}</h1>
     <ul>
-        {{ if .entries }}
-        <li>
-            <a href="#"
-                data-action="markPageAsRead"
-                data-label-question="{{ t "confirm.question" }}"
-                data-label-yes="{{ t "confirm.yes" }}"
-                data-label-no="{{ t "confirm.no" }}"
-                data-label-loading="{{ t "confirm.loading" }}"
-                data-show-only-unread="{{ if .showOnlyUnreadEntries }}1{{ end }}">{{ t "menu.mark_page_as_read" }}</a>
-        </li>
-        {{ end }}
-        {{ if .showOnlyUnreadEntries }}
         <li>
-            <a href="{{ route "feedEntriesAll" "feedID" .feed.ID }}">{{ t "menu.show_all_entries" }}</a>
+            <a href="{{ route "feedEntry" "feedID" .entry.FeedID "entryID" .entry.ID }}">{{ t "menu.back_to_entry" }}</a>
         </li>
-        {{ else }}
         <li>
-            <a href="{{ route "feedEntries" "feedID" .feed.ID }}">{{ t "menu.show_only_unread_entries" }}</a>
+            <a href="{{ route "labels" }}">{{ t "menu.labels" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .labels }}
+    <p class="alert alert-info">{{ t "alert.no_label" }}</p>
+{{ else }}
+<form action="{{ route "updateEntryLabels" "entryID" .entry.ID }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ range .labels }}
+        <label><input type="checkbox" name="label_id" value="{{ .ID }}" {{ if index $.checkedLabels .ID }}checked{{ end }}> {{ .Title }}</label>
+    {{ end }}
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "feedEntry" "feedID" .entry.FeedID "entryID" .entry.ID }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
+{{ end }}
+`,
+	"entry_revisions": `{{ define "title"}}{{ t "page.entry_revisions.title" }} - {{ .entry.Title }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ .entry.Title }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "feedEntry" "feedID" .entry.FeedID "entryID" .entry.ID }}">{{ t "menu.back_to_entry" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .revisions }}
+    <p class="alert alert-info">{{ t "alert.no_entry_revision" }}</p>
+{{ else }}
+    <p>{{ t "page.entry_revisions.help" }}</p>
+    <div class="items">
+    {{ range .revisions }}
+        <article class="item entry-revision">
+            <div class="item-header">
+                <span class="item-title">
+                    {{ if .TitleChanged }}<del>{{ .Revision.Title }}</del> <ins>{{ .Title }}</ins>{{ else }}{{ .Title }}{{ end }}
+                </span>
+            </div>
+            <div class="item-meta">
+                <ul class="item-meta-info">
+                    <li>
+                        {{ t "page.entry_revisions.replaced" }}
+                        <time datetime="{{ isodate .Revision.CreatedAt }}" title="{{ isodate .Revision.CreatedAt }}">{{ elapsed $.user.Timezone .Revision.CreatedAt }}</time>
+                    </li>
+                </ul>
+            </div>
+            {{ if .ContentDiff }}
+            <details>
+                <summary>{{ t "page.entry_revisions.content_changed" }}</summary>
+                <div class="details-content entry-revision-diff">{{ noescape .ContentDiff }}</div>
+            </details>
+            {{ else }}
+            <p class="entry-revision-unchanged">{{ t "page.entry_revisions.content_unchanged" }}</p>
+            {{ end }}
+        </article>
+    {{ end }}
+    </div>
+{{ end }}
+{{ end }}
+`,
+	"feed_entries": `{{ define "title"}}{{ .feed.Title }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ .feed.Title }} ({{ .total }})</h1>
+    <ul>
+        {{ if .entries }}
+        <li>
+            <a href="#"
+                data-action="markPageAsRead"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-show-only-unread="{{ if .showOnlyUnreadEntries }}1{{ end }}">{{ t "menu.mark_page_as_read" }}</a>
+        </li>
+        {{ end }}
+        {{ if .showOnlyUnreadEntries }}
+        <li>
+            <a href="{{ route "feedEntriesAll" "feedID" .feed.ID }}">{{ t "menu.show_all_entries" }}</a>
+        </li>
+        {{ else }}
+        <li>
+            <a href="{{ route "feedEntries" "feedID" .feed.ID }}">{{ t "menu.show_only_unread_entries" }}</a>
         </li>
         {{ end }}
         <li>
@@ -938,6 +1476,134 @@ var templateViewsMap = map[string]string{
     {{ template "feed_list" dict "user" .user "feeds" .feeds "ParsingErrorCount" .ParsingErrorCount }}
 {{ end }}
 
+{{ end }}
+`,
+	"filter_rules": `{{ define "title"}}{{ t "page.filter_rules.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.filter_rules.title" }}</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+{{ if not .rules }}
+    <p class="alert">{{ t "alert.no_filter_rule" }}</p>
+{{ else }}
+{{ range .rules }}
+    <table>
+    <tr>
+        <th class="column-25">{{ t "page.filter_rules.table.scope" }}</th>
+        <td>
+            {{ if .FeedID }}
+                <a href="{{ route "editFeed" "feedID" .FeedID }}">{{ index $.feedTitles .FeedID }}</a>
+            {{ else if .CategoryID }}
+                <a href="{{ route "editCategory" "categoryID" .CategoryID }}">{{ index $.categoryTitles .CategoryID }}</a>
+            {{ else }}
+                {{ t "form.filter_rule.scope.all" }}
+            {{ end }}
+        </td>
+    </tr>
+    <tr>
+        <th>{{ t "page.filter_rules.table.condition" }}</th>
+        <td>{{ t (printf "filter_rule.field.%s" .Field) }} {{ t (printf "filter_rule.operator.%s" .Operator) }} <code>{{ .Value }}</code></td>
+    </tr>
+    {{ if .ErrorMsg }}
+    <tr>
+        <th>{{ t "page.filter_rules.table.error" }}</th>
+        <td>
+            <div class="parsing-error">
+                <small class="parsing-error-message">{{ t "page.filter_rules.ignored" }} {{ .ErrorMsg }}</small>
+            </div>
+        </td>
+    </tr>
+    {{ end }}
+    <tr>
+        <th>{{ t "page.filter_rules.table.action" }}</th>
+        <td>{{ t (printf "filter_rule.action.%s" .Action) }}{{ if .ActionValue }} <code>{{ .ActionValue }}</code>{{ end }}</td>
+    </tr>
+    <tr>
+        <th>{{ t "page.filter_rules.table.hits" }}</th>
+        <td>
+            {{ .HitCount }}
+            {{ if .LastHitAt }}
+                (<time datetime="{{ isodate .LastHitAt }}" title="{{ isodate .LastHitAt }}">{{ elapsed $.user.Timezone .LastHitAt }}</time>)
+            {{ end }}
+        </td>
+    </tr>
+    <tr>
+        <th>{{ t "page.filter_rules.table.actions" }}</th>
+        <td>
+            <a href="{{ route "editFilterRule" "ruleID" .ID }}">{{ t "action.edit" }}</a>,
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "removeFilterRule" "ruleID" .ID }}">{{ t "action.remove" }}</a>
+        </td>
+    </tr>
+    </table>
+    <br>
+{{ end }}
+{{ end }}
+
+<p>
+    <a href="{{ route "createFilterRule" }}" class="button button-primary">{{ t "menu.create_filter_rule" }}</a>
+</p>
+
+{{ end }}
+`,
+	"highlights": `{{ define "title"}}{{ t "page.highlights.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.highlights.title" }} ({{ .total }})</h1>
+</section>
+
+{{ if not .highlights }}
+    <p class="alert alert-info">{{ t "alert.no_highlight" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .highlights }}
+        <article class="item">
+            <div class="item-header">
+                <span class="item-title">
+                    <a href="{{ route "feedEntry" "feedID" .FeedID "entryID" .EntryID }}">{{ .EntryTitle }}</a>
+                </span>
+                <span class="category"><a href="{{ route "feedEntries" "feedID" .FeedID }}">{{ .FeedTitle }}</a></span>
+            </div>
+            <blockquote class="entry-highlight-quote">{{ .Quote }}</blockquote>
+            {{ if .Note }}
+                <p class="entry-highlight-note">{{ .Note }}</p>
+            {{ end }}
+            <div class="item-meta">
+                <ul class="item-meta-info">
+                    <li>
+                        <time datetime="{{ isodate .CreatedAt }}" title="{{ isodate .CreatedAt }}">{{ elapsed $.user.Timezone .CreatedAt }}</time>
+                    </li>
+                </ul>
+                <ul class="item-meta-icons">
+                    <li>
+                        <a href="{{ route "editHighlight" "highlightID" .ID }}">{{ t "menu.edit_highlight" }}</a>
+                    </li>
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "removeHighlight" "highlightID" .ID }}">{{ template "icon_delete" }}<span class="icon-label">{{ t "action.remove" }}</span></a>
+                    </li>
+                </ul>
+            </div>
+        </article>
+        {{ end }}
+    </div>
+    {{ template "pagination" .pagination }}
+{{ end }}
+
 {{ end }}
 `,
 	"history_entries": `{{ define "title"}}{{ t "page.history.title" }} ({{ .total }}){{ end }}
@@ -1159,6 +1825,110 @@ var templateViewsMap = map[string]string{
     <p>{{ t "page.integration.bookmarklet.instructions" }}</p>
 </div>
 
+{{ if .newsletterAddress }}
+<h3>{{ t "page.integration.newsletter" }}</h3>
+<div class="panel">
+    <p>{{ t "page.integration.newsletter.help" }}</p>
+    <p><strong>{{ .newsletterAddress }}</strong></p>
+</div>
+{{ end }}
+
+{{ end }}
+`,
+	"label_entries": `{{ define "title"}}{{ .label.Title }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ .label.Title }} ({{ .total }})</h1>
+    <ul>
+        <li>
+            <a href="{{ route "editLabel" "labelID" .label.ID }}">{{ t "menu.edit_label" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "labels" }}">{{ t "menu.labels" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .entries }}
+    <p class="alert alert-info">{{ t "alert.no_label_entry" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .entries }}
+        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+            <div class="item-header">
+                <span class="item-title">
+                    {{ if ne .Feed.Icon.IconID 0 }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.Title }}">
+                    {{ end }}
+                    <a href="{{ route "labelEntry" "labelID" $.label.ID "entryID" .ID }}">{{ .Title }}</a>
+                </span>
+                <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
+            </div>
+            {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry }}
+        </article>
+        {{ end }}
+    </div>
+    {{ template "pagination" .pagination }}
+{{ end }}
+
+{{ end }}
+`,
+	"labels": `{{ define "title"}}{{ t "page.labels.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.labels.title" }} ({{ .total }})</h1>
+</section>
+
+<form action="{{ route "saveLabel" }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <label for="form-title">{{ t "form.label.label.title" }}</label>
+    <input type="text" name="title" id="form-title" value="{{ if .form }}{{ .form.Title }}{{ end }}" required>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "menu.create_label" }}</button>
+    </div>
+</form>
+
+{{ if not .labels }}
+    <p class="alert alert-info">{{ t "alert.no_label" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .labels }}
+        <article class="item">
+            <div class="item-header">
+                <span class="item-title">
+                    <a href="{{ route "labelEntries" "labelID" .ID }}">{{ .Title }}</a>
+                </span>
+                (<span title="{{ plural "page.labels.entry_count" .EntryCount .EntryCount }}">{{ .EntryCount }}</span>)
+            </div>
+            <div class="item-meta">
+                <ul class="item-meta-info">
+                    <li>
+                        <a href="{{ route "editLabel" "labelID" .ID }}">{{ t "menu.edit_label" }}</a>
+                    </li>
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "removeLabel" "labelID" .ID }}">{{ t "action.remove" }}</a>
+                    </li>
+                </ul>
+            </div>
+        </article>
+        {{ end }}
+    </div>
+{{ end }}
+
 {{ end }}
 `,
 	"login": `{{ define "title"}}{{ t "page.login.title" }}{{ end }}
@@ -1202,6 +1972,11 @@ var templateViewsMap = map[string]string{
 {{ define "content"}}
 <section class="page-header">
     <h1>{{ t "page.search.title" }} ({{ .total }})</h1>
+    <ul>
+        <li>
+            <a href="{{ route "createSmartFolder" }}?q={{ .searchQuery }}">{{ t "menu.save_search" }}</a>
+        </li>
+    </ul>
 </section>
 
 {{ if not .entries }}
@@ -1320,6 +2095,12 @@ var templateViewsMap = map[string]string{
 
     <label><input type="checkbox" name="keyboard_shortcuts" value="1" {{ if .form.KeyboardShortcuts }}checked{{ end }}> {{ t "form.prefs.label.keyboard_shortcuts" }}</label>
 
+    <label for="form-entry-revisions-limit">{{ t "form.prefs.label.entry_revisions_limit" }}</label>
+    <input type="number" name="entry_revisions_limit" id="form-entry-revisions-limit" min="0" max="50" value="{{ .form.EntryRevisionsLimit }}">
+    <p>{{ t "form.prefs.help.entry_revisions_limit" }}</p>
+
+    <label><input type="checkbox" name="unread_on_update" value="1" {{ if .form.UnreadOnUpdate }}checked{{ end }}> {{ t "form.prefs.label.unread_on_update" }}</label>
+
     <label>{{t "form.prefs.label.custom_css" }}</label><textarea name="custom_css" cols="40" rows="5">{{ .form.CustomCSS }}</textarea>
     <label for="form-mercury_api_url">{{ t "form.user.label.mercury_api_url" }}</label>
     <input type="text" name="mercury_api_url" id="form-mercury_api_url" value="{{ .form.MercuryAPIURL }}">
@@ -1419,6 +2200,97 @@ var templateViewsMap = map[string]string{
     </div>
 {{ end }}
 
+{{ end }}
+`,
+	"smart_folder_entries": `{{ define "title"}}{{ .folder.Title }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ .folder.Title }} ({{ .total }})</h1>
+    <ul>
+        <li>
+            <a href="{{ route "editSmartFolder" "folderID" .folder.ID }}">{{ t "menu.edit_smart_folder" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "smartFolders" }}">{{ t "menu.smart_folders" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .entries }}
+    <p class="alert alert-info">{{ t "alert.no_smart_folder_entry" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .entries }}
+        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+            <div class="item-header">
+                <span class="item-title">
+                    {{ if ne .Feed.Icon.IconID 0 }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.Title }}">
+                    {{ end }}
+                    <a href="{{ route "smartFolderEntry" "folderID" $.folder.ID "entryID" .ID }}">{{ .Title }}</a>
+                </span>
+                <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
+            </div>
+            {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry }}
+        </article>
+        {{ end }}
+    </div>
+    {{ template "pagination" .pagination }}
+{{ end }}
+
+{{ end }}
+`,
+	"smart_folders": `{{ define "title"}}{{ t "page.smart_folders.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.smart_folders.title" }} ({{ .total }})</h1>
+    <ul>
+        <li>
+            <a href="{{ route "createSmartFolder" }}">{{ t "menu.create_smart_folder" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .folders }}
+    <p class="alert alert-info">{{ t "alert.no_smart_folder" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .folders }}
+        <article class="item">
+            <div class="item-header">
+                <span class="item-title">
+                    <a href="{{ route "smartFolderEntries" "folderID" .ID }}">{{ .Title }}</a>
+                </span>
+                {{ if gt .UnreadCount 0 }}
+                    (<span title="{{ plural "page.smart_folders.unread_count" .UnreadCount .UnreadCount }}">{{ .UnreadCount }}</span>)
+                {{ end }}
+            </div>
+            <div class="item-meta">
+                <ul class="item-meta-info">
+                    {{ if .SearchQuery }}
+                    <li>{{ .SearchQuery }}</li>
+                    {{ end }}
+                    <li>
+                        <a href="{{ route "editSmartFolder" "folderID" .ID }}">{{ t "menu.edit_smart_folder" }}</a>
+                    </li>
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "removeSmartFolder" "folderID" .ID }}">{{ t "action.remove" }}</a>
+                    </li>
+                </ul>
+            </div>
+        </article>
+        {{ end }}
+    </div>
+{{ end }}
+
 {{ end }}
 `,
 	"unread_entries": `{{ define "title"}}{{ t "page.unread.title" }} {{ if gt .countUnread 0 }}({{ .countUnread }}){{ end }} {{ end }}
@@ -1453,7 +2325,7 @@ var templateViewsMap = map[string]string{
 {{ if not .entries }}
     <p class="alert">{{ t "alert.no_unread_entry" }}</p>
 {{ else }}
-    <div class="items hide-read-items">
+    <div class="items hide-read-items" data-collapsed-clusters="true">
         {{ range .entries }}
         <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
             <div class="item-header">
@@ -1466,6 +2338,9 @@ var templateViewsMap = map[string]string{
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
             {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry }}
+            {{ with index $.clusters .ID }}
+                {{ template "item_cluster" . }}
+            {{ end }}
         </article>
         {{ end }}
     </div>
@@ -1543,31 +2418,45 @@ var templateViewsMap = map[string]string{
 }
 
 var templateViewsMapChecksums = map[string]string{
-	"about":               "4035658497363d7af7f79be83190404eb21ec633fe8ec636bdfc219d9fc78cfc",
-	"add_subscription":    "0dbea93b6fc07423fa066122ad960c69616b829533371a2dbadec1e22d4f1ae0",
-	"api_keys":            "27d401b31a72881d5232486ba17eb47edaf5246eaedce81de88698c15ebb2284",
-	"bookmark_entries":    "65588da78665699dd3f287f68325e9777d511f1a57fee4131a5bb6d00bb68df8",
-	"categories":          "7a927a2c28ae60c995df9d94220153418d3bd31bf35e0800980a215b1a6a80c7",
-	"category_entries":    "dee7b9cd60c6c46f01dd4289940679df31c1fce28ce4aa7249fa459023e1eeb4",
-	"category_feeds":      "527c2ffbc4fcec775071424ba1022ae003525dba53a28cc41f48fb7b30aa984b",
-	"choose_subscription": "84c9730cadd78e6ee5a6b4c499aab33acddb4324ac01924d33387543eec4d702",
-	"create_api_key":      "5f74d4e92a6684927f5305096378c8be278159a5cd88ce652c7be3280a7d1685",
-	"create_category":     "6b22b5ce51abf4e225e23a79f81be09a7fb90acb265e93a8faf9446dff74018d",
-	"create_user":         "9b73a55233615e461d1f07d99ad1d4d3b54532588ab960097ba3e090c85aaf3a",
-	"edit_category":       "b1c0b38f1b714c5d884edcd61e5b5295a5f1c8b71c469b35391e4dcc97cc6d36",
-	"edit_feed":           "7bd13ff6b0493b5bd290aed9f94f618d9e26f78284a05030960a6d0724648004",
-	"edit_user":           "c692db9de1a084c57b93e95a14b041d39bf489846cbb91fc982a62b72b77062a",
-	"entry":               "d8c30d412d58e14c946ba682166f7c582948e7b0f657d04dcbc3d004267627bb",
-	"feed_entries":        "9c70b82f55e4b311eff20be1641733612e3c1b406ce8010861e4c417d97b6dcc",
-	"feeds":               "ec7d3fa96735bd8422ba69ef0927dcccddc1cc51327e0271f0312d3f881c64fd",
-	"history_entries":     "93c0c4cc541eec7f07f5c2634f250ea82ac64024939179276b6f636b72c189bf",
-	"import":              "1b59b3bd55c59fcbc6fbb346b414dcdd26d1b4e0c307e437bb58b3f92ef01ad1",
-	"integrations":        "30329452743b35c668278f519245fd9be05c1726856e0384ba542f7c307f2788",
-	"login":               "79ff2ca488c0a19b37c8fa227a21f73e94472eb357a51a077197c852f7713f11",
-	"search_entries":      "274950d03298c24f3942e209c0faed580a6d57be9cf76a6c236175a7e766ac6a",
-	"sessions":            "5d5c677bddbd027e0b0c9f7a0dd95b66d9d95b4e130959f31fb955b926c2201c",
-	"settings":            "b00b920ac9225bbf3d5eae3942ab14ae31a4245ecfbda46329f5bad5bd1b0f5e",
-	"shared_entries":      "19caea053664220bb9519df295eb2a17cf5836eaa9104b7ee24c60b88bb524e9",
-	"unread_entries":      "e38f7ffce17dfad3151b08cd33771a2cefe8ca9db42df04fc98bd1d675dd6075",
-	"users":               "d7ff52efc582bbad10504f4a04fa3adcc12d15890e45dff51cac281e0c446e45",
+	"about":                "4035658497363d7af7f79be83190404eb21ec633fe8ec636bdfc219d9fc78cfc",
+	"add_subscription":     "3c2cf6ec61951eeace30b0931a9aac7873c2ec4f12bfab968045a9d7076a9c80",
+	"api_keys":             "27d401b31a72881d5232486ba17eb47edaf5246eaedce81de88698c15ebb2284",
+	"bookmark_entries":     "65588da78665699dd3f287f68325e9777d511f1a57fee4131a5bb6d00bb68df8",
+	"categories":           "7a927a2c28ae60c995df9d94220153418d3bd31bf35e0800980a215b1a6a80c7",
+	"category_entries":     "425c6fabbcf8407d25a794c6219dea11d0ceb5d88a4c9e495dd9fdbffa170318",
+	"category_feeds":       "527c2ffbc4fcec775071424ba1022ae003525dba53a28cc41f48fb7b30aa984b",
+	"choose_subscription":  "84c9730cadd78e6ee5a6b4c499aab33acddb4324ac01924d33387543eec4d702",
+	"create_api_key":       "5f74d4e92a6684927f5305096378c8be278159a5cd88ce652c7be3280a7d1685",
+	"create_category":      "6b22b5ce51abf4e225e23a79f81be09a7fb90acb265e93a8faf9446dff74018d",
+	"create_filter_rule":   "417ec710011e55318102eefde63f63dfa9a2f1a4276c287e6faa3f578cfb3ccc",
+	"create_smart_folder":  "aaf29788f927c922b32b772cb15d41c0e85f3dd4305c7e31d69349d690ebddb0",
+	"create_user":          "9b73a55233615e461d1f07d99ad1d4d3b54532588ab960097ba3e090c85aaf3a",
+	"edit_category":        "d104ba47b35cd722303631c335b25c79c03b60e4456280afbc5a9da8b6f0d51d",
+	"edit_feed":            "f9d4cd56b720da15fcc74e3c1da2e1b8c8184af13c5a84b7a570abbc6c80f07e",
+	"edit_filter_rule":     "38e9982caefceb6d00d15ec08bf05d824f764895f09816f1e3991126df6210cb",
+	"edit_highlight":       "fec104296016091bf374da15d2943443e1e9aa859952358f5bf76896445352d9",
+	"edit_label":           "a48e8649b50f8f667b1f6a152ffbf02c592f3c86b0792c21ac375ff9786e5098",
+	"edit_smart_folder":    "c6eb8ed91f05569809fda98eebb70fbd2e9ee37911c5444fe2966083fc6b5e1b",
+	"edit_user":            "c692db9de1a084c57b93e95a14b041d39bf489846cbb91fc982a62b72b77062a",
+	"entry":                "fef10f7a4475aa6dcb2ed2ca94a7ee6e7694d2f23d991cb96a45b0a30008253d",
+	"entry_labels":         "0a57e046b49d88f8c02492818cf649de500e2bc5928ce14494bd4092fab79b9c",
+	"entry_revisions":      "60612e43e88dbebe3bdb3a2caa6875ff87f2a28d3e1d756d97f977e5dbf79adb",
+	"feed_entries":         "9c70b82f55e4b311eff20be1641733612e3c1b406ce8010861e4c417d97b6dcc",
+	"feeds":                "ec7d3fa96735bd8422ba69ef0927dcccddc1cc51327e0271f0312d3f881c64fd",
+	"filter_rules":         "8ea5c3b99c0792796b9ea87742c4b907085dfa48ff7a0f8f0745ec2b3bcfbacb",
+	"highlights":           "73c0cd5e593eac86515d6fe5e0cdf0cb450e8d8b2722a7dceb293fdc434e7358",
+	"history_entries":      "93c0c4cc541eec7f07f5c2634f250ea82ac64024939179276b6f636b72c189bf",
+	"import":               "1b59b3bd55c59fcbc6fbb346b414dcdd26d1b4e0c307e437bb58b3f92ef01ad1",
+	"integrations":         "5ede6978763ead7a572e117d391eb4936a66294e416fd7cafdcc9d5f9524c764",
+	"label_entries":        "be21b278b58fbe5ca806f86a919c116856543a884233c0ed30a90f0cf6d5bbb0",
+	"labels":               "567405a739babd358b4ead8d959e81d9e0e1b25ab5ce0cca578b98d5b1c30cd4",
+	"login":                "79ff2ca488c0a19b37c8fa227a21f73e94472eb357a51a077197c852f7713f11",
+	"search_entries":       "d219f28b8dd9aef0145f8b3b25ca67d0d2f77f7a33adbd1bded61bdd9d899c2f",
+	"sessions":             "5d5c677bddbd027e0b0c9f7a0dd95b66d9d95b4e130959f31fb955b926c2201c",
+	"settings":             "404926734ddc74d71576a75a93bb82c7563feb50869ffe013d30dcd997381947",
+	"shared_entries":       "19caea053664220bb9519df295eb2a17cf5836eaa9104b7ee24c60b88bb524e9",
+	"smart_folder_entries": "4e388290296a33bf1883c0adcf2575079c41d2d9260bc8ba67b38e28306d1aee",
+	"smart_folders":        "ccd6ea777c29920e19600c0a69339160a6fc22f58c6d1f1cd5a92cd40605fd20",
+	"unread_entries":       "8b2606cc40f6276f4fe7d614dcbcd3b624eae672bc6fca6215e119b5e62c41e1",
+	"users":                "d7ff52efc582bbad10504f4a04fa3adcc12d15890e45dff51cac281e0c446e45",
 }
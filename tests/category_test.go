@@ -8,6 +8,8 @@ package tests
 
 import (
 	"testing"
+
+	miniflux "miniflux.app/client"
 )
 
 func TestCreateCategory(t *testing.T) {
@@ -81,6 +83,66 @@ func TestUpdateCategory(t *testing.T) {
 	}
 }
 
+func TestUpdateCategoryDefaultSettings(t *testing.T) {
+	client := createClient(t)
+	feed, category := createFeed(t, client)
+
+	category, err := client.UpdateCategorySettings(category.ID, &miniflux.Category{
+		Title:        category.Title,
+		Crawler:      true,
+		UserAgent:    "Category UA",
+		ScraperRules: "article",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !category.Crawler || category.UserAgent != "Category UA" || category.ScraperRules != "article" {
+		t.Fatalf(`The default settings have not been saved: %+v`, category)
+	}
+
+	feed, err = client.Feed(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Category.UserAgent != "Category UA" || feed.UserAgent != "" {
+		t.Fatalf(`The feed should inherit the settings of its category: %+v`, feed)
+	}
+
+	if _, err := client.UpdateCategorySettings(category.ID, &miniflux.Category{Title: category.Title, RewriteRules: "unknown_rule"}); err == nil {
+		t.Fatal(`Invalid rewrite rules should be rejected`)
+	}
+}
+
+func TestUpdateCategoryTitleKeepsDefaultSettings(t *testing.T) {
+	client := createClient(t)
+	_, category := createFeed(t, client)
+
+	category, err := client.UpdateCategorySettings(category.ID, &miniflux.Category{
+		Title:        category.Title,
+		Crawler:      true,
+		UserAgent:    "Category UA",
+		ScraperRules: "article",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	category, err = client.UpdateCategory(category.ID, "Renamed category")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if category.Title != "Renamed category" {
+		t.Fatalf(`Invalid title, got %q`, category.Title)
+	}
+
+	if !category.Crawler || category.UserAgent != "Category UA" || category.ScraperRules != "article" {
+		t.Fatalf(`The default settings should be kept when the category is renamed: %+v`, category)
+	}
+}
+
 func TestListCategories(t *testing.T) {
 	categoryName := "My category"
 	client := createClient(t)
@@ -201,6 +201,33 @@ func TestGetEntry(t *testing.T) {
 	}
 }
 
+func TestGetEntryRevisions(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Entries[0].RevisionCount != 0 {
+		t.Fatalf(`A new entry should not have any revision, got %d`, result.Entries[0].RevisionCount)
+	}
+
+	revisions, err := client.EntryRevisions(result.Entries[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(revisions) != 0 {
+		t.Fatalf(`A new entry should not have any revision, got %d`, len(revisions))
+	}
+
+	if _, err := client.EntryRevisions(123456789); err == nil {
+		t.Fatal(`Fetching the revisions of a missing entry should fail`)
+	}
+}
+
 func TestUpdateStatus(t *testing.T) {
 	client := createClient(t)
 	createFeed(t, client)
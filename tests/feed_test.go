@@ -145,6 +145,138 @@ func TestUpdateFeedCrawler(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedContentExtractors(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	extractors := "scraper, readability:10"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{ContentExtractors: &extractors})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.ContentExtractors != extractors {
+		t.Fatalf(`Wrong content extractors, got %q instead of %q`, updatedFeed.ContentExtractors, extractors)
+	}
+
+	extractors = "unknown"
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{ContentExtractors: &extractors}); err == nil {
+		t.Fatal(`Unknown content extractors should be rejected`)
+	}
+}
+
+func TestUpdateFeedFilterMode(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	filterMode := "allowlist"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{FilterMode: &filterMode})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.FilterMode != filterMode {
+		t.Fatalf(`Wrong filter mode, got %q instead of %q`, updatedFeed.FilterMode, filterMode)
+	}
+
+	filterMode = "unknown"
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{FilterMode: &filterMode}); err == nil {
+		t.Fatal(`Invalid filter modes should be rejected`)
+	}
+}
+
+func TestUpdateFeedEntryIdentity(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	entries, err := client.FeedEntries(feed.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryIdentity := "canonical_url"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{EntryIdentity: &entryIdentity})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.EntryIdentity != entryIdentity {
+		t.Fatalf(`Wrong entry identity, got %q instead of %q`, updatedFeed.EntryIdentity, entryIdentity)
+	}
+
+	merged, err := client.DeduplicateFeed(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if merged != 0 {
+		t.Fatalf(`The entries of the feed should already be unique, %d entries have been merged`, merged)
+	}
+
+	if err := client.RefreshFeed(feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	refreshedEntries, err := client.FeedEntries(feed.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if refreshedEntries.Total != entries.Total {
+		t.Fatalf(`The refresh should not create duplicates, got %d entries instead of %d`, refreshedEntries.Total, entries.Total)
+	}
+
+	entryIdentity = "unknown"
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{EntryIdentity: &entryIdentity}); err == nil {
+		t.Fatal(`Invalid entry identities should be rejected`)
+	}
+}
+
+func TestPreviewFeed(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	rewriteRules := `replace_title("(?i)miniflux", "Reader")`
+	previews, err := client.PreviewFeed(feed.ID, &miniflux.FeedPreview{
+		FeedModification: miniflux.FeedModification{RewriteRules: &rewriteRules},
+		FilterRules: miniflux.FilterRules{
+			{Field: "title", Operator: "matches", Value: ".", Action: "remove"},
+		},
+		Limit: 3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(previews) == 0 || len(previews) > 3 {
+		t.Fatalf(`Unexpected number of previews: %d`, len(previews))
+	}
+
+	for _, preview := range previews {
+		if preview.Status != "removed" || len(preview.MatchedRules) != 1 || preview.MatchedRules[0] != 1 {
+			t.Errorf(`The entry %q should be removed by the proposed rule: %+v`, preview.OriginalTitle, preview)
+		}
+	}
+
+	updatedFeed, err := client.Feed(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.RewriteRules != feed.RewriteRules {
+		t.Error(`The previewed settings should not be saved`)
+	}
+
+	entries, err := client.FeedEntries(feed.ID, &miniflux.Filter{Status: "removed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entries.Total != 0 {
+		t.Error(`The previewed filter rules should not remove the stored entries`)
+	}
+}
+
 func TestUpdateFeedScraperRules(t *testing.T) {
 	client := createClient(t)
 	feed, _ := createFeed(t, client)
@@ -315,6 +447,23 @@ func TestRefreshFeed(t *testing.T) {
 	}
 }
 
+func TestRefreshFeedIcon(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+	if err := client.RefreshFeedIcon(feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	feedIcon, err := client.FeedIcon(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feedIcon.ID == 0 {
+		t.Fatalf(`Invalid feed icon ID, got "%v"`, feedIcon.ID)
+	}
+}
+
 func TestGetFeed(t *testing.T) {
 	client := createClient(t)
 	feed, category := createFeed(t, client)
@@ -356,11 +505,12 @@ func TestGetFeedIcon(t *testing.T) {
 		t.Fatalf(`Invalid feed icon ID, got "%v"`, feedIcon.ID)
 	}
 
+	// The test website declares neither touch icons nor a web app manifest, its favicon is selected.
 	if feedIcon.MimeType != "image/x-icon" {
 		t.Fatalf(`Invalid feed icon mime type, got "%v" instead of "%v"`, feedIcon.MimeType, "image/x-icon")
 	}
 
-	if !strings.Contains(feedIcon.Data, "image/x-icon") {
+	if !strings.HasPrefix(feedIcon.Data, "image/x-icon;base64,") {
 		t.Fatalf(`Invalid feed icon data, got "%v"`, feedIcon.Data)
 	}
 }
@@ -0,0 +1,96 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestCreateFilterRule(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	rule, err := client.CreateFilterRule(&miniflux.FilterRule{
+		FeedID:   feed.ID,
+		Field:    "title",
+		Operator: "contains",
+		Value:    "sponsored",
+		Action:   "remove",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rule.ID == 0 || rule.FeedID != feed.ID || rule.HitCount != 0 {
+		t.Fatalf(`Invalid filter rule: %v`, rule)
+	}
+
+	rules, err := client.FilterRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules) != 1 || rules[0].ID != rule.ID {
+		t.Fatalf(`Invalid list of filter rules: %v`, rules)
+	}
+}
+
+func TestCreateInvalidFilterRule(t *testing.T) {
+	client := createClient(t)
+
+	invalidRules := []*miniflux.FilterRule{
+		{Field: "title", Operator: "matches", Value: "(", Action: "remove"},
+		{Field: "date", Operator: "older_than", Value: "1 month", Action: "remove"},
+		{Field: "title", Operator: "contains", Value: "a", Action: "tag"},
+		{Field: "title", Operator: "contains", Value: "a", Action: "remove", FeedID: 123456789},
+	}
+
+	for _, rule := range invalidRules {
+		if _, err := client.CreateFilterRule(rule); err == nil {
+			t.Errorf(`The filter rule %v should be rejected`, rule)
+		}
+	}
+}
+
+func TestUpdateFilterRule(t *testing.T) {
+	client := createClient(t)
+
+	rule, err := client.CreateFilterRule(&miniflux.FilterRule{Field: "author", Operator: "equals", Value: "Bot", Action: "read"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rule.Action = "tag"
+	rule.ActionValue = "Automated"
+	updatedRule, err := client.UpdateFilterRule(rule.ID, rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedRule.Action != "tag" || updatedRule.ActionValue != "Automated" {
+		t.Fatalf(`The filter rule has not been updated: %v`, updatedRule)
+	}
+}
+
+func TestDeleteFilterRule(t *testing.T) {
+	client := createClient(t)
+
+	rule, err := client.CreateFilterRule(&miniflux.FilterRule{Field: "url", Operator: "matches", Value: `/ads/`, Action: "remove"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteFilterRule(rule.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteFilterRule(rule.ID); err == nil {
+		t.Fatal(`Removing a filter rule twice should return an error`)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestCreateHighlight(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryID := result.Entries[0].ID
+	highlight, err := client.CreateHighlight(entryID, &miniflux.Highlight{Quote: "a passage", Prefix: "before ", Suffix: " after", Note: "a note"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if highlight.ID == 0 || highlight.EntryID != entryID {
+		t.Fatalf(`Unexpected highlight: %v`, highlight)
+	}
+
+	if highlight.Quote != "a passage" || highlight.Prefix != "before " || highlight.Suffix != " after" || highlight.Note != "a note" {
+		t.Fatalf(`Unexpected highlight: %v`, highlight)
+	}
+
+	if _, err := client.CreateHighlight(entryID, &miniflux.Highlight{Note: "without quote"}); err == nil {
+		t.Fatal(`The quote should be mandatory`)
+	}
+
+	if _, err := client.CreateHighlight(123456789, &miniflux.Highlight{Quote: "a passage"}); err == nil {
+		t.Fatal(`Unknown entries should be rejected`)
+	}
+
+	entry, err := client.Entry(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entry.Highlights) != 1 || entry.Highlights[0].ID != highlight.ID {
+		t.Fatalf(`The entry should have 1 highlight, got %v`, entry.Highlights)
+	}
+}
+
+func TestListUpdateAndDeleteHighlight(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := result.Entries[0]
+	highlight, err := client.CreateHighlight(entry.ID, &miniflux.Highlight{Quote: "a passage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	highlight, err = client.UpdateHighlight(highlight.ID, "a new note")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if highlight.Note != "a new note" || highlight.Quote != "a passage" {
+		t.Fatalf(`Unexpected highlight: %v`, highlight)
+	}
+
+	highlights, err := client.Highlights(0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if highlights.Total != 1 || highlights.Highlights[0].EntryTitle != entry.Title {
+		t.Fatalf(`Unexpected highlights: %v`, highlights.Highlights)
+	}
+
+	if err := client.DeleteHighlight(highlight.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	entryHighlights, err := client.EntryHighlights(entry.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entryHighlights) != 0 {
+		t.Fatalf(`The highlight should be removed, got %v`, entryHighlights)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestCreateLabel(t *testing.T) {
+	client := createClient(t)
+	label, err := client.CreateLabel("Read later")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if label.ID == 0 {
+		t.Fatalf(`Invalid labelID, got "%v"`, label.ID)
+	}
+
+	if label.Title != "Read later" {
+		t.Fatalf(`Invalid title, got "%v"`, label.Title)
+	}
+
+	if _, err := client.CreateLabel("Read later"); err == nil {
+		t.Fatal(`Duplicated labels should not be allowed`)
+	}
+
+	if _, err := client.CreateLabel(""); err == nil {
+		t.Fatal(`The label title should be mandatory`)
+	}
+}
+
+func TestUpdateAndDeleteLabel(t *testing.T) {
+	client := createClient(t)
+	label, err := client.CreateLabel("Work")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	label, err = client.UpdateLabel(label.ID, "Office")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if label.Title != "Office" {
+		t.Fatalf(`Invalid title, got "%v"`, label.Title)
+	}
+
+	if err := client.DeleteLabel(label.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	labels, err := client.Labels()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(labels) != 0 {
+		t.Fatalf(`The label should be removed, got %d labels`, len(labels))
+	}
+}
+
+func TestSetEntryLabels(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	later, err := client.CreateLabel("Later")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	work, err := client.CreateLabel("Work")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryID := result.Entries[0].ID
+	labels, err := client.SetEntryLabels(entryID, []int64{later.ID, work.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(labels) != 2 || labels[0].Title != "Later" || labels[1].Title != "Work" {
+		t.Fatalf(`Unexpected labels: %v`, labels)
+	}
+
+	if _, err := client.SetEntryLabels(entryID, []int64{123456789}); err == nil {
+		t.Fatal(`Unknown labels should be rejected`)
+	}
+
+	entry, err := client.Entry(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entry.Labels) != 2 {
+		t.Fatalf(`The entry should have 2 labels, got %d`, len(entry.Labels))
+	}
+
+	filtered, err := client.Entries(&miniflux.Filter{LabelID: work.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if filtered.Total != 1 || filtered.Entries[0].ID != entryID {
+		t.Fatalf(`Only the labeled entry should be returned, got %d entries`, filtered.Total)
+	}
+
+	labels, err = client.SetEntryLabels(entryID, []int64{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(labels) != 0 {
+		t.Fatalf(`The labels of the entry should be removed, got %v`, labels)
+	}
+
+	labels, err = client.EntryLabels(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(labels) != 0 {
+		t.Fatalf(`The labels of the entry should be removed, got %v`, labels)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+	"time"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestCreateSmartFolder(t *testing.T) {
+	client := createClient(t)
+	folder, err := client.CreateSmartFolder(&miniflux.SmartFolder{Title: "Unread news", Status: "unread"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if folder.ID == 0 {
+		t.Fatalf(`Invalid folderID, got "%v"`, folder.ID)
+	}
+
+	if folder.Title != "Unread news" || folder.Status != "unread" {
+		t.Fatalf(`Unexpected smart folder: %v`, folder)
+	}
+
+	if folder.FeedIDs == nil || len(folder.FeedIDs) != 0 {
+		t.Fatalf(`The feeds should be an empty list, got %v`, folder.FeedIDs)
+	}
+
+	if _, err := client.CreateSmartFolder(&miniflux.SmartFolder{Title: "Unread news"}); err == nil {
+		t.Fatal(`Duplicated smart folders should not be allowed`)
+	}
+
+	if _, err := client.CreateSmartFolder(&miniflux.SmartFolder{}); err == nil {
+		t.Fatal(`The smart folder title should be mandatory`)
+	}
+
+	if _, err := client.CreateSmartFolder(&miniflux.SmartFolder{Title: "Removed", Status: "removed"}); err == nil {
+		t.Fatal(`Invalid status should be rejected`)
+	}
+
+	now := time.Now()
+	yesterday := now.AddDate(0, 0, -1)
+	if _, err := client.CreateSmartFolder(&miniflux.SmartFolder{Title: "Backwards", PublishedAfter: &now, PublishedBefore: &yesterday}); err == nil {
+		t.Fatal(`Invalid date ranges should be rejected`)
+	}
+}
+
+func TestUpdateAndDeleteSmartFolder(t *testing.T) {
+	client := createClient(t)
+	folder, err := client.CreateSmartFolder(&miniflux.SmartFolder{Title: "Work"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	folder.Title = "Office"
+	folder.SearchQuery = "meeting"
+	folder, err = client.UpdateSmartFolder(folder.ID, folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if folder.Title != "Office" || folder.SearchQuery != "meeting" {
+		t.Fatalf(`Unexpected smart folder: %v`, folder)
+	}
+
+	if err := client.DeleteSmartFolder(folder.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	folders, err := client.SmartFolders()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(folders) != 0 {
+		t.Fatalf(`The smart folder should be removed, got %d folders`, len(folders))
+	}
+}
+
+func TestSmartFolderEntries(t *testing.T) {
+	client := createClient(t)
+	feed, category := createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	label, err := client.CreateLabel("Later")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryID := result.Entries[0].ID
+	if _, err := client.SetEntryLabels(entryID, []int64{label.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	folder, err := client.CreateSmartFolder(&miniflux.SmartFolder{
+		Title:       "Later",
+		FeedIDs:     []int64{feed.ID},
+		CategoryIDs: []int64{category.ID},
+		LabelIDs:    []int64{label.ID},
+		Status:      "unread",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if folder.UnreadCount != 1 {
+		t.Fatalf(`The smart folder should have 1 unread entry, got %d`, folder.UnreadCount)
+	}
+
+	entries, err := client.SmartFolderEntries(folder.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entries.Total != 1 || entries.Entries[0].ID != entryID {
+		t.Fatalf(`Only the labeled entry should be returned, got %d entries`, entries.Total)
+	}
+
+	if err := client.UpdateEntries([]int64{entryID}, miniflux.EntryStatusRead); err != nil {
+		t.Fatal(err)
+	}
+
+	folder, err = client.SmartFolder(folder.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if folder.UnreadCount != 0 {
+		t.Fatalf(`The smart folder should not have unread entries, got %d`, folder.UnreadCount)
+	}
+}
@@ -6,9 +6,12 @@ package ui // import "miniflux.app/ui"
 
 import (
 	"net/http"
+	"strings"
 
+	"miniflux.app/http/client"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/reader/extractor"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -37,15 +40,23 @@ func (h *handler) showEditCategoryPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	categoryForm := form.CategoryForm{
-		Title: category.Title,
+		Title:             category.Title,
+		ContentExtractors: category.ContentExtractors,
+		FilterMode:        category.FilterMode,
+		Crawler:           category.Crawler,
+		UserAgent:         category.UserAgent,
+		ScraperRules:      category.ScraperRules,
+		RewriteRules:      category.RewriteRules,
 	}
 
 	view.Set("form", categoryForm)
 	view.Set("category", category)
+	view.Set("contentExtractors", strings.Join(extractor.Names(), ", "))
 	view.Set("menu", "categories")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
 	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	view.Set("defaultUserAgent", client.DefaultUserAgent)
 
 	html.OK(w, r, view.Render("edit_category"))
 }
@@ -40,6 +40,7 @@ func (h *handler) showCategoryEntriesPage(w http.ResponseWriter, r *http.Request
 	builder.WithOrder(model.DefaultSortingOrder)
 	builder.WithDirection(user.EntryDirection)
 	builder.WithStatus(model.EntryStatusUnread)
+	builder.WithCollapsedClusters()
 	builder.WithOffset(offset)
 	builder.WithLimit(nbItemsPerPage)
 
@@ -55,11 +56,18 @@ func (h *handler) showCategoryEntriesPage(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	clusters, err := h.store.EntryClusters(user.ID, entries)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("category", category)
 	view.Set("total", count)
 	view.Set("entries", entries)
+	view.Set("clusters", clusters)
 	view.Set("pagination", getPagination(route.Path(h.router, "categoryEntries", "categoryID", category.ID), count, offset))
 	view.Set("menu", "categories")
 	view.Set("user", user)
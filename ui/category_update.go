@@ -6,11 +6,14 @@ package ui // import "miniflux.app/ui"
 
 import (
 	"net/http"
+	"strings"
 
+	"miniflux.app/http/client"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/logger"
+	"miniflux.app/reader/extractor"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -41,10 +44,12 @@ func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", categoryForm)
 	view.Set("category", category)
+	view.Set("contentExtractors", strings.Join(extractor.Names(), ", "))
 	view.Set("menu", "categories")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
 	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	view.Set("defaultUserAgent", client.DefaultUserAgent)
 
 	if err := categoryForm.Validate(); err != nil {
 		view.Set("errorMessage", err.Error())
@@ -49,6 +49,12 @@ func (h *handler) showCategoryEntryPage(w http.ResponseWriter, r *http.Request)
 			return
 		}
 
+		// The category list shows the near-duplicate entries once, they are read together.
+		if err := h.store.SetClustersStatus(user.ID, []int64{entry.ID}, model.EntryStatusRead); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
 		entry.Status = model.EntryStatusRead
 	}
 
@@ -0,0 +1,83 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/model"
+	"miniflux.app/reader/diff"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+// entryRevisionChange shows what changed between a revision and the version that replaced it.
+type entryRevisionChange struct {
+	Revision     *model.EntryRevision
+	Title        string
+	TitleChanged bool
+	ContentDiff  string
+}
+
+func (h *handler) showEntryRevisionsPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	entryID := request.RouteInt64Param(r, "entryID")
+	builder := h.store.NewEntryQueryBuilder(user.ID)
+	builder.WithEntryID(entryID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	revisions, err := h.store.EntryRevisions(user.ID, entry.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	title, content := entry.Title, entry.Content
+	changes := make([]*entryRevisionChange, 0, len(revisions))
+	for _, revision := range revisions {
+		change := &entryRevisionChange{
+			Revision:     revision,
+			Title:        title,
+			TitleChanged: revision.Title != title,
+		}
+
+		if words := diff.Words(sanitizer.StripTags(revision.Content), sanitizer.StripTags(content)); words.HasChanges() {
+			change.ContentDiff = words.HTML()
+		}
+
+		changes = append(changes, change)
+		title, content = revision.Title, revision.Content
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("entry", entry)
+	view.Set("revisions", changes)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	html.OK(w, r, view.Render("entry_revisions"))
+}
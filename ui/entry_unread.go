@@ -72,6 +72,12 @@ func (h *handler) showUnreadEntryPage(w http.ResponseWriter, r *http.Request) {
 		html.ServerError(w, r, err)
 		return
 	}
+
+	// The unread list shows the near-duplicate entries once, they are read together.
+	if err := h.store.SetClustersStatus(user.ID, []int64{entry.ID}, model.EntryStatusRead); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
 	entry.Status = model.EntryStatusRead
 
 	sess := session.New(h.store, request.SessionID(r))
@@ -13,7 +13,7 @@ import (
 )
 
 func (h *handler) updateEntriesStatus(w http.ResponseWriter, r *http.Request) {
-	entryIDs, status, err := decodeEntryStatusPayload(r.Body)
+	entryIDs, status, clusters, err := decodeEntryStatusPayload(r.Body)
 	if err != nil {
 		json.BadRequest(w, r, err)
 		return
@@ -30,5 +30,12 @@ func (h *handler) updateEntriesStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if clusters {
+		if err := h.store.SetClustersStatus(request.UserID(r), entryIDs, status); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+	}
+
 	json.OK(w, r, "OK")
 }
@@ -0,0 +1,38 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/ui/session"
+)
+
+func (h *handler) deduplicateFeed(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	feedID := request.RouteInt64Param(r, "feedID")
+	if !h.store.FeedExists(userID, feedID) {
+		html.NotFound(w, r)
+		return
+	}
+
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess := session.New(h.store, request.SessionID(r))
+
+	count, err := h.store.DeduplicateEntries(userID, feedID)
+	if err != nil {
+		logger.Error("[UI:DeduplicateFeed] %v", err)
+		sess.NewFlashErrorMessage(printer.Printf("error.unable_to_deduplicate_feed"))
+	} else {
+		sess.NewFlashMessage(printer.Printf("alert.feed_deduplicated", count))
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "editFeed", "feedID", feedID))
+}
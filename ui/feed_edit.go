@@ -6,10 +6,14 @@ package ui // import "miniflux.app/ui"
 
 import (
 	"net/http"
+	"strings"
 
+	"miniflux.app/config"
 	"miniflux.app/http/client"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/model"
+	"miniflux.app/reader/extractor"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -41,26 +45,56 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	feedForm := form.FeedForm{
-		SiteURL:       feed.SiteURL,
-		FeedURL:       feed.FeedURL,
-		Title:         feed.Title,
-		ScraperRules:  feed.ScraperRules,
-		RewriteRules:  feed.RewriteRules,
-		TitleFilter:   feed.TitleFilter,
-		ContentFilter: feed.ContentFilter,
-		Crawler:       feed.Crawler,
-		UseMercury:    feed.UseMercury,
-		UserAgent:     feed.UserAgent,
-		CategoryID:    feed.Category.ID,
-		Username:      feed.Username,
-		Password:      feed.Password,
+		SiteURL:      feed.SiteURL,
+		FeedURL:      feed.FeedURL,
+		Title:        feed.Title,
+		ScraperRules: feed.ScraperRules,
+		RewriteRules: feed.RewriteRules,
+		Crawler:      feed.Crawler,
+		UseMercury:   feed.UseMercury,
+		UserAgent:    feed.UserAgent,
+		CategoryID:   feed.Category.ID,
+		Username:     feed.Username,
+		Password:     feed.Password,
 		Disabled:     feed.Disabled,
+		SourceType:   feed.SourceType,
+	}
+
+	feedForm.ContentExtractors = feed.ContentExtractors
+	feedForm.FilterMode = feed.FilterMode
+	feedForm.EntryIdentity = feed.EntryIdentity
+	feedForm.CrawlerOverride = feed.CrawlerOverride
+
+	if rules := feed.SourceSettings.WebPage; rules != nil {
+		feedForm.ItemSelector = rules.ItemSelector
+		feedForm.TitleSelector = rules.TitleSelector
+		feedForm.LinkSelector = rules.LinkSelector
+		feedForm.DateSelector = rules.DateSelector
+		feedForm.SummarySelector = rules.SummarySelector
+	}
+
+	if settings := feed.SourceSettings.PageMonitor; settings != nil {
+		feedForm.MonitorSelector = settings.Selector
+		feedForm.MonitorThreshold = settings.Threshold
+	}
+
+	var newsletterAddress string
+	if feed.IsNewsletter() && config.Opts.HasMailService() {
+		token, err := h.store.NewsletterToken(user.ID)
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		newsletterAddress = model.NewsletterAddress(token, config.Opts.MailDomain(), feed.ID)
 	}
 
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", feedForm)
+	view.Set("newsletterAddress", newsletterAddress)
 	view.Set("categories", categories)
+	view.Set("contentExtractors", strings.Join(extractor.Names(), ", "))
 	view.Set("feed", feed)
 	view.Set("menu", "feeds")
 	view.Set("user", user)
@@ -0,0 +1,97 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/http/client"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/logger"
+	"miniflux.app/reader/extractor"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+// previewFeed shows the effect of the submitted settings on the latest entries of the feed, nothing is saved.
+func (h *handler) previewFeed(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	feedID := request.RouteInt64Param(r, "feedID")
+	feed, err := h.store.FeedByID(user.ID, feedID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	feedForm := form.NewFeedForm(r)
+	feedForm.SourceType = feed.SourceType
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", feedForm)
+	view.Set("categories", categories)
+	view.Set("contentExtractors", strings.Join(extractor.Names(), ", "))
+	view.Set("feed", feed)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	view.Set("defaultUserAgent", client.DefaultUserAgent)
+
+	if err := feedForm.ValidateModification(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("edit_feed"))
+		return
+	}
+
+	// The feed is only used for the preview, the form values are never saved here.
+	proposedFeed := *feed
+	proposedCategory := *feed.Category
+	proposedFeed.Category = &proposedCategory
+
+	previews, err := h.feedHandler.PreviewFeed(feedForm.Merge(&proposedFeed), nil, feedForm.PreviewRequest())
+	if err != nil {
+		logger.Error("[UI:PreviewFeed] %v", err)
+		view.Set("errorMessage", err)
+		html.OK(w, r, view.Render("edit_feed"))
+		return
+	}
+
+	if len(previews) == 0 {
+		view.Set("errorMessage", "error.no_entry_to_preview")
+		html.OK(w, r, view.Render("edit_feed"))
+		return
+	}
+
+	removedCount := 0
+	for _, preview := range previews {
+		if preview.IsRemoved() {
+			removedCount++
+		}
+	}
+
+	view.Set("previews", previews)
+	view.Set("previewRemovedCount", removedCount)
+	html.OK(w, r, view.Render("edit_feed"))
+}
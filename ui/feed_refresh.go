@@ -10,7 +10,9 @@ import (
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
+	"miniflux.app/locale"
 	"miniflux.app/logger"
+	"miniflux.app/ui/session"
 )
 
 func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
@@ -22,6 +24,21 @@ func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
 	html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feedID))
 }
 
+func (h *handler) refreshFeedIcon(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess := session.New(h.store, request.SessionID(r))
+
+	if err := h.feedHandler.RefreshFeedIcon(request.UserID(r), feedID); err != nil {
+		logger.Error("[UI:RefreshFeedIcon] %v", err)
+		sess.NewFlashErrorMessage(printer.Printf("error.feed_icon_not_found"))
+	} else {
+		sess.NewFlashMessage(printer.Printf("alert.feed_icon_refreshed"))
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "editFeed", "feedID", feedID))
+}
+
 func (h *handler) refreshAllFeeds(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	jobs, err := h.store.NewUserBatch(userID, h.store.CountFeeds(userID))
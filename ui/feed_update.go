@@ -6,12 +6,14 @@ package ui // import "miniflux.app/ui"
 
 import (
 	"net/http"
+	"strings"
 
 	"miniflux.app/http/client"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/logger"
+	"miniflux.app/reader/extractor"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -43,11 +45,13 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 	}
 
 	feedForm := form.NewFeedForm(r)
+	feedForm.SourceType = feed.SourceType
 
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", feedForm)
 	view.Set("categories", categories)
+	view.Set("contentExtractors", strings.Join(extractor.Names(), ", "))
 	view.Set("feed", feed)
 	view.Set("menu", "feeds")
 	view.Set("user", user)
@@ -61,6 +65,7 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	previousIdentity := feed.EntryIdentity
 	err = h.store.UpdateFeed(feedForm.Merge(feed))
 	if err != nil {
 		logger.Error("[UI:UpdateFeed] %v", err)
@@ -69,5 +74,15 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The stored entries must be recognized with the new strategy when the feed is refreshed.
+	if feed.EntryIdentity != previousIdentity {
+		if _, err := h.store.DeduplicateEntries(user.ID, feed.ID); err != nil {
+			logger.Error("[UI:UpdateFeed] %v", err)
+			view.Set("errorMessage", "error.unable_to_update_feed")
+			html.OK(w, r, view.Render("edit_feed"))
+			return
+		}
+	}
+
 	html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feed.ID))
 }
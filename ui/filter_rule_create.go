@@ -0,0 +1,47 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showCreateFilterRulePage(w http.ResponseWriter, r *http.Request) {
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if err := h.setFilterRuleFormData(view, user.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	filterRuleForm := &form.FilterRuleForm{
+		Scope:    form.FilterRuleScope(request.QueryInt64Param(r, "category_id", 0), request.QueryInt64Param(r, "feed_id", 0)),
+		Field:    model.FilterFieldTitle,
+		Operator: model.FilterOperatorContains,
+		Action:   model.FilterActionRemove,
+	}
+
+	view.Set("form", filterRuleForm)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	html.OK(w, r, view.Render("create_filter_rule"))
+}
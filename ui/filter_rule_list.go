@@ -0,0 +1,78 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showFilterRulesPage(w http.ResponseWriter, r *http.Request) {
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	rules, err := h.store.FilterRules(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if err := h.setFilterRuleFormData(view, user.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	view.Set("rules", rules)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	html.OK(w, r, view.Render("filter_rules"))
+}
+
+// setFilterRuleFormData adds the choices of the filter rule form to the view: the fields, the operators,
+// the actions, and the categories and feeds of the user with their titles indexed by ID.
+func (h *handler) setFilterRuleFormData(v *view.View, userID int64) error {
+	categories, err := h.store.Categories(userID)
+	if err != nil {
+		return err
+	}
+
+	feeds, err := h.store.Feeds(userID)
+	if err != nil {
+		return err
+	}
+
+	categoryTitles := make(map[int64]string)
+	for _, category := range categories {
+		categoryTitles[category.ID] = category.Title
+	}
+
+	feedTitles := make(map[int64]string)
+	for _, feed := range feeds {
+		feedTitles[feed.ID] = feed.Title
+	}
+
+	v.Set("filterFields", model.FilterFields)
+	v.Set("filterOperators", model.FilterOperators)
+	v.Set("filterActions", model.FilterActions)
+	v.Set("categories", categories)
+	v.Set("feeds", feeds)
+	v.Set("categoryTitles", categoryTitles)
+	v.Set("feedTitles", feedTitles)
+	return nil
+}
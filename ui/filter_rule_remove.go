@@ -0,0 +1,23 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+)
+
+func (h *handler) removeFilterRule(w http.ResponseWriter, r *http.Request) {
+	ruleID := request.RouteInt64Param(r, "ruleID")
+	if err := h.store.RemoveFilterRule(request.UserID(r), ruleID); err != nil {
+		logger.Error("[UI:RemoveFilterRule] %v", err)
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "filterRules"))
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) saveFilterRule(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	filterRuleForm := form.NewFilterRuleForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", filterRuleForm)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	if err := h.setFilterRuleFormData(view, user.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if err := filterRuleForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("create_filter_rule"))
+		return
+	}
+
+	rule := filterRuleForm.Merge(&model.FilterRule{UserID: user.ID})
+	if !h.isValidFilterRuleScope(rule) {
+		view.Set("errorMessage", "error.filter_rule_invalid")
+		html.OK(w, r, view.Render("create_filter_rule"))
+		return
+	}
+
+	if err := h.store.CreateFilterRule(rule); err != nil {
+		logger.Error("[UI:SaveFilterRule] %v", err)
+		view.Set("errorMessage", "error.unable_to_create_filter_rule")
+		html.OK(w, r, view.Render("create_filter_rule"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "filterRules"))
+}
+
+// isValidFilterRuleScope makes sure the category or the feed of the rule belongs to the user.
+func (h *handler) isValidFilterRuleScope(rule *model.FilterRule) bool {
+	if rule.CategoryID > 0 && !h.store.CategoryExists(rule.UserID, rule.CategoryID) {
+		return false
+	}
+
+	if rule.FeedID > 0 && !h.store.FeedExists(rule.UserID, rule.FeedID) {
+		return false
+	}
+
+	return true
+}
@@ -9,11 +9,20 @@ import (
 
 	"miniflux.app/errors"
 	"miniflux.app/model"
+	"miniflux.app/reader/extractor"
+	"miniflux.app/reader/rewrite"
+	"miniflux.app/reader/scraper"
 )
 
 // CategoryForm represents a feed form in the UI
 type CategoryForm struct {
-	Title string
+	Title             string
+	ContentExtractors string
+	FilterMode        string
+	Crawler           bool
+	UserAgent         string
+	ScraperRules      string
+	RewriteRules      string
 }
 
 // Validate makes sure the form values are valid.
@@ -21,18 +30,42 @@ func (c CategoryForm) Validate() error {
 	if c.Title == "" {
 		return errors.NewLocalizedError("error.title_required")
 	}
+	if _, err := extractor.ParseChain(c.ContentExtractors); err != nil {
+		return errors.NewLocalizedError("error.content_extractors_invalid")
+	}
+	if err := model.ValidateFilterMode(c.FilterMode); err != nil {
+		return errors.NewLocalizedError("error.filter_mode_invalid")
+	}
+	if _, err := scraper.ParseRules(c.ScraperRules); err != nil {
+		return errors.NewLocalizedError("error.scraper_rules_invalid")
+	}
+	if err := rewrite.ValidateRules(c.RewriteRules); err != nil {
+		return errors.NewLocalizedError("error.rewrite_rules_invalid")
+	}
 	return nil
 }
 
 // Merge update the given category fields.
 func (c CategoryForm) Merge(category *model.Category) *model.Category {
 	category.Title = c.Title
+	category.ContentExtractors = c.ContentExtractors
+	category.FilterMode = c.FilterMode
+	category.Crawler = c.Crawler
+	category.UserAgent = c.UserAgent
+	category.ScraperRules = c.ScraperRules
+	category.RewriteRules = c.RewriteRules
 	return category
 }
 
 // NewCategoryForm returns a new CategoryForm.
 func NewCategoryForm(r *http.Request) *CategoryForm {
 	return &CategoryForm{
-		Title: r.FormValue("title"),
+		Title:             r.FormValue("title"),
+		ContentExtractors: r.FormValue("content_extractors"),
+		FilterMode:        r.FormValue("filter_mode"),
+		Crawler:           r.FormValue("crawler") == "1",
+		UserAgent:         r.FormValue("user_agent"),
+		ScraperRules:      r.FormValue("scraper_rules"),
+		RewriteRules:      r.FormValue("rewrite_rules"),
 	}
 }
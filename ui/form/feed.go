@@ -6,29 +6,85 @@ package form // import "miniflux.app/ui/form"
 
 import (
 	"net/http"
-	"regexp"
 	"strconv"
 
 	"miniflux.app/errors"
 	"miniflux.app/model"
+	"miniflux.app/reader/extractor"
+	"miniflux.app/reader/rewrite"
+	"miniflux.app/reader/scraper"
 )
 
 // FeedForm represents a feed form in the UI
 type FeedForm struct {
-	FeedURL       string
-	SiteURL       string
-	Title         string
-	ScraperRules  string
-	RewriteRules  string
-	TitleFilter   string
-	ContentFilter string
-	Crawler       bool
-	UseMercury    bool
-	UserAgent     string
-	CategoryID    int64
-	Username      string
-	Password      string
+	FeedURL      string
+	SiteURL      string
+	Title        string
+	ScraperRules string
+	RewriteRules string
+	Crawler      bool
+	UseMercury   bool
+	UserAgent    string
+	CategoryID   int64
+	Username     string
+	Password     string
 	Disabled     bool
+
+	SourceType      string
+	ItemSelector    string
+	TitleSelector   string
+	LinkSelector    string
+	DateSelector    string
+	SummarySelector string
+
+	MonitorSelector  string
+	MonitorThreshold int
+
+	ContentExtractors string
+	FilterMode        string
+	EntryIdentity     string
+	CrawlerOverride   bool
+
+	PreviewRefetch bool
+	PreviewCrawl   bool
+}
+
+// IsWebPage returns true if entries are extracted from a plain web page.
+func (f FeedForm) IsWebPage() bool {
+	return f.SourceType == model.FeedSourceTypeWebPage
+}
+
+// WebPageRules returns the CSS selectors used to extract entries from a web page.
+func (f FeedForm) WebPageRules() *model.WebPageRules {
+	return &model.WebPageRules{
+		ItemSelector:    f.ItemSelector,
+		TitleSelector:   f.TitleSelector,
+		LinkSelector:    f.LinkSelector,
+		DateSelector:    f.DateSelector,
+		SummarySelector: f.SummarySelector,
+	}
+}
+
+// IsPageMonitor returns true if the feed monitors the changes of a web page.
+func (f FeedForm) IsPageMonitor() bool {
+	return f.SourceType == model.FeedSourceTypePageMonitor
+}
+
+// PageMonitorSettings returns the settings used to compare the page with its previous snapshot.
+func (f FeedForm) PageMonitorSettings() *model.PageMonitorSettings {
+	return &model.PageMonitorSettings{
+		Selector:  f.MonitorSelector,
+		Threshold: f.MonitorThreshold,
+	}
+}
+
+// PreviewRequest returns the options used to preview the feed settings.
+func (f FeedForm) PreviewRequest() *model.FeedPreviewRequest {
+	return &model.FeedPreviewRequest{
+		Limit:   model.DefaultPreviewLimit,
+		Refetch: f.PreviewRefetch,
+		Crawl:   f.PreviewCrawl,
+	}
 }
 
 // ValidateModification validates FeedForm fields
@@ -36,17 +92,30 @@ func (f FeedForm) ValidateModification() error {
 	if f.FeedURL == "" || f.SiteURL == "" || f.Title == "" || f.CategoryID == 0 {
 		return errors.NewLocalizedError("error.fields_mandatory")
 	}
-	if f.TitleFilter != "" || f.ContentFilter != "" {
-		var err error
-		_, err = regexp.CompilePOSIX(f.TitleFilter)
-		if err != nil {
-			return errors.NewLocalizedError("error.title_filter_cannot_compile")
+	if _, err := extractor.ParseChain(f.ContentExtractors); err != nil {
+		return errors.NewLocalizedError("error.content_extractors_invalid")
+	}
+	if err := model.ValidateFilterMode(f.FilterMode); err != nil {
+		return errors.NewLocalizedError("error.filter_mode_invalid")
+	}
+	if err := model.ValidateEntryIdentity(f.EntryIdentity); err != nil {
+		return errors.NewLocalizedError("error.entry_identity_invalid")
+	}
+	if _, err := scraper.ParseRules(f.ScraperRules); err != nil {
+		return errors.NewLocalizedError("error.scraper_rules_invalid")
+	}
+	if err := rewrite.ValidateRules(f.RewriteRules); err != nil {
+		return errors.NewLocalizedError("error.rewrite_rules_invalid")
+	}
+	if f.IsWebPage() {
+		if err := f.WebPageRules().Validate(); err != nil {
+			return errors.NewLocalizedError("error.web_page_rules_invalid")
 		}
-		_, err = regexp.CompilePOSIX(f.ContentFilter)
-		if err != nil {
-			return errors.NewLocalizedError("error.content_filter_cannot_compile")
+	}
+	if f.IsPageMonitor() {
+		if err := f.PageMonitorSettings().Validate(); err != nil {
+			return errors.NewLocalizedError("error.page_monitor_threshold_invalid")
 		}
-
 	}
 	return nil
 }
@@ -59,16 +128,24 @@ func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.FeedURL = f.FeedURL
 	feed.ScraperRules = f.ScraperRules
 	feed.RewriteRules = f.RewriteRules
-	feed.TitleFilter = f.TitleFilter
-	feed.ContentFilter = f.ContentFilter
 	feed.Crawler = f.Crawler
+	feed.CrawlerOverride = f.CrawlerOverride
 	feed.UseMercury = f.UseMercury
+	feed.ContentExtractors = f.ContentExtractors
+	feed.FilterMode = f.FilterMode
+	feed.EntryIdentity = f.EntryIdentity
 	feed.UserAgent = f.UserAgent
 	feed.ParsingErrorCount = 0
 	feed.ParsingErrorMsg = ""
 	feed.Username = f.Username
 	feed.Password = f.Password
 	feed.Disabled = f.Disabled
+	if feed.IsWebPage() {
+		feed.SourceSettings.WebPage = f.WebPageRules()
+	}
+	if feed.IsPageMonitor() {
+		feed.SourceSettings.PageMonitor = f.PageMonitorSettings()
+	}
 	return feed
 }
 
@@ -79,20 +156,41 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		categoryID = 0
 	}
 
+	monitorThreshold, err := strconv.Atoi(r.FormValue("monitor_threshold"))
+	if err != nil {
+		monitorThreshold = 0
+	}
+
 	return &FeedForm{
-		FeedURL:       r.FormValue("feed_url"),
-		SiteURL:       r.FormValue("site_url"),
-		Title:         r.FormValue("title"),
-		ScraperRules:  r.FormValue("scraper_rules"),
-		UserAgent:     r.FormValue("user_agent"),
-		RewriteRules:  r.FormValue("rewrite_rules"),
-		TitleFilter:   r.FormValue("title_filter"),
-		ContentFilter: r.FormValue("content_filter"),
-		Crawler:       r.FormValue("crawler") == "1",
-		UseMercury:    r.FormValue("use_mercury") == "1",
-		CategoryID:    int64(categoryID),
-		Username:      r.FormValue("feed_username"),
-		Password:      r.FormValue("feed_password"),
+		FeedURL:      r.FormValue("feed_url"),
+		SiteURL:      r.FormValue("site_url"),
+		Title:        r.FormValue("title"),
+		ScraperRules: r.FormValue("scraper_rules"),
+		UserAgent:    r.FormValue("user_agent"),
+		RewriteRules: r.FormValue("rewrite_rules"),
+		Crawler:      r.FormValue("crawler") == "1",
+		UseMercury:   r.FormValue("use_mercury") == "1",
+		CategoryID:   int64(categoryID),
+		Username:     r.FormValue("feed_username"),
+		Password:     r.FormValue("feed_password"),
 		Disabled:     r.FormValue("disabled") == "1",
+
+		SourceType:      r.FormValue("source_type"),
+		ItemSelector:    r.FormValue("item_selector"),
+		TitleSelector:   r.FormValue("title_selector"),
+		LinkSelector:    r.FormValue("link_selector"),
+		DateSelector:    r.FormValue("date_selector"),
+		SummarySelector: r.FormValue("summary_selector"),
+
+		MonitorSelector:  r.FormValue("monitor_selector"),
+		MonitorThreshold: monitorThreshold,
+
+		ContentExtractors: r.FormValue("content_extractors"),
+		FilterMode:        r.FormValue("filter_mode"),
+		EntryIdentity:     r.FormValue("entry_identity"),
+		CrawlerOverride:   r.FormValue("crawler_override") == "1",
+
+		PreviewRefetch: r.FormValue("preview_refetch") == "1",
+		PreviewCrawl:   r.FormValue("preview_crawl") == "1",
 	}
 }
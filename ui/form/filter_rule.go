@@ -0,0 +1,98 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+	"miniflux.app/reader/filter"
+)
+
+// FilterRuleForm represents the filter rule form.
+type FilterRuleForm struct {
+	Scope       string
+	Field       string
+	Operator    string
+	Value       string
+	Action      string
+	ActionValue string
+}
+
+// Validate makes sure the form values are valid.
+func (f FilterRuleForm) Validate() error {
+	if f.Field == "" || f.Operator == "" || f.Action == "" {
+		return errors.NewLocalizedError("error.fields_mandatory")
+	}
+
+	if err := filter.ValidateRule(f.Merge(&model.FilterRule{})); err != nil {
+		return errors.NewLocalizedError("error.filter_rule_invalid")
+	}
+
+	return nil
+}
+
+// Merge updates the fields of the given filter rule.
+func (f FilterRuleForm) Merge(rule *model.FilterRule) *model.FilterRule {
+	rule.CategoryID = 0
+	rule.FeedID = 0
+
+	if parts := strings.SplitN(f.Scope, ":", 2); len(parts) == 2 {
+		id, _ := strconv.ParseInt(parts[1], 10, 64)
+		switch parts[0] {
+		case "category":
+			rule.CategoryID = id
+		case "feed":
+			rule.FeedID = id
+		}
+	}
+
+	rule.Field = f.Field
+	rule.Operator = f.Operator
+	rule.Value = f.Value
+	rule.Action = f.Action
+	rule.ActionValue = strings.TrimSpace(f.ActionValue)
+	return rule
+}
+
+// NewFilterRuleForm returns a new FilterRuleForm.
+func NewFilterRuleForm(r *http.Request) *FilterRuleForm {
+	return &FilterRuleForm{
+		Scope:       r.FormValue("scope"),
+		Field:       r.FormValue("field"),
+		Operator:    r.FormValue("operator"),
+		Value:       r.FormValue("value"),
+		Action:      r.FormValue("action"),
+		ActionValue: r.FormValue("action_value"),
+	}
+}
+
+// NewFilterRuleFormFromRule returns a FilterRuleForm initialized with the values of the rule.
+func NewFilterRuleFormFromRule(rule *model.FilterRule) *FilterRuleForm {
+	return &FilterRuleForm{
+		Scope:       FilterRuleScope(rule.CategoryID, rule.FeedID),
+		Field:       rule.Field,
+		Operator:    rule.Operator,
+		Value:       rule.Value,
+		Action:      rule.Action,
+		ActionValue: rule.ActionValue,
+	}
+}
+
+// FilterRuleScope returns the value of the scope field for a category or a feed.
+func FilterRuleScope(categoryID, feedID int64) string {
+	switch {
+	case feedID > 0:
+		return fmt.Sprintf("feed:%d", feedID)
+	case categoryID > 0:
+		return fmt.Sprintf("category:%d", categoryID)
+	default:
+		return ""
+	}
+}
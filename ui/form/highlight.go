@@ -0,0 +1,30 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/model"
+)
+
+// HighlightForm represents the form used to change the note of a highlight.
+type HighlightForm struct {
+	Note string
+}
+
+// Merge updates the note of the given highlight.
+func (h HighlightForm) Merge(highlight *model.Highlight) *model.Highlight {
+	highlight.Note = h.Note
+	return highlight
+}
+
+// NewHighlightForm returns a new HighlightForm.
+func NewHighlightForm(r *http.Request) *HighlightForm {
+	return &HighlightForm{
+		Note: strings.TrimSpace(r.FormValue("note")),
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestHighlightFormMerge(t *testing.T) {
+	values := url.Values{"note": {"  To quote in the report  "}}
+	r, err := http.NewRequest("POST", "/highlight/1/update", strings.NewReader(values.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	highlight := NewHighlightForm(r).Merge(&model.Highlight{Quote: "Some passage"})
+	if highlight.Note != "To quote in the report" {
+		t.Errorf(`Unexpected note: %q`, highlight.Note)
+	}
+
+	if highlight.Quote != "Some passage" {
+		t.Errorf(`The quote should not change, got %q`, highlight.Quote)
+	}
+}
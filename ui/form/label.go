@@ -0,0 +1,65 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+// LabelForm represents the form used to create or rename a label.
+type LabelForm struct {
+	Title string
+}
+
+// Validate makes sure the form values are valid.
+func (l LabelForm) Validate() error {
+	if l.Title == "" {
+		return errors.NewLocalizedError("error.title_required")
+	}
+
+	return nil
+}
+
+// Merge updates the fields of the given label.
+func (l LabelForm) Merge(label *model.Label) *model.Label {
+	label.Title = l.Title
+	return label
+}
+
+// NewLabelForm returns a new LabelForm.
+func NewLabelForm(r *http.Request) *LabelForm {
+	return &LabelForm{
+		Title: strings.TrimSpace(r.FormValue("title")),
+	}
+}
+
+// EntryLabelsForm represents the labels checked for an entry.
+type EntryLabelsForm struct {
+	LabelIDs []int64
+}
+
+// NewEntryLabelsForm returns a new EntryLabelsForm, the invalid label IDs are ignored.
+func NewEntryLabelsForm(r *http.Request) *EntryLabelsForm {
+	return &EntryLabelsForm{LabelIDs: formValueIDs(r, "label_id")}
+}
+
+// formValueIDs returns the positive IDs submitted with the given name.
+func formValueIDs(r *http.Request, name string) []int64 {
+	r.ParseForm()
+
+	ids := make([]int64, 0)
+	for _, value := range r.Form[name] {
+		if id, err := strconv.ParseInt(value, 10, 64); err == nil && id > 0 {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
@@ -0,0 +1,37 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLabelFormValidation(t *testing.T) {
+	if err := (LabelForm{}).Validate(); err == nil {
+		t.Error(`A label without title should be rejected`)
+	}
+
+	if err := (LabelForm{Title: "Later"}).Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEntryLabelsForm(t *testing.T) {
+	values := url.Values{"label_id": {"3", "invalid", "0", "7"}}
+	r, err := http.NewRequest("POST", "/entry/1/labels", strings.NewReader(values.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form := NewEntryLabelsForm(r)
+	if !reflect.DeepEqual(form.LabelIDs, []int64{3, 7}) {
+		t.Errorf(`Unexpected label IDs: %v`, form.LabelIDs)
+	}
+}
@@ -6,6 +6,7 @@ package form // import "miniflux.app/ui/form"
 
 import (
 	"net/http"
+	"strconv"
 
 	"miniflux.app/errors"
 	"miniflux.app/model"
@@ -13,16 +14,18 @@ import (
 
 // SettingsForm represents the settings form.
 type SettingsForm struct {
-	Username          string
-	Password          string
-	Confirmation      string
-	Theme             string
-	Language          string
-	Timezone          string
-	EntryDirection    string
-	KeyboardShortcuts bool
-	CustomCSS         string
-	MercuryAPIURL     string
+	Username            string
+	Password            string
+	Confirmation        string
+	Theme               string
+	Language            string
+	Timezone            string
+	EntryDirection      string
+	KeyboardShortcuts   bool
+	CustomCSS           string
+	MercuryAPIURL       string
+	EntryRevisionsLimit int
+	UnreadOnUpdate      bool
 }
 
 // Merge updates the fields of the given user.
@@ -35,6 +38,8 @@ func (s *SettingsForm) Merge(user *model.User) *model.User {
 	user.KeyboardShortcuts = s.KeyboardShortcuts
 	user.Extra["custom_css"] = s.CustomCSS
 	user.MercuryAPIURL = s.MercuryAPIURL
+	user.EntryRevisionsLimit = s.EntryRevisionsLimit
+	user.UnreadOnUpdate = s.UnreadOnUpdate
 	if s.Password != "" {
 		user.Password = s.Password
 	}
@@ -63,21 +68,32 @@ func (s *SettingsForm) Validate() error {
 		}
 	}
 
+	if s.EntryRevisionsLimit < 0 || s.EntryRevisionsLimit > model.MaxEntryRevisionsLimit {
+		return errors.NewLocalizedError("error.entry_revisions_limit_invalid", model.MaxEntryRevisionsLimit)
+	}
+
 	return nil
 }
 
 // NewSettingsForm returns a new SettingsForm.
 func NewSettingsForm(r *http.Request) *SettingsForm {
+	entryRevisionsLimit, err := strconv.Atoi(r.FormValue("entry_revisions_limit"))
+	if err != nil {
+		entryRevisionsLimit = model.DefaultEntryRevisionsLimit
+	}
+
 	return &SettingsForm{
-		Username:          r.FormValue("username"),
-		Password:          r.FormValue("password"),
-		Confirmation:      r.FormValue("confirmation"),
-		Theme:             r.FormValue("theme"),
-		Language:          r.FormValue("language"),
-		Timezone:          r.FormValue("timezone"),
-		EntryDirection:    r.FormValue("entry_direction"),
-		KeyboardShortcuts: r.FormValue("keyboard_shortcuts") == "1",
-		CustomCSS:         r.FormValue("custom_css"),
-		MercuryAPIURL:     r.FormValue("mercury_api_url"),
+		Username:            r.FormValue("username"),
+		Password:            r.FormValue("password"),
+		Confirmation:        r.FormValue("confirmation"),
+		Theme:               r.FormValue("theme"),
+		Language:            r.FormValue("language"),
+		Timezone:            r.FormValue("timezone"),
+		EntryDirection:      r.FormValue("entry_direction"),
+		KeyboardShortcuts:   r.FormValue("keyboard_shortcuts") == "1",
+		CustomCSS:           r.FormValue("custom_css"),
+		MercuryAPIURL:       r.FormValue("mercury_api_url"),
+		EntryRevisionsLimit: entryRevisionsLimit,
+		UnreadOnUpdate:      r.FormValue("unread_on_update") == "1",
 	}
 }
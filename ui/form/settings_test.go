@@ -2,6 +2,8 @@ package form // import "miniflux.app/ui/form"
 
 import (
 	"testing"
+
+	"miniflux.app/model"
 )
 
 func TestValid(t *testing.T) {
@@ -58,3 +60,28 @@ func TestConfirmationIncorrect(t *testing.T) {
 		t.Error("Validate should return an error")
 	}
 }
+
+func TestEntryRevisionsLimit(t *testing.T) {
+	settings := &SettingsForm{
+		Username:            "user",
+		Theme:               "default",
+		Language:            "en_US",
+		Timezone:            "UTC",
+		EntryDirection:      "asc",
+		EntryRevisionsLimit: 0,
+	}
+
+	if err := settings.Validate(); err != nil {
+		t.Errorf(`Disabling the revisions should be allowed: %v`, err)
+	}
+
+	settings.EntryRevisionsLimit = -1
+	if err := settings.Validate(); err == nil {
+		t.Error(`A negative number of revisions should be rejected`)
+	}
+
+	settings.EntryRevisionsLimit = model.MaxEntryRevisionsLimit + 1
+	if err := settings.Validate(); err == nil {
+		t.Error(`A number of revisions above the maximum should be rejected`)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+	"miniflux.app/timezone"
+)
+
+// smartFolderDateFormat is the format of the date inputs.
+const smartFolderDateFormat = "2006-01-02"
+
+// SmartFolderForm represents the form used to create or edit a smart folder.
+type SmartFolderForm struct {
+	Title           string
+	SearchQuery     string
+	FeedIDs         []int64
+	CategoryIDs     []int64
+	LabelIDs        []int64
+	Status          string
+	PublishedAfter  string
+	PublishedBefore string
+}
+
+// Validate makes sure the form values are valid.
+func (s SmartFolderForm) Validate() error {
+	if s.Title == "" {
+		return errors.NewLocalizedError("error.title_required")
+	}
+
+	switch s.Status {
+	case "", model.EntryStatusUnread, model.EntryStatusRead:
+	default:
+		return errors.NewLocalizedError("error.smart_folder_invalid_status")
+	}
+
+	after, errAfter := parseSmartFolderDate(s.PublishedAfter, time.UTC)
+	before, errBefore := parseSmartFolderDate(s.PublishedBefore, time.UTC)
+	if errAfter != nil || errBefore != nil {
+		return errors.NewLocalizedError("error.smart_folder_invalid_date")
+	}
+
+	if after != nil && before != nil && !after.Before(*before) {
+		return errors.NewLocalizedError("error.smart_folder_invalid_date_range")
+	}
+
+	return nil
+}
+
+// Merge updates the fields of the given smart folder, the dates are days of the given timezone.
+func (s SmartFolderForm) Merge(folder *model.SmartFolder, tz string) *model.SmartFolder {
+	location := timezone.Now(tz).Location()
+
+	folder.Title = s.Title
+	folder.SearchQuery = s.SearchQuery
+	folder.FeedIDs = s.FeedIDs
+	folder.CategoryIDs = s.CategoryIDs
+	folder.LabelIDs = s.LabelIDs
+	folder.Status = s.Status
+	folder.PublishedAfter, _ = parseSmartFolderDate(s.PublishedAfter, location)
+	folder.PublishedBefore, _ = parseSmartFolderDate(s.PublishedBefore, location)
+	return folder
+}
+
+// HasFeed returns true if the feed is selected.
+func (s SmartFolderForm) HasFeed(feedID int64) bool {
+	return containsID(s.FeedIDs, feedID)
+}
+
+// HasCategory returns true if the category is selected.
+func (s SmartFolderForm) HasCategory(categoryID int64) bool {
+	return containsID(s.CategoryIDs, categoryID)
+}
+
+// HasLabel returns true if the label is selected.
+func (s SmartFolderForm) HasLabel(labelID int64) bool {
+	return containsID(s.LabelIDs, labelID)
+}
+
+// NewSmartFolderForm returns a new SmartFolderForm.
+func NewSmartFolderForm(r *http.Request) *SmartFolderForm {
+	return &SmartFolderForm{
+		Title:           strings.TrimSpace(r.FormValue("title")),
+		SearchQuery:     strings.TrimSpace(r.FormValue("search_query")),
+		FeedIDs:         formValueIDs(r, "feed_id"),
+		CategoryIDs:     formValueIDs(r, "category_id"),
+		LabelIDs:        formValueIDs(r, "label_id"),
+		Status:          r.FormValue("status"),
+		PublishedAfter:  strings.TrimSpace(r.FormValue("published_after")),
+		PublishedBefore: strings.TrimSpace(r.FormValue("published_before")),
+	}
+}
+
+// NewSmartFolderFormFromFolder returns a SmartFolderForm initialized with the values of the smart folder.
+func NewSmartFolderFormFromFolder(folder *model.SmartFolder, tz string) *SmartFolderForm {
+	return &SmartFolderForm{
+		Title:           folder.Title,
+		SearchQuery:     folder.SearchQuery,
+		FeedIDs:         folder.FeedIDs,
+		CategoryIDs:     folder.CategoryIDs,
+		LabelIDs:        folder.LabelIDs,
+		Status:          folder.Status,
+		PublishedAfter:  formatSmartFolderDate(folder.PublishedAfter, tz),
+		PublishedBefore: formatSmartFolderDate(folder.PublishedBefore, tz),
+	}
+}
+
+func parseSmartFolderDate(value string, location *time.Location) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.ParseInLocation(smartFolderDateFormat, value, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
+
+func formatSmartFolderDate(date *time.Time, tz string) string {
+	if date == nil {
+		return ""
+	}
+
+	return timezone.Convert(tz, *date).Format(smartFolderDateFormat)
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, value := range ids {
+		if value == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestSmartFolderFormValidation(t *testing.T) {
+	scenarios := []struct {
+		form  SmartFolderForm
+		valid bool
+	}{
+		{SmartFolderForm{Title: "News"}, true},
+		{SmartFolderForm{}, false},
+		{SmartFolderForm{Title: "News", Status: model.EntryStatusRead}, true},
+		{SmartFolderForm{Title: "News", Status: model.EntryStatusRemoved}, false},
+		{SmartFolderForm{Title: "News", PublishedAfter: "2020-01-01", PublishedBefore: "2020-02-01"}, true},
+		{SmartFolderForm{Title: "News", PublishedAfter: "2020-02-01", PublishedBefore: "2020-01-01"}, false},
+		{SmartFolderForm{Title: "News", PublishedAfter: "01/02/2020"}, false},
+	}
+
+	for i, scenario := range scenarios {
+		err := scenario.form.Validate()
+		if scenario.valid && err != nil {
+			t.Errorf(`Scenario #%d should be valid: %v`, i, err)
+		}
+
+		if !scenario.valid && err == nil {
+			t.Errorf(`Scenario #%d should be invalid`, i)
+		}
+	}
+}
+
+func TestSmartFolderForm(t *testing.T) {
+	values := url.Values{
+		"title":           {" News "},
+		"search_query":    {"golang"},
+		"feed_id":         {"3", "invalid"},
+		"category_id":     {"5"},
+		"published_after": {"2020-01-01"},
+	}
+	r, err := http.NewRequest("POST", "/folder/save", strings.NewReader(values.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form := NewSmartFolderForm(r)
+	if !form.HasFeed(3) || form.HasFeed(5) || !form.HasCategory(5) || form.HasLabel(1) {
+		t.Errorf(`Unexpected selection: %+v`, form)
+	}
+
+	folder := form.Merge(&model.SmartFolder{}, "Europe/Paris")
+	if folder.Title != "News" || folder.SearchQuery != "golang" || !reflect.DeepEqual(folder.FeedIDs, []int64{3}) {
+		t.Errorf(`Unexpected smart folder: %v`, folder)
+	}
+
+	if folder.PublishedAfter == nil || folder.PublishedAfter.UTC().Format("2006-01-02 15:04") != "2019-12-31 23:00" {
+		t.Errorf(`The start of the date range should be midnight in the user timezone: %v`, folder.PublishedAfter)
+	}
+
+	if folder.PublishedBefore != nil {
+		t.Errorf(`The end of the date range should be empty`)
+	}
+
+	if edit := NewSmartFolderFormFromFolder(folder, "Europe/Paris"); edit.PublishedAfter != "2020-01-01" {
+		t.Errorf(`Unexpected date in the edit form: %q`, edit.PublishedAfter)
+	}
+}
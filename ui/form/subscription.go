@@ -9,6 +9,9 @@ import (
 	"strconv"
 
 	"miniflux.app/errors"
+	"miniflux.app/model"
+	"miniflux.app/reader/rewrite"
+	"miniflux.app/reader/scraper"
 )
 
 // SubscriptionForm represents the subscription form.
@@ -21,6 +24,76 @@ type SubscriptionForm struct {
 	Password     string
 	ScraperRules string
 	RewriteRules string
+
+	SourceType      string
+	ItemSelector    string
+	TitleSelector   string
+	LinkSelector    string
+	DateSelector    string
+	SummarySelector string
+	Preview         bool
+
+	MonitorSelector  string
+	MonitorThreshold int
+}
+
+// IsWebPage returns true if the subscription is a plain web page.
+func (s *SubscriptionForm) IsWebPage() bool {
+	return s.SourceType == model.FeedSourceTypeWebPage
+}
+
+// WebPageRules returns the CSS selectors used to extract entries from a web page.
+func (s *SubscriptionForm) WebPageRules() *model.WebPageRules {
+	return &model.WebPageRules{
+		ItemSelector:    s.ItemSelector,
+		TitleSelector:   s.TitleSelector,
+		LinkSelector:    s.LinkSelector,
+		DateSelector:    s.DateSelector,
+		SummarySelector: s.SummarySelector,
+	}
+}
+
+// IsPageMonitor returns true if the subscription monitors the changes of a web page.
+func (s *SubscriptionForm) IsPageMonitor() bool {
+	return s.SourceType == model.FeedSourceTypePageMonitor
+}
+
+// IsLocal returns true if the subscription is a local file or command.
+func (s *SubscriptionForm) IsLocal() bool {
+	return model.IsLocalSourceType(s.SourceType)
+}
+
+// PageMonitorSettings returns the settings used to compare the page with its previous snapshot.
+func (s *SubscriptionForm) PageMonitorSettings() *model.PageMonitorSettings {
+	return &model.PageMonitorSettings{
+		Selector:  s.MonitorSelector,
+		Threshold: s.MonitorThreshold,
+	}
+}
+
+// FeedCreationRequest returns the parameters used to create the feed.
+func (s *SubscriptionForm) FeedCreationRequest(feedURL string) *model.FeedCreationRequest {
+	request := &model.FeedCreationRequest{
+		FeedURL:      feedURL,
+		CategoryID:   s.CategoryID,
+		UserAgent:    s.UserAgent,
+		Username:     s.Username,
+		Password:     s.Password,
+		Crawler:      s.Crawler,
+		ScraperRules: s.ScraperRules,
+		RewriteRules: s.RewriteRules,
+		SourceType:   s.SourceType,
+	}
+
+	if s.IsWebPage() {
+		request.SourceSettings.WebPage = s.WebPageRules()
+	}
+
+	if s.IsPageMonitor() {
+		request.SourceSettings.PageMonitor = s.PageMonitorSettings()
+	}
+
+	return request
 }
 
 // Validate makes sure the form values are valid.
@@ -29,6 +102,26 @@ func (s *SubscriptionForm) Validate() error {
 		return errors.NewLocalizedError("error.feed_mandatory_fields")
 	}
 
+	if s.IsWebPage() {
+		if err := s.WebPageRules().Validate(); err != nil {
+			return errors.NewLocalizedError("error.web_page_rules_invalid")
+		}
+	}
+
+	if s.IsPageMonitor() {
+		if err := s.PageMonitorSettings().Validate(); err != nil {
+			return errors.NewLocalizedError("error.page_monitor_threshold_invalid")
+		}
+	}
+
+	if _, err := scraper.ParseRules(s.ScraperRules); err != nil {
+		return errors.NewLocalizedError("error.scraper_rules_invalid")
+	}
+
+	if err := rewrite.ValidateRules(s.RewriteRules); err != nil {
+		return errors.NewLocalizedError("error.rewrite_rules_invalid")
+	}
+
 	return nil
 }
 
@@ -39,6 +132,11 @@ func NewSubscriptionForm(r *http.Request) *SubscriptionForm {
 		categoryID = 0
 	}
 
+	monitorThreshold, err := strconv.Atoi(r.FormValue("monitor_threshold"))
+	if err != nil {
+		monitorThreshold = 0
+	}
+
 	return &SubscriptionForm{
 		URL:          r.FormValue("url"),
 		Crawler:      r.FormValue("crawler") == "1",
@@ -48,5 +146,16 @@ func NewSubscriptionForm(r *http.Request) *SubscriptionForm {
 		Password:     r.FormValue("feed_password"),
 		ScraperRules: r.FormValue("scraper_rules"),
 		RewriteRules: r.FormValue("rewrite_rules"),
+
+		SourceType:      r.FormValue("source_type"),
+		ItemSelector:    r.FormValue("item_selector"),
+		TitleSelector:   r.FormValue("title_selector"),
+		LinkSelector:    r.FormValue("link_selector"),
+		DateSelector:    r.FormValue("date_selector"),
+		SummarySelector: r.FormValue("summary_selector"),
+		Preview:         r.FormValue("preview") == "1",
+
+		MonitorSelector:  r.FormValue("monitor_selector"),
+		MonitorThreshold: monitorThreshold,
 	}
 }
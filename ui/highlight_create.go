@@ -0,0 +1,42 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+)
+
+func (h *handler) createHighlight(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	highlight, err := decodeHighlightPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if !h.store.EntryExists(userID, entryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	highlight.UserID = userID
+	highlight.EntryID = entryID
+	if err := highlight.ValidateHighlight(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.CreateHighlight(highlight); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, highlight)
+}
@@ -0,0 +1,56 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) updateHighlight(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	highlight, err := h.store.Highlight(user.ID, request.RouteInt64Param(r, "highlightID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if highlight == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	highlightForm := form.NewHighlightForm(r)
+
+	if err := h.store.UpdateHighlight(highlightForm.Merge(highlight)); err != nil {
+		logger.Error("[UI:UpdateHighlight] %v", err)
+
+		sess := session.New(h.store, request.SessionID(r))
+		view := view.New(h.tpl, r, sess)
+		view.Set("form", highlightForm)
+		view.Set("highlight", highlight)
+		view.Set("menu", "highlights")
+		view.Set("user", user)
+		view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+		view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+		view.Set("errorMessage", "error.unable_to_update_highlight")
+		html.OK(w, r, view.Render("edit_highlight"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "highlights"))
+}
@@ -10,6 +10,7 @@ import (
 	"miniflux.app/config"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/model"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -53,9 +54,21 @@ func (h *handler) showIntegrationPage(w http.ResponseWriter, r *http.Request) {
 		PocketConsumerKey:    integration.PocketConsumerKey,
 	}
 
+	var newsletterAddress string
+	if config.Opts.HasMailService() {
+		token, err := h.store.NewsletterToken(user.ID)
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		newsletterAddress = model.NewsletterAddress(token, config.Opts.MailDomain(), 0)
+	}
+
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", integrationForm)
+	view.Set("newsletterAddress", newsletterAddress)
 	view.Set("menu", "settings")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
@@ -0,0 +1,70 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) saveLabel(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	labels, err := h.store.Labels(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	labelForm := form.NewLabelForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", labelForm)
+	view.Set("labels", labels)
+	view.Set("total", len(labels))
+	view.Set("menu", "labels")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	if err := labelForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("labels"))
+		return
+	}
+
+	if h.store.AnotherLabelExists(user.ID, 0, labelForm.Title) {
+		view.Set("errorMessage", "error.label_already_exists")
+		html.OK(w, r, view.Render("labels"))
+		return
+	}
+
+	label := model.Label{
+		Title:  labelForm.Title,
+		UserID: user.ID,
+	}
+
+	if err := h.store.CreateLabel(&label); err != nil {
+		logger.Error("[UI:SaveLabel] %v", err)
+		view.Set("errorMessage", "error.unable_to_create_label")
+		html.OK(w, r, view.Render("labels"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "labels"))
+}
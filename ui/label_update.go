@@ -0,0 +1,68 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) updateLabel(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	label, err := h.store.Label(user.ID, request.RouteInt64Param(r, "labelID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if label == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	labelForm := form.NewLabelForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", labelForm)
+	view.Set("label", label)
+	view.Set("menu", "labels")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	if err := labelForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("edit_label"))
+		return
+	}
+
+	if h.store.AnotherLabelExists(user.ID, label.ID, labelForm.Title) {
+		view.Set("errorMessage", "error.label_already_exists")
+		html.OK(w, r, view.Render("edit_label"))
+		return
+	}
+
+	if err := h.store.UpdateLabel(labelForm.Merge(label)); err != nil {
+		logger.Error("[UI:UpdateLabel] %v", err)
+		view.Set("errorMessage", "error.unable_to_update_label")
+		html.OK(w, r, view.Render("edit_label"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "labels"))
+}
@@ -136,6 +136,7 @@ func (m *middleware) isPublicRoute(r *http.Request) bool {
 		"webManifest",
 		"robots",
 		"sharedEntry",
+		"newsletterImage",
 		"healthcheck":
 		return true
 	default:
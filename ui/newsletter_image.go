@@ -0,0 +1,38 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response"
+	"miniflux.app/http/response/html"
+)
+
+func (h *handler) showNewsletterImage(w http.ResponseWriter, r *http.Request) {
+	token := request.RouteStringParam(r, "token")
+	image, err := h.store.NewsletterImage(token)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if image == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	// The content type is given by the sender of the newsletter, the image must never be rendered as a document.
+	response.New(w, r).WithCaching(image.Token, 72*time.Hour, func(b *response.Builder) {
+		b.WithHeader("Content-Type", image.MimeType)
+		b.WithHeader("X-Content-Type-Options", "nosniff")
+		b.WithHeader("Content-Security-Policy", "default-src 'none'; sandbox")
+		b.WithBody(image.Content)
+		b.WithoutCompression()
+		b.Write()
+	})
+}
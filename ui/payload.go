@@ -12,22 +12,41 @@ import (
 	"miniflux.app/model"
 )
 
-func decodeEntryStatusPayload(r io.ReadCloser) (entryIDs []int64, status string, err error) {
+func decodeEntryStatusPayload(r io.ReadCloser) (entryIDs []int64, status string, clusters bool, err error) {
 	type payload struct {
 		EntryIDs []int64 `json:"entry_ids"`
 		Status   string  `json:"status"`
+		Clusters bool    `json:"clusters"`
 	}
 
 	var p payload
 	decoder := json.NewDecoder(r)
 	defer r.Close()
 	if err = decoder.Decode(&p); err != nil {
-		return nil, "", fmt.Errorf("invalid JSON payload: %v", err)
+		return nil, "", false, fmt.Errorf("invalid JSON payload: %v", err)
 	}
 
 	if err := model.ValidateEntryStatus(p.Status); err != nil {
-		return nil, "", err
+		return nil, "", false, err
 	}
 
-	return p.EntryIDs, p.Status, nil
+	return p.EntryIDs, p.Status, p.Clusters, nil
+}
+
+func decodeHighlightPayload(r io.ReadCloser) (*model.Highlight, error) {
+	type payload struct {
+		Quote  string `json:"quote"`
+		Prefix string `json:"prefix"`
+		Suffix string `json:"suffix"`
+		Note   string `json:"note"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	return &model.Highlight{Quote: p.Quote, Prefix: p.Prefix, Suffix: p.Suffix, Note: p.Note}, nil
 }
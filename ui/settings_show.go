@@ -27,14 +27,16 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	settingsForm := form.SettingsForm{
-		Username:          user.Username,
-		Theme:             user.Theme,
-		Language:          user.Language,
-		Timezone:          user.Timezone,
-		EntryDirection:    user.EntryDirection,
-		KeyboardShortcuts: user.KeyboardShortcuts,
-		CustomCSS:         user.Extra["custom_css"],
-		MercuryAPIURL:     user.MercuryAPIURL,
+		Username:            user.Username,
+		Theme:               user.Theme,
+		Language:            user.Language,
+		Timezone:            user.Timezone,
+		EntryDirection:      user.EntryDirection,
+		KeyboardShortcuts:   user.KeyboardShortcuts,
+		CustomCSS:           user.Extra["custom_css"],
+		MercuryAPIURL:       user.MercuryAPIURL,
+		EntryRevisionsLimit: user.EntryRevisionsLimit,
+		UnreadOnUpdate:      user.UnreadOnUpdate,
 	}
 
 	timezones, err := h.store.Timezones()
@@ -12,6 +12,7 @@ import (
 	"miniflux.app/http/response"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
+	"miniflux.app/reader/sanitizer"
 	"miniflux.app/storage"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -56,6 +57,9 @@ func (h *handler) sharedEntry(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Shared entries are public, the content is sanitized again in case the sanitizer policy changed since the entry was saved.
+		entry.Content = sanitizer.Sanitize(entry.URL, entry.Content)
+
 		sess := session.New(h.store, request.SessionID(r))
 		view := view.New(h.tpl, r, sess)
 		view.Set("entry", entry)
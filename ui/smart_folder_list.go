@@ -0,0 +1,61 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showSmartFolderListPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	folders, err := h.store.SmartFolders(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("folders", folders)
+	view.Set("total", len(folders))
+	view.Set("menu", "folders")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	html.OK(w, r, view.Render("smart_folders"))
+}
+
+func (h *handler) setSmartFolderFormData(v *view.View, userID int64) error {
+	feeds, err := h.store.Feeds(userID)
+	if err != nil {
+		return err
+	}
+
+	categories, err := h.store.Categories(userID)
+	if err != nil {
+		return err
+	}
+
+	labels, err := h.store.Labels(userID)
+	if err != nil {
+		return err
+	}
+
+	v.Set("feeds", feeds)
+	v.Set("categories", categories)
+	v.Set("labels", labels)
+	return nil
+}
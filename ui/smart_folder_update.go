@@ -0,0 +1,73 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) updateSmartFolder(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	folder, err := h.store.SmartFolder(user.ID, request.RouteInt64Param(r, "folderID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if folder == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	folderForm := form.NewSmartFolderForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", folderForm)
+	view.Set("folder", folder)
+	view.Set("menu", "folders")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	if err := h.setSmartFolderFormData(view, user.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if err := folderForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("edit_smart_folder"))
+		return
+	}
+
+	if h.store.AnotherSmartFolderExists(user.ID, folder.ID, folderForm.Title) {
+		view.Set("errorMessage", "error.smart_folder_already_exists")
+		html.OK(w, r, view.Render("edit_smart_folder"))
+		return
+	}
+
+	if err := h.store.UpdateSmartFolder(folderForm.Merge(folder, user.Timezone)); err != nil {
+		logger.Error("[UI:UpdateSmartFolder] %v", err)
+		view.Set("errorMessage", "error.unable_to_update_smart_folder")
+		html.OK(w, r, view.Render("edit_smart_folder"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "smartFolders"))
+}
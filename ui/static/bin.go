@@ -5,25 +5,25 @@ package static // import "miniflux.app/ui/static"
 var Binaries = map[string]string{
 	"favicon-16.png": `iVBORw0KGgoAAAANSUhEUgAAABAAAAAQCAAAAAA6mKC9AAAAAXNCSVQI5gpbmQAAAAlwSFlzAAAHYgAAB2IBOHqZ2wAAABl0RVh0U29mdHdhcmUAd3d3Lmlua3NjYXBlLm9yZ5vuPBoAAAAKdEVYdFRpdGxlAGljb27OjKq6AAAAfklEQVQY02P4jwYYsAn8+IcssC5Sm+VhurTi5L8TtaViP/9n+KbGwJCy3YyBMXZRNQNDK1CLKQPDy/+1DAyz/19nYPCGCfQxMEz4/5KBwQxJoA8kYEodgT+aDAx3/89iYGj4/5mRQesPw9ry8vKu/89rysvv/Z9SXr6OgaBvAUyyoQDU+m9nAAAAAElFTkSuQmCC`,
 	"favicon-32.png": `iVBORw0KGgoAAAANSUhEUgAAACAAAAAgCAAAAABWESUoAAAAAXNCSVQI5gpbmQAAAAlwSFlzAAAOxAAADsQBlSsOGwAAABl0RVh0U29mdHdhcmUAd3d3Lmlua3NjYXBlLm9yZ5vuPBoAAAAKdEVYdFRpdGxlAGljb27OjKq6AAAA20lEQVQ4y2P4TwAwDCIFT37jVPDt9Jxce0GGx//fTXLR0A3bDxK9UWyropd2A6zgMDMDGDxeJAymGSf+/5rLCGZyHQWbcEsfzIsRym9RADFYL1lqNJSwgZg6/8BuyAArsHv5//8RMIuzDOieKjDzLFhBJph9GWjcX3YQyxZk9U6w4HQkBVdAwhIglgmIdQYsWIWuQB7EUgaxLoMFc7EqkEcoyB5VQCsFCnAFVxAKPtqA2YtAwqbg9HALyHoJFrT9+J/hKCckyTF4A4VngzmMTUBmBBNY8VGGoZD1ADmAhZM2DZSEAAAAAElFTkSuQmCC`,
-	"favicon.ico":    `AAABAAIAEBAAAAEAIABoBAAAJgAAACAgAAABACAAqBAAAI4EAAAoAAAAEAAAACAAAAABACAAAAAAAAAEAABhBwAAYQcAAAAAAAAAAAAA//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////+tra3/d3d3/3d3d/93d3f/ioqK///////n5+f/fHx8/3d3d/93d3f/3t7e//////+UlJT/d3d3/3d3d/+urq7//Pz8/ykpKf8AAAD/AAAA/93d3f//////mpqa/wAAAP8AAAD/gICA///////z8/P/AQEB/wAAAP8qKir//Pz8//////81NTX/AAAA/wAAAP/p6en//////46Ojv8AAAD/AAAA/46Ojv//////6enp/wAAAP8AAAD/NTU1////////////NTU1/wAAAP8AAAD/6enp//////+Ojo7/AAAA/wAAAP+Ojo7//////+np6f8AAAD/AAAA/zU1Nf///////////zU1Nf8AAAD/AAAA/+np6f//////jo6O/wAAAP8AAAD/jo6O///////p6en/AAAA/wAAAP81NTX///////////81NTX/AAAA/wAAAP/p6en//////46Ojv8AAAD/AAAA/46Ojv//////6enp/wAAAP8AAAD/NTU1////////////NTU1/wAAAP8AAAD/6enp//////+Ojo7/AAAA/wAAAP+Ojo7//////+np6f8AAAD/AAAA/zU1Nf///////////zU1Nf8AAAD/AAAA/+np6f//////jo6O/wAAAP8AAAD/kJCQ///////p6en/AAAA/wAAAP82Njb///////////81NTX/AAAA/wAAAP/p6en//////319ff8AAAD/AAAA/5ubm///////19fX/wAAAP8AAAD/S0tL///////29vb/JiYm/wAAAP8AAAD/ZGRk/7e3t/82Njb/AAAA/wEBAf9dXV3/oqKi/3t7e/8AAAD/AAAA/4WFhf//////rq6u/1lZWf8rKyv/BAQE/+Hh4f9nZ2f/Gxsb/yEhIf+Tk5P//f39/5GRkf8rKyv/Ghoa/11dXf/z8/P///////////////////////j4+P/+/v7//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////wAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAoAAAAIAAAAEAAAAABACAAAAAAAAAQAADDDgAAww4AAAAAAAAAAAAA/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////8XFxf8JCQn/AAAA/wAAAP8AAAD/AAAA/wAAAP8AAAD/AAAA/0tLS/////////////////+bm5v/CQkJ/wAAAP8AAAD/AAAA/wAAAP8BAQH/goKC/////////////////1hYWP8CAgL/AAAA/wAAAP8AAAD/AAAA/wkJCf/FxcX///////Hx8f88PDz/AAAA/wAAAP8AAAD/AAAA/wAAAP+ioqL//////////////////////zU1Nf8AAAD/AAAA/wAAAP8AAAD/CQkJ/9ra2v/////////////////p6en/AAAA/wAAAP8AAAD/AAAA/wAAAP89PT3/8fHx/////////////////2lpaf8AAAD/AAAA/wAAAP8AAAD/AAAA/9TU1P//////////////////////ICAg/wAAAP8AAAD/AAAA/wAAAP8fHx///////////////////////9TU1P8AAAD/AAAA/wAAAP8AAAD/AAAA/2tra///////////////////////aWlp/wAAAP8AAAD/AAAA/wAAAP8AAAD/1NTU//////////////////////8fHx//AAAA/wAAAP8AAAD/AAAA/x8fH///////////////////////09PT/wAAAP8AAAD/AAAA/wAAAP8AAAD/a2tr//////////////////////9paWn/AAAA/wAAAP8AAAD/AAAA/wAAAP/U1NT//////////////////////x8fH/8AAAD/AAAA/wAAAP8AAAD/Hx8f///////////////////////T09P/AAAA/wAAAP8AAAD/AAAA/wAAAP9ra2v//////////////////////2lpaf8AAAD/AAAA/wAAAP8AAAD/AAAA/9TU1P//////////////////////Hx8f/wAAAP8AAAD/AAAA/wAAAP8fHx///////////////////////9PT0/8AAAD/AAAA/wAAAP8AAAD/AAAA/2tra///////////////////////aWlp/wAAAP8AAAD/AAAA/wAAAP8AAAD/1NTU//////////////////////8fHx//AAAA/wAAAP8AAAD/AAAA/x8fH///////////////////////09PT/wAAAP8AAAD/AAAA/wAAAP8AAAD/a2tr//////////////////////9paWn/AAAA/wAAAP8AAAD/AAAA/wAAAP/U1NT//////////////////////x8fH/8AAAD/AAAA/wAAAP8AAAD/Hx8f///////////////////////T09P/AAAA/wAAAP8AAAD/AAAA/wAAAP9ra2v//////////////////////2lpaf8AAAD/AAAA/wAAAP8AAAD/AAAA/9TU1P//////////////////////Hx8f/wAAAP8AAAD/AAAA/wAAAP8fHx///////////////////////9PT0/8AAAD/AAAA/wAAAP8AAAD/AAAA/2tra///////////////////////aWlp/wAAAP8AAAD/AAAA/wAAAP8AAAD/1NTU//////////////////////8fHx//AAAA/wAAAP8AAAD/AAAA/x8fH///////////////////////09PT/wAAAP8AAAD/AAAA/wAAAP8AAAD/a2tr//////////////////////9paWn/AAAA/wAAAP8AAAD/AAAA/wAAAP/U1NT//////////////////////x8fH/8AAAD/AAAA/wAAAP8AAAD/Hx8f///////////////////////T09P/AAAA/wAAAP8AAAD/AAAA/wAAAP9ra2v//////////////////////2lpaf8AAAD/AAAA/wAAAP8AAAD/AAAA/9TU1P//////////////////////Hx8f/wAAAP8AAAD/AAAA/wAAAP8fHx///////////////////////9PT0/8AAAD/AAAA/wAAAP8AAAD/AAAA/2tra///////////////////////aWlp/wAAAP8AAAD/AAAA/wAAAP8AAAD/1NTU//////////////////////8fHx//AAAA/wAAAP8AAAD/AAAA/x8fH///////////////////////09PT/wAAAP8AAAD/AAAA/wAAAP8AAAD/a2tr//////////////////////9paWn/AAAA/wAAAP8AAAD/AAAA/wAAAP/U1NT//////////////////////x8fH/8AAAD/AAAA/wAAAP8AAAD/Hx8f///////////////////////T09P/AAAA/wAAAP8AAAD/AAAA/wAAAP9ra2v//////////////////////2lpaf8AAAD/AAAA/wAAAP8AAAD/AAAA/9TU1P//////////////////////Hx8f/wAAAP8AAAD/AAAA/wAAAP8jIyP//////////////////////9PT0/8AAAD/AAAA/wAAAP8AAAD/AAAA/21tbf//////////////////////aWlp/wAAAP8AAAD/AAAA/wAAAP8AAAD/1NTU//////////////////////8YGBj/AAAA/wAAAP8AAAD/AAAA/zQ0NP//////////////////////zMzM/wAAAP8AAAD/AAAA/wAAAP8AAAD/enp6//////////////////////9paWn/AAAA/wAAAP8AAAD/AAAA/wAAAP/T09P//////////////////f39/wcHB/8AAAD/AAAA/wAAAP8AAAD/PT09//////////////////////+5ubn/AAAA/wAAAP8AAAD/AAAA/wAAAP+Xl5f//////////////////////2hoaP8AAAD/AAAA/wAAAP8AAAD/AAAA/z4+Pv/p6en////////////ExMT/AAAA/wAAAP8AAAD/AAAA/wAAAP8JCQn/dnZ2//v7+////////////3p6ev8AAAD/AAAA/wAAAP8AAAD/AAAA/83Nzf/////////////////a2tr/Ly8v/wAAAP8AAAD/AAAA/wAAAP8AAAD/XFxc/xISEv9vb2//hISE/yAgIP8AAAD/AAAA/wAAAP8AAAD/BQUF/9LS0v85OTn/KCgo/4CAgP90dHT/BgYG/wAAAP8AAAD/AAAA/wAAAP8sLCz//v7+////////////w8PD/wMDA/8AAAD/AAAA/wAAAP8AAAD/AAAA/wAAAP/j4+P/oqKi/xMTE/8AAAD/AAAA/wAAAP8AAAD/AAAA/wEBAf+RkZH///////X19f9tbW3/AQEB/wAAAP8AAAD/AAAA/wAAAP8AAAD/CgoK/8XFxf//////////////////////9vb2/8vLy/+cnJz/bW1t/z8/P/8RERH/AAAA/+Pj4///////7u7u/5KSkv9ERET/KCgo/y0tLf9WVlb/v7+////////////////////////Y2Nj/c3Nz/z09Pf8kJCT/Li4u/2ZmZv/Y2Nj////////////////////////////////////////////////////////////k5OT/+/v7/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////wAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA`,
-	"icon-120.png":   `iVBORw0KGgoAAAANSUhEUgAAAHgAAAB4CAAAAAAcD2kOAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAdNJREFUaN7t2m15wyAQB3AcICESkIAEJERCJCAhEpCABCQgAQlI6LakTwrkeGn3dO22Px+X436Bg33IlV1eNBhgwIABAwYMGDBgwP8DDlariekfhd26SLaPFI5mkXz7K5eLrUzVctpnCrWGcXhfZjJusFEsG1zHcrafeR4jzACcLJOAzXR+xvO0jpjOZGjDnsibwF7ST+ekDjMdwn0TdqwFL7WnN9nyWggpD8FesPpY9/lLI0Q0a+yXqQI73kjK+FcNY+vVGHUlWfdwaHMcE1Hb7MOdJPmSPPauE5FaXK/OVihD5Q1XV9lYO4ZrD9aV7TxurCdkvbnyOEKEPD0Gi9B9tXRNgXjsH4HntEJx4MbMA3s9AM95CFVCkS/InCPU/XB5CYkLK4pD6weK3IdlMWPth1yITfk+7Ab+MxFH3z0DPi1HvhGs/xYcXwU7wIABAwYMGDBgwIABAwYMGDBgwIABvzkcnwLPAx+jB2DVbTAUMNlTs/2v4GXHlNg47qpwNJWOqbS95XwuKGuHWDKNiRQcVKs5qEKzx7K1O9b2rhRp+t3UcxUrDUzZ79glaR6Bo+zCF81H4eiaI/8GH6iQoovXSYMfoAAGDBgwYMCAAQMG/IvhD0bIrCTvw1ZZAAAAAElFTkSuQmCC`,
-	"icon-128.png":   `iVBORw0KGgoAAAANSUhEUgAAAIAAAACACAAAAADmVT4XAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAg1JREFUeNrt2kGVxCAMBuA4QAISKiESkFAJlYAEJFRCJSChEioBCbOH6c60NEAeb3e7+/bn2kz6QQNzCPS4eRAAAAAAAAAAAAAAAAAAAMBvB8Tg/XwPYFu8s0RExOcH6+wdM/Pol6346xSDZ2bmqRZVAsQwMb3HETA7c3hC1ifJHoZjEA0haQHvaUuA5A1dxoUQ3TXI+DZgPU/7ChBfT0R2PU2hkGTYWoDCD1+AaEvPzUHgSRPVAUgTUTv3OlSihlQHjDVANTPRsFeoqUa5RhHmtXsAxHpmIl9f/n3E5i7YnAiYP5eameVKMOm9hJaZTa2aq+eAlH/PNj6/tLwaYX+/mZ5RQYxa24BiKbrXNtqk3PZZpNOr0BYpydQNMMf/g7mEtMePLBW17QWcz5qH0eyzVfcNVIB8B4ulSqOinEIXwOYnSNC8X2SOXYDL7okS4HLOedVG7AKsqlNGrNWvATyk1Itqnb4R4FWACMC/B4S7AR4AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAgD8JuHb7jKY1vXUBrKofxeUOemOdpp7mNXHebxRb/eOmmUx+nUTTvieicU7NhhjRELYmk8idcmkuMFwXLxXvE4RWFeYdXM0VDmEv+OpNhnqL99y46QQU7gdkgOLFExGwxvrIC1GOzwtRzpUaN6l+cgAAAAAAAAAAAAAAAAAAANwO+ADfTiYsfv4fIwAAAABJRU5ErkJggg==`,
-	"icon-152.png":   `iVBORw0KGgoAAAANSUhEUgAAAJgAAACYCAAAAAA9qX/9AAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAlVJREFUeNrt21G15CAMBuA4QEIlIAEJlVAJSEACEiqhEpCABCQgoftw792dtgSSbXfOnLN/XienfECgfcjQ/qFBgAEGGGCAAQYYYIABBhhggAEGGGCA/XewtHrn3EfBSgqzpa+4/FhTDLNzzs0hpn38JHlyF5ZW7wy9xPHn7C0dwsXKPmtbpmOy7STzsNdlasNqmKgRS3O0sphmctHALsvUgtXApZh4ZTmSJ7dhpbVMDVg0naTlVIae5MkcLBEJYNn1s+yhtkw/eXkOFmkUfwaryzDZPwSrjsbxUzrZCpI3SfFnP/VheRIMRaaItvErpio7lTnwY+9JNNT3Zq6yXArSe6yyG/B7KBu2lJLnmeXFZUNKaVvYZCO+YLfRevxcjHXml2w9Jxd+vlJY/wy41/uaOwrm2zUXwU7MT8BOl3XpH4JN9tx6H2byKbd3Udnzu9Bq9lIFs1VRj+6SHDXXvwZ2de1V87JJkpfY38Ban3eTahVGny0PwtzgtSQqsvQPYF5znztF9d+FBa70W7BZMYu3woLi2+cubNWcNM0s7sKS5qQBBhhggAEGGGCAAQYYYIABBhhggAEGGGCAAQYYYIABBhhggAEGGGCAAfYszL4T1urvi4pZkHxezdYrtktx08yiSGB877lpLRmbvCpmYdMI1mmkJSKy8TI3vvnaLJdV47uSJ595WFkELcvTqaeq27lsTv9y8PIna7rTm5Va+33hxz0qRvzk27A9Gzls0Bn+LKxfAeeqzrPRwz4qAAMMMMAAAwwwwAADDDDAAAMMMMAAA+wN8Qu1n9Ebrh+1gQAAAABJRU5ErkJggg==`,
-	"icon-167.png":   `iVBORw0KGgoAAAANSUhEUgAAAKcAAACnCAAAAABRfRMwAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAqdJREFUeNrt21GZ6yAQBtBxgAQkIAEJkRAJSIgEJERCJCABCUhAAvuwbTdpYRh2k3u73/68FsJhAkMeOlR+RyM44YQTTjjhhBNOOOGEE0444YQTTjjhhBNOOEsJIby5M23LpImI3tcZvLP0aJUlrM7q26/KujUJ1mzN/Xl2WsJPnY8gUtMZ3XMHIuNz+5m1AWoO33Ueg9hw5lVX+5BaGtLV1AeQDaPOWhDrzkW1u6mtpmSeSy7LnakRxJpz03xH9/KKOgNMEjsDkdCZugui+TBPnrsDVDzduap+z0NEg2SAyec680Si9rVHF9mASejM3gic0chmJXWLT7bCAbSJz3vqUIWvcL9Fxesi0iP5M3Gvtaz7DWU7kUqllLhbl7aWX+U6lOeZ2R9M7T+P52b5gD6Yat5yKaWk+TsBrTr7297s7g/P9Mt35v6K4nbONuJcewfEH7ozAbrfWfMh5WzSrNtxdvKTfbo5cu9kvdyi7b2iznO+LtmN5m8moOEsZ+VIRn5dlWum/QaWk5zVzKFocMe1U589x+nKYCKrH4xF9OX4A+dyzrSBvxuucjIptP4NFEcP0jnOMH5+B2eA89b8qHO60JlGBzAZwl7oLHD+K6cbdar/47SjToITTjjhhBNOOOGEE0444YQTTjjhhBNOOOGEE0444YQTTjjhhBNOOOGEE0444YTzrzn9L3EuJzrNsDNLnZmpuNPD09ar3phQeKFzZeuaplR7DFOKUi/m5Epd5th3bnO3XMv4ODQtkfEvi0vsAO0i50yzsKZMP//7tFsysy9Y6u2Uzyl82xlI3Ab2W/0AdueylzizGXT2ioEucpbs1JiTLzdmnTmIW/Ua41otTURuQOzn+TdscMIJJ5xwwgknnHDCCSeccMIJJ5xwwgknnHDCKWkftXgSUlCOnPYAAAAASUVORK5CYII=`,
-	"icon-180.png":   `iVBORw0KGgoAAAANSUhEUgAAALQAAAC0CAAAAAAYplnuAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAthJREFUeNrt3F2Z4yAYhmEcIAEJkYCESEBCJEQCEpAQCUiIhEhAQmdn2u1PAgRoOtte+3wnPckLdwmBnBBx+sASoEGDBg0aNGjQoEGDBg0aNGjQoEGDBg0aNGjQH4z2dtBaz5+CXqaxV+JcPvGP/Hgu55fyYfD2HLJ+PhL9Pbzivrbo4Ez3cIns7S58WYeEHubn0ffDm0YH14lYaZdpOth4SI2hHb0Z3hR6MTJ1nVBTajRMMiNkAVsUDm8cHUz2StGHSvLPf53r0FaL/bqhR7l3bbcF7Iekq0KXmK9o3xVcLFdqr0q6cK9CD6Ko5MMyUhhKLatPoueusHvR3eb1UhySoRztukL0JEVxDX9brwn1NavHYrsC9Chq6jKtXVXI163Ty87z7U+mqnuhf5ptClVsLiH7iPtb98o4/6dGtT9q5n6P/w7t3VJfuyNmb/+1M31r12b7N3fmu93dysZZHUf7kvv3MBT5CXvdOdVDaM6GlsPR0q4i2RW4v/yOq1D2Btmj0d1mGML+ehZ5qVBNj2Ib2kTWflOxy5Q9POFQtIllJtEQys7q6Ui0STTVEspNqvFAdGot6hrM2bcdfSA6NQB9brFJ9T+2hA5EZx+qVP+2JXQg2ha8NNX15F+Pbur/rdGp5Wtu6em30OnlC/QboDXo47bRt0Zr0KBBgwYNGjRo0KBBgwYNGjRo0KBBgwYNGjRo0KBBgwYNGjRo0KBBgwYNGjRo0KBBvx49fyL69I/R2cNj5tfQXRV6aGkqe3gseaQpe1Q9VKB9/kCka+nfJAB9Q2iLDm73nL7x9fdHqPgnR/JHimU0JNbiXpSUNFOoexJS7qUhJCrGalXDBlDwRQlVN6kuoTmH1jVoXT/UInJcr+S4t38huuTTAbWz+vXok1P16NOknkLPvqLi5yL3moiGlp1QKNlc3rxAgwYNGjRo0KBBgwYNGjRo0KBBgwYNGjRo0KBB/6foL8fRkN8fNJAgAAAAAElFTkSuQmCC`,
-	"icon-192.png":   `iVBORw0KGgoAAAANSUhEUgAAAMAAAADACAAAAAB3tzPbAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAxNJREFUeNrt3F+d4yAQwPFxgIRIQAISIgEJlYCESEDCSkBCJEQCEvYebq/dayAMNO1ne/fjOZPMl/8vGfl88yYAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAD41wFrSml7T0BOwVsREQlvB1hjcEauLVQfcl9PGDeHlPUd4+xX4OR8SGcCbt0uR4AcvZFdc0vTsF7sPk7mmE8A3HX7ASB5qTV/tF5ymGpxxm8PAHJavJV6+xsQjx4VudQ6c/OHcSYMAdaP4CZptO+vTrb19LQWO8m34sRu3QAnqnYDbKqIuP/wYhRxZu0FSCcgGF3AvWC1uri24CGANo2dYFHHNQWPAKKRoUSy08eJ7QPMeoBiEZYTWU1XYOjbhXKcdS/NVmQokWg6A7fec0BhCL3dKCLm93EQe+PEDxxkrfTC9wemOYQQZqMagu/zzvoQQrCPDUHlKtHop1u25rJerwWmPQS3/KflT17b/MgQVABZOS1C7hi3+Bmu6ceOXdXkgcucKv/7W05qbER/xtUsd1/z3Qf5GQCzv7brdla3m9T5eOzmpwBcYWA31bgVPheOQ54BKK8sxeFQnA8NeTofUNkZwujdxo6exoOA2rJKo3ezy/F0PRtQf+Ho3fL44DGvA0yDUyGNHsZnA9zwVBhcxWcD/HMAy8sAwxv66Nz7MQA3eJ97MSCfvnjOBsTRxfhTAOk5gOndAQIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAKkCsBvpzAY2yDHYUEKqBjV8At05Aq4BI9ZfChnweBcQ+wNoqUuWriTSKYyyDQ1f9F7xUHOZDUeDGfVRe2Iq9lSTpGrpq5bax8jwiIqb8RkXxJls0KCo6lL44ViDpmsp+W9x0gVv3sqssorESVUe7ykUVuJfnSRX4fICu9FPqXsYvA+hqgJWO5DQ9DnCdrbw9r6EZWN4V46UZqL1KvE0DAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAD/F+AXnrvx6UGyTmYAAAAASUVORK5CYII=`,
-	"icon-512.png":   `iVBORw0KGgoAAAANSUhEUgAAAgAAAAIACAAAAADRE4smAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAClRJREFUeNrt3GGZm0oYgFEcIAEJSEBCJCAhEpAQCUhAAhKQgAQk7N27be/T2+5mA8wME3Len/3TL8MZICTZ4k0vXWEJABAAAkAACAABIAAEgAAQAAJAAAgAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgAAQAAJAAAgAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgAAQAAJAAAgAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgAAQAAJAAAgAACwBAAJAAAgAASAABIAAEAACQAAIAAEgAASAAAjePHaXuvjZ6Ii8EoCxvzZl8XsAvAiA/217AF4KwN/bPjyAZRzH7vf6cZyOX+y/xrq9/8MLAfhq2wcEMA7d5WtezeVdwjHXuntjVc21G+dzA5j67usFCANgvLV18Uh1288JN313qR4aq2y6YTkhgPcVePDA7AAwdk2xqqrt4y/2MlzrdWMV9XU8EYBHt/0+AEt/Wfmf/OwS1cDU1ZumKsp2eH4A67b9DgDvR7/YURtpv03Xas9Y1XV+XgAbtv1mAPuO/o+17sPf63bV7rGKZnw+AJu3/TYA87UsQlR2Qa8EfVOEqRmeCMC+bb8FwHgpglUGOwssITZ/2rNAkcG2Xw8g2Db7dSEIstRzW4Ydq7jMOQMItu3XAuirIniXZf/hDz9VUd6yBBB4268DMFZR/udyyO/wf1wH5rwAxNj2awCMTbT/u112XPvjrUk5ZAJgefhZazwA0fbZj8dwWzdbX0ZdlOvxAKauqYoUjUftsx+bbdO94NTEXpXLcjSArkjUvSMwJDC4/g3hck2wLPUCwNwkGWCtgKFMMlY8Ac8CoCsTTbBKwHJJtTDRBDwHgKkuktVnc/OXRMBTAOiKlPXZbf8fAl4WQMrt/9GQ09X/t+cULwrglnqhi/KB748mufn/o9srAlia9Av9wPU2+Vnpo+n1AIzlEQtdXDK6+/utank1AF1xUHfPtkt71FjXowCM9READjn9/7wNuPOxwFwfNlaEX049+mHQfKtTv8CpOm6hi+brzVAeOFb4i8CKj4NTGBgPv85+dxHoD52q6I79PkB0A+Pxl/9fF4HP91p79FjzoQCiGxhzWejPn7skfviX4nHQlq+ERTTwE8BSF8f39w1XFmNNxwN4C/el/M8Xfc5hof++D5zyHOugbwXHeRb6AWAqiyz64xSQ6VjHfS08xvuh8aGFri9dN37UxfyKarPy+FfNr7FuMce65ALgbYoC4P5Cl5fbnxfBnb/EfPSpxP0jWndjsrHmXABEuFUf755XvvzldF/F3mt3j/9XPzQf4jzKbLMBEP4UMN55ztLc+6ZGnA+N5weOf9XNiT81KpdcALyFf9D1Nftv3v5Eeedw/fb4N/0BY92yAZDs05r2++tejI+Oym+O/yO/343wSKvKBkCi53UP/kg2goD+3vGvhqMWacoFQJIH9vWjb3wjPKj7953g8vkd5uM/3A0/VvtCAMoVn39FeFQzf8Vqxc+1ljLSpekVAKz7dfQtwv3Wp8d/3V+UGIKPNbwKgLX3u8FvA+p25/aPM1b7GgDq1Tc7Y4qbkvW/2J/yvQbkDGDLX2xI8MZ0y58RaLO9BmQMoN8y0RD9+G/6Zu6YxRRPBaDc+F438jdJt/5JudBvBauzA9j851ri/mRrK8vw70/mcwNoNn/eMcU8/tt/oz1ncYF8GgB73uVEvAbs+Vs9dUZLlD2AXS8u3jWgzWmpqhMD2Hd2i/Y+YN+N95TpTUCGAHZe3Zac3pVG/O5Ef1YAu19YneXxD/6I6npSAPthX7M8/sHXqjkngAAntj7L4x/+3uScAAK8pDHL4x/+ScAEQKLbrUD3W5lOdUIAVZ5X2zzvAs8IIPRKB/rwvc3S5RkBdDkOFX6sEoBUU41BlrrP0uUZAQR/GxDm6zdjli4BeKAuCIA5S5dnBBD804BQT11zdHlGAMFXuskTQAvAcwGocxzrlACaLKcKPlYFwGsDKABItdJzkLGC/5XJBYBEAMI8CcryAdUpAXQAABC0W55j9QAkGqs771gAAABAomfBtxzHAiDdo8Axx7EAAAAAAAB4SgA1AJ835LjSMX6wAMATrTQAAAAAAAAAAAAAAAAAAMD+JgBeG8AIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABEWekpxFgLAN83n3al/13s0wForfShYw1HA2hyfEkxVvoWZKw69Fjd0QDKIsOXFANmm+dYl4MBTMGPf1HnudJVkLGCXzHLgwGEvwUIdBfY5XkTEH6s/lAAEU4AgU62ffgz0xJgrCH4WNVyIICpjAEgAOoYDwKKSwABc5EfzM0Alq6IVIg77ghjVQHeoETYMmV/CIC5K4toNfvvA5oYc9X93u12iTFWtWusLQDmW13E7bJ3t13jzFW2wy4Dt0jr1W43UOR39H+wbofMbgL+w7ljw035jVXkePT/e01zTlfbEAaq7MYqcj36Py+73cbP4droOJeMLk07xioyPvp7LgZjihPUktM1YONYjwEYjzr6v17UnNfJdvuz+Ca3sR4D0BUHt/5JbJ9krhzPTOvGOi2ANKeAtzxPAQCk2mvPP9Z5AUS/494GILexTgxgqbMEkOIiAEAqAc8/1pkBJFjqbWM1AKQBEH/wrWOVAKQB8Da3OQLIaKyzA3g/4d4uZXYA3sfq8xjrwUfB3cHt/IrIPEZ6Bc8/Vpgf4+lpAwAAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgAAQAAJAAAgAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgAAQAAJAAAgAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgAAQAAJAAAgAASAABIAAEAACQAAIAAEgAASAABAAAAgAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgADQU/cPa/In9oheDS0AAAAASUVORK5CYII=`,
+	"favicon.ico": `AAABAAIAEBAAAAEAIABoBAAAJgAAACAgAAABACAAqBAAAI4EAAAoAAAAEAAAACAAAAABACAAAAAAAAAEAABhBwAAYQcAAAAAAAAAAAAA//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////+tra3/d3d3/3d3d/93d3f/ioqK///////n5+f/fHx8/3d3d/93d3f/3t7e//////+UlJT/d3d3/3d3d/+urq7//Pz8/ykpKf8AAAD/AAAA/93d3f//////mpqa/wAAAP8AAAD/gICA///////z8/P/AQEB/wAAAP8qKir//Pz8//////81NTX/AAAA/wAAAP/p6en//////46Ojv8AAAD/AAAA/46Ojv//////6enp/wAAAP8AAAD/NTU1////////////NTU1/wAAAP8AAAD/6enp//////+Ojo7/AAAA/wAAAP+Ojo7//////+np6f8AAAD/AAAA/zU1Nf///////////zU1Nf8AAAD/AAAA/+np6f//////jo6O/wAAAP8AAAD/jo6O///////p6en/AAAA/wAAAP81NTX///////////81NTX/AAAA/wAAAP/p6en//////46Ojv8AAAD/AAAA/46Ojv//////6enp/wAAAP8AAAD/NTU1////////////NTU1/wAAAP8AAAD/6enp//////+Ojo7/AAAA/wAAAP+Ojo7//////+np6f8AAAD/AAAA/zU1Nf///////////zU1Nf8AAAD/AAAA/+np6f//////jo6O/wAAAP8AAAD/kJCQ///////p6en/AAAA/wAAAP82Njb///////////81NTX/AAAA/wAAAP/p6en//////319ff8AAAD/AAAA/5ubm///////19fX/wAAAP8AAAD/S0tL///////29vb/JiYm/wAAAP8AAAD/ZGRk/7e3t/82Njb/AAAA/wEBAf9dXV3/oqKi/3t7e/8AAAD/AAAA/4WFhf//////rq6u/1lZWf8rKyv/BAQE/+Hh4f9nZ2f/Gxsb/yEhIf+Tk5P//f39/5GRkf8rKyv/Ghoa/11dXf/z8/P///////////////////////j4+P/+/v7//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////wAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAoAAAAIAAAAEAAAAABACAAAAAAAAAQAADDDgAAww4AAAAAAAAAAAAA/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////8XFxf8JCQn/AAAA/wAAAP8AAAD/AAAA/wAAAP8AAAD/AAAA/0tLS/////////////////+bm5v/CQkJ/wAAAP8AAAD/AAAA/wAAAP8BAQH/goKC/////////////////1hYWP8CAgL/AAAA/wAAAP8AAAD/AAAA/wkJCf/FxcX///////Hx8f88PDz/AAAA/wAAAP8AAAD/AAAA/wAAAP+ioqL//////////////////////zU1Nf8AAAD/AAAA/wAAAP8AAAD/CQkJ/9ra2v/////////////////p6en/AAAA/wAAAP8AAAD/AAAA/wAAAP89PT3/8fHx/////////////////2lpaf8AAAD/AAAA/wAAAP8AAAD/AAAA/9TU1P//////////////////////ICAg/wAAAP8AAAD/AAAA/wAAAP8fHx///////////////////////9TU1P8AAAD/AAAA/wAAAP8AAAD/AAAA/2tra///////////////////////aWlp/wAAAP8AAAD/AAAA/wAAAP8AAAD/1NTU//////////////////////8fHx//AAAA/wAAAP8AAAD/AAAA/x8fH///////////////////////09PT/wAAAP8AAAD/AAAA/wAAAP8AAAD/a2tr//////////////////////9paWn/AAAA/wAAAP8AAAD/AAAA/wAAAP/U1NT//////////////////////x8fH/8AAAD/AAAA/wAAAP8AAAD/Hx8f///////////////////////T09P/AAAA/wAAAP8AAAD/AAAA/wAAAP9ra2v//////////////////////2lpaf8AAAD/AAAA/wAAAP8AAAD/AAAA/9TU1P//////////////////////Hx8f/wAAAP8AAAD/AAAA/wAAAP8fHx///////////////////////9PT0/8AAAD/AAAA/wAAAP8AAAD/AAAA/2tra///////////////////////aWlp/wAAAP8AAAD/AAAA/wAAAP8AAAD/1NTU//////////////////////8fHx//AAAA/wAAAP8AAAD/AAAA/x8fH///////////////////////09PT/wAAAP8AAAD/AAAA/wAAAP8AAAD/a2tr//////////////////////9paWn/AAAA/wAAAP8AAAD/AAAA/wAAAP/U1NT//////////////////////x8fH/8AAAD/AAAA/wAAAP8AAAD/Hx8f///////////////////////T09P/AAAA/wAAAP8AAAD/AAAA/wAAAP9ra2v//////////////////////2lpaf8AAAD/AAAA/wAAAP8AAAD/AAAA/9TU1P//////////////////////Hx8f/wAAAP8AAAD/AAAA/wAAAP8fHx///////////////////////9PT0/8AAAD/AAAA/wAAAP8AAAD/AAAA/2tra///////////////////////aWlp/wAAAP8AAAD/AAAA/wAAAP8AAAD/1NTU//////////////////////8fHx//AAAA/wAAAP8AAAD/AAAA/x8fH///////////////////////09PT/wAAAP8AAAD/AAAA/wAAAP8AAAD/a2tr//////////////////////9paWn/AAAA/wAAAP8AAAD/AAAA/wAAAP/U1NT//////////////////////x8fH/8AAAD/AAAA/wAAAP8AAAD/Hx8f///////////////////////T09P/AAAA/wAAAP8AAAD/AAAA/wAAAP9ra2v//////////////////////2lpaf8AAAD/AAAA/wAAAP8AAAD/AAAA/9TU1P//////////////////////Hx8f/wAAAP8AAAD/AAAA/wAAAP8fHx///////////////////////9PT0/8AAAD/AAAA/wAAAP8AAAD/AAAA/2tra///////////////////////aWlp/wAAAP8AAAD/AAAA/wAAAP8AAAD/1NTU//////////////////////8fHx//AAAA/wAAAP8AAAD/AAAA/x8fH///////////////////////09PT/wAAAP8AAAD/AAAA/wAAAP8AAAD/a2tr//////////////////////9paWn/AAAA/wAAAP8AAAD/AAAA/wAAAP/U1NT//////////////////////x8fH/8AAAD/AAAA/wAAAP8AAAD/Hx8f///////////////////////T09P/AAAA/wAAAP8AAAD/AAAA/wAAAP9ra2v//////////////////////2lpaf8AAAD/AAAA/wAAAP8AAAD/AAAA/9TU1P//////////////////////Hx8f/wAAAP8AAAD/AAAA/wAAAP8jIyP//////////////////////9PT0/8AAAD/AAAA/wAAAP8AAAD/AAAA/21tbf//////////////////////aWlp/wAAAP8AAAD/AAAA/wAAAP8AAAD/1NTU//////////////////////8YGBj/AAAA/wAAAP8AAAD/AAAA/zQ0NP//////////////////////zMzM/wAAAP8AAAD/AAAA/wAAAP8AAAD/enp6//////////////////////9paWn/AAAA/wAAAP8AAAD/AAAA/wAAAP/T09P//////////////////f39/wcHB/8AAAD/AAAA/wAAAP8AAAD/PT09//////////////////////+5ubn/AAAA/wAAAP8AAAD/AAAA/wAAAP+Xl5f//////////////////////2hoaP8AAAD/AAAA/wAAAP8AAAD/AAAA/z4+Pv/p6en////////////ExMT/AAAA/wAAAP8AAAD/AAAA/wAAAP8JCQn/dnZ2//v7+////////////3p6ev8AAAD/AAAA/wAAAP8AAAD/AAAA/83Nzf/////////////////a2tr/Ly8v/wAAAP8AAAD/AAAA/wAAAP8AAAD/XFxc/xISEv9vb2//hISE/yAgIP8AAAD/AAAA/wAAAP8AAAD/BQUF/9LS0v85OTn/KCgo/4CAgP90dHT/BgYG/wAAAP8AAAD/AAAA/wAAAP8sLCz//v7+////////////w8PD/wMDA/8AAAD/AAAA/wAAAP8AAAD/AAAA/wAAAP/j4+P/oqKi/xMTE/8AAAD/AAAA/wAAAP8AAAD/AAAA/wEBAf+RkZH///////X19f9tbW3/AQEB/wAAAP8AAAD/AAAA/wAAAP8AAAD/CgoK/8XFxf//////////////////////9vb2/8vLy/+cnJz/bW1t/z8/P/8RERH/AAAA/+Pj4///////7u7u/5KSkv9ERET/KCgo/y0tLf9WVlb/v7+////////////////////////Y2Nj/c3Nz/z09Pf8kJCT/Li4u/2ZmZv/Y2Nj////////////////////////////////////////////////////////////k5OT/+/v7/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////wAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA`,
+	"icon-120.png": `iVBORw0KGgoAAAANSUhEUgAAAHgAAAB4CAAAAAAcD2kOAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAdNJREFUaN7t2m15wyAQB3AcICESkIAEJERCJCAhEpCABCQgAQlI6LakTwrkeGn3dO22Px+X436Bg33IlV1eNBhgwIABAwYMGDBgwP8DDlariekfhd26SLaPFI5mkXz7K5eLrUzVctpnCrWGcXhfZjJusFEsG1zHcrafeR4jzACcLJOAzXR+xvO0jpjOZGjDnsibwF7ST+ekDjMdwn0TdqwFL7WnN9nyWggpD8FesPpY9/lLI0Q0a+yXqQI73kjK+FcNY+vVGHUlWfdwaHMcE1Hb7MOdJPmSPPauE5FaXK/OVihD5Q1XV9lYO4ZrD9aV7TxurCdkvbnyOEKEPD0Gi9B9tXRNgXjsH4HntEJx4MbMA3s9AM95CFVCkS/InCPU/XB5CYkLK4pD6weK3IdlMWPth1yITfk+7Ab+MxFH3z0DPi1HvhGs/xYcXwU7wIABAwYMGDBgwIABAwYMGDBgwIABvzkcnwLPAx+jB2DVbTAUMNlTs/2v4GXHlNg47qpwNJWOqbS95XwuKGuHWDKNiRQcVKs5qEKzx7K1O9b2rhRp+t3UcxUrDUzZ79glaR6Bo+zCF81H4eiaI/8GH6iQoovXSYMfoAAGDBgwYMCAAQMG/IvhD0bIrCTvw1ZZAAAAAElFTkSuQmCC`,
+	"icon-128.png": `iVBORw0KGgoAAAANSUhEUgAAAIAAAACACAAAAADmVT4XAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAg1JREFUeNrt2kGVxCAMBuA4QAISKiESkFAJlYAEJFRCJSChEioBCbOH6c60NEAeb3e7+/bn2kz6QQNzCPS4eRAAAAAAAAAAAAAAAAAAAMBvB8Tg/XwPYFu8s0RExOcH6+wdM/Pol6346xSDZ2bmqRZVAsQwMb3HETA7c3hC1ifJHoZjEA0haQHvaUuA5A1dxoUQ3TXI+DZgPU/7ChBfT0R2PU2hkGTYWoDCD1+AaEvPzUHgSRPVAUgTUTv3OlSihlQHjDVANTPRsFeoqUa5RhHmtXsAxHpmIl9f/n3E5i7YnAiYP5eameVKMOm9hJaZTa2aq+eAlH/PNj6/tLwaYX+/mZ5RQYxa24BiKbrXNtqk3PZZpNOr0BYpydQNMMf/g7mEtMePLBW17QWcz5qH0eyzVfcNVIB8B4ulSqOinEIXwOYnSNC8X2SOXYDL7okS4HLOedVG7AKsqlNGrNWvATyk1Itqnb4R4FWACMC/B4S7AR4AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAgD8JuHb7jKY1vXUBrKofxeUOemOdpp7mNXHebxRb/eOmmUx+nUTTvieicU7NhhjRELYmk8idcmkuMFwXLxXvE4RWFeYdXM0VDmEv+OpNhnqL99y46QQU7gdkgOLFExGwxvrIC1GOzwtRzpUaN6l+cgAAAAAAAAAAAAAAAAAAANwO+ADfTiYsfv4fIwAAAABJRU5ErkJggg==`,
+	"icon-152.png": `iVBORw0KGgoAAAANSUhEUgAAAJgAAACYCAAAAAA9qX/9AAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAlVJREFUeNrt21G15CAMBuA4QEIlIAEJlVAJSEACEiqhEpCABCQgoftw792dtgSSbXfOnLN/XienfECgfcjQ/qFBgAEGGGCAAQYYYIABBhhggAEGGGCA/XewtHrn3EfBSgqzpa+4/FhTDLNzzs0hpn38JHlyF5ZW7wy9xPHn7C0dwsXKPmtbpmOy7STzsNdlasNqmKgRS3O0sphmctHALsvUgtXApZh4ZTmSJ7dhpbVMDVg0naTlVIae5MkcLBEJYNn1s+yhtkw/eXkOFmkUfwaryzDZPwSrjsbxUzrZCpI3SfFnP/VheRIMRaaItvErpio7lTnwY+9JNNT3Zq6yXArSe6yyG/B7KBu2lJLnmeXFZUNKaVvYZCO+YLfRevxcjHXml2w9Jxd+vlJY/wy41/uaOwrm2zUXwU7MT8BOl3XpH4JN9tx6H2byKbd3Udnzu9Bq9lIFs1VRj+6SHDXXvwZ2de1V87JJkpfY38Ban3eTahVGny0PwtzgtSQqsvQPYF5znztF9d+FBa70W7BZMYu3woLi2+cubNWcNM0s7sKS5qQBBhhggAEGGGCAAQYYYIABBhhggAEGGGCAAQYYYIABBhhggAEGGGCAAfYszL4T1urvi4pZkHxezdYrtktx08yiSGB877lpLRmbvCpmYdMI1mmkJSKy8TI3vvnaLJdV47uSJ595WFkELcvTqaeq27lsTv9y8PIna7rTm5Va+33hxz0qRvzk27A9Gzls0Bn+LKxfAeeqzrPRwz4qAAMMMMAAAwwwwAADDDDAAAMMMMAAA+wN8Qu1n9Ebrh+1gQAAAABJRU5ErkJggg==`,
+	"icon-167.png": `iVBORw0KGgoAAAANSUhEUgAAAKcAAACnCAAAAABRfRMwAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAqdJREFUeNrt21GZ6yAQBtBxgAQkIAEJkRAJSIgEJERCJCABCUhAAvuwbTdpYRh2k3u73/68FsJhAkMeOlR+RyM44YQTTjjhhBNOOOGEE0444YQTTjjhhBNOOEsJIby5M23LpImI3tcZvLP0aJUlrM7q26/KujUJ1mzN/Xl2WsJPnY8gUtMZ3XMHIuNz+5m1AWoO33Ueg9hw5lVX+5BaGtLV1AeQDaPOWhDrzkW1u6mtpmSeSy7LnakRxJpz03xH9/KKOgNMEjsDkdCZugui+TBPnrsDVDzduap+z0NEg2SAyec680Si9rVHF9mASejM3gic0chmJXWLT7bCAbSJz3vqUIWvcL9Fxesi0iP5M3Gvtaz7DWU7kUqllLhbl7aWX+U6lOeZ2R9M7T+P52b5gD6Yat5yKaWk+TsBrTr7297s7g/P9Mt35v6K4nbONuJcewfEH7ozAbrfWfMh5WzSrNtxdvKTfbo5cu9kvdyi7b2iznO+LtmN5m8moOEsZ+VIRn5dlWum/QaWk5zVzKFocMe1U589x+nKYCKrH4xF9OX4A+dyzrSBvxuucjIptP4NFEcP0jnOMH5+B2eA89b8qHO60JlGBzAZwl7oLHD+K6cbdar/47SjToITTjjhhBNOOOGEE0444YQTTjjhhBNOOOGEE0444YQTTjjhhBNOOOGEE0444YTzrzn9L3EuJzrNsDNLnZmpuNPD09ar3phQeKFzZeuaplR7DFOKUi/m5Epd5th3bnO3XMv4ODQtkfEvi0vsAO0i50yzsKZMP//7tFsysy9Y6u2Uzyl82xlI3Ab2W/0AdueylzizGXT2ioEucpbs1JiTLzdmnTmIW/Ua41otTURuQOzn+TdscMIJJ5xwwgknnHDCCSeccMIJJ5xwwgknnHDCKWkftXgSUlCOnPYAAAAASUVORK5CYII=`,
+	"icon-180.png": `iVBORw0KGgoAAAANSUhEUgAAALQAAAC0CAAAAAAYplnuAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAthJREFUeNrt3F2Z4yAYhmEcIAEJkYCESEBCJEQCEpAQCUiIhEhAQmdn2u1PAgRoOtte+3wnPckLdwmBnBBx+sASoEGDBg0aNGjQoEGDBg0aNGjQoEGDBg0aNGjQH4z2dtBaz5+CXqaxV+JcPvGP/Hgu55fyYfD2HLJ+PhL9Pbzivrbo4Ez3cIns7S58WYeEHubn0ffDm0YH14lYaZdpOth4SI2hHb0Z3hR6MTJ1nVBTajRMMiNkAVsUDm8cHUz2StGHSvLPf53r0FaL/bqhR7l3bbcF7Iekq0KXmK9o3xVcLFdqr0q6cK9CD6Ko5MMyUhhKLatPoueusHvR3eb1UhySoRztukL0JEVxDX9brwn1NavHYrsC9Chq6jKtXVXI163Ty87z7U+mqnuhf5ptClVsLiH7iPtb98o4/6dGtT9q5n6P/w7t3VJfuyNmb/+1M31r12b7N3fmu93dysZZHUf7kvv3MBT5CXvdOdVDaM6GlsPR0q4i2RW4v/yOq1D2Btmj0d1mGML+ehZ5qVBNj2Ib2kTWflOxy5Q9POFQtIllJtEQys7q6Ui0STTVEspNqvFAdGot6hrM2bcdfSA6NQB9brFJ9T+2hA5EZx+qVP+2JXQg2ha8NNX15F+Pbur/rdGp5Wtu6em30OnlC/QboDXo47bRt0Zr0KBBgwYNGjRo0KBBgwYNGjRo0KBBgwYNGjRo0KBBgwYNGjRo0KBBgwYNGjRo0KBBvx49fyL69I/R2cNj5tfQXRV6aGkqe3gseaQpe1Q9VKB9/kCka+nfJAB9Q2iLDm73nL7x9fdHqPgnR/JHimU0JNbiXpSUNFOoexJS7qUhJCrGalXDBlDwRQlVN6kuoTmH1jVoXT/UInJcr+S4t38huuTTAbWz+vXok1P16NOknkLPvqLi5yL3moiGlp1QKNlc3rxAgwYNGjRo0KBBgwYNGjRo0KBBgwYNGjRo0KBB/6foL8fRkN8fNJAgAAAAAElFTkSuQmCC`,
+	"icon-192.png": `iVBORw0KGgoAAAANSUhEUgAAAMAAAADACAAAAAB3tzPbAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAAxNJREFUeNrt3F+d4yAQwPFxgIRIQAISIgEJlYCESEDCSkBCJEQCEvYebq/dayAMNO1ne/fjOZPMl/8vGfl88yYAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAD41wFrSml7T0BOwVsREQlvB1hjcEauLVQfcl9PGDeHlPUd4+xX4OR8SGcCbt0uR4AcvZFdc0vTsF7sPk7mmE8A3HX7ASB5qTV/tF5ymGpxxm8PAHJavJV6+xsQjx4VudQ6c/OHcSYMAdaP4CZptO+vTrb19LQWO8m34sRu3QAnqnYDbKqIuP/wYhRxZu0FSCcgGF3AvWC1uri24CGANo2dYFHHNQWPAKKRoUSy08eJ7QPMeoBiEZYTWU1XYOjbhXKcdS/NVmQokWg6A7fec0BhCL3dKCLm93EQe+PEDxxkrfTC9wemOYQQZqMagu/zzvoQQrCPDUHlKtHop1u25rJerwWmPQS3/KflT17b/MgQVABZOS1C7hi3+Bmu6ceOXdXkgcucKv/7W05qbER/xtUsd1/z3Qf5GQCzv7brdla3m9T5eOzmpwBcYWA31bgVPheOQ54BKK8sxeFQnA8NeTofUNkZwujdxo6exoOA2rJKo3ezy/F0PRtQf+Ho3fL44DGvA0yDUyGNHsZnA9zwVBhcxWcD/HMAy8sAwxv66Nz7MQA3eJ97MSCfvnjOBsTRxfhTAOk5gOndAQIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAKkCsBvpzAY2yDHYUEKqBjV8At05Aq4BI9ZfChnweBcQ+wNoqUuWriTSKYyyDQ1f9F7xUHOZDUeDGfVRe2Iq9lSTpGrpq5bax8jwiIqb8RkXxJls0KCo6lL44ViDpmsp+W9x0gVv3sqssorESVUe7ykUVuJfnSRX4fICu9FPqXsYvA+hqgJWO5DQ9DnCdrbw9r6EZWN4V46UZqL1KvE0DAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAD/F+AXnrvx6UGyTmYAAAAASUVORK5CYII=`,
+	"icon-512.png": `iVBORw0KGgoAAAANSUhEUgAAAgAAAAIACAAAAADRE4smAAAAGXRFWHRTb2Z0d2FyZQBBZG9iZSBJbWFnZVJlYWR5ccllPAAAClRJREFUeNrt3GGZm0oYgFEcIAEJSEBCJCAhEpAQCUhAAhKQgAQk7N27be/T2+5mA8wME3Len/3TL8MZICTZ4k0vXWEJABAAAkAACAABIAAEgAAQAAJAAAgAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgAAQAAJAAAgAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgAAQAAJAAAgAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgAAQAAJAAAgAACwBAAJAAAgAASAABIAAEAACQAAIAAEgAASAAAjePHaXuvjZ6Ii8EoCxvzZl8XsAvAiA/217AF4KwN/bPjyAZRzH7vf6cZyOX+y/xrq9/8MLAfhq2wcEMA7d5WtezeVdwjHXuntjVc21G+dzA5j67usFCANgvLV18Uh1288JN313qR4aq2y6YTkhgPcVePDA7AAwdk2xqqrt4y/2MlzrdWMV9XU8EYBHt/0+AEt/Wfmf/OwS1cDU1ZumKsp2eH4A67b9DgDvR7/YURtpv03Xas9Y1XV+XgAbtv1mAPuO/o+17sPf63bV7rGKZnw+AJu3/TYA87UsQlR2Qa8EfVOEqRmeCMC+bb8FwHgpglUGOwssITZ/2rNAkcG2Xw8g2Db7dSEIstRzW4Ydq7jMOQMItu3XAuirIniXZf/hDz9VUd6yBBB4268DMFZR/udyyO/wf1wH5rwAxNj2awCMTbT/u112XPvjrUk5ZAJgefhZazwA0fbZj8dwWzdbX0ZdlOvxAKauqYoUjUftsx+bbdO94NTEXpXLcjSArkjUvSMwJDC4/g3hck2wLPUCwNwkGWCtgKFMMlY8Ac8CoCsTTbBKwHJJtTDRBDwHgKkuktVnc/OXRMBTAOiKlPXZbf8fAl4WQMrt/9GQ09X/t+cULwrglnqhi/KB748mufn/o9srAlia9Av9wPU2+Vnpo+n1AIzlEQtdXDK6+/utank1AF1xUHfPtkt71FjXowCM9READjn9/7wNuPOxwFwfNlaEX049+mHQfKtTv8CpOm6hi+brzVAeOFb4i8CKj4NTGBgPv85+dxHoD52q6I79PkB0A+Pxl/9fF4HP91p79FjzoQCiGxhzWejPn7skfviX4nHQlq+ERTTwE8BSF8f39w1XFmNNxwN4C/el/M8Xfc5hof++D5zyHOugbwXHeRb6AWAqiyz64xSQ6VjHfS08xvuh8aGFri9dN37UxfyKarPy+FfNr7FuMce65ALgbYoC4P5Cl5fbnxfBnb/EfPSpxP0jWndjsrHmXABEuFUf755XvvzldF/F3mt3j/9XPzQf4jzKbLMBEP4UMN55ztLc+6ZGnA+N5weOf9XNiT81KpdcALyFf9D1Nftv3v5Eeedw/fb4N/0BY92yAZDs05r2++tejI+Oym+O/yO/343wSKvKBkCi53UP/kg2goD+3vGvhqMWacoFQJIH9vWjb3wjPKj7953g8vkd5uM/3A0/VvtCAMoVn39FeFQzf8Vqxc+1ljLSpekVAKz7dfQtwv3Wp8d/3V+UGIKPNbwKgLX3u8FvA+p25/aPM1b7GgDq1Tc7Y4qbkvW/2J/yvQbkDGDLX2xI8MZ0y58RaLO9BmQMoN8y0RD9+G/6Zu6YxRRPBaDc+F438jdJt/5JudBvBauzA9j851ri/mRrK8vw70/mcwNoNn/eMcU8/tt/oz1ncYF8GgB73uVEvAbs+Vs9dUZLlD2AXS8u3jWgzWmpqhMD2Hd2i/Y+YN+N95TpTUCGAHZe3Zac3pVG/O5Ef1YAu19YneXxD/6I6npSAPthX7M8/sHXqjkngAAntj7L4x/+3uScAAK8pDHL4x/+ScAEQKLbrUD3W5lOdUIAVZ5X2zzvAs8IIPRKB/rwvc3S5RkBdDkOFX6sEoBUU41BlrrP0uUZAQR/GxDm6zdjli4BeKAuCIA5S5dnBBD804BQT11zdHlGAMFXuskTQAvAcwGocxzrlACaLKcKPlYFwGsDKABItdJzkLGC/5XJBYBEAMI8CcryAdUpAXQAABC0W55j9QAkGqs771gAAABAomfBtxzHAiDdo8Axx7EAAAAAAAB4SgA1AJ835LjSMX6wAMATrTQAAAAAAAAAAAAAAAAAAMD+JgBeG8AIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABEWekpxFgLAN83n3al/13s0wForfShYw1HA2hyfEkxVvoWZKw69Fjd0QDKIsOXFANmm+dYl4MBTMGPf1HnudJVkLGCXzHLgwGEvwUIdBfY5XkTEH6s/lAAEU4AgU62ffgz0xJgrCH4WNVyIICpjAEgAOoYDwKKSwABc5EfzM0Alq6IVIg77ghjVQHeoETYMmV/CIC5K4toNfvvA5oYc9X93u12iTFWtWusLQDmW13E7bJ3t13jzFW2wy4Dt0jr1W43UOR39H+wbofMbgL+w7ljw035jVXkePT/e01zTlfbEAaq7MYqcj36Py+73cbP4droOJeMLk07xioyPvp7LgZjihPUktM1YONYjwEYjzr6v17UnNfJdvuz+Ca3sR4D0BUHt/5JbJ9krhzPTOvGOi2ANKeAtzxPAQCk2mvPP9Z5AUS/494GILexTgxgqbMEkOIiAEAqAc8/1pkBJFjqbWM1AKQBEH/wrWOVAKQB8Da3OQLIaKyzA3g/4d4uZXYA3sfq8xjrwUfB3cHt/IrIPEZ6Bc8/Vpgf4+lpAwAAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgAAQAAJAAAgAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgAAQAAJAAAgAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgAAQAAJAAAgAASAABIAAEAACQAAIAAEgAASAABAAAAgAASAABIAAEAACQAAIAAEgAASAABAAAkAACAABIAAEgADQU/cPa/In9oheDS0AAAAASUVORK5CYII=`,
 }
 
 var BinariesChecksums = map[string]string{
 	"favicon-16.png": "e19eaad2375e4d9cf0d71296719c807fff13a1e5fe9f4049b4848537c14d347b",
 	"favicon-32.png": "46e48ce5c6d7e0f30ddd07df3d2df901d273377d5af75f7917ac4aa2ea98e631",
-	"favicon.ico":    "98b08c2d66e3d4e4ac4b05a96098f7dba864f1468c9d1183c6a1ba9033bf3e26",
-	"icon-120.png":   "1ff2e1c2436c1ad3f9db0f2ebb34a0a5efd3993cdceb0635e07798579b1811ae",
-	"icon-128.png":   "9efe18e919fbc5ad325879900cf925f040c9aff8a95ac2eca67035759609a362",
-	"icon-152.png":   "368e44afe444ab57932998a4e9f40848a3efb0589a8b4632d73b555b78bd4dee",
-	"icon-167.png":   "a18954f5b8dbbd76797c98b61a36c52437ad19dac8b972b699d1833df7506645",
-	"icon-180.png":   "6a79d9573a9b180cd76e8b4882f7bd4a886e829b1972f437649731bcf871d6e8",
-	"icon-192.png":   "b0b36eceb45f494fa4151a7ffce7a31d603f50e1a58c98e335c12776d24e755f",
-	"icon-512.png":   "f60e49807df3011ebe01c1e9fb45303d91c21f6edb9d618832b28f970bc7fee6",
+	"favicon.ico": "98b08c2d66e3d4e4ac4b05a96098f7dba864f1468c9d1183c6a1ba9033bf3e26",
+	"icon-120.png": "1ff2e1c2436c1ad3f9db0f2ebb34a0a5efd3993cdceb0635e07798579b1811ae",
+	"icon-128.png": "9efe18e919fbc5ad325879900cf925f040c9aff8a95ac2eca67035759609a362",
+	"icon-152.png": "368e44afe444ab57932998a4e9f40848a3efb0589a8b4632d73b555b78bd4dee",
+	"icon-167.png": "a18954f5b8dbbd76797c98b61a36c52437ad19dac8b972b699d1833df7506645",
+	"icon-180.png": "6a79d9573a9b180cd76e8b4882f7bd4a886e829b1972f437649731bcf871d6e8",
+	"icon-192.png": "b0b36eceb45f494fa4151a7ffce7a31d603f50e1a58c98e335c12776d24e755f",
+	"icon-512.png": "f60e49807df3011ebe01c1e9fb45303d91c21f6edb9d618832b28f970bc7fee6",
 }
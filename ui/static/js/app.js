@@ -171,7 +171,9 @@ function markEntryAsRead(element) {
 function updateEntriesStatus(entryIDs, status, callback) {
     let url = document.body.dataset.entriesStatusUrl;
     let request = new RequestBuilder(url);
-    request.withBody({ entry_ids: entryIDs, status: status });
+    // Lists showing one entry per cluster change the status of the whole cluster.
+    let clusters = document.querySelector(".items[data-collapsed-clusters]") !== null;
+    request.withBody({ entry_ids: entryIDs, status: status, clusters: clusters });
     request.withCallback(callback);
     request.execute();
 
@@ -279,6 +281,129 @@ function handleFetchOriginalContent() {
     request.execute();
 }
 
+// Remember the passage selected in the entry content, the selection is lost when the link is clicked.
+function captureEntrySelection(element) {
+    let content = document.querySelector(".entry-content");
+    let selection = window.getSelection();
+    if (!content || selection.isCollapsed || selection.rangeCount === 0) {
+        return;
+    }
+
+    let range = selection.getRangeAt(0);
+    if (!content.contains(range.commonAncestorContainer)) {
+        return;
+    }
+
+    let text = range.toString();
+    let quote = text.trim();
+    if (quote === "") {
+        return;
+    }
+
+    let before = document.createRange();
+    before.setStart(content, 0);
+    before.setEnd(range.startContainer, range.startOffset);
+
+    let after = document.createRange();
+    after.setStart(range.endContainer, range.endOffset);
+    after.setEnd(content, content.childNodes.length);
+
+    let leading = text.substring(0, text.indexOf(quote));
+    let trailing = text.substring(leading.length + quote.length);
+
+    element.dataset.quote = quote;
+    element.dataset.prefix = (before.toString() + leading).slice(-32);
+    element.dataset.suffix = (trailing + after.toString()).substring(0, 32);
+}
+
+// Send the Ajax request to highlight the selected passage with an optional note.
+function handleHighlight(element) {
+    captureEntrySelection(element);
+
+    if (!element.dataset.quote) {
+        toast(element.dataset.toastEmpty);
+        return;
+    }
+
+    let highlight = {
+        quote: element.dataset.quote,
+        prefix: element.dataset.prefix,
+        suffix: element.dataset.suffix
+    };
+
+    delete element.dataset.quote;
+    delete element.dataset.prefix;
+    delete element.dataset.suffix;
+
+    let note = prompt(element.dataset.labelNote);
+    if (note === null) {
+        return;
+    }
+
+    highlight.note = note.trim();
+
+    let request = new RequestBuilder(element.dataset.highlightUrl);
+    request.withBody(highlight);
+    request.withCallback(() => window.location.reload());
+    request.execute();
+}
+
+// Mark the highlighted passages in the entry content.
+function markHighlights() {
+    let content = document.querySelector(".entry-content");
+    if (!content) {
+        return;
+    }
+
+    document.querySelectorAll(".entry-highlight[data-quote]").forEach((element) => {
+        markPassage(content, element.dataset.quote, element.dataset.prefix);
+    });
+}
+
+// Wrap the passage in a mark element, only the passages contained in a single text node are marked.
+// The occurrence preceded by the prefix is preferred to the first one.
+function markPassage(content, quote, prefix) {
+    let walker = document.createTreeWalker(content, NodeFilter.SHOW_TEXT);
+    let precedingText = "";
+    let found = null;
+
+    while (walker.nextNode()) {
+        let node = walker.currentNode;
+        let index = node.nodeValue.indexOf(quote);
+
+        while (index !== -1) {
+            let matchesPrefix = (precedingText + node.nodeValue.substring(0, index)).endsWith(prefix);
+            if (found === null || matchesPrefix) {
+                found = {node: node, index: index};
+            }
+
+            if (matchesPrefix) {
+                break;
+            }
+
+            index = node.nodeValue.indexOf(quote, index + 1);
+        }
+
+        if (found !== null && index !== -1) {
+            break;
+        }
+
+        precedingText = (precedingText + node.nodeValue).slice(-prefix.length - 1);
+    }
+
+    if (found === null) {
+        return;
+    }
+
+    let range = document.createRange();
+    range.setStart(found.node, found.index);
+    range.setEnd(found.node, found.index + quote.length);
+
+    let mark = document.createElement("mark");
+    mark.className = "entry-highlight-mark";
+    range.surroundContents(mark);
+}
+
 function openOriginalLink(openLinkInCurrentTab) {
     let entryLink = document.querySelector(".entry h1 a");
     if (entryLink !== null) {
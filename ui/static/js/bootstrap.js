@@ -44,6 +44,14 @@ document.addEventListener("DOMContentLoaded", function () {
     onClick("a[data-action=markPageAsRead]", () => handleConfirmationMessage(event.target, () => markPageAsRead()));
     onClick("a[data-toggle-status]", (event) => handleEntryStatus(event.target));
 
+    let highlightLink = document.querySelector("a[data-highlight-entry]");
+    if (highlightLink) {
+        highlightLink.addEventListener("mousedown", () => captureEntrySelection(highlightLink));
+        highlightLink.addEventListener("touchstart", () => captureEntrySelection(highlightLink));
+        onClick("a[data-highlight-entry]", () => handleHighlight(highlightLink));
+        markHighlights();
+    }
+
     onClick("a[data-confirm]", (event) => handleConfirmationMessage(event.target, (url, redirectURL) => {
         let request = new RequestBuilder(url);
 
@@ -47,17 +47,7 @@ func (h *handler) showChooseSubscriptionPage(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	feed, err := h.feedHandler.CreateFeed(
-		user.ID,
-		subscriptionForm.CategoryID,
-		subscriptionForm.URL,
-		subscriptionForm.Crawler,
-		subscriptionForm.UserAgent,
-		subscriptionForm.Username,
-		subscriptionForm.Password,
-		subscriptionForm.ScraperRules,
-		subscriptionForm.RewriteRules,
-	)
+	feed, err := h.feedHandler.CreateFeed(user.ID, subscriptionForm.FeedCreationRequest(subscriptionForm.URL))
 	if err != nil {
 		view.Set("form", subscriptionForm)
 		view.Set("errorMessage", err)
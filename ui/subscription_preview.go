@@ -0,0 +1,57 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/http/client"
+	"miniflux.app/http/response/html"
+	"miniflux.app/logger"
+	"miniflux.app/reader/browser"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/reader/webpage"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/view"
+)
+
+const maxPreviewEntries = 20
+
+// previewWebPage renders the subscription form with the items extracted from the web page.
+func (h *handler) previewWebPage(w http.ResponseWriter, r *http.Request, v *view.View, subscriptionForm *form.SubscriptionForm) {
+	v.Set("form", subscriptionForm)
+
+	request := client.New(subscriptionForm.URL)
+	request.WithCredentials(subscriptionForm.Username, subscriptionForm.Password)
+	request.WithUserAgent(subscriptionForm.UserAgent)
+	response, requestErr := browser.Exec(request)
+	if requestErr != nil {
+		v.Set("errorMessage", requestErr)
+		html.OK(w, r, v.Render("add_subscription"))
+		return
+	}
+
+	feed, parseErr := webpage.Parse(response.EffectiveURL, strings.NewReader(response.BodyAsString()), subscriptionForm.WebPageRules())
+	if parseErr != nil {
+		logger.Error("[UI:PreviewWebPage] %s", parseErr)
+		v.Set("errorMessage", parseErr)
+		html.OK(w, r, v.Render("add_subscription"))
+		return
+	}
+
+	entries := feed.Entries
+	if len(entries) > maxPreviewEntries {
+		entries = entries[:maxPreviewEntries]
+	}
+
+	for _, entry := range entries {
+		entry.Content = sanitizer.StripTags(entry.Content)
+	}
+
+	v.Set("previewEntries", entries)
+	v.Set("previewEnabled", true)
+	html.OK(w, r, v.Render("add_subscription"))
+}
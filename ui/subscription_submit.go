@@ -7,11 +7,13 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/config"
 	"miniflux.app/http/client"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/logger"
+	"miniflux.app/reader/gemini"
 	"miniflux.app/reader/subscription"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
@@ -49,6 +51,45 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if subscriptionForm.IsWebPage() {
+		if subscriptionForm.Preview {
+			h.previewWebPage(w, r, v, subscriptionForm)
+			return
+		}
+
+		feed, err := h.feedHandler.CreateFeed(user.ID, subscriptionForm.FeedCreationRequest(subscriptionForm.URL))
+		if err != nil {
+			v.Set("form", subscriptionForm)
+			v.Set("errorMessage", err)
+			html.OK(w, r, v.Render("add_subscription"))
+			return
+		}
+
+		html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feed.ID))
+		return
+	}
+
+	if subscriptionForm.IsLocal() && (!user.IsAdmin || !config.Opts.HasLocalSources()) {
+		v.Set("form", subscriptionForm)
+		v.Set("errorMessage", "error.local_sources_forbidden")
+		html.OK(w, r, v.Render("add_subscription"))
+		return
+	}
+
+	// Monitored pages, local sources and Gemini capsules are subscribed directly, without discovery.
+	if subscriptionForm.IsPageMonitor() || subscriptionForm.IsLocal() || gemini.IsGeminiURL(subscriptionForm.URL) {
+		feed, err := h.feedHandler.CreateFeed(user.ID, subscriptionForm.FeedCreationRequest(subscriptionForm.URL))
+		if err != nil {
+			v.Set("form", subscriptionForm)
+			v.Set("errorMessage", err)
+			html.OK(w, r, v.Render("add_subscription"))
+			return
+		}
+
+		html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feed.ID))
+		return
+	}
+
 	subscriptions, findErr := subscription.FindSubscriptions(
 		subscriptionForm.URL,
 		subscriptionForm.UserAgent,
@@ -72,17 +113,7 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 		v.Set("errorMessage", "error.subscription_not_found")
 		html.OK(w, r, v.Render("add_subscription"))
 	case n == 1:
-		feed, err := h.feedHandler.CreateFeed(
-			user.ID,
-			subscriptionForm.CategoryID,
-			subscriptions[0].URL,
-			subscriptionForm.Crawler,
-			subscriptionForm.UserAgent,
-			subscriptionForm.Username,
-			subscriptionForm.Password,
-			subscriptionForm.ScraperRules,
-			subscriptionForm.RewriteRules,
-		)
+		feed, err := h.feedHandler.CreateFeed(user.ID, subscriptionForm.FeedCreationRequest(subscriptions[0].URL))
 		if err != nil {
 			v.Set("form", subscriptionForm)
 			v.Set("errorMessage", err)
@@ -61,13 +61,17 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 
 	// Individual feed pages.
 	uiRouter.HandleFunc("/feed/{feedID}/refresh", handler.refreshFeed).Name("refreshFeed").Methods("GET")
+	uiRouter.HandleFunc("/feed/{feedID}/icon/refresh", handler.refreshFeedIcon).Name("refreshFeedIcon").Methods("POST")
+	uiRouter.HandleFunc("/feed/{feedID}/deduplicate", handler.deduplicateFeed).Name("deduplicateFeed").Methods("POST")
 	uiRouter.HandleFunc("/feed/{feedID}/edit", handler.showEditFeedPage).Name("editFeed").Methods("GET")
 	uiRouter.HandleFunc("/feed/{feedID}/remove", handler.removeFeed).Name("removeFeed").Methods("POST")
 	uiRouter.HandleFunc("/feed/{feedID}/update", handler.updateFeed).Name("updateFeed").Methods("POST")
+	uiRouter.HandleFunc("/feed/{feedID}/preview", handler.previewFeed).Name("previewFeed").Methods("POST")
 	uiRouter.HandleFunc("/feed/{feedID}/entries", handler.showFeedEntriesPage).Name("feedEntries").Methods("GET")
 	uiRouter.HandleFunc("/feed/{feedID}/entries/all", handler.showFeedEntriesAllPage).Name("feedEntriesAll").Methods("GET")
 	uiRouter.HandleFunc("/feed/{feedID}/entry/{entryID}", handler.showFeedEntryPage).Name("feedEntry").Methods("GET")
 	uiRouter.HandleFunc("/feed/icon/{iconID}", handler.showIcon).Name("icon").Methods("GET")
+	uiRouter.HandleFunc("/newsletter/image/{token}", handler.showNewsletterImage).Name("newsletterImage").Methods("GET")
 
 	// Category pages.
 	uiRouter.HandleFunc("/category/{categoryID}/entry/{entryID}", handler.showCategoryEntryPage).Name("categoryEntry").Methods("GET")
@@ -81,12 +85,41 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/category/{categoryID}/update", handler.updateCategory).Name("updateCategory").Methods("POST")
 	uiRouter.HandleFunc("/category/{categoryID}/remove", handler.removeCategory).Name("removeCategory").Methods("POST")
 
+	// Label pages.
+	uiRouter.HandleFunc("/labels", handler.showLabelListPage).Name("labels").Methods("GET")
+	uiRouter.HandleFunc("/label/save", handler.saveLabel).Name("saveLabel").Methods("POST")
+	uiRouter.HandleFunc("/label/{labelID}/entries", handler.showLabelEntriesPage).Name("labelEntries").Methods("GET")
+	uiRouter.HandleFunc("/label/{labelID}/entry/{entryID}", handler.showLabelEntryPage).Name("labelEntry").Methods("GET")
+	uiRouter.HandleFunc("/label/{labelID}/edit", handler.showEditLabelPage).Name("editLabel").Methods("GET")
+	uiRouter.HandleFunc("/label/{labelID}/update", handler.updateLabel).Name("updateLabel").Methods("POST")
+	uiRouter.HandleFunc("/label/{labelID}/remove", handler.removeLabel).Name("removeLabel").Methods("POST")
+
+	// Smart folder pages.
+	uiRouter.HandleFunc("/folders", handler.showSmartFolderListPage).Name("smartFolders").Methods("GET")
+	uiRouter.HandleFunc("/folder/create", handler.showCreateSmartFolderPage).Name("createSmartFolder").Methods("GET")
+	uiRouter.HandleFunc("/folder/save", handler.saveSmartFolder).Name("saveSmartFolder").Methods("POST")
+	uiRouter.HandleFunc("/folder/{folderID}/entries", handler.showSmartFolderEntriesPage).Name("smartFolderEntries").Methods("GET")
+	uiRouter.HandleFunc("/folder/{folderID}/entry/{entryID}", handler.showSmartFolderEntryPage).Name("smartFolderEntry").Methods("GET")
+	uiRouter.HandleFunc("/folder/{folderID}/edit", handler.showEditSmartFolderPage).Name("editSmartFolder").Methods("GET")
+	uiRouter.HandleFunc("/folder/{folderID}/update", handler.updateSmartFolder).Name("updateSmartFolder").Methods("POST")
+	uiRouter.HandleFunc("/folder/{folderID}/remove", handler.removeSmartFolder).Name("removeSmartFolder").Methods("POST")
+
+	// Highlight pages.
+	uiRouter.HandleFunc("/highlights", handler.showHighlightListPage).Name("highlights").Methods("GET")
+	uiRouter.HandleFunc("/highlight/{highlightID}/edit", handler.showEditHighlightPage).Name("editHighlight").Methods("GET")
+	uiRouter.HandleFunc("/highlight/{highlightID}/update", handler.updateHighlight).Name("updateHighlight").Methods("POST")
+	uiRouter.HandleFunc("/highlight/{highlightID}/remove", handler.removeHighlight).Name("removeHighlight").Methods("POST")
+
 	// Entry pages.
 	uiRouter.HandleFunc("/entry/status", handler.updateEntriesStatus).Name("updateEntriesStatus").Methods("POST")
 	uiRouter.HandleFunc("/entry/save/{entryID}", handler.saveEntry).Name("saveEntry").Methods("POST")
 	uiRouter.HandleFunc("/entry/download/{entryID}", handler.fetchContent).Name("fetchContent").Methods("POST")
 	uiRouter.HandleFunc("/proxy/{encodedURL}", handler.imageProxy).Name("proxy").Methods("GET")
 	uiRouter.HandleFunc("/entry/bookmark/{entryID}", handler.toggleBookmark).Name("toggleBookmark").Methods("POST")
+	uiRouter.HandleFunc("/entry/{entryID}/revisions", handler.showEntryRevisionsPage).Name("entryRevisions").Methods("GET")
+	uiRouter.HandleFunc("/entry/{entryID}/labels", handler.showEntryLabelsPage).Name("entryLabels").Methods("GET")
+	uiRouter.HandleFunc("/entry/{entryID}/labels", handler.updateEntryLabels).Name("updateEntryLabels").Methods("POST")
+	uiRouter.HandleFunc("/entry/{entryID}/highlights", handler.createHighlight).Name("createHighlight").Methods("POST")
 
 	// Share pages.
 	uiRouter.HandleFunc("/entry/share/{entryID}", handler.createSharedEntry).Name("shareEntry").Methods("GET")
@@ -115,6 +148,14 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/sessions", handler.showSessionsPage).Name("sessions").Methods("GET")
 	uiRouter.HandleFunc("/sessions/{sessionID}/remove", handler.removeSession).Name("removeSession").Methods("POST")
 
+	// Filter rules pages.
+	uiRouter.HandleFunc("/filters", handler.showFilterRulesPage).Name("filterRules").Methods("GET")
+	uiRouter.HandleFunc("/filters/create", handler.showCreateFilterRulePage).Name("createFilterRule").Methods("GET")
+	uiRouter.HandleFunc("/filters/save", handler.saveFilterRule).Name("saveFilterRule").Methods("POST")
+	uiRouter.HandleFunc("/filters/{ruleID}/edit", handler.showEditFilterRulePage).Name("editFilterRule").Methods("GET")
+	uiRouter.HandleFunc("/filters/{ruleID}/update", handler.updateFilterRule).Name("updateFilterRule").Methods("POST")
+	uiRouter.HandleFunc("/filters/{ruleID}/remove", handler.removeFilterRule).Name("removeFilterRule").Methods("POST")
+
 	// API Keys pages.
 	uiRouter.HandleFunc("/keys", handler.showAPIKeysPage).Name("apiKeys").Methods("GET")
 	uiRouter.HandleFunc("/keys/{keyID}/remove", handler.removeAPIKey).Name("removeAPIKey").Methods("POST")
@@ -26,8 +26,11 @@ func (h *handler) showUnreadPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	offset := request.QueryIntParam(r, "offset", 0)
+
+	// Near-duplicate entries are shown once, the counter and the pagination count the clusters.
 	builder := h.store.NewEntryQueryBuilder(user.ID)
 	builder.WithStatus(model.EntryStatusUnread)
+	builder.WithCollapsedClusters()
 	countUnread, err := builder.CountEntries()
 	if err != nil {
 		html.ServerError(w, r, err)
@@ -40,6 +43,7 @@ func (h *handler) showUnreadPage(w http.ResponseWriter, r *http.Request) {
 
 	builder = h.store.NewEntryQueryBuilder(user.ID)
 	builder.WithStatus(model.EntryStatusUnread)
+	builder.WithCollapsedClusters()
 	builder.WithOrder(model.DefaultSortingOrder)
 	builder.WithDirection(user.EntryDirection)
 	builder.WithOffset(offset)
@@ -50,7 +54,14 @@ func (h *handler) showUnreadPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	clusters, err := h.store.EntryClusters(user.ID, entries)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	view.Set("entries", entries)
+	view.Set("clusters", clusters)
 	view.Set("pagination", getPagination(route.Path(h.router, "unread"), countUnread, offset))
 	view.Set("menu", "unread")
 	view.Set("user", user)